@@ -67,6 +67,9 @@ type EndpointChangeRequest struct {
 	// MAC address
 	Mac string `json:"mac,omitempty"`
 
+	// Network namespace cookie of the endpoint
+	NetnsCookie int64 `json:"netns-cookie,omitempty"`
+
 	// Process ID of the workload belonging to this endpoint
 	Pid int64 `json:"pid,omitempty"`
 
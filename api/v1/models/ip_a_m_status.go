@@ -32,6 +32,13 @@ type IPAMStatus struct {
 	// ipv6
 	IPV6 []string `json:"ipv6"`
 
+	// Per-family usage of the allocation pools
+	PoolUsage map[string]IPAMPoolUsage `json:"pool-usage,omitempty"`
+
+	// IPs which have been released but are waiting out the configured
+	// grace period before they are returned to the pool
+	QuarantinedIps []string `json:"quarantined-ips"`
+
 	// status
 	Status string `json:"status,omitempty"`
 }
@@ -44,12 +51,39 @@ func (m *IPAMStatus) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validatePoolUsage(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
 	return nil
 }
 
+func (m *IPAMStatus) validatePoolUsage(formats strfmt.Registry) error {
+	if swag.IsZero(m.PoolUsage) { // not required
+		return nil
+	}
+
+	for k := range m.PoolUsage {
+
+		if val, ok := m.PoolUsage[k]; ok {
+			if err := val.Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("pool-usage" + "." + k)
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("pool-usage" + "." + k)
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 func (m *IPAMStatus) validateAllocations(formats strfmt.Registry) error {
 	if swag.IsZero(m.Allocations) { // not required
 		return nil
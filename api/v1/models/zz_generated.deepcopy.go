@@ -399,6 +399,22 @@ func (in *HubbleStatusObserver) DeepCopy() *HubbleStatusObserver {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPAMPoolUsage) DeepCopyInto(out *IPAMPoolUsage) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPAMPoolUsage.
+func (in *IPAMPoolUsage) DeepCopy() *IPAMPoolUsage {
+	if in == nil {
+		return nil
+	}
+	out := new(IPAMPoolUsage)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IPAMStatus) DeepCopyInto(out *IPAMStatus) {
 	*out = *in
@@ -419,6 +435,18 @@ func (in *IPAMStatus) DeepCopyInto(out *IPAMStatus) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.PoolUsage != nil {
+		in, out := &in.PoolUsage, &out.PoolUsage
+		*out = make(map[string]IPAMPoolUsage, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.QuarantinedIps != nil {
+		in, out := &in.QuarantinedIps, &out.QuarantinedIps
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -1135,6 +1135,42 @@ func init() {
         }
       }
     },
+    "/policy/impact": {
+      "get": {
+        "description": "Evaluates a proposed policy against the local endpoints and recently\nobserved flows without importing it, and reports which endpoints and\nflows would be affected by the change.\n",
+        "tags": [
+          "policy"
+        ],
+        "summary": "Evaluate the impact of a proposed policy",
+        "parameters": [
+          {
+            "$ref": "#/parameters/policy-rules"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success",
+            "schema": {
+              "$ref": "#/definitions/PolicyImpactResult"
+            }
+          },
+          "400": {
+            "description": "Invalid policy",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            },
+            "x-go-name": "InvalidPolicy"
+          },
+          "500": {
+            "description": "Policy impact evaluation failed",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            },
+            "x-go-name": "Failure"
+          }
+        }
+      }
+    },
     "/policy/resolve": {
       "get": {
         "tags": [
@@ -1988,12 +2024,20 @@ func init() {
       "description": "Response to a daemon configuration request. Contains the addressing\ninformation, k8s, node monitor and immutable and mutable configuration\nsettings.\n",
       "type": "object",
       "properties": {
+        "GROIPV4MaxSize": {
+          "description": "Maximum IPv4 GRO size on workload facing devices",
+          "type": "integer"
+        },
         "GROMaxSize": {
-          "description": "Maximum GRO size on workload facing devices",
+          "description": "Maximum IPv6 GRO size on workload facing devices",
+          "type": "integer"
+        },
+        "GSOIPV4MaxSize": {
+          "description": "Maximum IPv4 GSO size on workload facing devices",
           "type": "integer"
         },
         "GSOMaxSize": {
-          "description": "Maximum GSO size on workload facing devices",
+          "description": "Maximum IPv6 GSO size on workload facing devices",
           "type": "integer"
         },
         "addressing": {
@@ -2802,6 +2846,23 @@ func init() {
         }
       }
     },
+    "IPAMPoolUsage": {
+      "description": "Occupancy of an IPAM allocation pool\n\n+k8s:deepcopy-gen=true",
+      "properties": {
+        "allocated": {
+          "description": "Number of addresses handed out of the pool",
+          "type": "integer"
+        },
+        "available": {
+          "description": "Number of addresses which can still be allocated, -1 if the pool\ncapacity is not known",
+          "type": "integer"
+        },
+        "reserved": {
+          "description": "Number of addresses withheld from allocation, i.e. blacklisted or\nquarantined addresses",
+          "type": "integer"
+        }
+      }
+    },
     "IPAMStatus": {
       "description": "Status of IP address management\n\n+k8s:deepcopy-gen=true",
       "properties": {
@@ -2820,6 +2881,20 @@ func init() {
             "type": "string"
           }
         },
+        "pool-usage": {
+          "description": "Per-family usage of the allocation pools",
+          "type": "object",
+          "additionalProperties": {
+            "$ref": "#/definitions/IPAMPoolUsage"
+          }
+        },
+        "quarantined-ips": {
+          "description": "IPs which have been released but are waiting out the configured\ngrace period before they are returned to the pool",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
         "status": {
           "type": "string"
         }
@@ -3543,6 +3618,86 @@ func init() {
         }
       }
     },
+    "PolicyImpactEndpoint": {
+      "description": "Change in policy enforcement for a local endpoint",
+      "type": "object",
+      "properties": {
+        "id": {
+          "description": "The cilium-agent-local ID of the endpoint",
+          "type": "integer"
+        },
+        "identity-labels": {
+          "description": "Security identity labels of the endpoint",
+          "$ref": "#/definitions/Labels"
+        },
+        "new-policy-enabled": {
+          "description": "Policy enforcement state with the proposed rules added",
+          "$ref": "#/definitions/EndpointPolicyEnabled"
+        },
+        "policy-enabled": {
+          "description": "Policy enforcement state with the current rules",
+          "$ref": "#/definitions/EndpointPolicyEnabled"
+        },
+        "selected": {
+          "description": "True if the proposed rules select the endpoint",
+          "type": "boolean"
+        }
+      }
+    },
+    "PolicyImpactFlow": {
+      "description": "Change in verdict for a recently observed flow",
+      "type": "object",
+      "properties": {
+        "dport": {
+          "description": "Destination port of the flow",
+          "type": "integer"
+        },
+        "from-labels": {
+          "description": "Security identity labels of the source of the flow",
+          "$ref": "#/definitions/Labels"
+        },
+        "new-verdict": {
+          "description": "Verdict with the proposed rules added",
+          "type": "string"
+        },
+        "protocol": {
+          "description": "Layer 4 protocol of the flow",
+          "type": "string"
+        },
+        "to-labels": {
+          "description": "Security identity labels of the destination of the flow",
+          "$ref": "#/definitions/Labels"
+        },
+        "verdict": {
+          "description": "Verdict with the current rules",
+          "type": "string"
+        }
+      }
+    },
+    "PolicyImpactResult": {
+      "description": "Impact of a proposed policy change on local endpoints and recently\nobserved flows",
+      "type": "object",
+      "properties": {
+        "endpoints": {
+          "description": "Endpoints which the proposed rules select or whose policy enforcement state changes",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/PolicyImpactEndpoint"
+          }
+        },
+        "flows": {
+          "description": "Recently observed flows whose verdict changes with the proposed rules added",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/PolicyImpactFlow"
+          }
+        },
+        "revision": {
+          "description": "Revision of the policy repository the impact was computed against",
+          "type": "integer"
+        }
+      }
+    },
     "PolicyRule": {
       "description": "A policy rule including the rule labels it derives from\n\n+k8s:deepcopy-gen=true",
       "properties": {
@@ -5694,6 +5849,48 @@ func init() {
         }
       }
     },
+    "/policy/impact": {
+      "get": {
+        "description": "Evaluates a proposed policy against the local endpoints and recently\nobserved flows without importing it, and reports which endpoints and\nflows would be affected by the change.\n",
+        "tags": [
+          "policy"
+        ],
+        "summary": "Evaluate the impact of a proposed policy",
+        "parameters": [
+          {
+            "description": "Policy rules",
+            "name": "policy",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "string"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success",
+            "schema": {
+              "$ref": "#/definitions/PolicyImpactResult"
+            }
+          },
+          "400": {
+            "description": "Invalid policy",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            },
+            "x-go-name": "InvalidPolicy"
+          },
+          "500": {
+            "description": "Policy impact evaluation failed",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            },
+            "x-go-name": "Failure"
+          }
+        }
+      }
+    },
     "/policy/resolve": {
       "get": {
         "tags": [
@@ -6652,12 +6849,20 @@ func init() {
       "description": "Response to a daemon configuration request. Contains the addressing\ninformation, k8s, node monitor and immutable and mutable configuration\nsettings.\n",
       "type": "object",
       "properties": {
+        "GROIPV4MaxSize": {
+          "description": "Maximum IPv4 GRO size on workload facing devices",
+          "type": "integer"
+        },
         "GROMaxSize": {
-          "description": "Maximum GRO size on workload facing devices",
+          "description": "Maximum IPv6 GRO size on workload facing devices",
+          "type": "integer"
+        },
+        "GSOIPV4MaxSize": {
+          "description": "Maximum IPv4 GSO size on workload facing devices",
           "type": "integer"
         },
         "GSOMaxSize": {
-          "description": "Maximum GSO size on workload facing devices",
+          "description": "Maximum IPv6 GSO size on workload facing devices",
           "type": "integer"
         },
         "addressing": {
@@ -7528,6 +7733,23 @@ func init() {
         }
       }
     },
+    "IPAMPoolUsage": {
+      "description": "Occupancy of an IPAM allocation pool\n\n+k8s:deepcopy-gen=true",
+      "properties": {
+        "allocated": {
+          "description": "Number of addresses handed out of the pool",
+          "type": "integer"
+        },
+        "available": {
+          "description": "Number of addresses which can still be allocated, -1 if the pool\ncapacity is not known",
+          "type": "integer"
+        },
+        "reserved": {
+          "description": "Number of addresses withheld from allocation, i.e. blacklisted or\nquarantined addresses",
+          "type": "integer"
+        }
+      }
+    },
     "IPAMStatus": {
       "description": "Status of IP address management\n\n+k8s:deepcopy-gen=true",
       "properties": {
@@ -7546,6 +7768,20 @@ func init() {
             "type": "string"
           }
         },
+        "pool-usage": {
+          "description": "Per-family usage of the allocation pools",
+          "type": "object",
+          "additionalProperties": {
+            "$ref": "#/definitions/IPAMPoolUsage"
+          }
+        },
+        "quarantined-ips": {
+          "description": "IPs which have been released but are waiting out the configured\ngrace period before they are returned to the pool",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
         "status": {
           "type": "string"
         }
@@ -8602,6 +8838,86 @@ func init() {
         }
       }
     },
+    "PolicyImpactEndpoint": {
+      "description": "Change in policy enforcement for a local endpoint",
+      "type": "object",
+      "properties": {
+        "id": {
+          "description": "The cilium-agent-local ID of the endpoint",
+          "type": "integer"
+        },
+        "identity-labels": {
+          "description": "Security identity labels of the endpoint",
+          "$ref": "#/definitions/Labels"
+        },
+        "new-policy-enabled": {
+          "description": "Policy enforcement state with the proposed rules added",
+          "$ref": "#/definitions/EndpointPolicyEnabled"
+        },
+        "policy-enabled": {
+          "description": "Policy enforcement state with the current rules",
+          "$ref": "#/definitions/EndpointPolicyEnabled"
+        },
+        "selected": {
+          "description": "True if the proposed rules select the endpoint",
+          "type": "boolean"
+        }
+      }
+    },
+    "PolicyImpactFlow": {
+      "description": "Change in verdict for a recently observed flow",
+      "type": "object",
+      "properties": {
+        "dport": {
+          "description": "Destination port of the flow",
+          "type": "integer"
+        },
+        "from-labels": {
+          "description": "Security identity labels of the source of the flow",
+          "$ref": "#/definitions/Labels"
+        },
+        "new-verdict": {
+          "description": "Verdict with the proposed rules added",
+          "type": "string"
+        },
+        "protocol": {
+          "description": "Layer 4 protocol of the flow",
+          "type": "string"
+        },
+        "to-labels": {
+          "description": "Security identity labels of the destination of the flow",
+          "$ref": "#/definitions/Labels"
+        },
+        "verdict": {
+          "description": "Verdict with the current rules",
+          "type": "string"
+        }
+      }
+    },
+    "PolicyImpactResult": {
+      "description": "Impact of a proposed policy change on local endpoints and recently\nobserved flows",
+      "type": "object",
+      "properties": {
+        "endpoints": {
+          "description": "Endpoints which the proposed rules select or whose policy enforcement state changes",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/PolicyImpactEndpoint"
+          }
+        },
+        "flows": {
+          "description": "Recently observed flows whose verdict changes with the proposed rules added",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/PolicyImpactFlow"
+          }
+        },
+        "revision": {
+          "description": "Revision of the policy repository the impact was computed against",
+          "type": "integer"
+        }
+      }
+    },
     "PolicyRule": {
       "description": "A policy rule including the rule labels it derives from\n\n+k8s:deepcopy-gen=true",
       "properties": {
@@ -144,6 +144,9 @@ func NewCiliumAPIAPI(spec *loads.Document) *CiliumAPIAPI {
 		PolicyGetPolicyHandler: policy.GetPolicyHandlerFunc(func(params policy.GetPolicyParams) middleware.Responder {
 			return middleware.NotImplemented("operation policy.GetPolicy has not yet been implemented")
 		}),
+		PolicyGetPolicyImpactHandler: policy.GetPolicyImpactHandlerFunc(func(params policy.GetPolicyImpactParams) middleware.Responder {
+			return middleware.NotImplemented("operation policy.GetPolicyImpact has not yet been implemented")
+		}),
 		PolicyGetPolicyResolveHandler: policy.GetPolicyResolveHandlerFunc(func(params policy.GetPolicyResolveParams) middleware.Responder {
 			return middleware.NotImplemented("operation policy.GetPolicyResolve has not yet been implemented")
 		}),
@@ -297,6 +300,8 @@ type CiliumAPIAPI struct {
 	MetricsGetMetricsHandler metrics.GetMetricsHandler
 	// PolicyGetPolicyHandler sets the operation handler for the get policy operation
 	PolicyGetPolicyHandler policy.GetPolicyHandler
+	// PolicyGetPolicyImpactHandler sets the operation handler for the get policy impact operation
+	PolicyGetPolicyImpactHandler policy.GetPolicyImpactHandler
 	// PolicyGetPolicyResolveHandler sets the operation handler for the get policy resolve operation
 	PolicyGetPolicyResolveHandler policy.GetPolicyResolveHandler
 	// PolicyGetPolicySelectorsHandler sets the operation handler for the get policy selectors operation
@@ -502,6 +507,9 @@ func (o *CiliumAPIAPI) Validate() error {
 	if o.PolicyGetPolicyHandler == nil {
 		unregistered = append(unregistered, "policy.GetPolicyHandler")
 	}
+	if o.PolicyGetPolicyImpactHandler == nil {
+		unregistered = append(unregistered, "policy.GetPolicyImpactHandler")
+	}
 	if o.PolicyGetPolicyResolveHandler == nil {
 		unregistered = append(unregistered, "policy.GetPolicyResolveHandler")
 	}
@@ -770,6 +778,10 @@ func (o *CiliumAPIAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/policy/impact"] = policy.NewGetPolicyImpact(o.context, o.PolicyGetPolicyImpactHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/policy/resolve"] = policy.NewGetPolicyResolve(o.context, o.PolicyGetPolicyResolveHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
@@ -72,3 +72,7 @@ struct srv6_vrf_key6 _49;
 struct srv6_policy_key4 _50;
 struct srv6_policy_key6 _51;
 struct trace_sock_notify _52;
+struct mcast_group_key _53;
+struct mcast_group_entry _54;
+struct xdp_port_filter_key _55;
+struct xdp_port_filter_entry _56;
@@ -19,12 +19,21 @@
 
 #define EVENT_SOURCE LXC_ID
 
+#ifdef ENABLE_CAPTURE
+/* Endpoint programs are not recompiled when recorder masks change.
+ * Capture classification happens against the static mask set in
+ * lib/pcap.h which covers exact 5-tuple and per-IP capture rules.
+ */
+#define capture_enabled 1
+#endif
+
 #include "lib/tailcall.h"
 #include "lib/common.h"
 #include "lib/config.h"
 #include "lib/maps.h"
 #include "lib/arp.h"
 #include "lib/edt.h"
+#include "lib/multicast.h"
 #include "lib/qm.h"
 #include "lib/ipv6.h"
 #include "lib/ipv4.h"
@@ -236,6 +245,18 @@ static __always_inline int handle_ipv6_from_lxc(struct __ctx_buff *ctx, __u32 *d
 	if (!revalidate_data(ctx, &data, &data_end, &ip6))
 		return DROP_INVALID;
 
+#ifdef ENDPOINT_DSCP
+	/* Remark traffic sent by the endpoint with its DSCP code point before
+	 * any forwarding decision is taken.
+	 */
+	ret = ipv6_set_dscp(ctx, ETH_HLEN, ip6, ENDPOINT_DSCP);
+	if (ret < 0)
+		return ret;
+	/* Writing to the packet invalidates the data pointers. */
+	if (!revalidate_data(ctx, &data, &data_end, &ip6))
+		return DROP_INVALID;
+#endif /* ENDPOINT_DSCP */
+
 	/* Determine the destination category for policy fallback.  Service
 	 * translation of the destination address is done before this function,
 	 * so we can do this first. Also, verifier on kernel 4.9 insisted this
@@ -758,8 +779,34 @@ static __always_inline int handle_ipv4_from_lxc(struct __ctx_buff *ctx, __u32 *d
 	if (!revalidate_data(ctx, &data, &data_end, &ip4))
 		return DROP_INVALID;
 
+#ifdef ENDPOINT_DSCP
+	/* Remark traffic sent by the endpoint with its DSCP code point before
+	 * any forwarding decision is taken.
+	 */
+	ret = ipv4_set_dscp(ctx, ETH_HLEN, ip4, ENDPOINT_DSCP);
+	if (ret < 0)
+		return ret;
+	/* Writing to the packet invalidates the data pointers. */
+	if (!revalidate_data(ctx, &data, &data_end, &ip4))
+		return DROP_INVALID;
+#endif /* ENDPOINT_DSCP */
+
 	has_l4_header = ipv4_has_l4_header(ip4);
 
+#ifdef ENABLE_MULTICAST
+	if (ipv4_is_multicast(ip4->daddr)) {
+		/* Pass IGMP up so the kernel can track group membership,
+		 * and hand opted-in groups to the stack for delivery. All
+		 * remaining multicast traffic is dropped.
+		 */
+		if (ip4->protocol == IPPROTO_IGMP)
+			return CTX_ACT_OK;
+		if (mcast_group_allowed(ip4->daddr))
+			return CTX_ACT_OK;
+		return DROP_MULTICAST_DENIED;
+	}
+#endif /* ENABLE_MULTICAST */
+
 	/* Determine the destination category for policy fallback. */
 	if (1) {
 		struct remote_endpoint_info *info;
@@ -1311,6 +1358,8 @@ int cil_from_container(struct __ctx_buff *ctx)
 	send_trace_notify(ctx, TRACE_FROM_LXC, SECLABEL, 0, 0, 0,
 			  TRACE_REASON_UNKNOWN, TRACE_PAYLOAD_LEN);
 
+	cilium_capture_in(ctx);
+
 	if (!validate_ethertype(ctx, &proto)) {
 		ret = DROP_UNSUPPORTED_L2;
 		goto out;
@@ -1377,6 +1426,16 @@ ipv6_policy(struct __ctx_buff *ctx, int ifindex, __u32 src_label,
 
 	policy_clear_mark(ctx);
 
+#if defined(ENABLE_BANDWIDTH_MANAGER)
+	ret = edt_throttle_ingress(ctx, LXC_ID);
+	/* No send_drop_notify() here given we're rate-limiting. */
+	if (ret == CTX_ACT_DROP) {
+		update_metrics(ctx_full_len(ctx), METRIC_INGRESS,
+			       -DROP_EDT_HORIZON);
+		return ret;
+	}
+#endif
+
 	ipv6_addr_copy(&orig_sip, (union v6addr *)&ip6->saddr);
 
 	/* If packet is coming from the ingress proxy we have to skip
@@ -1688,6 +1747,16 @@ ipv4_policy(struct __ctx_buff *ctx, int ifindex, __u32 src_label, enum ct_status
 
 	policy_clear_mark(ctx);
 
+#if defined(ENABLE_BANDWIDTH_MANAGER)
+	ret = edt_throttle_ingress(ctx, LXC_ID);
+	/* No send_drop_notify() here given we're rate-limiting. */
+	if (ret == CTX_ACT_DROP) {
+		update_metrics(ctx_full_len(ctx), METRIC_INGRESS,
+			       -DROP_EDT_HORIZON);
+		return ret;
+	}
+#endif
+
 	/* If packet is coming from the ingress proxy we have to skip
 	 * redirection to the ingress proxy as we would loop forever.
 	 */
@@ -2139,6 +2208,8 @@ int cil_to_container(struct __ctx_buff *ctx)
 	send_trace_notify(ctx, trace, identity, 0, 0, ctx->ingress_ifindex,
 			  TRACE_REASON_UNKNOWN, TRACE_PAYLOAD_LEN);
 
+	cilium_capture_out_unpaired(ctx);
+
 #if defined(ENABLE_HOST_FIREWALL) && !defined(ENABLE_ROUTING)
 	/* If the packet comes from the hostns and per-endpoint routes are enabled,
 	 * jump to bpf_host to enforce egress host policies before anything else.
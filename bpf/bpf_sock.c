@@ -89,6 +89,32 @@ ctx_in_hostns(void *ctx __maybe_unused, __net_cookie *cookie)
 #endif
 }
 
+#ifdef ENABLE_SOCKET_LB_SKIP_NETNS
+struct {
+	__uint(type, BPF_MAP_TYPE_HASH);
+	__type(key, __net_cookie);
+	__type(value, __u8);
+	__uint(pinning, LIBBPF_PIN_BY_NAME);
+	__uint(max_entries, LB_SKIP_NETNS_MAP_SIZE);
+	__uint(map_flags, BPF_F_NO_PREALLOC);
+} LB_SKIP_NETNS_MAP __section_maps_btf;
+#endif /* ENABLE_SOCKET_LB_SKIP_NETNS */
+
+/* sock_netns_skip_lb returns true if the socket lives in a netns which has
+ * been excluded from socket LB, e.g. so that a service mesh inside the pod
+ * can see the original ClusterIP. Service translation then falls back to
+ * the per-packet LB in the TC datapath.
+ */
+static __always_inline __maybe_unused
+bool sock_netns_skip_lb(__net_cookie cookie __maybe_unused)
+{
+#ifdef ENABLE_SOCKET_LB_SKIP_NETNS
+	if (map_lookup_elem(&LB_SKIP_NETNS_MAP, &cookie))
+		return true;
+#endif
+	return false;
+}
+
 static __always_inline __maybe_unused
 bool sock_is_health_check(struct bpf_sock_addr *ctx __maybe_unused)
 {
@@ -309,6 +335,9 @@ static __always_inline int __sock4_xlate_fwd(struct bpf_sock_addr *ctx,
 	if (is_defined(ENABLE_SOCKET_LB_HOST_ONLY) && !in_hostns)
 		return -ENXIO;
 
+	if (!in_hostns && sock_netns_skip_lb(id.client_cookie))
+		return -ENXIO;
+
 	if (!udp_only && !sock_proto_enabled(ctx->protocol))
 		return -ENOTSUP;
 
@@ -952,6 +981,9 @@ static __always_inline int __sock6_xlate_fwd(struct bpf_sock_addr *ctx,
 	if (is_defined(ENABLE_SOCKET_LB_HOST_ONLY) && !in_hostns)
 		return -ENXIO;
 
+	if (!in_hostns && sock_netns_skip_lb(id.client_cookie))
+		return -ENXIO;
+
 	if (!udp_only && !sock_proto_enabled(ctx->protocol))
 		return -ENOTSUP;
 
@@ -91,6 +91,31 @@ struct {
 } CIDR6_LMAP_NAME __section_maps_btf;
 #endif /* CIDR6_LPM_PREFILTER */
 #endif /* CIDR6_FILTER */
+
+struct {
+	__uint(type, BPF_MAP_TYPE_HASH);
+	__type(key, struct xdp_port_filter_key);
+	__type(value, struct xdp_port_filter_entry);
+	__uint(pinning, LIBBPF_PIN_BY_NAME);
+	__uint(max_entries, XDP_PORT_FILTER_MAP_SIZE);
+	__uint(map_flags, BPF_F_NO_PREALLOC);
+} XDP_PORT_FILTER_MAP __section_maps_btf;
+
+static __always_inline bool
+xdp_port_filter_denied(__be16 dport, __u8 proto, __u8 tcp_flags)
+{
+	struct xdp_port_filter_key key = {
+		.dport = dport,
+		.proto = proto,
+	};
+	struct xdp_port_filter_entry *entry;
+
+	entry = map_lookup_elem(&XDP_PORT_FILTER_MAP, &key);
+	if (!entry)
+		return false;
+
+	return !entry->tcp_flags || (tcp_flags & entry->tcp_flags);
+}
 #endif /* ENABLE_PREFILTER */
 
 static __always_inline __maybe_unused int
@@ -139,6 +164,52 @@ static __always_inline int check_v4_lb(struct __ctx_buff *ctx __maybe_unused)
 #endif /* ENABLE_NODEPORT_ACCELERATION */
 
 #ifdef ENABLE_PREFILTER
+static __always_inline int check_port_v4(struct __ctx_buff *ctx)
+{
+	void *data_end = ctx_data_end(ctx);
+	void *data = ctx_data(ctx);
+	struct iphdr *ip4 = data + sizeof(struct ethhdr);
+	__u8 tcp_flags = 0;
+	__be16 dport;
+	int l4_off;
+
+	if (ctx_no_room(ip4 + 1, data_end))
+		return CTX_ACT_DROP;
+
+	/* Only the first fragment carries the L4 header. */
+	if (ipv4_is_fragment(ip4))
+		return check_v4_lb(ctx);
+
+	l4_off = sizeof(struct ethhdr) + ipv4_hdrlen(ip4);
+
+	switch (ip4->protocol) {
+	case IPPROTO_TCP: {
+		struct tcphdr *tcp = data + l4_off;
+
+		if (ctx_no_room(tcp + 1, data_end))
+			return check_v4_lb(ctx);
+		dport = tcp->dest;
+		tcp_flags = ((__u8 *)tcp)[13];
+		break;
+	}
+	case IPPROTO_UDP: {
+		struct udphdr *udp = data + l4_off;
+
+		if (ctx_no_room(udp + 1, data_end))
+			return check_v4_lb(ctx);
+		dport = udp->dest;
+		break;
+	}
+	default:
+		return check_v4_lb(ctx);
+	}
+
+	if (xdp_port_filter_denied(dport, ip4->protocol, tcp_flags))
+		return CTX_ACT_DROP;
+
+	return check_v4_lb(ctx);
+}
+
 static __always_inline int check_v4(struct __ctx_buff *ctx)
 {
 	void *data_end = ctx_data_end(ctx);
@@ -157,11 +228,10 @@ static __always_inline int check_v4(struct __ctx_buff *ctx)
 	if (map_lookup_elem(&CIDR4_LMAP_NAME, &pfx))
 		return CTX_ACT_DROP;
 #endif /* CIDR4_LPM_PREFILTER */
-	return map_lookup_elem(&CIDR4_HMAP_NAME, &pfx) ?
-		CTX_ACT_DROP : check_v4_lb(ctx);
-#else
-	return check_v4_lb(ctx);
+	if (map_lookup_elem(&CIDR4_HMAP_NAME, &pfx))
+		return CTX_ACT_DROP;
 #endif /* CIDR4_FILTER */
+	return check_port_v4(ctx);
 }
 #else
 static __always_inline int check_v4(struct __ctx_buff *ctx)
@@ -202,6 +272,52 @@ static __always_inline int check_v6_lb(struct __ctx_buff *ctx __maybe_unused)
 #endif /* ENABLE_NODEPORT_ACCELERATION */
 
 #ifdef ENABLE_PREFILTER
+static __always_inline int check_port_v6(struct __ctx_buff *ctx)
+{
+	void *data_end = ctx_data_end(ctx);
+	void *data = ctx_data(ctx);
+	struct ipv6hdr *ip6 = data + sizeof(struct ethhdr);
+	__u8 tcp_flags = 0;
+	__be16 dport;
+	int l4_off;
+
+	if (ctx_no_room(ip6 + 1, data_end))
+		return CTX_ACT_DROP;
+
+	/* Extension headers are not parsed here, the filter only applies when
+	 * L4 directly follows the IPv6 header which is the common case for
+	 * NodePort traffic.
+	 */
+	l4_off = sizeof(struct ethhdr) + sizeof(struct ipv6hdr);
+
+	switch (ip6->nexthdr) {
+	case IPPROTO_TCP: {
+		struct tcphdr *tcp = data + l4_off;
+
+		if (ctx_no_room(tcp + 1, data_end))
+			return check_v6_lb(ctx);
+		dport = tcp->dest;
+		tcp_flags = ((__u8 *)tcp)[13];
+		break;
+	}
+	case IPPROTO_UDP: {
+		struct udphdr *udp = data + l4_off;
+
+		if (ctx_no_room(udp + 1, data_end))
+			return check_v6_lb(ctx);
+		dport = udp->dest;
+		break;
+	}
+	default:
+		return check_v6_lb(ctx);
+	}
+
+	if (xdp_port_filter_denied(dport, ip6->nexthdr, tcp_flags))
+		return CTX_ACT_DROP;
+
+	return check_v6_lb(ctx);
+}
+
 static __always_inline int check_v6(struct __ctx_buff *ctx)
 {
 	void *data_end = ctx_data_end(ctx);
@@ -220,11 +336,10 @@ static __always_inline int check_v6(struct __ctx_buff *ctx)
 	if (map_lookup_elem(&CIDR6_LMAP_NAME, &pfx))
 		return CTX_ACT_DROP;
 #endif /* CIDR6_LPM_PREFILTER */
-	return map_lookup_elem(&CIDR6_HMAP_NAME, &pfx) ?
-		CTX_ACT_DROP : check_v6_lb(ctx);
-#else
-	return check_v6_lb(ctx);
+	if (map_lookup_elem(&CIDR6_HMAP_NAME, &pfx))
+		return CTX_ACT_DROP;
 #endif /* CIDR6_FILTER */
+	return check_port_v6(ctx);
 }
 #else
 static __always_inline int check_v6(struct __ctx_buff *ctx)
@@ -15,6 +15,9 @@
 #ifndef ENXIO
 # define ENXIO		6
 #endif
+#ifndef E2BIG
+# define E2BIG		7
+#endif
 #ifndef ENOMEM
 # define ENOMEM		12
 #endif
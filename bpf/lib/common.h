@@ -272,11 +272,42 @@ struct edt_id {
 	__u64		id;
 };
 
+/* The aggregate is paced by the TCP socket's BBR congestion control. */
+#define EDT_F_BBR	(1 << 0)
+
+/* Aggregate IDs are the endpoint ID for egress throttling and the endpoint
+ * ID with EDT_INGRESS_ID set for ingress throttling.
+ */
+#define EDT_INGRESS_ID	(1ULL << 16)
+
 struct edt_info {
 	__u64		bps;
 	__u64		t_last;
 	__u64		t_horizon_drop;
-	__u64		pad[4];
+	__u64		flags;
+	__u64		pad[3];
+};
+
+struct mcast_group_key {
+	__be32		group;
+};
+
+struct mcast_group_entry {
+	__u32		flags;	/* unused, reserved for future use */
+};
+
+struct xdp_port_filter_key {
+	__be16		dport;
+	__u8		proto;
+	__u8		pad;
+};
+
+struct xdp_port_filter_entry {
+	__u8		tcp_flags;	/* drop only segments with any of these
+					 * flags set, 0 matches all segments
+					 */
+	__u8		pad1;
+	__u16		pad2;
 };
 
 struct remote_endpoint_info {
@@ -505,6 +536,10 @@ enum {
 #define DROP_MISSING_SRV6_STATE	-186
 #define DROP_NAT46		-187
 #define DROP_NAT64		-188
+#define DROP_MULTICAST_DENIED	-189
+#define DROP_POLICY_DENY_L3	-190
+#define DROP_POLICY_DENY_L4	-191
+#define DROP_CT_MAP_FULL	-192
 
 #define NAT_PUNT_TO_STACK	DROP_NAT_NOT_NEEDED
 #define NAT_46X64_RECIRC	100
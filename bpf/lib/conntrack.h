@@ -811,6 +811,17 @@ ct_update6_dsr(const void *map, const struct ipv6_ct_tuple *tuple,
 	entry->dsr = dsr;
 }
 
+/* The kernel reports a map which ran out of free slots with -E2BIG on
+ * insertion. Surface that as a distinct drop reason so that a conntrack
+ * table at capacity can be told apart from other insertion failures.
+ */
+static __always_inline int ct_create_fail(int err)
+{
+	if (err == -E2BIG)
+		return DROP_CT_MAP_FULL;
+	return DROP_CT_CREATE_FAILED;
+}
+
 /* Offset must point to IPv6 */
 static __always_inline int ct_create6(const void *map_main, const void *map_related,
 				      struct ipv6_ct_tuple *tuple,
@@ -822,6 +833,7 @@ static __always_inline int ct_create6(const void *map_main, const void *map_rela
 	struct ct_entry entry = { };
 	bool is_tcp = tuple->nexthdr == IPPROTO_TCP;
 	union tcp_flags seen_flags = { .value = 0 };
+	int err;
 
 	/* Note if this is a proxy connection so that replies can be redirected
 	 * back to the proxy.
@@ -853,9 +865,10 @@ static __always_inline int ct_create6(const void *map_main, const void *map_rela
 	cilium_dbg3(ctx, DBG_CT_CREATED6, entry.rev_nat_index, ct_state->src_sec_id, 0);
 
 	entry.src_sec_id = ct_state->src_sec_id;
-	if (map_update_elem(map_main, tuple, &entry, 0) < 0) {
+	err = map_update_elem(map_main, tuple, &entry, 0);
+	if (err < 0) {
 		send_signal_ct_fill_up(ctx, SIGNAL_PROTO_V6);
-		return DROP_CT_CREATE_FAILED;
+		return ct_create_fail(err);
 	}
 
 	if (map_related != NULL) {
@@ -872,9 +885,10 @@ static __always_inline int ct_create6(const void *map_main, const void *map_rela
 		ipv6_addr_copy(&icmp_tuple.daddr, &tuple->daddr);
 		ipv6_addr_copy(&icmp_tuple.saddr, &tuple->saddr);
 
-		if (map_update_elem(map_related, &icmp_tuple, &entry, 0) < 0) {
+		err = map_update_elem(map_related, &icmp_tuple, &entry, 0);
+		if (err < 0) {
 			send_signal_ct_fill_up(ctx, SIGNAL_PROTO_V6);
-			return DROP_CT_CREATE_FAILED;
+			return ct_create_fail(err);
 		}
 	}
 	return 0;
@@ -930,6 +944,7 @@ static __always_inline int ct_create4(const void *map_main,
 	struct ct_entry entry = { };
 	bool is_tcp = tuple->nexthdr == IPPROTO_TCP;
 	union tcp_flags seen_flags = { .value = 0 };
+	int err;
 
 	/* Note if this is a proxy connection so that replies can be redirected
 	 * back to the proxy.
@@ -961,9 +976,10 @@ static __always_inline int ct_create4(const void *map_main,
 		    ct_state->src_sec_id, ct_state->addr);
 
 	entry.src_sec_id = ct_state->src_sec_id;
-	if (map_update_elem(map_main, tuple, &entry, 0) < 0) {
+	err = map_update_elem(map_main, tuple, &entry, 0);
+	if (err < 0) {
 		send_signal_ct_fill_up(ctx, SIGNAL_PROTO_V4);
-		return DROP_CT_CREATE_FAILED;
+		return ct_create_fail(err);
 	}
 
 	if (ct_state->addr && ct_state->loopback) {
@@ -987,9 +1003,10 @@ static __always_inline int ct_create4(const void *map_main,
 			tuple->daddr = ct_state->addr;
 		}
 
-		if (map_update_elem(map_main, tuple, &entry, 0) < 0) {
+		err = map_update_elem(map_main, tuple, &entry, 0);
+		if (err < 0) {
 			send_signal_ct_fill_up(ctx, SIGNAL_PROTO_V4);
-			return DROP_CT_CREATE_FAILED;
+			return ct_create_fail(err);
 		}
 
 		tuple->saddr = saddr;
@@ -1013,9 +1030,10 @@ static __always_inline int ct_create4(const void *map_main,
 		 * the below throws an error, but we might as well just let
 		 * it time out.
 		 */
-		if (map_update_elem(map_related, &icmp_tuple, &entry, 0) < 0) {
+		err = map_update_elem(map_related, &icmp_tuple, &entry, 0);
+		if (err < 0) {
 			send_signal_ct_fill_up(ctx, SIGNAL_PROTO_V4);
-			return DROP_CT_CREATE_FAILED;
+			return ct_create_fail(err);
 		}
 	}
 	return 0;
@@ -54,6 +54,13 @@ static __always_inline int edt_sched_departure(struct __ctx_buff *ctx)
 	if (!info)
 		return CTX_ACT_OK;
 
+	/* BBR-paced aggregates have their departure time set by the TCP
+	 * socket's own pacing. Trust it and only rate limit on top when a
+	 * bandwidth limit has been configured for the aggregate as well.
+	 */
+	if ((info->flags & EDT_F_BBR) && !info->bps)
+		return CTX_ACT_OK;
+
 	now = ktime_get_ns();
 	t = ctx->tstamp;
 	if (t < now)
@@ -75,6 +82,34 @@ static __always_inline int edt_sched_departure(struct __ctx_buff *ctx)
 	ctx->tstamp = t_next;
 	return CTX_ACT_OK;
 }
+
+static __always_inline int edt_throttle_ingress(struct __ctx_buff *ctx,
+						__u32 ep_id)
+{
+	struct edt_id aggregate = { .id = ep_id | EDT_INGRESS_ID };
+	__u64 delay, now, t_next;
+	struct edt_info *info;
+
+	info = map_lookup_elem(&THROTTLE_MAP, &aggregate);
+	if (!info || !info->bps)
+		return CTX_ACT_OK;
+
+	now = ktime_get_ns();
+	delay = ((__u64)ctx_wire_len(ctx)) * NSEC_PER_SEC / info->bps;
+	t_next = READ_ONCE(info->t_last) + delay;
+	if (t_next <= now) {
+		WRITE_ONCE(info->t_last, now);
+		return CTX_ACT_OK;
+	}
+	/* There is no FQ qdisc on the way into the Pod which could delay
+	 * the packet, so unlike on egress the horizon acts as a policer
+	 * here and everything above the allowed burst is dropped.
+	 */
+	if (t_next - now >= info->t_horizon_drop)
+		return CTX_ACT_DROP;
+	WRITE_ONCE(info->t_last, t_next);
+	return CTX_ACT_OK;
+}
 #else
 static __always_inline void
 edt_set_aggregate(struct __ctx_buff *ctx __maybe_unused,
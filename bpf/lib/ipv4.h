@@ -54,6 +54,29 @@ static __always_inline int ipv4_dec_ttl(struct __ctx_buff *ctx, int off,
 	return 0;
 }
 
+static __always_inline int ipv4_set_dscp(struct __ctx_buff *ctx, int off,
+					 const struct iphdr *ip4, __u8 dscp)
+{
+	__u8 new_tos, tos = ip4->tos;
+
+	new_tos = (__u8)(dscp << 2) | (tos & 0x3);
+	if (new_tos == tos)
+		return 0;
+
+	/* The tos is the second byte of the first 16-bit word of the header,
+	 * hence the host byte order values need to be shifted accordingly for
+	 * the checksum update.
+	 */
+	if (l3_csum_replace(ctx, off + offsetof(struct iphdr, check),
+			    bpf_htons((__u16)tos), bpf_htons((__u16)new_tos), 2) < 0)
+		return DROP_CSUM_L3;
+	if (ctx_store_bytes(ctx, off + offsetof(struct iphdr, tos),
+			    &new_tos, sizeof(new_tos), 0) < 0)
+		return DROP_WRITE_ERROR;
+
+	return 0;
+}
+
 static __always_inline int ipv4_hdrlen(const struct iphdr *ip4)
 {
 	return ip4->ihl * 4;
@@ -85,6 +108,11 @@ static __always_inline bool ipv4_has_l4_header(const struct iphdr *ip4)
 	return !ipv4_is_not_first_fragment(ip4);
 }
 
+static __always_inline bool ipv4_is_multicast(__be32 addr)
+{
+	return (addr & bpf_htonl(0xf0000000)) == bpf_htonl(0xe0000000);
+}
+
 static __always_inline bool ipv4_is_in_subnet(__be32 addr,
 					      __be32 subnet, int prefixlen)
 {
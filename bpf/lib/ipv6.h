@@ -160,6 +160,27 @@ static __always_inline int ipv6_dec_hoplimit(struct __ctx_buff *ctx, int off)
 	return 0;
 }
 
+static __always_inline int ipv6_set_dscp(struct __ctx_buff *ctx, int off,
+					 const struct ipv6hdr *ip6, __u8 dscp)
+{
+	/* The traffic class spans the lower nibble of the first byte and the
+	 * upper nibble of the second one.
+	 */
+	__u8 tc = (__u8)(ip6->priority << 4) | (ip6->flow_lbl[0] >> 4);
+	__u8 new_tc = (__u8)(dscp << 2) | (tc & 0x3);
+	__u8 bytes[2];
+
+	if (new_tc == tc)
+		return 0;
+
+	bytes[0] = (__u8)(ip6->version << 4) | (new_tc >> 4);
+	bytes[1] = (__u8)(new_tc << 4) | (ip6->flow_lbl[0] & 0xf);
+	if (ctx_store_bytes(ctx, off, bytes, sizeof(bytes), 0) < 0)
+		return DROP_WRITE_ERROR;
+
+	return 0;
+}
+
 static __always_inline int ipv6_load_saddr(struct __ctx_buff *ctx, int off,
 					   union v6addr *dst)
 {
@@ -64,6 +64,17 @@ struct {
 } THROTTLE_MAP __section_maps_btf;
 #endif /* ENABLE_BANDWIDTH_MANAGER */
 
+#ifdef ENABLE_MULTICAST
+struct {
+	__uint(type, BPF_MAP_TYPE_HASH);
+	__type(key, struct mcast_group_key);
+	__type(value, struct mcast_group_entry);
+	__uint(pinning, LIBBPF_PIN_BY_NAME);
+	__uint(max_entries, MCAST_GROUP_MAP_SIZE);
+	__uint(map_flags, BPF_F_NO_PREALLOC);
+} MCAST_GROUP_MAP __section_maps_btf;
+#endif /* ENABLE_MULTICAST */
+
 /* Map to link endpoint id to per endpoint cilium_policy map */
 #ifdef SOCKMAP
 struct {
@@ -0,0 +1,24 @@
+/* SPDX-License-Identifier: (GPL-2.0-only OR BSD-2-Clause) */
+/* Copyright Authors of Cilium */
+
+#ifndef __LIB_MULTICAST_H_
+#define __LIB_MULTICAST_H_
+
+#include "common.h"
+#include "maps.h"
+
+#ifdef ENABLE_MULTICAST
+/* mcast_group_allowed returns true if the given group address has been
+ * opted into the datapath via a CiliumMulticastGroup resource.
+ */
+static __always_inline bool mcast_group_allowed(__be32 group)
+{
+	struct mcast_group_key key = {
+		.group = group,
+	};
+
+	return map_lookup_elem(&MCAST_GROUP_MAP, &key);
+}
+#endif /* ENABLE_MULTICAST */
+
+#endif /* __LIB_MULTICAST_H_ */
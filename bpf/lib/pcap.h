@@ -172,14 +172,29 @@ cilium_capture4_masked_key(const struct capture4_wcard *orig,
 /* The agent is generating and emitting the PREFIX_MASKS4 and regenerating
  * if a mask was added or removed. The cilium_capture4_rules can have n
  * entries with m different PREFIX_MASKS4 where n >> m. Lookup performance
- * depends mainly on m. Below is a fallback / example definition mainly for
- * compile testing given agent typically emits this instead. Ordering of
+ * depends mainly on m. Below is the static fallback definition which is in
+ * use by tc programs given those are not recompiled when recorder masks
+ * change. It covers exact 5-tuple as well as per-IP capture rules, which is
+ * what targeted per-flow captures emit. Rules with other masks only match
+ * in programs recompiled with the agent generated mask set. Ordering of
  * masks from agent side can f.e. be based on # of 1s from high to low.
  */
 #ifndef PREFIX_MASKS4
 # define PREFIX_MASKS4					\
 	{						\
-		/* rule_id 1:				\
+		/* rule_id 1 (1st mask):		\
+		 *  srcIP/32, sport, dstIP/32, dport,	\
+		 *  nexthdr				\
+		 */					\
+		.daddr   = 0xffffffff,			\
+		.dmask   = 32,				\
+		.saddr   = 0xffffffff,			\
+		.smask   = 32,				\
+		.dport   = 0xffff,			\
+		.sport   = 0xffff,			\
+		.nexthdr = 0xff,			\
+	}, {						\
+		/* rule_id 1 (2nd mask):		\
 		 *  srcIP/32, dstIP/32, dport, nexthdr	\
 		 */					\
 		.daddr   = 0xffffffff,			\
@@ -284,12 +299,31 @@ cilium_capture6_masked_key(const struct capture6_wcard *orig,
 }
 
 /* The agent is generating and emitting the PREFIX_MASKS6 and regenerating
- * if a mask was added or removed. Example for compile testing:
+ * if a mask was added or removed. As with PREFIX_MASKS4, the static
+ * fallback below is in use by tc programs:
  */
 #ifndef PREFIX_MASKS6
 # define PREFIX_MASKS6					 \
 	{						 \
-		/* rule_id 1:				 \
+		/* rule_id 1 (1st mask):		 \
+		 *  srcIP/128, sport, dstIP/128, dport,	 \
+		 *  nexthdr				 \
+		 */					 \
+		.daddr = {				 \
+			.d1 = 0xffffffff,		 \
+			.d2 = 0xffffffff,		 \
+		},					 \
+		.dmask    = 128,			 \
+		.saddr = {				 \
+			.d1 = 0xffffffff,		 \
+			.d2 = 0xffffffff,		 \
+		},					 \
+		.smask    = 128,			 \
+		.dport    = 0xffff,			 \
+		.sport    = 0xffff,			 \
+		.nexthdr  = 0xff,			 \
+	}, {						 \
+		/* rule_id 1 (2nd mask):		 \
 		 *  srcIP/128, dstIP/128, dport, nexthdr \
 		 */					 \
 		.daddr = {				 \
@@ -478,6 +512,22 @@ cilium_capture_out(struct __ctx_buff *ctx __maybe_unused)
 		__cilium_capture_out(ctx, rule_id, cap_len);
 }
 
+/* Variant of cilium_capture_out() for programs which did not run
+ * cilium_capture_in() earlier in the same invocation and therefore can
+ * neither rely on the cached classification result nor the cached
+ * timestamp.
+ */
+static __always_inline void
+cilium_capture_out_unpaired(struct __ctx_buff *ctx __maybe_unused)
+{
+	__u16 cap_len;
+	__u16 rule_id;
+
+	if (cilium_capture_candidate(ctx, &rule_id, &cap_len))
+		cilium_capture(ctx, CAPTURE_EGRESS, rule_id,
+			       bpf_ktime_cache_set(boot_ns), cap_len);
+}
+
 #else /* ENABLE_CAPTURE */
 
 static __always_inline void
@@ -490,5 +540,10 @@ cilium_capture_out(struct __ctx_buff *ctx __maybe_unused)
 {
 }
 
+static __always_inline void
+cilium_capture_out_unpaired(struct __ctx_buff *ctx __maybe_unused)
+{
+}
+
 #endif /* ENABLE_CAPTURE */
 #endif /* __LIB_PCAP_H_ */
@@ -36,7 +36,7 @@ policy_sk_egress(__u32 identity, __u32 ip,  __u16 dport)
 		/* FIXME: Need byte counter */
 		__sync_fetch_and_add(&policy->packets, 1);
 		if (unlikely(policy->deny))
-			return DROP_POLICY_DENY;
+			return DROP_POLICY_DENY_L4;
 		return policy->proxy_port;
 	}
 
@@ -47,7 +47,7 @@ policy_sk_egress(__u32 identity, __u32 ip,  __u16 dport)
 		/* FIXME: Need byte counter */
 		__sync_fetch_and_add(&policy->packets, 1);
 		if (unlikely(policy->deny))
-			return DROP_POLICY_DENY;
+			return DROP_POLICY_DENY_L4;
 		return policy->proxy_port;
 	}
 	key.sec_label = identity;
@@ -59,7 +59,7 @@ policy_sk_egress(__u32 identity, __u32 ip,  __u16 dport)
 		/* FIXME: Need byte counter */
 		__sync_fetch_and_add(&policy->packets, 1);
 		if (unlikely(policy->deny))
-			return DROP_POLICY_DENY;
+			return DROP_POLICY_DENY_L4;
 		return CTX_ACT_OK;
 	}
 
@@ -70,7 +70,7 @@ policy_sk_egress(__u32 identity, __u32 ip,  __u16 dport)
 		/* FIXME: Need byte counter */
 		__sync_fetch_and_add(&policy->packets, 1);
 		if (unlikely(policy->deny))
-			return DROP_POLICY_DENY;
+			return DROP_POLICY_DENY_L3;
 		return CTX_ACT_OK;
 	}
 
@@ -109,6 +109,9 @@ __policy_can_access(const void *map, struct __ctx_buff *ctx, __u32 local_id,
 		.egress = !dir,
 		.pad = 0,
 	};
+#ifdef ENABLE_ICMP_RULE
+	__u16 icmp_code_dport = 0;
+#endif
 
 #ifdef ALLOW_ICMP_FRAG_NEEDED
 	/* When ALLOW_ICMP_FRAG_NEEDED is defined we allow all packets
@@ -151,23 +154,63 @@ __policy_can_access(const void *map, struct __ctx_buff *ctx, __u32 local_id,
 		 * Therefore, the "icmp_type" should be shifted not just casting.
 		 */
 		key.dport = (__u16)(icmphdr.type << 8);
+		/* Rules with an ICMP code are stored with the type in the
+		 * upper byte and the code in the lower byte of the port in
+		 * network byte order. Type 0 never has a code.
+		 */
+		if (icmphdr.type)
+			icmp_code_dport = (__u16)(icmphdr.code << 8 | icmphdr.type);
 	} else if (proto == IPPROTO_ICMPV6) {
 		void *data, *data_end;
 		struct ipv6hdr *ip6;
 		__u32 off;
-		__u8 icmp_type;
+		__u8 icmp_hdr[2];
 
 		if (!revalidate_data(ctx, &data, &data_end, &ip6))
 			return DROP_INVALID;
 		off = ((void *)ip6 - data) + ipv6_hdrlen(ctx, &ip6->nexthdr);
-		if (ctx_load_bytes(ctx, off, &icmp_type, sizeof(icmp_type)) < 0)
+		if (ctx_load_bytes(ctx, off, &icmp_hdr, sizeof(icmp_hdr)) < 0)
 			return DROP_INVALID;
 
 		/* Convert from unsigned char to unsigned short considering byte order(little-endian).
 		 * In the little-endian case, for example, 2byte data "AB" convert to "BA".
 		 * Therefore, the "icmp_type" should be shifted not just casting.
 		 */
-		key.dport = (__u16)(icmp_type << 8);
+		key.dport = (__u16)(icmp_hdr[0] << 8);
+		if (icmp_hdr[0])
+			icmp_code_dport = (__u16)(icmp_hdr[1] << 8 | icmp_hdr[0]);
+	}
+#endif /* ENABLE_ICMP_RULE */
+
+#ifdef ENABLE_ICMP_RULE
+	if (icmp_code_dport && !is_untracked_fragment) {
+		__u16 icmp_type_dport = key.dport;
+
+		/* Rules with an ICMP code are more specific than the type-only
+		 * rules, look them up first.
+		 */
+		key.dport = icmp_code_dport;
+		policy = map_lookup_elem(map, &key);
+		if (policy) {
+			account(ctx, policy);
+			*match_type = POLICY_MATCH_L3_L4;
+			if (unlikely(policy->deny))
+				return DROP_POLICY_DENY_L4;
+			return policy->proxy_port;
+		}
+
+		/* L4-only lookup. */
+		key.sec_label = 0;
+		policy = map_lookup_elem(map, &key);
+		if (policy) {
+			account(ctx, policy);
+			*match_type = POLICY_MATCH_L4_ONLY;
+			if (unlikely(policy->deny))
+				return DROP_POLICY_DENY_L4;
+			return policy->proxy_port;
+		}
+		key.sec_label = remote_id;
+		key.dport = icmp_type_dport;
 	}
 #endif /* ENABLE_ICMP_RULE */
 
@@ -182,7 +225,7 @@ __policy_can_access(const void *map, struct __ctx_buff *ctx, __u32 local_id,
 			account(ctx, policy);
 			*match_type = POLICY_MATCH_L3_L4;
 			if (unlikely(policy->deny))
-				return DROP_POLICY_DENY;
+				return DROP_POLICY_DENY_L4;
 			return policy->proxy_port;
 		}
 
@@ -193,7 +236,7 @@ __policy_can_access(const void *map, struct __ctx_buff *ctx, __u32 local_id,
 			account(ctx, policy);
 			*match_type = POLICY_MATCH_L4_ONLY;
 			if (unlikely(policy->deny))
-				return DROP_POLICY_DENY;
+				return DROP_POLICY_DENY_L4;
 			return policy->proxy_port;
 		}
 
@@ -204,7 +247,7 @@ __policy_can_access(const void *map, struct __ctx_buff *ctx, __u32 local_id,
 			account(ctx, policy);
 			*match_type = POLICY_MATCH_L4_ONLY;
 			if (unlikely(policy->deny))
-				return DROP_POLICY_DENY;
+				return DROP_POLICY_DENY_L4;
 			return CTX_ACT_OK;
 		}
 		key.sec_label = remote_id;
@@ -218,7 +261,7 @@ __policy_can_access(const void *map, struct __ctx_buff *ctx, __u32 local_id,
 		account(ctx, policy);
 		*match_type = POLICY_MATCH_L3_ONLY;
 		if (unlikely(policy->deny))
-			return DROP_POLICY_DENY;
+			return DROP_POLICY_DENY_L3;
 		return CTX_ACT_OK;
 	}
 
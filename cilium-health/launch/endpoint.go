@@ -271,7 +271,9 @@ func LaunchAsEndpoint(baseCtx context.Context,
 
 	switch option.Config.DatapathMode {
 	case datapathOption.DatapathModeVeth:
-		_, epLink, err := connector.SetupVethWithNames(vethName, epIfaceName, mtuConfig.GetDeviceMTU(), bigTCPConfig.GetGROMaxSize(), bigTCPConfig.GetGSOMaxSize(), info)
+		_, epLink, err := connector.SetupVethWithNames(vethName, epIfaceName, mtuConfig.GetDeviceMTU(),
+			bigTCPConfig.GetGROMaxSize(), bigTCPConfig.GetGSOMaxSize(),
+			bigTCPConfig.GetGROIPv4MaxSize(), bigTCPConfig.GetGSOIPv4MaxSize(), info)
 		if err != nil {
 			return nil, fmt.Errorf("Error while creating veth: %s", err)
 		}
@@ -0,0 +1,18 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// ipamCmd represents the ipam command
+var ipamCmd = &cobra.Command{
+	Use:   "ipam",
+	Short: "Inspect IP address management state",
+}
+
+func init() {
+	rootCmd.AddCommand(ipamCmd)
+}
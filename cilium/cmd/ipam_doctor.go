@@ -0,0 +1,200 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package cmd
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	"github.com/vishvananda/netlink"
+
+	"github.com/cilium/cilium/api/v1/client/daemon"
+	"github.com/cilium/cilium/api/v1/models"
+	"github.com/cilium/cilium/pkg/command"
+)
+
+var fixDrift bool
+
+// ipamFinding describes a single inconsistency found between the allocation
+// state of the agent, the endpoints present on the node and the addresses
+// configured on the local interfaces.
+type ipamFinding struct {
+	Category string `json:"category"`
+	IP       string `json:"ip"`
+	Detail   string `json:"detail"`
+}
+
+// ipamDoctorCmd represents the ipam_doctor command
+var ipamDoctorCmd = &cobra.Command{
+	Use:   "doctor",
+	Short: "Check the local IPAM state for inconsistencies",
+	Long: `Compares the allocation state of the agent with the endpoints present on
+the node and the addresses configured on the local interfaces, and reports
+any drift between them. The command exits with a non-zero exit code if
+inconsistencies are found. With --fix, stale pod allocations without a
+matching endpoint are released back to the pool.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		ipamDoctor()
+	},
+}
+
+func init() {
+	ipamCmd.AddCommand(ipamDoctorCmd)
+	ipamDoctorCmd.Flags().BoolVar(&fixDrift, "fix", false, "Release stale allocations without a matching endpoint")
+	command.AddOutputOption(ipamDoctorCmd)
+}
+
+func ipamDoctor() {
+	params := daemon.NewGetHealthzParams()
+	resp, err := client.Daemon.GetHealthz(params)
+	if err != nil {
+		Fatalf("Unable to retrieve agent status: %s", err)
+	}
+	sr := resp.Payload
+	if sr.Ipam == nil {
+		Fatalf("IPAM status not available")
+	}
+
+	cfg, err := client.ConfigGet()
+	if err != nil {
+		Fatalf("Unable to retrieve daemon configuration: %s", err)
+	}
+
+	eps, err := client.EndpointList()
+	if err != nil {
+		Fatalf("Unable to list endpoints: %s", err)
+	}
+
+	// Addresses in use by endpoints on this node
+	endpointIPs := map[string]int64{}
+	for _, ep := range eps {
+		if ep.Status == nil || ep.Status.Networking == nil {
+			continue
+		}
+		for _, pair := range ep.Status.Networking.Addressing {
+			if pair.IPV4 != "" {
+				endpointIPs[pair.IPV4] = ep.ID
+			}
+			if pair.IPV6 != "" {
+				endpointIPs[pair.IPV6] = ep.ID
+			}
+		}
+	}
+
+	// Addresses configured on the local interfaces
+	interfaceIPs := map[string]string{}
+	links, err := netlink.LinkList()
+	if err != nil {
+		Fatalf("Unable to list interfaces: %s", err)
+	}
+	for _, link := range links {
+		addrs, err := netlink.AddrList(link, netlink.FAMILY_ALL)
+		if err != nil {
+			continue
+		}
+		for _, addr := range addrs {
+			interfaceIPs[addr.IP.String()] = link.Attrs().Name
+		}
+	}
+
+	// Allocation ranges of the node, derived from the CiliumNode or
+	// Kubernetes node resource depending on the IPAM mode
+	var allocRanges []*net.IPNet
+	if cfg.Status != nil && cfg.Status.Addressing != nil {
+		for _, elem := range []*models.NodeAddressingElement{cfg.Status.Addressing.IPV4, cfg.Status.Addressing.IPV6} {
+			if elem == nil || elem.AllocRange == "" {
+				continue
+			}
+			if _, ipnet, err := net.ParseCIDR(elem.AllocRange); err == nil {
+				allocRanges = append(allocRanges, ipnet)
+			}
+		}
+	}
+
+	var findings []ipamFinding
+
+	// Allocations with a pod owner must be backed by an endpoint
+	for ip, owner := range sr.Ipam.Allocations {
+		if !strings.Contains(owner, "/") || strings.Contains(owner, "(") {
+			// Addresses owned by the agent itself (router, health,
+			// ...) or in a transient state (quarantined,
+			// blacklisted) are not backed by an endpoint
+			continue
+		}
+		if _, ok := endpointIPs[ip]; ok {
+			continue
+		}
+
+		detail := fmt.Sprintf("allocated to %s but not in use by any endpoint", owner)
+		if fixDrift {
+			if err := client.IPAMReleaseIP(ip); err != nil {
+				detail += fmt.Sprintf(" (release failed: %s)", err)
+			} else {
+				detail += " (released)"
+			}
+		}
+		findings = append(findings, ipamFinding{Category: "stale-allocation", IP: ip, Detail: detail})
+	}
+
+	// Endpoint IPs must be tracked by IPAM
+	for ip, id := range endpointIPs {
+		if _, ok := sr.Ipam.Allocations[ip]; !ok {
+			findings = append(findings, ipamFinding{
+				Category: "missing-allocation",
+				IP:       ip,
+				Detail:   fmt.Sprintf("in use by endpoint %d but not tracked by IPAM", id),
+			})
+		}
+	}
+
+	// Interface addresses within the allocation range must be tracked by
+	// IPAM, e.g. the router IP on cilium_host
+	for ip, ifName := range interfaceIPs {
+		parsed := net.ParseIP(ip)
+		inRange := false
+		for _, ipnet := range allocRanges {
+			if ipnet.Contains(parsed) {
+				inRange = true
+				break
+			}
+		}
+		if !inRange {
+			continue
+		}
+		if _, ok := sr.Ipam.Allocations[ip]; !ok {
+			findings = append(findings, ipamFinding{
+				Category: "untracked-interface-address",
+				IP:       ip,
+				Detail:   fmt.Sprintf("configured on %s but not tracked by IPAM", ifName),
+			})
+		}
+	}
+
+	if command.OutputOption() {
+		if err := command.PrintOutput(findings); err != nil {
+			os.Exit(1)
+		}
+		if len(findings) > 0 {
+			os.Exit(1)
+		}
+		return
+	}
+
+	if len(findings) == 0 {
+		fmt.Println("No inconsistencies found")
+		return
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 5, 0, 3, ' ', 0)
+	fmt.Fprintln(w, "CATEGORY\tIP\tDETAIL")
+	for _, f := range findings {
+		fmt.Fprintf(w, "%s\t%s\t%s\n", f.Category, f.IP, f.Detail)
+	}
+	w.Flush()
+	os.Exit(1)
+}
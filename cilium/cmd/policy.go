@@ -126,6 +126,10 @@ func loadPolicyFile(path string) (api.Rules, error) {
 		return nil, err
 	}
 
+	if ruleList, isBundle, err := parsePolicyBundle(path, content); isBundle {
+		return ruleList, err
+	}
+
 	var ruleList api.Rules
 	err = json.Unmarshal(content, &ruleList)
 	if err != nil {
@@ -0,0 +1,131 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	policyApi "github.com/cilium/cilium/api/v1/client/policy"
+	"github.com/cilium/cilium/api/v1/models"
+	"github.com/cilium/cilium/pkg/api"
+	pkg "github.com/cilium/cilium/pkg/client"
+	policyapi "github.com/cilium/cilium/pkg/policy/api"
+)
+
+// policyBundleVersion is the version of the policy bundle format produced by
+// `cilium policy export`. Bump it whenever the layout of policyBundle changes
+// in an incompatible way.
+const policyBundleVersion = 1
+
+// policyBundle is a self-contained snapshot of the policy state of an agent.
+// It carries the policy repository together with the allocated identities and
+// the selector cache so that the state can be moved between clusters or
+// attached to support tickets and replayed with `cilium policy import`.
+type policyBundle struct {
+	// Version is the bundle format version, see policyBundleVersion.
+	Version int `json:"version"`
+
+	// ExportedAt is the time at which the bundle was created.
+	ExportedAt time.Time `json:"exportedAt"`
+
+	// Revision is the policy repository revision at export time.
+	Revision int64 `json:"revision"`
+
+	// Policy contains the rules of the policy repository in JSON format.
+	Policy json.RawMessage `json:"policy"`
+
+	// Identities are the security identities allocated at export time.
+	Identities []*models.Identity `json:"identities,omitempty"`
+
+	// Selectors is the selector cache snapshot at export time.
+	Selectors models.SelectorCache `json:"selectors,omitempty"`
+}
+
+// policyExportCmd represents the policy_export command
+var policyExportCmd = &cobra.Command{
+	Use:   "export <path>",
+	Short: "Export policy state as a versioned bundle",
+	Long: `Exports the policy repository, the allocated identities and the
+selector cache as a versioned bundle which can be replayed with
+"cilium policy import" or attached to support tickets.`,
+	Example: `  cilium policy export ~/policy-bundle.json
+  cilium policy export -`,
+	PreRun: requirePath,
+	Run: func(cmd *cobra.Command, args []string) {
+		path := args[0]
+
+		policy, err := client.PolicyGet(nil)
+		if err != nil {
+			Fatalf("Cannot get policy: %s\n", err)
+		}
+
+		params := policyApi.NewGetIdentityParams().WithTimeout(api.ClientTimeout)
+		identities, err := client.Policy.GetIdentity(params)
+		if err != nil {
+			Fatalf("Cannot get identities: %s\n", pkg.Hint(err))
+		}
+
+		selectors, err := client.PolicyCacheGet()
+		if err != nil {
+			Fatalf("Cannot get selector cache: %s\n", err)
+		}
+
+		bundle := policyBundle{
+			Version:    policyBundleVersion,
+			ExportedAt: time.Now().UTC(),
+			Revision:   policy.Revision,
+			Policy:     json.RawMessage(policy.Policy),
+			Identities: identities.Payload,
+			Selectors:  selectors,
+		}
+
+		content, err := json.MarshalIndent(bundle, "", "  ")
+		if err != nil {
+			Fatalf("Cannot marshal policy bundle: %s\n", err)
+		}
+		content = append(content, '\n')
+
+		if path == "-" {
+			if _, err := os.Stdout.Write(content); err != nil {
+				Fatalf("Cannot write policy bundle: %s\n", err)
+			}
+			return
+		}
+
+		if err := os.WriteFile(path, content, 0644); err != nil {
+			Fatalf("Cannot write policy bundle: %s\n", err)
+		}
+		fmt.Printf("Exported policy bundle to %s\nRevision: %d\n", path, policy.Revision)
+	},
+}
+
+func init() {
+	policyCmd.AddCommand(policyExportCmd)
+}
+
+// parsePolicyBundle returns the rules contained in a policy bundle. The
+// returned boolean indicates whether the content is a bundle at all; content
+// which is not a bundle is parsed as a plain rule list by the caller.
+func parsePolicyBundle(path string, content []byte) (ruleList policyapi.Rules, isBundle bool, err error) {
+	var bundle policyBundle
+	if json.Unmarshal(content, &bundle) != nil || bundle.Version == 0 {
+		return nil, false, nil
+	}
+
+	if bundle.Version > policyBundleVersion {
+		return nil, true, fmt.Errorf("unsupported policy bundle version %d, supported versions: <= %d",
+			bundle.Version, policyBundleVersion)
+	}
+
+	if err := json.Unmarshal(bundle.Policy, &ruleList); err != nil {
+		return nil, true, handleUnmarshalError(path, bundle.Policy, err)
+	}
+
+	return ruleList, true, nil
+}
@@ -7,21 +7,27 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"time"
 
 	"github.com/spf13/cobra"
 
 	"github.com/cilium/cilium/pkg/command"
 	"github.com/cilium/cilium/pkg/logging/logfields"
+	policyApi "github.com/cilium/cilium/pkg/policy/api"
 )
 
-var printPolicy bool
+var (
+	printPolicy bool
+	importTTL   time.Duration
+)
 
 // policyImportCmd represents the policy_import command
 var policyImportCmd = &cobra.Command{
 	Use:   "import <path>",
 	Short: "Import security policy in JSON format",
 	Example: `  cilium policy import ~/policy.json
-  cilium policy import ./policies/app/`,
+  cilium policy import ./policies/app/
+  cilium policy import ~/policy-bundle.json`,
 	PreRun: requirePath,
 	Run: func(cmd *cobra.Command, args []string) {
 		path := args[0]
@@ -36,6 +42,13 @@ var policyImportCmd = &cobra.Command{
 				return
 			}
 
+			if importTTL > 0 {
+				deadline := time.Now().Add(importTTL)
+				for _, r := range ruleList {
+					r.Labels = append(r.Labels, policyApi.NewExpirationLabel(deadline))
+				}
+			}
+
 			for _, r := range ruleList {
 				if err := r.Sanitize(); err != nil {
 					Fatalf("%s", err)
@@ -64,5 +77,6 @@ var policyImportCmd = &cobra.Command{
 func init() {
 	policyCmd.AddCommand(policyImportCmd)
 	policyImportCmd.Flags().BoolVarP(&printPolicy, "print", "", false, "Print policy after import")
+	policyImportCmd.Flags().DurationVar(&importTTL, "ttl", 0, "Remove the imported policy automatically after this duration (e.g. 30m)")
 	command.AddOutputOption(policyImportCmd)
 }
@@ -31,3 +31,46 @@ func TestLoadInvalidPolicyJSON(t *testing.T) {
 		t.Errorf("expected malformed policy error, got %v", err)
 	}
 }
+
+func TestLoadPolicyBundle(t *testing.T) {
+	bundleJSON := []byte(`{
+		"version": 1,
+		"exportedAt": "2023-01-01T00:00:00Z",
+		"revision": 42,
+		"policy": [{
+			"endpointSelector": {
+				"matchLabels": {"id.httpd1": ""}
+			}
+		}]
+	}`)
+
+	bundle := filepath.Join(t.TempDir(), "bundle.json")
+	if err := os.WriteFile(bundle, bundleJSON, 0666); err != nil {
+		t.Fatalf("failed to write policy bundle file: %v", err)
+	}
+
+	ruleList, err := loadPolicyFile(bundle)
+	if err != nil {
+		t.Fatalf("failed to load policy bundle: %v", err)
+	}
+	if len(ruleList) != 1 {
+		t.Errorf("expected 1 rule from bundle, got %d", len(ruleList))
+	}
+}
+
+func TestLoadPolicyBundleUnsupportedVersion(t *testing.T) {
+	bundleJSON := []byte(`{"version": 999, "policy": []}`)
+
+	bundle := filepath.Join(t.TempDir(), "bundle.json")
+	if err := os.WriteFile(bundle, bundleJSON, 0666); err != nil {
+		t.Fatalf("failed to write policy bundle file: %v", err)
+	}
+
+	_, err := loadPolicyFile(bundle)
+	if err == nil {
+		t.Error("loading unsupported bundle version unexpectedly succeeded")
+	}
+	if !strings.Contains(err.Error(), "unsupported policy bundle version") {
+		t.Errorf("expected unsupported version error, got %v", err)
+	}
+}
@@ -201,6 +201,8 @@ func (h *getConfig) Handle(params GetConfigParams) middleware.Responder {
 		EgressMultiHomeIPRuleCompat: option.Config.EgressMultiHomeIPRuleCompat,
 		GROMaxSize:                  int64(d.bigTCPConfig.GetGROMaxSize()),
 		GSOMaxSize:                  int64(d.bigTCPConfig.GetGSOMaxSize()),
+		GROIPV4MaxSize:              int64(d.bigTCPConfig.GetGROIPv4MaxSize()),
+		GSOIPV4MaxSize:              int64(d.bigTCPConfig.GetGSOIPv4MaxSize()),
 	}
 
 	cfg := &models.DaemonConfiguration{
@@ -12,6 +12,7 @@ import (
 	"net/netip"
 	"os"
 	"runtime"
+	"sort"
 	"strings"
 	"sync"
 	"time"
@@ -73,6 +74,7 @@ import (
 	monitoragent "github.com/cilium/cilium/pkg/monitor/agent"
 	monitorAPI "github.com/cilium/cilium/pkg/monitor/api"
 	"github.com/cilium/cilium/pkg/mtu"
+	"github.com/cilium/cilium/pkg/multicast"
 	"github.com/cilium/cilium/pkg/node"
 	nodemanager "github.com/cilium/cilium/pkg/node/manager"
 	nodeStore "github.com/cilium/cilium/pkg/node/store"
@@ -93,6 +95,7 @@ import (
 	"github.com/cilium/cilium/pkg/status"
 	"github.com/cilium/cilium/pkg/trigger"
 	wg "github.com/cilium/cilium/pkg/wireguard/agent"
+	"github.com/cilium/cilium/pkg/xdpfilter"
 	cnitypes "github.com/cilium/cilium/plugins/cilium-cni/types"
 )
 
@@ -105,8 +108,40 @@ const (
 	ConfigModifyQueueSize = 10
 
 	syncHostIPsController = "sync-host-ips"
+
+	bpfMapPressureController = "bpf-map-pressure"
+
+	defaultDenyRolloutController = "default-deny-rollout"
+
+	policyExpirationGCController = "policy-expiration-gc"
+
+	// bpfMapPressureThreshold is the fill ratio above which a BPF map is
+	// reported as near capacity
+	bpfMapPressureThreshold = 0.9
 )
 
+// checkBPFMapPressure returns an error listing all BPF maps which are near
+// capacity, degrading the agent health status.
+func checkBPFMapPressure() error {
+	maps := bpf.MapsNearCapacity(bpfMapPressureThreshold)
+	if len(maps) == 0 {
+		return nil
+	}
+
+	names := make([]string, 0, len(maps))
+	for name := range maps {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	descs := make([]string, 0, len(names))
+	for _, name := range names {
+		descs = append(descs, fmt.Sprintf("%s (%.2f%% full)", name, maps[name]*100))
+	}
+
+	return fmt.Errorf("BPF maps near capacity: %s", strings.Join(descs, ", "))
+}
+
 // Daemon is the cilium daemon that is in charge of perform all necessary plumbing,
 // monitoring when a LXC starts.
 type Daemon struct {
@@ -333,7 +368,7 @@ func (d *Daemon) restoreCiliumHostIPs(ipv6 bool, fromK8s net.IP) {
 		case ipamOption.IPAMCRD:
 			// The native routing CIDR is the pod CIDR in CRD mode.
 			cidrs = []*cidr.CIDR{option.Config.GetIPv4NativeRoutingCIDR()}
-		case ipamOption.IPAMENI, ipamOption.IPAMAzure, ipamOption.IPAMAlibabaCloud:
+		case ipamOption.IPAMENI, ipamOption.IPAMAzure, ipamOption.IPAMAlibabaCloud, ipamOption.IPAMOpenStack:
 			// d.startIPAM() has already been called at this stage to initialize sharedNodeStore with ownNode info
 			// needed for GetVpcCIDRs()
 			cidrs = d.ipam.GetVpcCIDRs()
@@ -911,7 +946,7 @@ func newDaemon(ctx context.Context, cleaner *daemonCleanup,
 		// in large clusters.
 		d.k8sWatcher.NodesInit(d.clientset)
 
-		if option.Config.IPAM == ipamOption.IPAMClusterPool || option.Config.IPAM == ipamOption.IPAMClusterPoolV2 {
+		if option.Config.IPAM == ipamOption.IPAMClusterPool || option.Config.IPAM == ipamOption.IPAMClusterPoolV2 || option.Config.IPAM == ipamOption.IPAMMultiPool {
 			// Create the CiliumNode custom resource. This call will block until
 			// the custom resource has been created
 			d.nodeDiscovery.UpdateCiliumNodeResource()
@@ -1132,6 +1167,16 @@ func newDaemon(ctx context.Context, cleaner *daemonCleanup,
 
 	// Start IPAM
 	d.startIPAM()
+
+	if option.Config.EnableMulticast && clientset.IsEnabled() {
+		multicast.WatchGroups(clientset, d.k8sWatcher)
+	}
+
+	// The egress gateway manager is created before IPAM, hand it the
+	// allocator backing the per-namespace egress IP pools now
+	if d.egressGatewayManager != nil && len(option.Config.EgressIPPools) > 0 {
+		d.egressGatewayManager.SetEgressIPAllocator(d.ipam)
+	}
 	// After the IPAM is started, in particular IPAM modes (CRD, ENI, Alibaba)
 	// which use the VPC CIDR as the pod CIDR, we must attempt restoring the
 	// router IPs from the K8s resources if we weren't able to restore them
@@ -1194,7 +1239,8 @@ func newDaemon(ctx context.Context, cleaner *daemonCleanup,
 	// Trigger refresh and update custom resource in the apiserver with all restored endpoints.
 	// Trigger after nodeDiscovery.StartDiscovery to avoid custom resource update conflict.
 	if option.Config.IPAM == ipamOption.IPAMCRD || option.Config.IPAM == ipamOption.IPAMENI || option.Config.IPAM == ipamOption.IPAMAzure ||
-		option.Config.IPAM == ipamOption.IPAMAlibabaCloud || option.Config.IPAM == ipamOption.IPAMClusterPoolV2 {
+		option.Config.IPAM == ipamOption.IPAMAlibabaCloud || option.Config.IPAM == ipamOption.IPAMOpenStack ||
+		option.Config.IPAM == ipamOption.IPAMClusterPoolV2 {
 		if option.Config.EnableIPv6 {
 			d.ipam.IPv6Allocator.RestoreFinished()
 		}
@@ -1224,6 +1270,12 @@ func newDaemon(ctx context.Context, cleaner *daemonCleanup,
 		return nil, restoredEndpoints, fmt.Errorf("error while initializing daemon: %w", err)
 	}
 
+	// The XDP filter watcher can only start once d.init() has loaded the
+	// base datapath and set up the prefilter maps.
+	if option.Config.EnableXDPPrefilter && clientset.IsEnabled() && d.preFilter != nil {
+		xdpfilter.WatchFilters(clientset, d.k8sWatcher, d.preFilter)
+	}
+
 	// iptables rules can be updated only after d.init() intializes the iptables above.
 	err = d.updateDNSDatapathRules(d.ctx)
 	if err != nil {
@@ -1272,6 +1324,50 @@ func newDaemon(ctx context.Context, cleaner *daemonCleanup,
 		return nil, nil, err
 	}
 
+	// Periodically check the fill level of the BPF maps. A failing
+	// controller degrades the agent health status so that near-capacity
+	// maps surface in "cilium status" before entries start being dropped.
+	d.controllers.UpdateController(
+		bpfMapPressureController,
+		controller.ControllerParams{
+			DoFunc: func(ctx context.Context) error {
+				return checkBPFMapPressure()
+			},
+			RunInterval: 5 * time.Minute,
+			Context:     d.ctx,
+		})
+
+	// Advance the staged default-deny rollout. The controller reports the
+	// per-namespace enforcement status and regenerates endpoints when a
+	// namespace finishes its audit phase.
+	if len(option.Config.PolicyDefaultDenyNamespaces) > 0 {
+		enforced := make(map[string]bool)
+		d.controllers.UpdateController(
+			defaultDenyRolloutController,
+			controller.ControllerParams{
+				DoFunc: func(ctx context.Context) error {
+					d.advanceDefaultDenyRollout(enforced)
+					return nil
+				},
+				RunInterval: time.Minute,
+				Context:     d.ctx,
+			})
+	}
+
+	// Remove temporary policy exceptions from the repository once their
+	// TTL has passed. Expired rules are removed through the regular policy
+	// deletion path so that endpoints are regenerated accordingly.
+	d.controllers.UpdateController(
+		policyExpirationGCController,
+		controller.ControllerParams{
+			DoFunc: func(ctx context.Context) error {
+				d.removeExpiredPolicyRules()
+				return nil
+			},
+			RunInterval: 30 * time.Second,
+			Context:     d.ctx,
+		})
+
 	if err := loader.RestoreTemplates(option.Config.StateDir); err != nil {
 		log.WithError(err).Error("Unable to restore previous BPF templates")
 	}
@@ -1282,6 +1378,10 @@ func newDaemon(ctx context.Context, cleaner *daemonCleanup,
 	d.ipcache.InitIPIdentityWatcher(d.ctx)
 	identitymanager.Subscribe(d.policy)
 
+	// Start feeding the set of identities relevant to the node if
+	// relevance-based policy computation is enabled.
+	d.startIdentityRelevanceWatcher()
+
 	// Start listening to changed devices if requested.
 	if option.Config.EnableRuntimeDeviceDetection {
 		if option.Config.AreDevicesRequired() {
@@ -229,6 +229,9 @@ func initializeFlags() {
 	flags.String(option.DatapathMode, defaults.DatapathMode, "Datapath mode name")
 	option.BindEnv(Vp, option.DatapathMode)
 
+	flags.Duration(option.DatapathDrainTimeout, 0, "Time for which obsolete datapath programs keep forwarding so that established connections can drain before they are detached (0 to detach immediately)")
+	option.BindEnv(Vp, option.DatapathDrainTimeout)
+
 	flags.Bool(option.EnableEndpointRoutes, defaults.EnableEndpointRoutes, "Use per endpoint routes instead of routing via cilium_host")
 	option.BindEnv(Vp, option.EnableEndpointRoutes)
 
@@ -257,9 +260,15 @@ func initializeFlags() {
 	flags.Bool(option.EnableNat46X64Gateway, false, "Enable NAT46 and NAT64 gateway")
 	option.BindEnv(Vp, option.EnableNat46X64Gateway)
 
+	flags.Bool(option.EnableDNS64, false, "Enable DNS64 AAAA synthesis in the DNS proxy for the NAT46x64 gateway prefix")
+	option.BindEnv(Vp, option.EnableDNS64)
+
 	flags.Bool(option.EnableIPv6NDPName, defaults.EnableIPv6NDP, "Enable IPv6 NDP support")
 	option.BindEnv(Vp, option.EnableIPv6NDPName)
 
+	flags.Bool(option.EnableIPv6OnlyIPAM, false, "Allow single-stack IPv6 allocation in the ENI, Azure, AlibabaCloud and CRD IPAM modes")
+	option.BindEnv(Vp, option.EnableIPv6OnlyIPAM)
+
 	flags.Bool(option.EnableSRv6, defaults.EnableSRv6, "Enable SRv6 support (beta)")
 	flags.MarkHidden(option.EnableSRv6)
 	option.BindEnv(Vp, option.EnableSRv6)
@@ -271,6 +280,9 @@ func initializeFlags() {
 	flags.Bool(option.EnableSCTPName, defaults.EnableSCTP, "Enable SCTP support (beta)")
 	option.BindEnv(Vp, option.EnableSCTPName)
 
+	flags.Bool(option.EnableMulticastName, defaults.EnableMulticast, "Enable multicast forwarding for groups listed in CiliumMulticastGroup resources (beta)")
+	option.BindEnv(Vp, option.EnableMulticastName)
+
 	flags.String(option.IPv6MCastDevice, "", "Device that joins a Solicited-Node multicast group for IPv6")
 	option.BindEnv(Vp, option.IPv6MCastDevice)
 
@@ -305,12 +317,18 @@ func initializeFlags() {
 	flags.Bool(option.EnableSocketLB, false, "Enable socket-based LB for E/W traffic")
 	option.BindEnv(Vp, option.EnableSocketLB)
 
+	flags.String(option.BPFSocketLBSkipSelector, "", "Label selector matching pods which are excluded from socket LB, evaluated against the pod labels including the namespace labels under the io.cilium.k8s.namespace.labels prefix")
+	option.BindEnv(Vp, option.BPFSocketLBSkipSelector)
+
 	flags.Bool(option.EnableSocketLBTracing, true, "Enable tracing for socket-based LB")
 	option.BindEnv(Vp, option.EnableSocketLBTracing)
 
 	flags.Bool(option.EnableAutoDirectRoutingName, defaults.EnableAutoDirectRouting, "Enable automatic L2 routing between nodes")
 	option.BindEnv(Vp, option.EnableAutoDirectRoutingName)
 
+	flags.StringSlice(option.DirectRoutingNodeCIDRsName, []string{}, "List of CIDRs. Nodes with an IP within these CIDRs are reached via native routing while tunneling remains in use towards all other nodes")
+	option.BindEnv(Vp, option.DirectRoutingNodeCIDRsName)
+
 	flags.Bool(option.EnableBPFTProxy, defaults.EnableBPFTProxy, "Enable BPF-based proxy redirection, if support available")
 	option.BindEnv(Vp, option.EnableBPFTProxy)
 
@@ -332,6 +350,9 @@ func initializeFlags() {
 	flags.Bool(option.EnableL7Proxy, defaults.EnableL7Proxy, "Enable L7 proxy for L7 policy enforcement")
 	option.BindEnv(Vp, option.EnableL7Proxy)
 
+	flags.Bool(option.EnableStickyIPs, false, "Re-assign the same IP to pods with a stable identity, e.g. StatefulSet pods, across pod restarts")
+	option.BindEnv(Vp, option.EnableStickyIPs)
+
 	flags.Bool(option.EnableTracing, false, "Enable tracing while determining policy (debugging)")
 	option.BindEnv(Vp, option.EnableTracing)
 
@@ -418,6 +439,27 @@ func initializeFlags() {
 	flags.String(option.IPAM, ipamOption.IPAMClusterPool, "Backend to use for IPAM")
 	option.BindEnv(Vp, option.IPAM)
 
+	flags.Duration(option.IPAMReleaseGracePeriod, 0, "Duration a released pod IP is quarantined before it can be re-allocated (0 to disable)")
+	option.BindEnv(Vp, option.IPAMReleaseGracePeriod)
+
+	flags.String(option.ExternalIPAMEndpoint, "", "gRPC endpoint of the external IPAM plugin used in external IPAM mode, e.g. 'unix:///var/run/cilium/external-ipam.sock'")
+	option.BindEnv(Vp, option.ExternalIPAMEndpoint)
+
+	flags.String(option.ExternalIPAMCAFile, "", "CA certificate bundle used to verify the external IPAM plugin, enables TLS (required for non-unix endpoints)")
+	option.BindEnv(Vp, option.ExternalIPAMCAFile)
+
+	flags.String(option.ExternalIPAMCertFile, "", "Client certificate presented to the external IPAM plugin for mutual TLS")
+	option.BindEnv(Vp, option.ExternalIPAMCertFile)
+
+	flags.String(option.ExternalIPAMKeyFile, "", "Client key presented to the external IPAM plugin for mutual TLS")
+	option.BindEnv(Vp, option.ExternalIPAMKeyFile)
+
+	flags.Bool(option.EnableIPAMEvents, false, "Emit Kubernetes events for IP allocations and releases")
+	option.BindEnv(Vp, option.EnableIPAMEvents)
+
+	flags.String(option.IPAMEventLogFile, "", "Path to a file to which structured IPAM allocation and release events are appended")
+	option.BindEnv(Vp, option.IPAMEventLogFile)
+
 	flags.String(option.CNIChainingMode, "", "Enable CNI chaining with the specified plugin")
 	option.BindEnv(Vp, option.CNIChainingMode)
 
@@ -658,12 +700,19 @@ func initializeFlags() {
 	flags.Bool(option.EnableIPv6BIGTCP, false, "Enable IPv6 BIG TCP option which increases device's maximum GRO/GSO limits")
 	option.BindEnv(Vp, option.EnableIPv6BIGTCP)
 
+	flags.Bool(option.EnableIPv4BIGTCP, false, "Enable IPv4 BIG TCP option which increases device's maximum GRO/GSO limits")
+	option.BindEnv(Vp, option.EnableIPv4BIGTCP)
+
 	flags.Bool(option.EnableIPv4EgressGateway, false, "Enable egress gateway for IPv4")
 	option.BindEnv(Vp, option.EnableIPv4EgressGateway)
 
 	flags.Bool(option.InstallEgressGatewayRoutes, false, "Install egress gateway IP rules and routes in order to properly steer egress gateway traffic to the correct ENI interface")
 	option.BindEnv(Vp, option.InstallEgressGatewayRoutes)
 
+	flags.Var(option.NewNamedMapOptions(option.EgressIPPools, &option.Config.EgressIPPools, nil),
+		option.EgressIPPools, "Per-namespace pools of source IPs used for masquerading the namespace's traffic, e.g. 'ns1=10.100.1.0/28,ns2=10.100.2.0/28'")
+	option.BindEnv(Vp, option.EgressIPPools)
+
 	flags.Bool(option.EnableEnvoyConfig, false, "Enable Envoy Config CRDs")
 	option.BindEnv(Vp, option.EnableEnvoyConfig)
 
@@ -702,6 +751,9 @@ func initializeFlags() {
 	flags.Int(option.MTUName, 0, "Overwrite auto-detected MTU of underlying network")
 	option.BindEnv(Vp, option.MTUName)
 
+	flags.Bool(option.RouteMTUDiscoveryName, false, "Discover the MTU of the path towards each remote node from the kernel routing table and program it on the per-node routes")
+	option.BindEnv(Vp, option.RouteMTUDiscoveryName)
+
 	flags.String(option.ProcFs, "/proc", "Root's proc filesystem path")
 	option.BindEnv(Vp, option.ProcFs)
 
@@ -820,6 +872,9 @@ func initializeFlags() {
 	flags.Float64(option.MapEntriesGlobalDynamicSizeRatioName, 0.0025, "Ratio (0.0-1.0] of total system memory to use for dynamic sizing of CT, NAT and policy BPF maps")
 	option.BindEnv(Vp, option.MapEntriesGlobalDynamicSizeRatioName)
 
+	flags.String(option.BPFMapSizingProfileName, option.MapSizingProfileCustom, "BPF map sizing profile (custom, small, medium, large). Scales the policy, LB, CT and NAT map sizes relative to their defaults for the expected number of endpoints and services per node. Explicitly set map size options override the profile")
+	option.BindEnv(Vp, option.BPFMapSizingProfileName)
+
 	flags.String(option.CMDRef, "", "Path to cmdref output directory")
 	flags.MarkHidden(option.CMDRef)
 	option.BindEnv(Vp, option.CMDRef)
@@ -898,6 +953,15 @@ func initializeFlags() {
 	flags.Bool(option.PolicyAuditModeArg, false, "Enable policy audit (non-drop) mode")
 	option.BindEnv(Vp, option.PolicyAuditModeArg)
 
+	flags.StringSlice(option.PolicyDefaultDenyNamespaces, nil, "Namespaces to roll out to default-deny policy enforcement, each starting with an audit phase")
+	option.BindEnv(Vp, option.PolicyDefaultDenyNamespaces)
+
+	flags.Duration(option.PolicyDefaultDenyAuditDuration, defaults.PolicyDefaultDenyAuditDuration, "Duration of the audit phase for namespaces in the staged default-deny rollout")
+	option.BindEnv(Vp, option.PolicyDefaultDenyAuditDuration)
+
+	flags.Bool(option.EnableIdentityRelevance, false, "Compute policy map entries only for identities relevant to the node, filling in entries for the remaining identities when they are first seen in the datapath (EXPERIMENTAL)")
+	option.BindEnv(Vp, option.EnableIdentityRelevance)
+
 	flags.Bool(option.EnableHubble, false, "Enable hubble server")
 	option.BindEnv(Vp, option.EnableHubble)
 
@@ -934,6 +998,18 @@ func initializeFlags() {
 	flags.StringSlice(option.HubbleMetrics, []string{}, "List of Hubble metrics to enable.")
 	option.BindEnv(Vp, option.HubbleMetrics)
 
+	flags.String(option.HubbleFlowStorePath, "", "Filepath of the on-disk store backing the Hubble ring buffer. If unset, flows do not survive agent restarts.")
+	option.BindEnv(Vp, option.HubbleFlowStorePath)
+
+	flags.Int(option.HubbleFlowStoreMaxSizeMB, 64, "Size in MB at which the on-disk Hubble flow store is capped.")
+	option.BindEnv(Vp, option.HubbleFlowStoreMaxSizeMB)
+
+	flags.StringSlice(option.HubbleFlowSampling, []string{}, "Sampling rates for Hubble flows per verdict and namespace in the form '<verdict>[/<namespace>]:<rate>' (e.g. 'FORWARDED/kube-system:0.01')")
+	option.BindEnv(Vp, option.HubbleFlowSampling)
+
+	flags.Duration(option.HubbleFlowAggregationWindow, 0, "Window within which forwarded Hubble flows of the same connection are deduplicated (0 to disable)")
+	option.BindEnv(Vp, option.HubbleFlowAggregationWindow)
+
 	flags.String(option.HubbleExportFilePath, exporteroption.Default.Path, "Filepath to write Hubble events to.")
 	option.BindEnv(Vp, option.HubbleExportFilePath)
 
@@ -1043,6 +1119,15 @@ func initializeFlags() {
 	flags.MarkHidden(option.EnableICMPRules)
 	option.BindEnv(Vp, option.EnableICMPRules)
 
+	flags.Bool(option.EnableNodeSelectorLabels, defaults.EnableNodeSelectorLabels, "Enable use of node label based identity")
+	option.BindEnv(Vp, option.EnableNodeSelectorLabels)
+
+	flags.Bool(option.MeshAuthEnabled, false, "Enable authentication rule support for Cilium Network Policies (EXPERIMENTAL)")
+	option.BindEnv(Vp, option.MeshAuthEnabled)
+
+	flags.Bool(option.EnablePolicyRateLimit, false, "Enable rate limit rule support for Cilium Network Policies (EXPERIMENTAL)")
+	option.BindEnv(Vp, option.EnablePolicyRateLimit)
+
 	flags.Bool(option.BypassIPAvailabilityUponRestore, false, "Bypasses the IP availability error within IPAM upon endpoint restore")
 	flags.MarkHidden(option.BypassIPAvailabilityUponRestore)
 	option.BindEnv(Vp, option.BypassIPAvailabilityUponRestore)
@@ -1298,6 +1383,10 @@ func initEnv() {
 	if option.Config.PolicyAuditMode {
 		log.Warningf("%s is enabled. Network policy will not be enforced.", option.PolicyAuditMode)
 	}
+	policy.SetDefaultDenyRollout(option.Config.PolicyDefaultDenyNamespaces, option.Config.PolicyDefaultDenyAuditDuration)
+	if option.Config.EnableIdentityRelevance {
+		policy.EnableIdentityRelevance()
+	}
 
 	if err := identity.AddUserDefinedNumericIdentitySet(option.Config.FixedIdentityMapping); err != nil {
 		log.WithError(err).Fatal("Invalid fixed identities provided")
@@ -1364,6 +1453,20 @@ func initEnv() {
 		log.Fatalf("%s cannot be used with tunneling. Packets must be routed through the tunnel device.", option.EnableAutoDirectRoutingName)
 	}
 
+	if option.Config.SocketLBSkipSelector != nil && !option.Config.EnableSocketLB {
+		log.Fatalf("--%s requires socket LB (--%s) to be enabled", option.BPFSocketLBSkipSelector, option.EnableSocketLB)
+	}
+
+	if len(option.Config.DirectRoutingNodeCIDRs) > 0 {
+		if !option.Config.TunnelingEnabled() {
+			log.Fatalf("%s requires tunneling, use %s to install direct routes in native routing mode.",
+				option.DirectRoutingNodeCIDRsName, option.EnableAutoDirectRoutingName)
+		}
+		if option.Config.EnableIPSec {
+			log.Fatalf("%s is not compatible with IPsec encryption.", option.DirectRoutingNodeCIDRsName)
+		}
+	}
+
 	initClockSourceOption()
 	initSockmapOption()
 
@@ -1467,6 +1570,15 @@ func initEnv() {
 		)
 	}
 
+	if option.Config.IPAM == ipamOption.IPAMOpenStack {
+		option.Config.EgressMultiHomeIPRuleCompat = true
+		log.Infof(
+			"Auto-set %q to `true` because the interface number of Neutron ports is not tracked. "+
+				"Connectivity is not affected.",
+			option.EgressMultiHomeIPRuleCompat,
+		)
+	}
+
 	if option.Config.IPAM == ipamOption.IPAMClusterPoolV2 {
 		if option.Config.TunnelingEnabled() {
 			log.Fatalf("Cannot specify IPAM mode %s in tunnel mode.", ipamOption.IPAMClusterPoolV2)
@@ -1969,6 +2081,9 @@ func (d *Daemon) instantiateAPI() *restapi.CiliumAPIAPI {
 		restAPI.PolicyDeletePolicyHandler = newDeletePolicyHandler(d)
 		restAPI.PolicyGetPolicySelectorsHandler = newGetPolicyCacheHandler(d)
 
+		// /policy/impact/
+		restAPI.PolicyGetPolicyImpactHandler = NewGetPolicyImpactHandler(d)
+
 		// /policy/resolve/
 		restAPI.PolicyGetPolicyResolveHandler = NewGetPolicyResolveHandler(d)
 
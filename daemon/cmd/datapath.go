@@ -30,6 +30,7 @@ import (
 	"github.com/cilium/cilium/pkg/ipcache"
 	"github.com/cilium/cilium/pkg/labels"
 	"github.com/cilium/cilium/pkg/logging/logfields"
+	"github.com/cilium/cilium/pkg/maps/authmap"
 	"github.com/cilium/cilium/pkg/maps/ctmap"
 	"github.com/cilium/cilium/pkg/maps/egressmap"
 	"github.com/cilium/cilium/pkg/maps/eventsmap"
@@ -37,15 +38,19 @@ import (
 	ipcachemap "github.com/cilium/cilium/pkg/maps/ipcache"
 	"github.com/cilium/cilium/pkg/maps/ipmasq"
 	"github.com/cilium/cilium/pkg/maps/lbmap"
+	"github.com/cilium/cilium/pkg/maps/lbskipmap"
 	"github.com/cilium/cilium/pkg/maps/lxcmap"
+	"github.com/cilium/cilium/pkg/maps/mcastmap"
 	"github.com/cilium/cilium/pkg/maps/metricsmap"
 	"github.com/cilium/cilium/pkg/maps/nat"
 	"github.com/cilium/cilium/pkg/maps/neighborsmap"
 	"github.com/cilium/cilium/pkg/maps/policymap"
+	"github.com/cilium/cilium/pkg/maps/ratelimitmap"
 	"github.com/cilium/cilium/pkg/maps/signalmap"
 	"github.com/cilium/cilium/pkg/maps/srv6map"
 	"github.com/cilium/cilium/pkg/maps/tunnel"
 	"github.com/cilium/cilium/pkg/maps/vtep"
+	"github.com/cilium/cilium/pkg/maps/xdpportmap"
 	"github.com/cilium/cilium/pkg/mtu"
 	"github.com/cilium/cilium/pkg/node"
 	"github.com/cilium/cilium/pkg/option"
@@ -360,6 +365,36 @@ func (d *Daemon) initMaps() error {
 		}
 	}
 
+	if option.Config.MeshAuthEnabled {
+		if _, err := authmap.AuthMap.OpenOrCreate(); err != nil {
+			return err
+		}
+	}
+
+	if option.Config.EnablePolicyRateLimit {
+		if _, err := ratelimitmap.RateLimitMap.OpenOrCreate(); err != nil {
+			return err
+		}
+	}
+
+	if option.Config.EnableMulticast {
+		if _, err := mcastmap.McastGroupMap.OpenOrCreate(); err != nil {
+			return err
+		}
+	}
+
+	if option.Config.EnableXDPPrefilter {
+		if _, err := xdpportmap.PortFilterMap.OpenOrCreate(); err != nil {
+			return err
+		}
+	}
+
+	if option.Config.EnableSocketLB && option.Config.SocketLBSkipSelector != nil {
+		if _, err := lbskipmap.SkipMap.OpenOrCreate(); err != nil {
+			return err
+		}
+	}
+
 	createSockRevNatMaps := option.Config.EnableSocketLB &&
 		probes.HaveMapType(ebpf.LRUHash) == nil
 	if err := d.svc.InitMaps(option.Config.EnableIPv6, option.Config.EnableIPv4,
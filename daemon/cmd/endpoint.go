@@ -15,6 +15,7 @@ import (
 
 	"github.com/go-openapi/runtime/middleware"
 	"github.com/sirupsen/logrus"
+	k8sLabels "k8s.io/apimachinery/pkg/labels"
 
 	"github.com/cilium/cilium/api/v1/models"
 	. "github.com/cilium/cilium/api/v1/server/restapi/endpoint"
@@ -33,6 +34,7 @@ import (
 	"github.com/cilium/cilium/pkg/labelsfilter"
 	"github.com/cilium/cilium/pkg/lock"
 	"github.com/cilium/cilium/pkg/logging/logfields"
+	"github.com/cilium/cilium/pkg/maps/lbskipmap"
 	monitorAPI "github.com/cilium/cilium/pkg/monitor/api"
 	"github.com/cilium/cilium/pkg/option"
 	"github.com/cilium/cilium/pkg/proxy"
@@ -398,11 +400,11 @@ func (d *Daemon) createEndpoint(ctx context.Context, owner regeneration.Owner, e
 			}
 			addLabels.MergeLabels(identityLabels)
 			infoLabels.MergeLabels(info)
-			if _, ok := annotations[bandwidth.IngressBandwidth]; ok {
+			if _, ok := annotations[bandwidth.IngressBandwidth]; ok && !option.Config.EnableBandwidthManager {
 				log.WithFields(logrus.Fields{
 					logfields.K8sPodName:  epTemplate.K8sNamespace + "/" + epTemplate.K8sPodName,
 					logfields.Annotations: logfields.Repr(annotations),
-				}).Warningf("Endpoint has %s annotation which is unsupported. This annotation is ignored.",
+				}).Warningf("Endpoint has %s annotation, but BPF bandwidth manager is disabled. This annotation is ignored.",
 					bandwidth.IngressBandwidth)
 			}
 			if _, ok := annotations[bandwidth.EgressBandwidth]; ok && !option.Config.EnableBandwidthManager {
@@ -457,12 +459,14 @@ func (d *Daemon) createEndpoint(ctx context.Context, owner regeneration.Owner, e
 			}
 			return p.Annotations[annotation.ProxyVisibility], nil
 		})
-		ep.UpdateBandwidthPolicy(func(ns, podName string) (bandwidthEgress string, err error) {
+		ep.UpdateBandwidthPolicy(func(ns, podName string) (bandwidthEgress, bandwidthIngress, bbrPacing string, err error) {
 			p, err := d.k8sWatcher.GetCachedPod(ns, podName)
 			if err != nil {
-				return "", err
+				return "", "", "", err
 			}
-			return p.Annotations[bandwidth.EgressBandwidth], nil
+			return p.Annotations[bandwidth.EgressBandwidth],
+				p.Annotations[bandwidth.IngressBandwidth],
+				p.Annotations[bandwidth.BBRPacing], nil
 		})
 		ep.UpdateNoTrackRules(func(ns, podName string) (noTrackPort string, err error) {
 			p, err := d.k8sWatcher.GetCachedPod(ns, podName)
@@ -521,9 +525,37 @@ func (d *Daemon) createEndpoint(ctx context.Context, owner regeneration.Owner, e
 		}
 	}
 
+	// Exclude the endpoint from socket LB if it matches the configured skip
+	// selector. Matching is done against the labels known at creation time,
+	// labels resolved later, e.g. for static pods, are not taken into
+	// account.
+	if ep.NetNsCookie != 0 && matchesSocketLBSkipSelector(addLabels) {
+		if err := lbskipmap.AddNetNSCookie(ep.NetNsCookie); err != nil {
+			ep.Logger(daemonSubsys).WithError(err).Warning("Unable to exclude endpoint from socket LB")
+		}
+	}
+
 	return ep, 0, nil
 }
 
+// matchesSocketLBSkipSelector returns true if the k8s labels of an endpoint,
+// including the namespace labels, match the socket LB skip selector.
+func matchesSocketLBSkipSelector(lbls labels.Labels) bool {
+	sel := option.Config.SocketLBSkipSelector
+	if sel == nil {
+		return false
+	}
+
+	set := k8sLabels.Set{}
+	for _, lbl := range lbls {
+		if lbl.Source == labels.LabelSourceK8s {
+			set[lbl.Key] = lbl.Value
+		}
+	}
+
+	return sel.Matches(set)
+}
+
 func (h *putEndpointID) Handle(params PutEndpointIDParams) (resp middleware.Responder) {
 	if ep := params.Endpoint; ep != nil {
 		log.WithField("endpoint", logfields.Repr(*ep)).Debug("PUT /endpoint/{id} request")
@@ -716,6 +748,12 @@ func (d *Daemon) DeleteEndpoint(id string) (int, error) {
 func (d *Daemon) EndpointDeleted(ep *endpoint.Endpoint, conf endpoint.DeleteConfig) {
 	d.SendNotification(monitorAPI.EndpointDeleteMessage(ep))
 
+	if option.Config.SocketLBSkipSelector != nil && ep.NetNsCookie != 0 {
+		if err := lbskipmap.DeleteNetNSCookie(ep.NetNsCookie); err != nil {
+			ep.Logger(daemonSubsys).WithError(err).Warning("Unable to remove socket LB exclusion of endpoint")
+		}
+	}
+
 	if !conf.NoIPRelease {
 		if option.Config.EnableIPv4 {
 			if err := d.ipam.ReleaseIP(ep.IPv4.AsSlice()); err != nil {
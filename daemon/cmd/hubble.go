@@ -33,9 +33,11 @@ import (
 	"github.com/cilium/cilium/pkg/hubble/parser"
 	"github.com/cilium/cilium/pkg/hubble/peer"
 	"github.com/cilium/cilium/pkg/hubble/peer/serviceoption"
+	"github.com/cilium/cilium/pkg/hubble/persist"
 	"github.com/cilium/cilium/pkg/hubble/recorder"
 	"github.com/cilium/cilium/pkg/hubble/recorder/recorderoption"
 	"github.com/cilium/cilium/pkg/hubble/recorder/sink"
+	"github.com/cilium/cilium/pkg/hubble/sampling"
 	"github.com/cilium/cilium/pkg/hubble/server"
 	"github.com/cilium/cilium/pkg/hubble/server/serveroption"
 	"github.com/cilium/cilium/pkg/identity"
@@ -128,6 +130,21 @@ func (d *Daemon) launchHubble() {
 		)
 	}
 
+	if len(option.Config.HubbleFlowSampling) > 0 || option.Config.HubbleFlowAggregationWindow > 0 {
+		specs, err := sampling.ParseSpecs(option.Config.HubbleFlowSampling)
+		if err != nil {
+			logger.WithError(err).Error("Failed to parse Hubble flow sampling configuration")
+			return
+		}
+		logger.WithFields(logrus.Fields{
+			"sampling":          option.Config.HubbleFlowSampling,
+			"aggregationWindow": option.Config.HubbleFlowAggregationWindow,
+		}).Info("Enabling Hubble flow sampling")
+		observerOpts = append(observerOpts,
+			observeroption.WithOnDecodedFlow(sampling.NewSampler(specs, option.Config.HubbleFlowAggregationWindow)),
+		)
+	}
+
 	d.linkCache = link.NewLinkCache()
 	payloadParser, err := parser.New(logger, d, d, d, d, d, d.linkCache, d.cgroupManager)
 	if err != nil {
@@ -163,6 +180,16 @@ func (d *Daemon) launchHubble() {
 		}
 	}
 
+	var flowStore *persist.Store
+	if option.Config.HubbleFlowStorePath != "" {
+		flowStore, err = persist.NewStore(logger, option.Config.HubbleFlowStorePath, option.Config.HubbleFlowStoreMaxSizeMB)
+		if err != nil {
+			logger.WithError(err).Error("Failed to open Hubble flow store")
+		} else {
+			observerOpts = append(observerOpts, observeroption.WithOnDecodedFlow(flowStore))
+		}
+	}
+
 	d.hubbleObserver, err = observer.NewLocalServer(payloadParser, logger,
 		observerOpts...,
 	)
@@ -170,6 +197,18 @@ func (d *Daemon) launchHubble() {
 		logger.WithError(err).Error("Failed to initialize Hubble")
 		return
 	}
+	if flowStore != nil {
+		ring := d.hubbleObserver.GetRingBuffer()
+		restored := 0
+		if err := flowStore.Restore(func(ev *v1.Event) {
+			ring.Write(ev)
+			restored++
+		}); err != nil {
+			logger.WithError(err).Warn("Failed to restore flows from Hubble flow store")
+		} else {
+			logger.WithField("numFlows", restored).Info("Restored flows from Hubble flow store")
+		}
+	}
 	go d.hubbleObserver.Start()
 	d.monitorAgent.RegisterNewConsumer(monitor.NewConsumer(d.hubbleObserver))
 
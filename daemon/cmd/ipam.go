@@ -23,6 +23,7 @@ import (
 	"github.com/cilium/cilium/pkg/ipam"
 	ipamOption "github.com/cilium/cilium/pkg/ipam/option"
 	"github.com/cilium/cilium/pkg/logging/logfields"
+	"github.com/cilium/cilium/pkg/metrics"
 	"github.com/cilium/cilium/pkg/node"
 	nodeTypes "github.com/cilium/cilium/pkg/node/types"
 	"github.com/cilium/cilium/pkg/option"
@@ -160,6 +161,22 @@ func (d *Daemon) DumpIPAM() *models.IPAMStatus {
 	}
 
 	status.Allocations = allocv4
+	status.QuarantinedIps = d.ipam.QuarantinedIPs()
+
+	status.PoolUsage = make(map[string]models.IPAMPoolUsage)
+	for family, usage := range d.ipam.PoolUsage() {
+		status.PoolUsage[string(family)] = models.IPAMPoolUsage{
+			Allocated: int64(usage.Allocated),
+			Available: int64(usage.Available),
+			Reserved:  int64(usage.Reserved),
+		}
+
+		metrics.IpamPoolIPs.WithLabelValues(string(family), "allocated").Set(float64(usage.Allocated))
+		if usage.Available >= 0 {
+			metrics.IpamPoolIPs.WithLabelValues(string(family), "available").Set(float64(usage.Available))
+		}
+		metrics.IpamPoolIPs.WithLabelValues(string(family), "reserved").Set(float64(usage.Reserved))
+	}
 
 	return status
 }
@@ -254,6 +271,7 @@ func (d *Daemon) allocateDatapathIPs(family types.NodeAddressingFamily) (routerI
 
 	if (option.Config.IPAM == ipamOption.IPAMENI ||
 		option.Config.IPAM == ipamOption.IPAMAlibabaCloud ||
+		option.Config.IPAM == ipamOption.IPAMOpenStack ||
 		option.Config.IPAM == ipamOption.IPAMAzure) && result != nil {
 		var routingInfo *linuxrouting.RoutingInfo
 		routingInfo, err = linuxrouting.NewRoutingInfo(result.GatewayIP, result.CIDRs,
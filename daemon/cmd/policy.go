@@ -9,11 +9,13 @@ import (
 	"fmt"
 	stdlog "log"
 	"net/netip"
+	"strings"
 	"sync"
 	"time"
 
 	"github.com/go-openapi/runtime/middleware"
 	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
 
 	"github.com/cilium/cilium/api/v1/models"
 	. "github.com/cilium/cilium/api/v1/server/restapi/policy"
@@ -24,6 +26,7 @@ import (
 	"github.com/cilium/cilium/pkg/endpointmanager"
 	"github.com/cilium/cilium/pkg/envoy"
 	"github.com/cilium/cilium/pkg/eventqueue"
+	"github.com/cilium/cilium/pkg/hubble/container"
 	"github.com/cilium/cilium/pkg/identity"
 	"github.com/cilium/cilium/pkg/identity/cache"
 	"github.com/cilium/cilium/pkg/labels"
@@ -72,6 +75,30 @@ func (d *Daemon) TriggerPolicyUpdates(force bool, reason string) {
 	d.policyUpdater.TriggerPolicyUpdates(force, reason)
 }
 
+// advanceDefaultDenyRollout reports the per-namespace enforcement status
+// of the staged default-deny rollout and triggers a policy update when
+// one or more namespaces have finished their audit phase, so that the
+// affected endpoints move from audit mode to enforcement.
+func (d *Daemon) advanceDefaultDenyRollout(enforced map[string]bool) {
+	transitioned := false
+	for _, s := range policy.DefaultDenyRolloutStatus() {
+		enforcing := s.Phase == policy.DefaultDenyPhaseEnforcing
+		var v float64
+		if enforcing {
+			v = 1
+		}
+		metrics.PolicyDefaultDenyRolloutPhase.WithLabelValues(s.Namespace).Set(v)
+		if enforcing && !enforced[s.Namespace] {
+			enforced[s.Namespace] = true
+			transitioned = true
+			log.WithField(logfields.K8sNamespace, s.Namespace).Info("Namespace moved to default-deny enforcement after audit phase")
+		}
+	}
+	if transitioned {
+		d.TriggerPolicyUpdates(true, "default-deny rollout audit phase ended")
+	}
+}
+
 // UpdateIdentities informs the policy package of all identity changes
 // and also triggers policy updates.
 //
@@ -192,6 +219,190 @@ func (h *getPolicyResolve) Handle(params GetPolicyResolveParams) middleware.Resp
 	return NewGetPolicyResolveOK().WithPayload(&result)
 }
 
+// policyImpactMaxFlows bounds how many recent Hubble events are examined when
+// computing the impact of a proposed policy.
+const policyImpactMaxFlows = 10000
+
+type getPolicyImpact struct {
+	daemon *Daemon
+}
+
+func NewGetPolicyImpactHandler(d *Daemon) GetPolicyImpactHandler {
+	return &getPolicyImpact{daemon: d}
+}
+
+// policyImpactEnforcement returns the policy enforcement state which the given
+// repository yields for an endpoint with the given identity labels.
+func policyImpactEnforcement(repo *policy.Repository, lbls labels.LabelArray) models.EndpointPolicyEnabled {
+	ingress, egress := true, true
+	switch policy.GetPolicyEnabled() {
+	case option.NeverEnforce:
+		ingress, egress = false, false
+	case option.DefaultEnforcement:
+		ingress, egress = repo.GetRulesMatching(lbls)
+	}
+	switch {
+	case ingress && egress:
+		return models.EndpointPolicyEnabledBoth
+	case ingress:
+		return models.EndpointPolicyEnabledIngress
+	case egress:
+		return models.EndpointPolicyEnabledEgress
+	}
+	return models.EndpointPolicyEnabledNone
+}
+
+// policyImpactVerdict evaluates the given repository for traffic between the
+// two sets of identity labels, following the same enforcement semantics as
+// the policy trace endpoint. The repository must be read locked.
+func policyImpactVerdict(repo *policy.Repository, from, to labels.LabelArray, dports []*models.Port) policyAPI.Decision {
+	if policy.GetPolicyEnabled() == option.NeverEnforce {
+		return policyAPI.Allowed
+	}
+	fromEgress := true
+	toIngress := true
+	if policy.GetPolicyEnabled() == option.DefaultEnforcement {
+		_, fromEgress = repo.GetRulesMatching(from)
+		toIngress, _ = repo.GetRulesMatching(to)
+	}
+	searchCtx := policy.SearchContext{
+		From:   from,
+		To:     to,
+		DPorts: dports,
+	}
+	if fromEgress && repo.AllowsEgressRLocked(&searchCtx) == policyAPI.Denied {
+		return policyAPI.Denied
+	}
+	if toIngress && repo.AllowsIngressRLocked(&searchCtx) == policyAPI.Denied {
+		return policyAPI.Denied
+	}
+	return policyAPI.Allowed
+}
+
+func (h *getPolicyImpact) Handle(params GetPolicyImpactParams) middleware.Responder {
+	log.Debug("GET /policy/impact request")
+
+	d := h.daemon
+
+	var rules policyAPI.Rules
+	if err := json.Unmarshal([]byte(params.Policy), &rules); err != nil {
+		return NewGetPolicyImpactInvalidPolicy()
+	}
+
+	for _, r := range rules {
+		if err := r.Sanitize(); err != nil {
+			return api.Error(GetPolicyImpactInvalidPolicyCode, err)
+		}
+	}
+
+	// Build a scratch repository holding the current rules with the proposed
+	// rules added on top. The repository is local to this request and never
+	// selects any endpoint, so the identity components are not needed.
+	proposed := policy.NewPolicyRepository(nil, nil, nil)
+
+	d.policy.Mutex.RLock()
+	defer d.policy.Mutex.RUnlock()
+
+	proposed.AddListLocked(append(d.policy.SearchRLocked(labels.LabelArray{}), rules...))
+
+	result := &models.PolicyImpactResult{
+		Revision: int64(d.policy.GetRevision()),
+	}
+
+	for _, ep := range d.endpointManager.GetEndpoints() {
+		epLabels := labels.NewSelectLabelArrayFromModel(ep.GetLabels())
+
+		selected := false
+		for _, r := range rules {
+			if (r.EndpointSelector.LabelSelector != nil && r.EndpointSelector.Matches(epLabels)) ||
+				(r.NodeSelector.LabelSelector != nil && r.NodeSelector.Matches(epLabels)) {
+				selected = true
+				break
+			}
+		}
+
+		enforcement := policyImpactEnforcement(d.policy, epLabels)
+		newEnforcement := policyImpactEnforcement(proposed, epLabels)
+		if !selected && enforcement == newEnforcement {
+			continue
+		}
+
+		result.Endpoints = append(result.Endpoints, &models.PolicyImpactEndpoint{
+			ID:               int64(ep.GetID()),
+			IdentityLabels:   ep.GetLabels(),
+			Selected:         selected,
+			PolicyEnabled:    enforcement,
+			NewPolicyEnabled: newEnforcement,
+		})
+	}
+
+	// Replay the flows currently held in the Hubble ring buffer against both
+	// repositories and report the ones which change verdict. Flows between
+	// the same identities on the same port are only evaluated once.
+	if d.hubbleObserver != nil {
+		ring := d.hubbleObserver.GetRingBuffer()
+		reader := container.NewRingReader(ring, ring.LastWriteParallel())
+		evaluated := map[string]struct{}{}
+
+		for i := uint64(0); i < ring.Len() && i < policyImpactMaxFlows; i++ {
+			ev, err := reader.Previous()
+			if err != nil {
+				break
+			}
+			flow := ev.GetFlow()
+			if flow == nil || flow.GetSource() == nil || flow.GetDestination() == nil {
+				continue
+			}
+
+			var dport uint16
+			protocol := ""
+			switch {
+			case flow.GetL4().GetTCP() != nil:
+				dport = uint16(flow.GetL4().GetTCP().GetDestinationPort())
+				protocol = "TCP"
+			case flow.GetL4().GetUDP() != nil:
+				dport = uint16(flow.GetL4().GetUDP().GetDestinationPort())
+				protocol = "UDP"
+			case flow.GetL4().GetSCTP() != nil:
+				dport = uint16(flow.GetL4().GetSCTP().GetDestinationPort())
+				protocol = "SCTP"
+			}
+
+			fromModel := flow.GetSource().GetLabels()
+			toModel := flow.GetDestination().GetLabels()
+			key := fmt.Sprintf("%s|%s|%s/%d", strings.Join(fromModel, ","), strings.Join(toModel, ","), protocol, dport)
+			if _, done := evaluated[key]; done {
+				continue
+			}
+			evaluated[key] = struct{}{}
+
+			from := labels.NewSelectLabelArrayFromModel(fromModel)
+			to := labels.NewSelectLabelArrayFromModel(toModel)
+			var dports []*models.Port
+			if dport != 0 {
+				dports = []*models.Port{{Port: dport, Protocol: protocol}}
+			}
+
+			verdict := policyImpactVerdict(d.policy, from, to, dports)
+			newVerdict := policyImpactVerdict(proposed, from, to, dports)
+			if verdict == newVerdict {
+				continue
+			}
+
+			result.Flows = append(result.Flows, &models.PolicyImpactFlow{
+				FromLabels: fromModel,
+				ToLabels:   toModel,
+				Dport:      int64(dport),
+				Protocol:   protocol,
+				Verdict:    verdict.String(),
+				NewVerdict: newVerdict.String(),
+			})
+		}
+	}
+
+	return NewGetPolicyImpactOK().WithPayload(result)
+}
+
 // PolicyAddEvent is a wrapper around the parameters for policyAdd.
 type PolicyAddEvent struct {
 	rules policyAPI.Rules
@@ -252,6 +463,15 @@ func (d *Daemon) policyAdd(sourceRules policyAPI.Rules, opts *policy.AddOptions,
 		logger.WithField(logfields.CiliumNetworkPolicy, sourceRules.String()).Info("Policy Add Request")
 	}
 
+	for _, r := range sourceRules {
+		if deadline, ok := policyAPI.GetRuleExpiration(r.Labels); ok {
+			logger.WithFields(logrus.Fields{
+				logfields.Labels:   r.Labels,
+				"policyExpiration": deadline,
+			}).Info("Installing policy exception with expiration")
+		}
+	}
+
 	prefixes := policy.GetCIDRPrefixes(sourceRules)
 	logger.WithField("prefixes", prefixes).Debug("Policy imported via API, found CIDR prefixes...")
 
@@ -547,6 +767,18 @@ func (d *Daemon) PolicyDelete(labels labels.LabelArray) (newRev uint64, err erro
 	return 0, fmt.Errorf("policy deletion event cancelled")
 }
 
+// removeExpiredPolicyRules removes all rules from the policy repository whose
+// expiration label deadline has passed. Each removal is logged so that the
+// lifecycle of temporary policy exceptions can be audited.
+func (d *Daemon) removeExpiredPolicyRules() {
+	for _, lbls := range d.policy.GetExpiredRuleLabels(time.Now()) {
+		log.WithField(logfields.Labels, lbls).Info("Removing expired policy exception")
+		if _, err := d.PolicyDelete(lbls); err != nil {
+			log.WithError(err).WithField(logfields.Labels, lbls).Warn("Unable to remove expired policy exception")
+		}
+	}
+}
+
 func (d *Daemon) policyDelete(labels labels.LabelArray, res chan interface{}) {
 	log.WithField(logfields.IdentityLabels, logfields.Repr(labels)).Debug("Policy Delete Request")
 
@@ -719,17 +951,17 @@ func (h *getPolicy) Handle(params GetPolicyParams) middleware.Responder {
 	defer repository.Mutex.RUnlock()
 
 	lbls := labels.ParseSelectLabelArrayFromArray(params.Labels)
-	ruleList := repository.SearchRLocked(lbls)
+	ruleList, numRules := repository.JSONMarshalRulesWithUsageRLocked(lbls)
 
 	// Error if labels have been specified but no entries found, otherwise,
 	// return empty list
-	if len(ruleList) == 0 && len(lbls) != 0 {
+	if numRules == 0 && len(lbls) != 0 {
 		return NewGetPolicyNotFound()
 	}
 
 	policy := &models.Policy{
 		Revision: int64(repository.GetRevision()),
-		Policy:   policy.JSONMarshalRules(ruleList),
+		Policy:   ruleList,
 	}
 	return NewGetPolicyOK().WithPayload(policy)
 }
@@ -0,0 +1,90 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package cmd
+
+import (
+	"bytes"
+	"encoding/binary"
+	"sync"
+
+	"github.com/cilium/cilium/pkg/byteorder"
+	"github.com/cilium/cilium/pkg/identity"
+	"github.com/cilium/cilium/pkg/identity/identitymanager"
+	"github.com/cilium/cilium/pkg/logging/logfields"
+	"github.com/cilium/cilium/pkg/monitor"
+	monitorAPI "github.com/cilium/cilium/pkg/monitor/api"
+	"github.com/cilium/cilium/pkg/option"
+	"github.com/cilium/cilium/pkg/policy"
+)
+
+// identityRelevanceWatcher feeds the set of identities relevant to the node
+// when relevance-based policy computation is enabled. It observes local
+// endpoint identity allocations and datapath drop notifications, marks the
+// identities seen as relevant and fills in the policy map entries that were
+// skipped for them.
+type identityRelevanceWatcher struct {
+	daemon *Daemon
+}
+
+// startIdentityRelevanceWatcher subscribes the identity relevance watcher to
+// local identity allocations and monitor events. It is a no-op when
+// relevance-based policy computation is disabled.
+func (d *Daemon) startIdentityRelevanceWatcher() {
+	if !option.Config.EnableIdentityRelevance {
+		return
+	}
+	w := &identityRelevanceWatcher{daemon: d}
+	identitymanager.Subscribe(w)
+	d.monitorAgent.RegisterNewConsumer(w)
+}
+
+// reveal marks the given identities as relevant to the node, re-announces
+// the newly relevant identities to the selector cache and updates the
+// policy maps of all endpoints with the resulting entries.
+func (w *identityRelevanceWatcher) reveal(identities ...identity.NumericIdentity) {
+	added := policy.MarkIdentitiesRelevant(identities)
+	if len(added) == 0 {
+		return
+	}
+	log.WithField(logfields.Identity, added).Debug("Identities became relevant to the node")
+	var wg sync.WaitGroup
+	w.daemon.policy.GetSelectorCache().NotifyRelevantIdentities(added, &wg)
+	// The endpoints wait on 'wg' before applying the policy map changes,
+	// so the notifications do not need to be waited for here.
+	w.daemon.endpointManager.UpdatePolicyMaps(w.daemon.ctx, &wg)
+}
+
+// LocalEndpointIdentityAdded marks the identity of a local endpoint as
+// relevant to the node.
+func (w *identityRelevanceWatcher) LocalEndpointIdentityAdded(id *identity.Identity) {
+	w.reveal(id.ID)
+}
+
+// LocalEndpointIdentityRemoved is a no-op; policy map entries of deleted
+// identities are cleaned up via the selector cache.
+func (w *identityRelevanceWatcher) LocalEndpointIdentityRemoved(id *identity.Identity) {
+}
+
+// NotifyPerfEvent marks the identities of dropped flows as relevant, so
+// that the first packet between two identities fills in the policy map
+// entries for them.
+func (w *identityRelevanceWatcher) NotifyPerfEvent(data []byte, cpu int) {
+	if len(data) < monitor.DropNotifyLen || data[0] != monitorAPI.MessageTypeDrop {
+		return
+	}
+	dn := &monitor.DropNotify{}
+	if err := binary.Read(bytes.NewReader(data), byteorder.Native, dn); err != nil {
+		return
+	}
+	w.reveal(dn.SrcLabel, dn.DstLabel)
+}
+
+// NotifyAgentEvent is a no-op, the watcher only consumes datapath events.
+func (w *identityRelevanceWatcher) NotifyAgentEvent(typ int, message interface{}) {
+}
+
+// NotifyPerfEventLost is a no-op, lost drop notifications are compensated
+// for by later packets between the same identities.
+func (w *identityRelevanceWatcher) NotifyPerfEventLost(numLostEvents uint64, cpu int) {
+}
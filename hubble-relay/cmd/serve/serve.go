@@ -15,6 +15,7 @@ import (
 	"golang.org/x/sys/unix"
 
 	"github.com/cilium/cilium/pkg/crypto/certloader"
+	"github.com/cilium/cilium/pkg/hubble/relay/auth"
 	"github.com/cilium/cilium/pkg/hubble/relay/defaults"
 	"github.com/cilium/cilium/pkg/hubble/relay/server"
 	"github.com/cilium/cilium/pkg/logging"
@@ -42,6 +43,8 @@ const (
 	keyTLSServerCertFile      = "tls-server-cert-file"
 	keyTLSServerKeyFile       = "tls-server-key-file"
 	keyTLSServerDisabled      = "disable-server-tls"
+	keyTLSClientCAFiles       = "tls-client-ca-files"
+	keyClientNamespaceScopes  = "client-namespace-scopes"
 )
 
 // New creates a new serve command.
@@ -135,6 +138,16 @@ func New(vp *viper.Viper) *cobra.Command {
 		false,
 		"Disable TLS for the server and allow clients to connect over plaintext.",
 	)
+	flags.StringSlice(
+		keyTLSClientCAFiles,
+		[]string{},
+		"Paths to one or more public key files of the CA which sign certificates for Hubble Relay clients. When provided, clients are required to present a certificate signed by one of these CAs (mTLS).",
+	)
+	flags.StringSlice(
+		keyClientNamespaceScopes,
+		[]string{},
+		"Restrict clients to observing flows of the namespaces they are mapped to, in the form '<common-name>=<namespace>[,<namespace>...]'. Requires mTLS to be enabled via "+keyTLSClientCAFiles+".",
+	)
 	vp.BindPFlags(flags)
 
 	return cmd
@@ -193,7 +206,8 @@ func runServe(vp *viper.Viper) error {
 	} else {
 		tlsServerConfig, err := certloader.NewWatchedServerConfig(
 			logger.WithField("config", "tls-server"),
-			nil, // no caFiles, mTLS is not supported for Relay clients yet.
+			// providing caFiles enables mTLS for Relay clients
+			vp.GetStringSlice(keyTLSClientCAFiles),
 			vp.GetString(keyTLSServerCertFile),
 			vp.GetString(keyTLSServerKeyFile),
 		)
@@ -203,6 +217,17 @@ func runServe(vp *viper.Viper) error {
 		opts = append(opts, server.WithServerTLS(tlsServerConfig))
 	}
 
+	if entries := vp.GetStringSlice(keyClientNamespaceScopes); len(entries) > 0 {
+		if vp.GetBool(keyTLSServerDisabled) || len(vp.GetStringSlice(keyTLSClientCAFiles)) == 0 {
+			return fmt.Errorf("%s requires mTLS to be enabled via %s", keyClientNamespaceScopes, keyTLSClientCAFiles)
+		}
+		scopes, err := auth.ParseScopes(entries)
+		if err != nil {
+			return err
+		}
+		opts = append(opts, server.WithClientScopes(scopes))
+	}
+
 	if vp.GetBool(keyPprof) {
 		pprof.Enable(vp.GetInt(keyPprofPort))
 	}
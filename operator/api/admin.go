@@ -0,0 +1,194 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package api
+
+import (
+	"crypto/subtle"
+	"fmt"
+	"net/http"
+	"strings"
+
+	operatorOption "github.com/cilium/cilium/operator/option"
+)
+
+// IPAMAdmin is the interface the admin endpoints of the operator API use to
+// manage nodes in the IPAM node manager. It is implemented by IPAM modes
+// backed by a node manager, such as the cloud-provider IPAM modes.
+type IPAMAdmin interface {
+	// CordonNode excludes the given node from new IP allocations, or
+	// includes it again if cordon is false.
+	CordonNode(node string, cordon bool) error
+
+	// DrainNode cordons the given node and releases all of its unused
+	// IPs.
+	DrainNode(node string) error
+
+	// ReleaseNodeIPs forces the release of the given IPs of the given
+	// node.
+	ReleaseNodeIPs(node string, ips []string) error
+}
+
+// adminAuthorized validates the bearer token of an admin API request. It
+// writes the appropriate error to the response and returns false if the
+// request is not authorized or the admin API is disabled.
+func adminAuthorized(rw http.ResponseWriter, r *http.Request) bool {
+	token := operatorOption.Config.OperatorAPIAdminToken
+	if token == "" {
+		http.Error(rw, fmt.Sprintf("The admin API is disabled, set --%s to enable it", operatorOption.OperatorAPIAdminToken), http.StatusForbidden)
+		return false
+	}
+
+	presented := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if subtle.ConstantTimeCompare([]byte(presented), []byte(token)) != 1 {
+		http.Error(rw, "Invalid bearer token", http.StatusUnauthorized)
+		return false
+	}
+
+	return true
+}
+
+// adminNodeParam extracts the mandatory "node" query parameter of an admin
+// API request. It writes the appropriate error to the response and returns
+// false if the parameter is missing.
+func adminNodeParam(rw http.ResponseWriter, r *http.Request) (string, bool) {
+	node := r.URL.Query().Get("node")
+	if node == "" {
+		http.Error(rw, "The \"node\" query parameter is required", http.StatusBadRequest)
+		return "", false
+	}
+	return node, true
+}
+
+// ipamAdmin returns the IPAM admin interface of the server. It writes the
+// appropriate error to the response and returns nil if the configured IPAM
+// mode does not support node management.
+func (s *Server) ipamAdmin(rw http.ResponseWriter) IPAMAdmin {
+	if s.ipamAdminHandler == nil {
+		http.Error(rw, "Node management is not supported by the configured IPAM mode", http.StatusNotImplemented)
+		return nil
+	}
+	return s.ipamAdminHandler
+}
+
+// This is the /v1/admin/ipam/cordon handler outside of the open-api spec.
+type postIPAMCordon struct {
+	*Server
+}
+
+// ServeHTTP handles POST requests for /v1/admin/ipam/cordon. The node to
+// cordon is selected with the "node" query parameter, setting the "cordon"
+// query parameter to "false" uncordons the node instead.
+func (h *postIPAMCordon) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		rw.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	if !adminAuthorized(rw, r) {
+		return
+	}
+
+	admin := h.Server.ipamAdmin(rw)
+	if admin == nil {
+		return
+	}
+
+	node, ok := adminNodeParam(rw, r)
+	if !ok {
+		return
+	}
+
+	cordon := r.URL.Query().Get("cordon") != "false"
+	if err := admin.CordonNode(node, cordon); err != nil {
+		http.Error(rw, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	rw.WriteHeader(http.StatusOK)
+	fmt.Fprintln(rw, "ok")
+}
+
+// This is the /v1/admin/ipam/drain handler outside of the open-api spec.
+type postIPAMDrain struct {
+	*Server
+}
+
+// ServeHTTP handles POST requests for /v1/admin/ipam/drain. The node to
+// drain is selected with the "node" query parameter.
+func (h *postIPAMDrain) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		rw.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	if !adminAuthorized(rw, r) {
+		return
+	}
+
+	admin := h.Server.ipamAdmin(rw)
+	if admin == nil {
+		return
+	}
+
+	node, ok := adminNodeParam(rw, r)
+	if !ok {
+		return
+	}
+
+	if err := admin.DrainNode(node); err != nil {
+		http.Error(rw, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	rw.WriteHeader(http.StatusOK)
+	fmt.Fprintln(rw, "ok")
+}
+
+// This is the /v1/admin/ipam/release handler outside of the open-api spec.
+type postIPAMRelease struct {
+	*Server
+}
+
+// ServeHTTP handles POST requests for /v1/admin/ipam/release. The node is
+// selected with the "node" query parameter, the IPs to release are passed as
+// a comma-separated list in the "ips" query parameter.
+func (h *postIPAMRelease) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		rw.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	if !adminAuthorized(rw, r) {
+		return
+	}
+
+	admin := h.Server.ipamAdmin(rw)
+	if admin == nil {
+		return
+	}
+
+	node, ok := adminNodeParam(rw, r)
+	if !ok {
+		return
+	}
+
+	var ips []string
+	for _, ip := range strings.Split(r.URL.Query().Get("ips"), ",") {
+		if ip = strings.TrimSpace(ip); ip != "" {
+			ips = append(ips, ip)
+		}
+	}
+	if len(ips) == 0 {
+		http.Error(rw, "The \"ips\" query parameter is required", http.StatusBadRequest)
+		return
+	}
+
+	if err := admin.ReleaseNodeIPs(node, ips); err != nil {
+		http.Error(rw, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	rw.WriteHeader(http.StatusOK)
+	fmt.Fprintln(rw, "ok")
+}
@@ -0,0 +1,205 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package api
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/pprof"
+	"runtime"
+	"runtime/trace"
+	"strconv"
+	"time"
+
+	operatorOption "github.com/cilium/cilium/operator/option"
+	"github.com/cilium/cilium/pkg/lock"
+)
+
+// maxTraceDuration caps the duration of an execution trace requested via
+// the /v1/admin/debug/trace handler.
+const maxTraceDuration = 5 * time.Minute
+
+// debugState tracks the profiling facilities toggled at runtime via the
+// /v1/admin/debug handlers.
+var debugState = struct {
+	mutex lock.Mutex
+
+	// pprofServer is the pprof HTTP server started at runtime, nil while
+	// pprof is disabled
+	pprofServer *http.Server
+}{}
+
+// This is the /v1/admin/debug/pprof handler outside of the open-api spec.
+type postDebugPProf struct {
+	*Server
+}
+
+// ServeHTTP handles POST requests for /v1/admin/debug/pprof. Setting the
+// "enable" query parameter to "false" stops a previously started pprof
+// server, the "port" query parameter overrides the configured pprof port.
+func (h *postDebugPProf) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		rw.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	if !adminAuthorized(rw, r) {
+		return
+	}
+
+	debugState.mutex.Lock()
+	defer debugState.mutex.Unlock()
+
+	if r.URL.Query().Get("enable") == "false" {
+		if debugState.pprofServer == nil {
+			http.Error(rw, "pprof is not enabled", http.StatusConflict)
+			return
+		}
+		if err := debugState.pprofServer.Shutdown(context.Background()); err != nil {
+			http.Error(rw, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		debugState.pprofServer = nil
+
+		log.Info("Stopped pprof server")
+		rw.WriteHeader(http.StatusOK)
+		fmt.Fprintln(rw, "ok")
+		return
+	}
+
+	if debugState.pprofServer != nil {
+		http.Error(rw, "pprof is already enabled", http.StatusConflict)
+		return
+	}
+
+	port := operatorOption.Config.PProfPort
+	if p := r.URL.Query().Get("port"); p != "" {
+		var err error
+		if port, err = strconv.Atoi(p); err != nil {
+			http.Error(rw, fmt.Sprintf("Invalid port %q", p), http.StatusBadRequest)
+			return
+		}
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/debug/pprof/", pprof.Index)
+	mux.HandleFunc("/debug/pprof/cmdline", pprof.Cmdline)
+	mux.HandleFunc("/debug/pprof/profile", pprof.Profile)
+	mux.HandleFunc("/debug/pprof/symbol", pprof.Symbol)
+	mux.HandleFunc("/debug/pprof/trace", pprof.Trace)
+
+	addr := net.JoinHostPort("localhost", strconv.Itoa(port))
+	srv := &http.Server{
+		Addr:    addr,
+		Handler: mux,
+	}
+
+	ln, err := net.Listen("tcp", addr)
+	if err != nil {
+		http.Error(rw, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	debugState.pprofServer = srv
+	go func() {
+		if err := srv.Serve(ln); err != nil && err != http.ErrServerClosed {
+			log.WithError(err).Warn("Unable to serve pprof API")
+		}
+	}()
+
+	log.Infof("Started pprof server on %s", addr)
+	rw.WriteHeader(http.StatusOK)
+	fmt.Fprintln(rw, "ok")
+}
+
+// This is the /v1/admin/debug/profiling handler outside of the open-api
+// spec.
+type postDebugProfiling struct {
+	*Server
+}
+
+// ServeHTTP handles POST requests for /v1/admin/debug/profiling. The
+// "mutex-fraction" and "block-rate" query parameters set the mutex profile
+// fraction and the block profile rate, omitted parameters are left
+// unchanged. Setting a parameter to 0 disables the profile.
+func (h *postDebugProfiling) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		rw.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	if !adminAuthorized(rw, r) {
+		return
+	}
+
+	if f := r.URL.Query().Get("mutex-fraction"); f != "" {
+		fraction, err := strconv.Atoi(f)
+		if err != nil {
+			http.Error(rw, fmt.Sprintf("Invalid mutex-fraction %q", f), http.StatusBadRequest)
+			return
+		}
+		previous := runtime.SetMutexProfileFraction(fraction)
+		log.Infof("Set mutex profile fraction to %d (was %d)", fraction, previous)
+	}
+
+	if b := r.URL.Query().Get("block-rate"); b != "" {
+		blockRate, err := strconv.Atoi(b)
+		if err != nil {
+			http.Error(rw, fmt.Sprintf("Invalid block-rate %q", b), http.StatusBadRequest)
+			return
+		}
+		runtime.SetBlockProfileRate(blockRate)
+		log.Infof("Set block profile rate to %d", blockRate)
+	}
+
+	rw.WriteHeader(http.StatusOK)
+	fmt.Fprintln(rw, "ok")
+}
+
+// This is the /v1/admin/debug/trace handler outside of the open-api spec.
+type postDebugTrace struct {
+	*Server
+}
+
+// ServeHTTP handles POST requests for /v1/admin/debug/trace. An execution
+// trace of the duration given by the "duration" query parameter (default 10
+// seconds, capped at 5 minutes) is streamed as the response body.
+func (h *postDebugTrace) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		rw.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	if !adminAuthorized(rw, r) {
+		return
+	}
+
+	duration := 10 * time.Second
+	if d := r.URL.Query().Get("duration"); d != "" {
+		var err error
+		if duration, err = time.ParseDuration(d); err != nil {
+			http.Error(rw, fmt.Sprintf("Invalid duration %q", d), http.StatusBadRequest)
+			return
+		}
+	}
+	if duration > maxTraceDuration {
+		http.Error(rw, fmt.Sprintf("Duration must not exceed %s", maxTraceDuration), http.StatusBadRequest)
+		return
+	}
+
+	rw.Header().Set("Content-Type", "application/octet-stream")
+	if err := trace.Start(rw); err != nil {
+		http.Error(rw, err.Error(), http.StatusConflict)
+		return
+	}
+	defer trace.Stop()
+
+	log.Infof("Started execution trace for %s", duration)
+	select {
+	case <-time.After(duration):
+	case <-r.Context().Done():
+	}
+}
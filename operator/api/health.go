@@ -28,6 +28,12 @@ func (h *getHealthz) Handle(params operator.GetHealthzParams) middleware.Respond
 
 			return operator.NewGetHealthzInternalServerError().WithPayload(err.Error())
 		}
+
+		if err := checkSubsystemHealth(); err != nil {
+			log.WithError(err).Warn("Subsystem health check status")
+
+			return operator.NewGetHealthzInternalServerError().WithPayload(err.Error())
+		}
 	default:
 	}
 
@@ -0,0 +1,155 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/cilium/cilium/pkg/ipam"
+	ipamTypes "github.com/cilium/cilium/pkg/ipam/types"
+)
+
+// IPAMResyncFunc forces an immediate IPAM resync of the given node, or of all
+// nodes if the node name is empty.
+type IPAMResyncFunc func(ctx context.Context, node string) error
+
+// This is the /v1/admin/ipam/resync handler outside of the open-api spec.
+type postIPAMResync struct {
+	*Server
+}
+
+// ServeHTTP handles POST requests for /v1/admin/ipam/resync. The node to
+// resync can be selected with the "node" query parameter, all nodes are
+// resynced if it is omitted.
+func (h *postIPAMResync) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		rw.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	if !adminAuthorized(rw, r) {
+		return
+	}
+
+	if h.Server.ipamResyncFunc == nil {
+		http.Error(rw, "IPAM resync is not supported by the configured IPAM mode", http.StatusNotImplemented)
+		return
+	}
+
+	node := r.URL.Query().Get("node")
+	if err := h.Server.ipamResyncFunc(r.Context(), node); err != nil {
+		http.Error(rw, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	rw.WriteHeader(http.StatusOK)
+	fmt.Fprintln(rw, "ok")
+}
+
+// IPAMForecastFunc returns the projected time-to-exhaustion of all IPAM
+// pools.
+type IPAMForecastFunc func() ([]ipam.PoolForecast, error)
+
+// This is the /v1/ipam/forecast handler outside of the open-api spec.
+type getIPAMForecast struct {
+	*Server
+}
+
+// ServeHTTP handles GET requests for /v1/ipam/forecast.
+func (h *getIPAMForecast) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		rw.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	if h.Server.ipamForecastFunc == nil {
+		http.Error(rw, "IPAM pool forecasts are not supported by the configured IPAM mode", http.StatusNotImplemented)
+		return
+	}
+
+	forecasts, err := h.Server.ipamForecastFunc()
+	if err != nil {
+		http.Error(rw, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	rw.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(rw).Encode(forecasts); err != nil {
+		log.WithError(err).Error("Unable to encode IPAM pool forecasts")
+	}
+}
+
+// IPAMLimitsFunc returns the instance type limits table of the IPAM
+// provider. The table is read-only and can be served by non-leading
+// operator replicas.
+type IPAMLimitsFunc func() map[string]ipamTypes.Limits
+
+// This is the /v1/ipam/limits handler outside of the open-api spec.
+type getIPAMLimits struct {
+	*Server
+}
+
+// ServeHTTP handles GET requests for /v1/ipam/limits.
+func (h *getIPAMLimits) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		rw.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	if h.Server.ipamLimitsFunc == nil {
+		http.Error(rw, "Instance limits are not provided by the configured IPAM mode", http.StatusNotImplemented)
+		return
+	}
+
+	rw.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(rw).Encode(h.Server.ipamLimitsFunc()); err != nil {
+		log.WithError(err).Error("Unable to encode instance limits")
+	}
+}
+
+// IPAMNodePoolStatus is the read-only per-node pool status served by the
+// /v1/ipam/pools handler. It is derived from the CiliumNode custom
+// resources and can be served by non-leading operator replicas.
+type IPAMNodePoolStatus struct {
+	// Available is the number of IPs available to the node
+	Available int `json:"available"`
+
+	// Used is the number of IPs in use on the node
+	Used int `json:"used"`
+}
+
+// IPAMPoolsFunc returns the pool status of all nodes, keyed by node name.
+type IPAMPoolsFunc func(ctx context.Context) (map[string]IPAMNodePoolStatus, error)
+
+// This is the /v1/ipam/pools handler outside of the open-api spec.
+type getIPAMPools struct {
+	*Server
+}
+
+// ServeHTTP handles GET requests for /v1/ipam/pools.
+func (h *getIPAMPools) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		rw.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	if h.Server.ipamPoolsFunc == nil {
+		http.Error(rw, "Pool status is not available", http.StatusNotImplemented)
+		return
+	}
+
+	pools, err := h.Server.ipamPoolsFunc(r.Context())
+	if err != nil {
+		http.Error(rw, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	rw.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(rw).Encode(pools); err != nil {
+		log.WithError(err).Error("Unable to encode pool status")
+	}
+}
@@ -41,6 +41,33 @@ type Server struct {
 	// This is the /healthz handler outside of the open-api spec.
 	healthzHandler *getHealthz
 
+	// ipamResyncFunc is invoked by the /v1/admin/ipam/resync handler to force an
+	// immediate IPAM resync. It is nil if the IPAM mode does not support
+	// forced resyncs.
+	ipamResyncFunc IPAMResyncFunc
+
+	// ipamForecastFunc is invoked by the /v1/ipam/forecast handler to
+	// project the time-to-exhaustion of the IPAM pools. It is nil if the
+	// IPAM mode does not provide pool forecasts.
+	ipamForecastFunc IPAMForecastFunc
+
+	// ipamAdminHandler is used by the /v1/admin/ipam handlers to manage
+	// nodes in the IPAM node manager. It is nil if the IPAM mode does not
+	// support node management.
+	ipamAdminHandler IPAMAdmin
+
+	// ipamLimitsFunc is invoked by the /v1/ipam/limits handler to serve
+	// the instance type limits table. Unlike the mutating IPAM handlers it
+	// is served by non-leading operator replicas as well. It is nil if the
+	// IPAM mode does not provide instance limits.
+	ipamLimitsFunc IPAMLimitsFunc
+
+	// ipamPoolsFunc is invoked by the /v1/ipam/pools handler to serve the
+	// per-node pool status derived from the CiliumNode custom resources.
+	// Unlike the mutating IPAM handlers it is served by non-leading
+	// operator replicas as well.
+	ipamPoolsFunc IPAMPoolsFunc
+
 	listenAddrs []string
 }
 
@@ -90,6 +117,42 @@ func (s *Server) WithStatusCheckFunc(f func() error) *Server {
 	return s
 }
 
+// WithIPAMResyncFunc returns the server configuring the function used to
+// force an immediate IPAM resync via the /v1/admin/ipam/resync handler.
+func (s *Server) WithIPAMResyncFunc(f IPAMResyncFunc) *Server {
+	s.ipamResyncFunc = f
+	return s
+}
+
+// WithIPAMForecastFunc returns the server configuring the function used to
+// project the time-to-exhaustion of the IPAM pools via the /v1/ipam/forecast
+// handler.
+func (s *Server) WithIPAMForecastFunc(f IPAMForecastFunc) *Server {
+	s.ipamForecastFunc = f
+	return s
+}
+
+// WithIPAMLimitsFunc returns the server configuring the function used to
+// serve the instance type limits table via the /v1/ipam/limits handler.
+func (s *Server) WithIPAMLimitsFunc(f IPAMLimitsFunc) *Server {
+	s.ipamLimitsFunc = f
+	return s
+}
+
+// WithIPAMPoolsFunc returns the server configuring the function used to
+// serve the per-node pool status via the /v1/ipam/pools handler.
+func (s *Server) WithIPAMPoolsFunc(f IPAMPoolsFunc) *Server {
+	s.ipamPoolsFunc = f
+	return s
+}
+
+// WithIPAMAdmin returns the server configuring the IPAM admin interface
+// used by the /v1/admin/ipam handlers to manage nodes.
+func (s *Server) WithIPAMAdmin(admin IPAMAdmin) *Server {
+	s.ipamAdminHandler = admin
+	return s
+}
+
 // StartServer starts the HTTP listeners for the apiserver.
 func (s *Server) StartServer() error {
 	errs := make(chan error, 1)
@@ -115,6 +178,24 @@ func (s *Server) StartServer() error {
 			resp := s.healthzHandler.Handle(operator.GetHealthzParams{})
 			resp.WriteResponse(rw, runtime.TextProducer())
 		})
+		// Custom handler to project the time-to-exhaustion of the IPAM
+		// pools, also outside of the open-api spec.
+		mux.Handle("/v1/ipam/forecast", &getIPAMForecast{Server: s})
+		// Read-only handlers served by non-leading replicas as well,
+		// also outside of the open-api spec.
+		mux.Handle("/v1/ipam/limits", &getIPAMLimits{Server: s})
+		mux.Handle("/v1/ipam/pools", &getIPAMPools{Server: s})
+		// Authenticated admin handlers to manage nodes in the IPAM node
+		// manager, also outside of the open-api spec.
+		mux.Handle("/v1/admin/ipam/resync", &postIPAMResync{Server: s})
+		mux.Handle("/v1/admin/ipam/cordon", &postIPAMCordon{Server: s})
+		mux.Handle("/v1/admin/ipam/drain", &postIPAMDrain{Server: s})
+		mux.Handle("/v1/admin/ipam/release", &postIPAMRelease{Server: s})
+		// Authenticated admin handlers to toggle profiling at runtime,
+		// also outside of the open-api spec.
+		mux.Handle("/v1/admin/debug/pprof", &postDebugPProf{Server: s})
+		mux.Handle("/v1/admin/debug/profiling", &postDebugProfiling{Server: s})
+		mux.Handle("/v1/admin/debug/trace", &postDebugTrace{Server: s})
 
 		srv := &http.Server{
 			Addr:    addr,
@@ -0,0 +1,98 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package api
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	opMetrics "github.com/cilium/cilium/operator/metrics"
+	"github.com/cilium/cilium/pkg/lock"
+)
+
+// subsystemHealth tracks the health of individual operator controllers, e.g.
+// the IPAM allocator or the CRD garbage collectors. Controllers either push
+// their status after each iteration or register a callback which is invoked
+// on every health check. An unhealthy subsystem fails the operator's
+// /healthz endpoint so that a wedged controller fails readiness instead of
+// being silently stuck.
+var subsystemHealth = struct {
+	mutex lock.Mutex
+
+	// errors holds the last pushed status per subsystem, nil means healthy
+	errors map[string]error
+
+	// checks holds the health callbacks of subsystems with pull-style
+	// health reporting
+	checks map[string]func() error
+}{
+	errors: map[string]error{},
+	checks: map[string]func() error{},
+}
+
+// RegisterSubsystemCheck registers a callback which is invoked on each health
+// check to determine the health of the given subsystem.
+func RegisterSubsystemCheck(subsystem string, check func() error) {
+	subsystemHealth.mutex.Lock()
+	subsystemHealth.checks[subsystem] = check
+	subsystemHealth.mutex.Unlock()
+}
+
+// ReportSubsystemOK records that the given subsystem completed an iteration
+// successfully.
+func ReportSubsystemOK(subsystem string) {
+	ReportSubsystemError(subsystem, nil)
+}
+
+// ReportSubsystemError records the failure of the given subsystem. The
+// subsystem remains unhealthy until it reports a successful iteration.
+func ReportSubsystemError(subsystem string, err error) {
+	subsystemHealth.mutex.Lock()
+	subsystemHealth.errors[subsystem] = err
+	subsystemHealth.mutex.Unlock()
+
+	updateSubsystemMetric(subsystem, err == nil)
+}
+
+func updateSubsystemMetric(subsystem string, healthy bool) {
+	if opMetrics.SubsystemHealth == nil {
+		return
+	}
+
+	value := float64(0)
+	if healthy {
+		value = 1
+	}
+	opMetrics.SubsystemHealth.WithLabelValues(subsystem).Set(value)
+}
+
+// checkSubsystemHealth returns an error listing all unhealthy subsystems, or
+// nil if all subsystems are healthy.
+func checkSubsystemHealth() error {
+	subsystemHealth.mutex.Lock()
+	defer subsystemHealth.mutex.Unlock()
+
+	var unhealthy []string
+	for subsystem, err := range subsystemHealth.errors {
+		if err != nil {
+			unhealthy = append(unhealthy, fmt.Sprintf("%s: %s", subsystem, err))
+		}
+	}
+
+	for subsystem, check := range subsystemHealth.checks {
+		err := check()
+		updateSubsystemMetric(subsystem, err == nil)
+		if err != nil {
+			unhealthy = append(unhealthy, fmt.Sprintf("%s: %s", subsystem, err))
+		}
+	}
+
+	if len(unhealthy) > 0 {
+		sort.Strings(unhealthy)
+		return fmt.Errorf("unhealthy subsystems: %s", strings.Join(unhealthy, ", "))
+	}
+
+	return nil
+}
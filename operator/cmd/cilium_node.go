@@ -7,6 +7,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"hash/fnv"
 	"strings"
 	"sync"
 
@@ -44,6 +45,20 @@ func (c *ciliumNodeName) GetKeyName() string {
 	return nodeTypes.GetKeyNodeName(c.cluster, c.name)
 }
 
+// ownedByThisShard returns true if this operator replica is responsible for
+// reconciling the CiliumNode with the given name. Unless sharding is enabled
+// via --cilium-node-shard-count, a single replica owns all nodes.
+func ownedByThisShard(nodeName string) bool {
+	shards := operatorOption.Config.CiliumNodeShardCount
+	if shards <= 1 {
+		return true
+	}
+
+	h := fnv.New32a()
+	h.Write([]byte(nodeName))
+	return int(h.Sum32())%shards == operatorOption.Config.CiliumNodeShardIndex
+}
+
 type ciliumNodeManagerQueueSyncedKey struct{}
 
 type ciliumNodeSynchronizer struct {
@@ -172,7 +187,7 @@ func (s *ciliumNodeSynchronizer) Start(ctx context.Context) error {
 					log.WithError(err).Warning("Unable to process CiliumNode Add event")
 					return
 				}
-				if s.nodeManager != nil {
+				if s.nodeManager != nil && ownedByThisShard(key) {
 					ciliumNodeManagerQueue.Add(key)
 				}
 				if s.withKVStore {
@@ -190,7 +205,7 @@ func (s *ciliumNodeSynchronizer) Start(ctx context.Context) error {
 							log.WithError(err).Warning("Unable to process CiliumNode Update event")
 							return
 						}
-						if s.nodeManager != nil {
+						if s.nodeManager != nil && ownedByThisShard(key) {
 							ciliumNodeManagerQueue.Add(key)
 						}
 						if s.withKVStore {
@@ -209,7 +224,7 @@ func (s *ciliumNodeSynchronizer) Start(ctx context.Context) error {
 					log.WithError(err).Warning("Unable to process CiliumNode Delete event")
 					return
 				}
-				if s.nodeManager != nil {
+				if s.nodeManager != nil && ownedByThisShard(key) {
 					ciliumNodeManagerQueue.Add(key)
 				}
 				if s.withKVStore {
@@ -243,11 +258,20 @@ func (s *ciliumNodeSynchronizer) Start(ctx context.Context) error {
 		// Only handle events if nodeManagerSyncHandler is not nil. If it is nil
 		// then there isn't any event handler set for CiliumNodes events.
 		if nodeManagerSyncHandler != nil {
-			go func() {
-				// infinite loop. run in a go routine to unblock code execution
-				for s.processNextWorkItem(ciliumNodeManagerQueue, nodeManagerSyncHandler) {
-				}
-			}()
+			workers := operatorOption.Config.CiliumNodeWorkers
+			if workers < 1 {
+				workers = 1
+			}
+			// The workqueue hands a given key to a single worker at a time,
+			// so running multiple workers never reconciles the same
+			// CiliumNode concurrently.
+			for i := 0; i < workers; i++ {
+				go func() {
+					// infinite loop. run in a go routine to unblock code execution
+					for s.processNextWorkItem(ciliumNodeManagerQueue, nodeManagerSyncHandler) {
+					}
+				}()
+			}
 		}
 		// Start handling events for KVStore **after** nodeManagerSyncHandler
 		// otherwise Cilium Operator will block until the KVStore is available.
@@ -27,6 +27,12 @@ func init() {
 	flags.Float64(operatorOption.IPAMAPIQPSLimit, defaults.IPAMAPIQPSLimit, "Queries per second limit when accessing external IPAM APIs")
 	option.BindEnv(Vp, operatorOption.IPAMAPIQPSLimit)
 
+	flags.Int(operatorOption.IPAMAPIBudget, 0, "Total number of external IPAM API calls allowed per minute across all API clients. Set to 0 to disable")
+	option.BindEnv(Vp, operatorOption.IPAMAPIBudget)
+
+	flags.Bool(operatorOption.IPAMSimulation, false, "Run the IPAM allocator against an in-memory fake cloud backend for capacity planning. No cloud resources are created or modified")
+	option.BindEnv(Vp, operatorOption.IPAMSimulation)
+
 	flags.Var(option.NewNamedMapOptions(operatorOption.IPAMSubnetsTags, &operatorOption.Config.IPAMSubnetsTags, nil),
 		operatorOption.IPAMSubnetsTags, "Subnets tags in the form of k1=v1,k2=v2 (multiple k/v pairs can also be passed by repeating the CLI flag")
 	option.BindEnv(Vp, operatorOption.IPAMSubnetsTags)
@@ -149,7 +155,7 @@ func init() {
 				return "cilium-operator-azure"
 			case ipamOption.IPAMAlibabaCloud:
 				return "cilium-operator-alibabacloud"
-			case ipamOption.IPAMKubernetes, ipamOption.IPAMClusterPool, ipamOption.IPAMClusterPoolV2, ipamOption.IPAMCRD:
+			case ipamOption.IPAMKubernetes, ipamOption.IPAMClusterPool, ipamOption.IPAMClusterPoolV2, ipamOption.IPAMMultiPool, ipamOption.IPAMCRD:
 				return "cilium-operator-generic"
 			default:
 				return ""
@@ -183,7 +189,7 @@ func init() {
 			}
 		case "cilium-operator-generic":
 			switch ipamFlagValue {
-			case ipamOption.IPAMENI, ipamOption.IPAMAzure, ipamOption.IPAMAlibabaCloud:
+			case ipamOption.IPAMENI, ipamOption.IPAMAzure, ipamOption.IPAMAlibabaCloud, ipamOption.IPAMOpenStack:
 				return unsupporterErr()
 			}
 		}
@@ -218,6 +224,10 @@ func init() {
 			option.EnableIPv6Name, "true"))
 	option.BindEnv(Vp, operatorOption.ClusterPoolIPv6CIDR)
 
+	flags.Duration(operatorOption.ClusterPoolDefragInterval, 0,
+		"Interval in which pod CIDRs marked as released by the agents are returned back into the cluster pool (0 to disable)")
+	option.BindEnv(Vp, operatorOption.ClusterPoolDefragInterval)
+
 	flags.Int(operatorOption.NodeCIDRMaskSizeIPv6, 112,
 		fmt.Sprintf("Mask size for each IPv6 podCIDR per node. Requires '%s=%s|%s' and '%s=%s'",
 			option.IPAM, ipamOption.IPAMClusterPool, ipamOption.IPAMClusterPoolV2,
@@ -251,6 +261,15 @@ func init() {
 	flags.String(option.K8sNamespaceName, "", "Name of the Kubernetes namespace in which Cilium Operator is deployed in")
 	option.BindEnv(Vp, option.K8sNamespaceName)
 
+	flags.Duration(operatorOption.NodesGCGracePeriod, 0, "Duration an invalid CiliumNode must remain marked before it is garbage collected (0 to use the GC interval)")
+	option.BindEnv(Vp, operatorOption.NodesGCGracePeriod)
+
+	flags.Duration(operatorOption.EndpointGCGracePeriod, 0, "Minimum age an orphaned CiliumEndpoint must have before it is garbage collected")
+	option.BindEnv(Vp, operatorOption.EndpointGCGracePeriod)
+
+	flags.Bool(operatorOption.CRDGCDryRun, false, "Report the CiliumEndpoint, CiliumNode and CiliumIdentity resources the garbage collectors would delete without deleting them")
+	option.BindEnv(Vp, operatorOption.CRDGCDryRun)
+
 	flags.Duration(operatorOption.NodesGCInterval, 5*time.Minute, "GC interval for CiliumNodes")
 	option.BindEnv(Vp, operatorOption.NodesGCInterval)
 
@@ -260,6 +279,9 @@ func init() {
 	flags.String(operatorOption.OperatorAPIServeAddr, "localhost:9234", "Address to serve API requests")
 	option.BindEnv(Vp, operatorOption.OperatorAPIServeAddr)
 
+	flags.String(operatorOption.OperatorAPIAdminToken, "", "Bearer token required to call the admin endpoints of the operator API, admin endpoints are disabled if empty")
+	option.BindEnv(Vp, operatorOption.OperatorAPIAdminToken)
+
 	flags.Bool(operatorOption.PProf, false, "Enable pprof debugging endpoint")
 	option.BindEnv(Vp, operatorOption.PProf)
 
@@ -294,6 +316,18 @@ func init() {
 		"Duration that LeaderElector clients should wait between retries of the actions")
 	option.BindEnv(Vp, operatorOption.LeaderElectionRetryPeriod)
 
+	flags.Int(operatorOption.CiliumNodeWorkers, 1,
+		"Number of workers processing CiliumNode events in parallel")
+	option.BindEnv(Vp, operatorOption.CiliumNodeWorkers)
+
+	flags.Int(operatorOption.CiliumNodeShardCount, 1,
+		"Total number of operator replicas sharding CiliumNode reconciliation by node-name hash. Values larger than one run the IPAM allocator on every replica")
+	option.BindEnv(Vp, operatorOption.CiliumNodeShardCount)
+
+	flags.Int(operatorOption.CiliumNodeShardIndex, 0,
+		"Zero-based shard index of this operator replica when CiliumNode sharding is enabled")
+	option.BindEnv(Vp, operatorOption.CiliumNodeShardIndex)
+
 	flags.String(option.K8sServiceProxyName, "", "Value of K8s service-proxy-name label for which Cilium handles the services (empty = all services without service.kubernetes.io/service-proxy-name label)")
 	option.BindEnv(Vp, option.K8sServiceProxyName)
 
@@ -332,6 +366,15 @@ func init() {
 	flags.StringSlice(operatorOption.IngressLBAnnotationPrefixes, operatorOption.IngressLBAnnotationsDefault, "Annotation prefixes for propagating from Ingress to the Load Balancer service")
 	option.BindEnv(Vp, operatorOption.IngressLBAnnotationPrefixes)
 
+	flags.String(operatorOption.PolicyValidationWebhookListenAddress, "", "Address the admission webhook validating CiliumNetworkPolicy and CiliumClusterwideNetworkPolicy listens on, e.g. \":9889\". Empty value disables the webhook")
+	option.BindEnv(Vp, operatorOption.PolicyValidationWebhookListenAddress)
+
+	flags.String(operatorOption.PolicyValidationWebhookCertFile, "", "Path to the certificate file served by the policy validation webhook")
+	option.BindEnv(Vp, operatorOption.PolicyValidationWebhookCertFile)
+
+	flags.String(operatorOption.PolicyValidationWebhookKeyFile, "", "Path to the private key file of the certificate served by the policy validation webhook")
+	option.BindEnv(Vp, operatorOption.PolicyValidationWebhookKeyFile)
+
 	flags.Bool(operatorOption.EnableK8s, true, `Enable operation of Kubernetes-related services/controllers when using Cilium with Kubernetes`)
 	option.BindEnv(Vp, operatorOption.EnableK8s)
 
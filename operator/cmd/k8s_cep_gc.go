@@ -11,6 +11,7 @@ import (
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	"github.com/cilium/cilium/operator/api"
 	"github.com/cilium/cilium/operator/metrics"
 	operatorOption "github.com/cilium/cilium/operator/option"
 	"github.com/cilium/cilium/operator/watchers"
@@ -22,6 +23,10 @@ import (
 	"github.com/cilium/cilium/pkg/logging/logfields"
 )
 
+// cepGCSubsystem is the subsystem name under which the CiliumEndpoint
+// garbage collector reports its health.
+const cepGCSubsystem = "cep-gc"
+
 // enableCiliumEndpointSyncGC starts the node-singleton sweeper for
 // CiliumEndpoint objects where the managing node is no longer running. These
 // objects are created by the sync-to-k8s-ciliumendpoint controller on each
@@ -63,7 +68,13 @@ func enableCiliumEndpointSyncGC(clientset k8sClient.Clientset, cns *ciliumNodeSy
 		controller.ControllerParams{
 			RunInterval: gcInterval,
 			DoFunc: func(ctx context.Context) error {
-				return doCiliumEndpointSyncGC(ctx, clientset, cns, once, stopCh, scopedLog)
+				err := doCiliumEndpointSyncGC(ctx, clientset, cns, once, stopCh, scopedLog)
+				if err != nil {
+					api.ReportSubsystemError(cepGCSubsystem, err)
+				} else {
+					api.ReportSubsystemOK(cepGCSubsystem)
+				}
+				return err
 			},
 		})
 }
@@ -146,6 +157,22 @@ func doCiliumEndpointSyncGC(ctx context.Context, clientset k8sClient.Clientset,
 		scopedLog = scopedLog.WithFields(logrus.Fields{
 			logfields.EndpointID: cep.Status.ID,
 		})
+
+		// Newly created CEPs may not have had their owning pod propagated
+		// to the pod store yet, give them the configured grace period
+		// before considering them orphaned.
+		if grace := operatorOption.Config.EndpointGCGracePeriod; grace > 0 &&
+			time.Since(cep.ObjectMeta.CreationTimestamp.Time) < grace {
+			scopedLog.Debug("Orphaned CiliumEndpoint is within the GC grace period")
+			continue
+		}
+
+		if operatorOption.Config.CRDGCDryRun {
+			scopedLog.Info("Dry-run: orphaned CiliumEndpoint would be garbage collected")
+			dryRunEndpointObjectGC()
+			continue
+		}
+
 		scopedLog.Debug("Orphaned CiliumEndpoint is being garbage collected")
 		PropagationPolicy := meta_v1.DeletePropagationBackground // because these are const strings but the API wants pointers
 		err := ciliumClient.CiliumEndpoints(cep.Namespace).Delete(
@@ -189,3 +216,9 @@ func failedEndpointObjectGC() {
 		metrics.EndpointGCObjects.WithLabelValues(metrics.LabelValueOutcomeFail).Inc()
 	}
 }
+
+func dryRunEndpointObjectGC() {
+	if operatorOption.Config.EnableMetrics {
+		metrics.EndpointGCObjects.WithLabelValues(metrics.LabelValueOutcomeDryRun).Inc()
+	}
+}
@@ -12,6 +12,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/tools/cache"
 
+	"github.com/cilium/cilium/operator/api"
 	"github.com/cilium/cilium/operator/identity"
 	"github.com/cilium/cilium/operator/metrics"
 	operatorOption "github.com/cilium/cilium/operator/option"
@@ -75,6 +76,10 @@ var (
 	successfulRuns = 0
 )
 
+// identityGCSubsystem is the subsystem name under which the identity garbage
+// collector reports its health.
+const identityGCSubsystem = "identity-gc"
+
 // identityGCIteration is a single iteration of a garbage collection. It will
 // delete identities that have not had its heartbeat lifesign updated since
 // option.Config.IdentityHeartbeatTimeout
@@ -131,6 +136,13 @@ func identityGCIteration(ctx context.Context, clientset k8sClient.Clientset) {
 				continue
 			}
 
+			if operatorOption.Config.CRDGCDryRun {
+				log.WithFields(logrus.Fields{
+					logfields.Identity: identity.Name,
+				}).Infof("Dry-run: unused identity would be deleted; marked for deletion at %s", ts)
+				continue
+			}
+
 			log.WithFields(logrus.Fields{
 				logfields.Identity: identity,
 			}).Debugf("Deleting unused identity; marked for deletion at %s", ts)
@@ -161,6 +173,12 @@ func identityGCIteration(ctx context.Context, clientset k8sClient.Clientset) {
 		metrics.IdentityGCSize.WithLabelValues(metrics.LabelValueOutcomeDeleted).Set(float64(deletedEntries))
 	}
 
+	if ctx.Err() == nil {
+		api.ReportSubsystemOK(identityGCSubsystem)
+	} else {
+		api.ReportSubsystemError(identityGCSubsystem, ctx.Err())
+	}
+
 	identityHeartbeat.GC()
 }
 
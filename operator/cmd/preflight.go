@@ -0,0 +1,160 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sort"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/cilium/cilium/pkg/ipam/allocator"
+	ipamTypes "github.com/cilium/cilium/pkg/ipam/types"
+	k8sClient "github.com/cilium/cilium/pkg/k8s/client"
+)
+
+const (
+	// instanceTypeLabel is the well-known node label carrying the cloud
+	// instance type of a node.
+	instanceTypeLabel = "node.kubernetes.io/instance-type"
+
+	// instanceTypeLabelBeta is the deprecated predecessor of
+	// instanceTypeLabel, still set by older kubelets.
+	instanceTypeLabelBeta = "beta.kubernetes.io/instance-type"
+)
+
+var (
+	preflightToIPAM           string
+	preflightPrefixDelegation bool
+	preflightKubeConfigPath   string
+
+	// PreflightCmd validates a planned IPAM mode migration without
+	// mutating any cloud or cluster resources.
+	PreflightCmd = &cobra.Command{
+		Use:   "preflight",
+		Short: "Validate a planned IPAM mode migration",
+		Long: `Validate a planned IPAM mode migration (e.g. cluster-pool to ENI, or ENI to
+prefix delegation) by checking cloud API permissions, subnet capacity and
+instance type support, and print the resulting migration plan. No cloud or
+cluster resources are mutated.`,
+		Run: func(cmd *cobra.Command, args []string) {
+			preflight()
+		},
+	}
+)
+
+func init() {
+	PreflightCmd.Flags().StringVar(&preflightToIPAM, "to-ipam", "", "Target IPAM mode of the planned migration")
+	PreflightCmd.Flags().BoolVar(&preflightPrefixDelegation, "prefix-delegation", false, "Validate that all nodes support ENI prefix delegation")
+	PreflightCmd.Flags().StringVar(&preflightKubeConfigPath, "k8s-kubeconfig-path", "", "Absolute path of the kubernetes kubeconfig file")
+}
+
+// checkInstanceTypes validates that the instance types of all nodes in the
+// cluster are present in the instance limits table of the target IPAM mode.
+func checkInstanceTypes(ctx context.Context, limits map[string]ipamTypes.Limits) allocator.PreflightCheck {
+	check := allocator.PreflightCheck{Name: "instance-type-support"}
+
+	clientset, err := k8sClient.NewStandaloneClientset(k8sClient.Config{
+		K8sKubeConfigPath: preflightKubeConfigPath,
+	})
+	if err != nil {
+		check.Error = fmt.Errorf("unable to create Kubernetes client: %w", err)
+		return check
+	}
+
+	nodes, err := clientset.CoreV1().Nodes().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		check.Error = fmt.Errorf("unable to list nodes: %w", err)
+		return check
+	}
+
+	var unsupported, noPrefixDelegation []string
+	for _, node := range nodes.Items {
+		instanceType, ok := node.Labels[instanceTypeLabel]
+		if !ok {
+			instanceType = node.Labels[instanceTypeLabelBeta]
+		}
+
+		limit, ok := limits[instanceType]
+		if !ok {
+			unsupported = append(unsupported, fmt.Sprintf("%s (%s)", node.Name, instanceType))
+			continue
+		}
+		if preflightPrefixDelegation && limit.HypervisorType != "nitro" {
+			noPrefixDelegation = append(noPrefixDelegation, fmt.Sprintf("%s (%s)", node.Name, instanceType))
+		}
+	}
+
+	switch {
+	case len(unsupported) > 0:
+		check.Error = fmt.Errorf("nodes with unsupported instance types: %v", unsupported)
+	case len(noPrefixDelegation) > 0:
+		check.Error = fmt.Errorf("nodes without prefix delegation support: %v", noPrefixDelegation)
+	default:
+		check.Details = fmt.Sprintf("all %d nodes run supported instance types", len(nodes.Items))
+	}
+	return check
+}
+
+func preflight() {
+	if preflightToIPAM == "" {
+		log.Fatal("--to-ipam is required")
+	}
+
+	alloc, ok := allocatorProviders[preflightToIPAM]
+	if !ok {
+		supported := make([]string, 0, len(allocatorProviders))
+		for mode := range allocatorProviders {
+			supported = append(supported, mode)
+		}
+		sort.Strings(supported)
+		log.Fatalf("IPAM mode %q is not supported by this version of %s, supported modes: %v",
+			preflightToIPAM, binaryName, supported)
+	}
+
+	ctx := context.Background()
+	checks := []allocator.PreflightCheck{{
+		Name:    "provider-built-in",
+		Details: fmt.Sprintf("%s allocator is supported by this operator", preflightToIPAM),
+	}}
+
+	if provider, ok := alloc.(interface {
+		InstanceLimits() map[string]ipamTypes.Limits
+	}); ok {
+		checks = append(checks, checkInstanceTypes(ctx, provider.InstanceLimits()))
+	}
+
+	if preflighter, ok := alloc.(allocator.MigrationPreflighter); ok {
+		checks = append(checks, preflighter.Preflight(ctx)...)
+	}
+
+	failed := 0
+	w := tabwriter.NewWriter(os.Stdout, 5, 0, 3, ' ', 0)
+	fmt.Fprintln(w, "CHECK\tSTATUS\tDETAILS")
+	for _, check := range checks {
+		if check.Error != nil {
+			failed++
+			fmt.Fprintf(w, "%s\tFAIL\t%s\n", check.Name, check.Error)
+		} else {
+			fmt.Fprintf(w, "%s\tOK\t%s\n", check.Name, check.Details)
+		}
+	}
+	w.Flush()
+
+	fmt.Printf("\nMigration plan to IPAM mode %q:\n", preflightToIPAM)
+	fmt.Printf("  1. Set ipam: %s in the Cilium ConfigMap\n", preflightToIPAM)
+	fmt.Println("  2. Restart the cilium-operator deployment and wait for it to become ready")
+	fmt.Println("  3. Restart the cilium agents, nodes are migrated as their CiliumNode resources are updated")
+	fmt.Println("  4. Recreate the pods on each node to move them to the new address pool")
+
+	if failed > 0 {
+		fmt.Printf("\n%d of %d preflight checks failed, do not proceed with the migration\n", failed, len(checks))
+		os.Exit(1)
+	}
+	fmt.Printf("\nAll %d preflight checks passed\n", len(checks))
+}
@@ -7,6 +7,7 @@ package cmd
 
 import (
 	operatorOption "github.com/cilium/cilium/operator/option"
+	"github.com/cilium/cilium/pkg/defaults"
 	"github.com/cilium/cilium/pkg/option"
 )
 
@@ -16,5 +17,18 @@ func init() {
 	flags.String(operatorOption.AlibabaCloudVPCID, "", "Specific VPC ID for AlibabaCloud ENI. If not set use same VPC as operator")
 	option.BindEnv(Vp, operatorOption.AlibabaCloudVPCID)
 
+	flags.Var(option.NewNamedMapOptions(operatorOption.AlibabaCloudInstanceLimitMapping, &operatorOption.Config.AlibabaCloudInstanceLimitMapping, nil),
+		operatorOption.AlibabaCloudInstanceLimitMapping,
+		`Add or overwrite mappings of AlibabaCloud instance limit in the form of `+
+			`{"AlibabaCloud instance type": "Maximum Network Interfaces","IPv4 Addresses `+
+			`per Interface","IPv6 Addresses per Interface"}. cli example: `+
+			`--alibaba-cloud-instance-limit-mapping=ecs.g6.large=3,10,10 `+
+			`configmap example: {"ecs.g6.large": "3,10,10"}`)
+	option.BindEnv(Vp, operatorOption.AlibabaCloudInstanceLimitMapping)
+
+	flags.Duration(operatorOption.AlibabaCloudENIGCInterval, defaults.ENIGarbageCollectionInterval,
+		"Interval for garbage collection of unattached ENIs. Set to 0 to disable")
+	option.BindEnv(Vp, operatorOption.AlibabaCloudENIGCInterval)
+
 	Vp.BindPFlags(flags)
 }
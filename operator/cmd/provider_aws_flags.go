@@ -33,6 +33,9 @@ func init() {
 	flags.Bool(operatorOption.AWSEnablePrefixDelegation, false, "Allows operator to allocate prefixes to ENIs instead of individual IP addresses")
 	option.BindEnv(Vp, operatorOption.AWSEnablePrefixDelegation)
 
+	flags.Bool(operatorOption.AWSStaticENIMode, false, "Only use ENIs and IPs pre-attached by external tooling, never create, modify or delete AWS resources")
+	option.BindEnv(Vp, operatorOption.AWSStaticENIMode)
+
 	flags.Var(option.NewNamedMapOptions(operatorOption.ENITags, &operatorOption.Config.ENITags, nil),
 		operatorOption.ENITags, "ENI tags in the form of k1=v1 (multiple k/v pairs can be passed by repeating the CLI flag)")
 	option.BindEnv(Vp, operatorOption.ENITags)
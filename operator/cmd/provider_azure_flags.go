@@ -25,5 +25,8 @@ func init() {
 	flags.Bool(operatorOption.AzureUsePrimaryAddress, false, "Use Azure IP address from interface's primary IPConfigurations")
 	option.BindEnvWithLegacyEnvFallback(Vp, operatorOption.AzureUsePrimaryAddress, "AZURE_USE_PRIMARY_ADDRESS")
 
+	flags.Bool(operatorOption.AzureDryRun, false, "Log Azure mutations instead of executing them")
+	option.BindEnv(Vp, operatorOption.AzureDryRun)
+
 	Vp.BindPFlags(flags)
 }
@@ -0,0 +1,29 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+//go:build ipam_provider_openstack
+
+package cmd
+
+import (
+	operatorOption "github.com/cilium/cilium/operator/option"
+	"github.com/cilium/cilium/pkg/option"
+)
+
+func init() {
+	flags := rootCmd.Flags()
+
+	flags.Bool(operatorOption.OpenStackReleaseExcessIPs, false, "Enable releasing excess free IP addresses from OpenStack ENI")
+	option.BindEnv(Vp, operatorOption.OpenStackReleaseExcessIPs)
+
+	flags.Var(option.NewNamedMapOptions(operatorOption.OpenStackFlavorLimitMapping, &operatorOption.Config.OpenStackFlavorLimitMapping, nil),
+		operatorOption.OpenStackFlavorLimitMapping,
+		`Set the mappings of OpenStack flavor limits in the form of `+
+			`{"flavor name": "Maximum Network Interfaces","IPv4 Addresses `+
+			`per Interface","IPv6 Addresses per Interface"}. cli example: `+
+			`--openstack-flavor-limit-mapping=m1.large=3,10,0 `+
+			`configmap example: {"m1.large": "3,10,0"}`)
+	option.BindEnv(Vp, operatorOption.OpenStackFlavorLimitMapping)
+
+	Vp.BindPFlags(flags)
+}
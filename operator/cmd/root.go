@@ -29,6 +29,7 @@ import (
 	gatewayapi "github.com/cilium/cilium/operator/pkg/gateway-api"
 	"github.com/cilium/cilium/operator/pkg/ingress"
 	"github.com/cilium/cilium/operator/pkg/lbipam"
+	"github.com/cilium/cilium/operator/pkg/webhook"
 	operatorWatchers "github.com/cilium/cilium/operator/watchers"
 
 	"github.com/cilium/cilium/pkg/components"
@@ -36,8 +37,10 @@ import (
 	"github.com/cilium/cilium/pkg/gops"
 	"github.com/cilium/cilium/pkg/hive"
 	"github.com/cilium/cilium/pkg/hive/cell"
+	"github.com/cilium/cilium/pkg/ipam"
 	"github.com/cilium/cilium/pkg/ipam/allocator"
 	ipamOption "github.com/cilium/cilium/pkg/ipam/option"
+	ipamTypes "github.com/cilium/cilium/pkg/ipam/types"
 	"github.com/cilium/cilium/pkg/k8s"
 	"github.com/cilium/cilium/pkg/k8s/apis/cilium.io/client"
 	k8sClient "github.com/cilium/cilium/pkg/k8s/client"
@@ -87,6 +90,21 @@ var (
 	// elected leader. Otherwise, it is false.
 	IsLeader atomic.Value
 
+	// ipamResyncer holds the IPAM resync function of the leading operator.
+	// It is only set if the configured IPAM mode supports forced resyncs
+	// via the operator API.
+	ipamResyncer atomic.Value
+
+	// ipamForecaster holds the IPAM pool forecast function of the leading
+	// operator. It is only set if the configured IPAM mode provides pool
+	// forecasts via the operator API.
+	ipamForecaster atomic.Value
+
+	// ipamAdmin holds the IPAM admin interface of the leading operator. It
+	// is only set if the configured IPAM mode supports node management via
+	// the operator admin API.
+	ipamAdmin atomic.Value
+
 	// OperatorCell are the operator specific cells without infrastructure cells.
 	// Used also in tests.
 	OperatorCell = cell.Module(
@@ -158,6 +176,7 @@ func newOperatorHive() *hive.Hive {
 
 func init() {
 	rootCmd.AddCommand(MetricsCmd)
+	rootCmd.AddCommand(PreflightCmd)
 
 	rootCmd.Run = func(cobraCmd *cobra.Command, args []string) {
 		cmdRefDir := operatorHive.Viper().GetString(option.CMDRef)
@@ -248,6 +267,48 @@ func runOperator(lc *LeaderLifecycle, clientset k8sClient.Clientset, shutdowner
 	}
 	close(allSystemsGo)
 
+	srv.WithIPAMResyncFunc(func(ctx context.Context, node string) error {
+		if f, ok := ipamResyncer.Load().(api.IPAMResyncFunc); ok {
+			return f(ctx, node)
+		}
+		return fmt.Errorf("IPAM mode %q does not support forced resyncs or the operator is not leading", option.Config.IPAM)
+	})
+
+	srv.WithIPAMForecastFunc(func() ([]ipam.PoolForecast, error) {
+		if f, ok := ipamForecaster.Load().(api.IPAMForecastFunc); ok {
+			return f()
+		}
+		return nil, fmt.Errorf("IPAM mode %q does not provide pool forecasts or the operator is not leading", option.Config.IPAM)
+	})
+
+	srv.WithIPAMAdmin(ipamAdminProxy{})
+
+	// The instance limits table and the per-node pool status are read-only
+	// and are served by non-leading replicas as well.
+	if provider, ok := allocatorProviders[option.Config.IPAM].(interface {
+		InstanceLimits() map[string]ipamTypes.Limits
+	}); ok {
+		srv.WithIPAMLimitsFunc(provider.InstanceLimits)
+	}
+
+	if clientset.IsEnabled() {
+		srv.WithIPAMPoolsFunc(func(ctx context.Context) (map[string]api.IPAMNodePoolStatus, error) {
+			nodes, err := clientset.CiliumV2().CiliumNodes().List(ctx, metav1.ListOptions{})
+			if err != nil {
+				return nil, err
+			}
+
+			pools := make(map[string]api.IPAMNodePoolStatus, len(nodes.Items))
+			for _, node := range nodes.Items {
+				pools[node.Name] = api.IPAMNodePoolStatus{
+					Available: len(node.Spec.IPAM.Pool),
+					Used:      len(node.Status.IPAM.Used),
+				}
+			}
+			return pools, nil
+		})
+	}
+
 	if operatorOption.Config.EnableK8s {
 		go func() {
 			err = srv.WithStatusCheckFunc(func() error { return checkStatus(clientset) }).StartServer()
@@ -283,6 +344,35 @@ func runOperator(lc *LeaderLifecycle, clientset k8sClient.Clientset, shutdowner
 		log.Info("Skipping creation of CRDs")
 	}
 
+	// When CiliumNode reconciliation is sharded across multiple replicas,
+	// every replica runs the IPAM allocator for its own subset of nodes,
+	// selected by node-name hash. Leader election below still gates the
+	// cluster-wide singleton subsystems (GC, identity management, kvstore
+	// synchronization), which keep running on exactly one replica.
+	if operatorOption.Config.CiliumNodeShardCount > 1 {
+		switch option.Config.IPAM {
+		case ipamOption.IPAMClusterPool, ipamOption.IPAMClusterPoolV2, ipamOption.IPAMMultiPool:
+			log.Fatalf("--%s is not supported with --%s=%s: pod CIDR pools are managed in memory by a single replica",
+				operatorOption.CiliumNodeShardCount, option.IPAM, option.Config.IPAM)
+		}
+		if !clientset.IsEnabled() {
+			log.Fatalf("--%s requires Kubernetes support to be enabled", operatorOption.CiliumNodeShardCount)
+		}
+
+		log.Infof("CiliumNode sharding enabled (shard %d of %d), starting IPAM allocator on this replica",
+			operatorOption.Config.CiliumNodeShardIndex, operatorOption.Config.CiliumNodeShardCount)
+
+		nodeManager := startIPAMAllocator(leaderElectionCtx, clientset)
+		if nodeManager == nil {
+			log.Fatalf("--%s is not supported with --%s=%s: the IPAM mode is not operator-managed",
+				operatorOption.CiliumNodeShardCount, option.IPAM, option.Config.IPAM)
+		}
+
+		if err := newCiliumNodeSynchronizer(clientset, nodeManager, false).Start(leaderElectionCtx); err != nil {
+			log.WithError(err).Fatal("Unable to setup cilium node synchronizer")
+		}
+	}
+
 	// We only support Operator in HA mode for Kubernetes Versions having support for
 	// LeasesResourceLock.
 	// See docs on capabilities.LeasesResourceLock for more context.
@@ -370,6 +460,100 @@ func kvstoreEnabled() bool {
 		operatorOption.Config.SyncK8sNodes
 }
 
+// ipamNodeResyncer is implemented by IPAM node managers which support forcing
+// an immediate resync via the operator API.
+type ipamNodeResyncer interface {
+	ResyncNode(ctx context.Context, node string) error
+}
+
+// ipamPoolForecaster is implemented by IPAM node managers which project the
+// time-to-exhaustion of their pools via the operator API.
+type ipamPoolForecaster interface {
+	PoolForecasts() []ipam.PoolForecast
+}
+
+// ipamAdminProxy forwards IPAM admin operations of the operator API to the
+// node manager of the leading operator.
+type ipamAdminProxy struct{}
+
+func (ipamAdminProxy) CordonNode(node string, cordon bool) error {
+	if a, ok := ipamAdmin.Load().(api.IPAMAdmin); ok {
+		return a.CordonNode(node, cordon)
+	}
+	return fmt.Errorf("IPAM mode %q does not support node management or the operator is not leading", option.Config.IPAM)
+}
+
+func (ipamAdminProxy) DrainNode(node string) error {
+	if a, ok := ipamAdmin.Load().(api.IPAMAdmin); ok {
+		return a.DrainNode(node)
+	}
+	return fmt.Errorf("IPAM mode %q does not support node management or the operator is not leading", option.Config.IPAM)
+}
+
+func (ipamAdminProxy) ReleaseNodeIPs(node string, ips []string) error {
+	if a, ok := ipamAdmin.Load().(api.IPAMAdmin); ok {
+		return a.ReleaseNodeIPs(node, ips)
+	}
+	return fmt.Errorf("IPAM mode %q does not support node management or the operator is not leading", option.Config.IPAM)
+}
+
+// startIPAMAllocator initializes and starts the IPAM allocator of the
+// configured IPAM mode and hooks it up to the operator API. It returns nil if
+// the configured IPAM mode is not operator-managed.
+func startIPAMAllocator(ctx context.Context, clientset k8sClient.Clientset) allocator.NodeEventHandler {
+	var nodeManager allocator.NodeEventHandler
+
+	log.WithField(logfields.Mode, option.Config.IPAM).Info("Initializing IPAM")
+
+	switch ipamMode := option.Config.IPAM; ipamMode {
+	case ipamOption.IPAMAzure, ipamOption.IPAMENI, ipamOption.IPAMClusterPool, ipamOption.IPAMClusterPoolV2, ipamOption.IPAMMultiPool, ipamOption.IPAMAlibabaCloud, ipamOption.IPAMOpenStack:
+		alloc, providerBuiltin := allocatorProviders[ipamMode]
+		if !providerBuiltin {
+			log.Fatalf("%s allocator is not supported by this version of %s", ipamMode, binaryName)
+		}
+
+		if withClientset, ok := alloc.(allocator.AllocatorProviderWithClientset); ok {
+			withClientset.SetClientset(clientset)
+		}
+
+		if err := alloc.Init(ctx); err != nil {
+			log.WithError(err).Fatalf("Unable to init %s allocator", ipamMode)
+		}
+
+		nm, err := alloc.Start(ctx, &ciliumNodeUpdateImplementation{clientset})
+		if err != nil {
+			log.WithError(err).Fatalf("Unable to start %s allocator", ipamMode)
+		}
+
+		nodeManager = nm
+
+		if resyncer, ok := nodeManager.(ipamNodeResyncer); ok {
+			ipamResyncer.Store(api.IPAMResyncFunc(resyncer.ResyncNode))
+		}
+
+		if forecaster, ok := nodeManager.(ipamPoolForecaster); ok {
+			ipamForecaster.Store(api.IPAMForecastFunc(func() ([]ipam.PoolForecast, error) {
+				return forecaster.PoolForecasts(), nil
+			}))
+		}
+
+		if admin, ok := nodeManager.(api.IPAMAdmin); ok {
+			ipamAdmin.Store(admin)
+		}
+
+		if readiness, ok := nodeManager.(interface{ InstancesAPIIsReady() bool }); ok {
+			api.RegisterSubsystemCheck("ipam-allocator", func() error {
+				if !readiness.InstancesAPIIsReady() {
+					return fmt.Errorf("instances API is not ready")
+				}
+				return nil
+			})
+		}
+	}
+
+	return nodeManager
+}
+
 var legacyCell = cell.Invoke(registerLegacyOnLeader)
 
 func registerLegacyOnLeader(lc hive.Lifecycle, clientset k8sClient.Clientset, resources SharedResources) {
@@ -438,25 +622,16 @@ func (legacy *legacyOnLeader) onStart(_ hive.HookContext) error {
 		withKVStore bool
 	)
 
-	log.WithField(logfields.Mode, option.Config.IPAM).Info("Initializing IPAM")
-
-	switch ipamMode := option.Config.IPAM; ipamMode {
-	case ipamOption.IPAMAzure, ipamOption.IPAMENI, ipamOption.IPAMClusterPool, ipamOption.IPAMClusterPoolV2, ipamOption.IPAMAlibabaCloud:
-		alloc, providerBuiltin := allocatorProviders[ipamMode]
-		if !providerBuiltin {
-			log.Fatalf("%s allocator is not supported by this version of %s", ipamMode, binaryName)
-		}
-
-		if err := alloc.Init(legacy.ctx); err != nil {
-			log.WithError(err).Fatalf("Unable to init %s allocator", ipamMode)
-		}
-
-		nm, err := alloc.Start(legacy.ctx, &ciliumNodeUpdateImplementation{legacy.clientset})
-		if err != nil {
-			log.WithError(err).Fatalf("Unable to start %s allocator", ipamMode)
-		}
-
-		nodeManager = nm
+	if operatorOption.Config.CiliumNodeShardCount > 1 {
+		// With CiliumNode sharding enabled, every replica, including
+		// this leader, has already started its own IPAM allocator for
+		// its shard of the nodes in runOperator. The synchronizer below
+		// still runs without a node manager to feed the CiliumNode
+		// store used by the garbage collectors and the kvstore
+		// synchronization.
+		log.Info("CiliumNode sharding enabled, IPAM allocator runs on every replica")
+	} else {
+		nodeManager = startIPAMAllocator(legacy.ctx, legacy.clientset)
 	}
 
 	if operatorOption.Config.BGPAnnounceLBIP {
@@ -684,6 +859,19 @@ func (legacy *legacyOnLeader) onStart(_ hive.HookContext) error {
 		go gatewayController.Run()
 	}
 
+	if operatorOption.Config.PolicyValidationWebhookListenAddress != "" {
+		webhookServer, err := webhook.NewServer(
+			operatorOption.Config.PolicyValidationWebhookListenAddress,
+			operatorOption.Config.PolicyValidationWebhookCertFile,
+			operatorOption.Config.PolicyValidationWebhookKeyFile,
+		)
+		if err != nil {
+			log.WithError(err).WithField(logfields.LogSubsys, webhook.Subsys).Fatal(
+				"Failed to create policy validation webhook")
+		}
+		go webhookServer.Run()
+	}
+
 	log.Info("Initialization complete")
 	return nil
 }
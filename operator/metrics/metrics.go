@@ -87,6 +87,14 @@ var (
 	// garbage-collected.
 	EndpointGCObjects *prometheus.CounterVec
 
+	// CiliumNodeGCObjects records the number of times CiliumNode objects
+	// have been garbage-collected.
+	CiliumNodeGCObjects *prometheus.CounterVec
+
+	// SubsystemHealth reports whether an operator subsystem is healthy
+	// (1) or unhealthy (0).
+	SubsystemHealth *prometheus.GaugeVec
+
 	// CiliumEndpointSliceDensity indicates the number of CEPs batched in a CES and it used to
 	// collect the number of CEPs in CES at various buckets.
 	CiliumEndpointSliceDensity prometheus.Histogram
@@ -121,6 +129,10 @@ const (
 	// LabelValueOutcomeFail is used as an unsuccessful outcome of an operation
 	LabelValueOutcomeFail = "fail"
 
+	// LabelValueOutcomeDryRun is used as outcome of a garbage collection
+	// which only reported the object instead of deleting it
+	LabelValueOutcomeDryRun = "dry-run"
+
 	// LabelValueOutcomeAlive is used as outcome of alive identity entries
 	LabelValueOutcomeAlive = "alive"
 
@@ -162,6 +174,20 @@ func registerMetrics() []prometheus.Collector {
 	}, []string{LabelOutcome})
 	collectors = append(collectors, EndpointGCObjects)
 
+	CiliumNodeGCObjects = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: Namespace,
+		Name:      "ciliumnode_gc_objects",
+		Help:      "The number of times CiliumNode objects have been garbage-collected",
+	}, []string{LabelOutcome})
+	collectors = append(collectors, CiliumNodeGCObjects)
+
+	SubsystemHealth = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: Namespace,
+		Name:      "subsystem_health",
+		Help:      "Whether an operator subsystem is healthy (1) or unhealthy (0)",
+	}, []string{"subsystem"})
+	collectors = append(collectors, SubsystemHealth)
+
 	CiliumEndpointSliceDensity = prometheus.NewHistogram(prometheus.HistogramOpts{
 		Namespace: Namespace,
 		Name:      "number_of_ceps_per_ces",
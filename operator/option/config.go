@@ -100,10 +100,29 @@ const (
 	// NodesGCInterval is the duration for which the cilium nodes are GC.
 	NodesGCInterval = "nodes-gc-interval"
 
+	// NodesGCGracePeriod is the duration an invalid CiliumNode must remain
+	// marked before it is garbage collected. If zero, the GC interval is
+	// used as grace period.
+	NodesGCGracePeriod = "nodes-gc-grace-period"
+
+	// EndpointGCGracePeriod is the minimum age an orphaned CiliumEndpoint
+	// must have before it is garbage collected.
+	EndpointGCGracePeriod = "cilium-endpoint-gc-grace-period"
+
+	// CRDGCDryRun makes the CiliumEndpoint, CiliumNode and CiliumIdentity
+	// garbage collectors report the resources they would delete without
+	// deleting them.
+	CRDGCDryRun = "crd-gc-dry-run"
+
 	// OperatorAPIServeAddr IP:Port on which to serve api requests in
 	// operator (pass ":Port" to bind on all interfaces, "" is off)
 	OperatorAPIServeAddr = "operator-api-serve-addr"
 
+	// OperatorAPIAdminToken is the bearer token required to call the admin
+	// endpoints of the operator API. The admin endpoints are disabled if
+	// the token is empty.
+	OperatorAPIAdminToken = "operator-api-admin-token"
+
 	// OperatorPrometheusServeAddr IP:Port on which to serve prometheus
 	// metrics (pass ":Port" to bind on all interfaces, "" is off).
 	OperatorPrometheusServeAddr = "operator-prometheus-serve-addr"
@@ -131,6 +150,14 @@ const (
 	// IPAMAPIQPSLimit is the queries per second limit when accessing external IPAM APIs
 	IPAMAPIQPSLimit = "limit-ipam-api-qps"
 
+	// IPAMAPIBudget caps the total number of external IPAM API calls per
+	// minute across all API clients of the provider
+	IPAMAPIBudget = "limit-ipam-api-calls-per-minute"
+
+	// IPAMSimulation instructs the operator to run the IPAM allocator
+	// against an in-memory fake cloud backend for capacity planning
+	IPAMSimulation = "ipam-simulation"
+
 	// IPAMSubnetsIDs are optional subnets IDs used to filter subnets and interfaces listing
 	IPAMSubnetsIDs = "subnet-ids-filter"
 
@@ -156,6 +183,11 @@ const (
 	// per node.
 	NodeCIDRMaskSizeIPv6 = "cluster-pool-ipv6-mask-size"
 
+	// ClusterPoolDefragInterval is the interval in which pod CIDRs which
+	// the agents have marked as released are returned back into the
+	// cluster pool.
+	ClusterPoolDefragInterval = "cluster-pool-defrag-interval"
+
 	// AWS options
 
 	// AWSInstanceLimitMapping allows overwirting AWS instance limits defined in
@@ -176,6 +208,11 @@ const (
 	// IP addresses. Allows for increased pod density on nodes.
 	AWSEnablePrefixDelegation = "aws-enable-prefix-delegation"
 
+	// AWSStaticENIMode configures the operator to only use ENIs and IPs
+	// which have been pre-attached to instances by external tooling and to
+	// never create, modify or delete AWS resources.
+	AWSStaticENIMode = "aws-static-eni-mode"
+
 	// ENITags are the tags that will be added to every ENI created by the
 	// AWS ENI IPAM.
 	ENITags = "eni-tags"
@@ -220,6 +257,10 @@ const (
 	// primary IPConfiguration
 	AzureUsePrimaryAddress = "azure-use-primary-address"
 
+	// AzureDryRun instructs the operator to log Azure mutations instead of
+	// executing them
+	AzureDryRun = "azure-dry-run"
+
 	// LeaderElectionLeaseDuration is the duration that non-leader candidates will wait to
 	// force acquire leadership
 	LeaderElectionLeaseDuration = "leader-election-lease-duration"
@@ -232,6 +273,21 @@ const (
 	// tries of the actions in operator HA deployment.
 	LeaderElectionRetryPeriod = "leader-election-retry-period"
 
+	// CiliumNodeWorkers is the number of workers processing CiliumNode
+	// events in parallel.
+	CiliumNodeWorkers = "cilium-node-workers"
+
+	// CiliumNodeShardCount is the total number of operator replicas
+	// sharding CiliumNode reconciliation by node-name hash. When set to a
+	// value larger than one, every replica runs the IPAM allocator for
+	// its own subset of nodes while leader election keeps gating the
+	// cluster-wide singleton subsystems.
+	CiliumNodeShardCount = "cilium-node-shard-count"
+
+	// CiliumNodeShardIndex is the zero-based shard index of this operator
+	// replica when CiliumNode sharding is enabled.
+	CiliumNodeShardIndex = "cilium-node-shard-index"
+
 	// AlibabaCloud options
 
 	// AlibabaCloudVPCID allows user to specific vpc
@@ -242,6 +298,24 @@ const (
 	// the number of API calls to AlibabaCloud ECS service.
 	AlibabaCloudReleaseExcessIPs = "alibaba-cloud-release-excess-ips"
 
+	// AlibabaCloudInstanceLimitMapping allows overwriting AlibabaCloud instance
+	// limits defined in pkg/alibabacloud/eni/limits
+	AlibabaCloudInstanceLimitMapping = "alibaba-cloud-instance-limit-mapping"
+
+	// AlibabaCloudENIGCInterval defines the interval of AlibabaCloud ENI GC
+	AlibabaCloudENIGCInterval = "alibaba-cloud-eni-gc-interval"
+
+	// OpenStack options
+
+	// OpenStackReleaseExcessIPs allows releasing excess free IP addresses from ENI.
+	// Enabling this option reduces waste of IP addresses but may increase
+	// the number of API calls to the Neutron service.
+	OpenStackReleaseExcessIPs = "openstack-release-excess-ips"
+
+	// OpenStackFlavorLimitMapping sets the OpenStack flavor limits used by
+	// pkg/openstack/eni/limits
+	OpenStackFlavorLimitMapping = "openstack-flavor-limit-mapping"
+
 	// CiliumEndpointSlice options
 
 	// CESMaxCEPsInCES is the maximum number of cilium endpoints allowed in single
@@ -273,6 +347,19 @@ const (
 	// GatewayAPISecretsNamespace is the namespace having tls secrets used by GatewayAPI and CEC.
 	GatewayAPISecretsNamespace = "gateway-api-secrets-namespace"
 
+	// PolicyValidationWebhookListenAddress is the address the policy
+	// validation webhook server listens on. An empty value disables the
+	// webhook.
+	PolicyValidationWebhookListenAddress = "policy-validation-webhook-listen-address"
+
+	// PolicyValidationWebhookCertFile is the path to the certificate file
+	// served by the policy validation webhook.
+	PolicyValidationWebhookCertFile = "policy-validation-webhook-cert-file"
+
+	// PolicyValidationWebhookKeyFile is the path to the private key file
+	// of the certificate served by the policy validation webhook.
+	PolicyValidationWebhookKeyFile = "policy-validation-webhook-key-file"
+
 	// EnableGatewayAPI enables support of Gateway API
 	// This must be enabled along with enable-envoy-config in cilium agent.
 	EnableGatewayAPI = "enable-gateway-api"
@@ -323,6 +410,19 @@ type OperatorConfig struct {
 	// NodesGCInterval is the GC interval for CiliumNodes
 	NodesGCInterval time.Duration
 
+	// NodesGCGracePeriod is the duration an invalid CiliumNode must remain
+	// marked before it is garbage collected. If zero, NodesGCInterval is
+	// used as grace period.
+	NodesGCGracePeriod time.Duration
+
+	// EndpointGCGracePeriod is the minimum age an orphaned CiliumEndpoint
+	// must have before it is garbage collected.
+	EndpointGCGracePeriod time.Duration
+
+	// CRDGCDryRun makes the CRD garbage collectors report the resources
+	// they would delete without deleting them.
+	CRDGCDryRun bool
+
 	// SkipCNPStatusStartupClean disables the cleanup of all the CNP
 	// NodeStatus updates at startup.
 	SkipCNPStatusStartupClean bool
@@ -361,6 +461,7 @@ type OperatorConfig struct {
 	IdentityHeartbeatTimeout time.Duration
 
 	OperatorAPIServeAddr        string
+	OperatorAPIAdminToken       string
 	OperatorPrometheusServeAddr string
 
 	// PProf enables pprof debugging endpoint
@@ -390,6 +491,18 @@ type OperatorConfig struct {
 	// retries of the actions in operator HA deployment.
 	LeaderElectionRetryPeriod time.Duration
 
+	// CiliumNodeWorkers is the number of workers processing CiliumNode
+	// events in parallel.
+	CiliumNodeWorkers int
+
+	// CiliumNodeShardCount is the total number of operator replicas
+	// sharding CiliumNode reconciliation by node-name hash.
+	CiliumNodeShardCount int
+
+	// CiliumNodeShardIndex is the zero-based shard index of this operator
+	// replica when CiliumNode sharding is enabled.
+	CiliumNodeShardIndex int
+
 	// BGPAnnounceLBIP announces service IPs of type LoadBalancer via BGP beta (deprecated)
 	BGPAnnounceLBIP bool
 
@@ -409,6 +522,14 @@ type OperatorConfig struct {
 	// IPAMAPIQPSLimit is the queries per second limit when accessing external IPAM APIs
 	IPAMAPIQPSLimit float64
 
+	// IPAMAPIBudget caps the total number of external IPAM API calls per
+	// minute across all API clients of the provider
+	IPAMAPIBudget int
+
+	// IPAMSimulation instructs the operator to run the IPAM allocator
+	// against an in-memory fake cloud backend for capacity planning
+	IPAMSimulation bool
+
 	// IPAMSubnetsIDs are optional subnets IDs used to filter subnets and interfaces listing
 	IPAMSubnetsIDs []string
 
@@ -436,6 +557,11 @@ type OperatorConfig struct {
 	// per node.
 	NodeCIDRMaskSizeIPv6 int
 
+	// ClusterPoolDefragInterval is the interval in which pod CIDRs which
+	// the agents have marked as released are returned back into the
+	// cluster pool. Set to 0 to disable defragmentation.
+	ClusterPoolDefragInterval time.Duration
+
 	// AWS options
 
 	// ENITags are the tags that will be added to every ENI created by the AWS ENI IPAM
@@ -467,6 +593,11 @@ type OperatorConfig struct {
 	// IP addresses. Allows for increased pod density on nodes.
 	AWSEnablePrefixDelegation bool
 
+	// AWSStaticENIMode configures the operator to only use ENIs and IPs
+	// which have been pre-attached to instances by external tooling and to
+	// never create, modify or delete AWS resources.
+	AWSStaticENIMode bool
+
 	// AWSUsePrimaryAddress specifies whether an interface's primary address should be available for allocations on
 	// node
 	AWSUsePrimaryAddress bool
@@ -499,6 +630,10 @@ type OperatorConfig struct {
 	// primary IPConfiguration
 	AzureUsePrimaryAddress bool
 
+	// AzureDryRun instructs the operator to log Azure mutations instead of
+	// executing them
+	AzureDryRun bool
+
 	// AlibabaCloud options
 
 	// AlibabaCloudVPCID allow user to specific vpc
@@ -509,6 +644,24 @@ type OperatorConfig struct {
 	// the number of API calls to AlibabaCloud ECS service.
 	AlibabaCloudReleaseExcessIPs bool
 
+	// AlibabaCloudInstanceLimitMapping allows overwriting AlibabaCloud instance
+	// limits defined in pkg/alibabacloud/eni/limits
+	AlibabaCloudInstanceLimitMapping map[string]string
+
+	// AlibabaCloudENIGCInterval defines the interval of AlibabaCloud ENI GC
+	AlibabaCloudENIGCInterval time.Duration
+
+	// OpenStack options
+
+	// OpenStackReleaseExcessIPs allows releasing excess free IP addresses from ENI.
+	// Enabling this option reduces waste of IP addresses but may increase
+	// the number of API calls to the Neutron service.
+	OpenStackReleaseExcessIPs bool
+
+	// OpenStackFlavorLimitMapping sets the OpenStack flavor limits used by
+	// pkg/openstack/eni/limits
+	OpenStackFlavorLimitMapping map[string]string
+
 	// CiliumEndpointSlice options
 
 	// CESMaxCEPsInCES is the maximum number of CiliumEndpoints allowed in single
@@ -540,6 +693,18 @@ type OperatorConfig struct {
 	// GatewayAPISecretsNamespace is the namespace having tls secrets used by CEC for Gateway API.
 	GatewayAPISecretsNamespace string
 
+	// PolicyValidationWebhookListenAddress is the address the policy
+	// validation webhook server listens on, empty when disabled.
+	PolicyValidationWebhookListenAddress string
+
+	// PolicyValidationWebhookCertFile is the path to the certificate file
+	// served by the policy validation webhook.
+	PolicyValidationWebhookCertFile string
+
+	// PolicyValidationWebhookKeyFile is the path to the private key file
+	// of the certificate served by the policy validation webhook.
+	PolicyValidationWebhookKeyFile string
+
 	// CiliumK8sNamespace is the namespace where Cilium pods are running.
 	CiliumK8sNamespace string
 
@@ -577,16 +742,22 @@ func (c *OperatorConfig) Populate(vp *viper.Viper) {
 	c.CNPNodeStatusGCInterval = vp.GetDuration(CNPNodeStatusGCInterval)
 	c.CNPStatusUpdateInterval = vp.GetDuration(CNPStatusUpdateInterval)
 	c.NodesGCInterval = vp.GetDuration(NodesGCInterval)
+	c.NodesGCGracePeriod = vp.GetDuration(NodesGCGracePeriod)
+	c.EndpointGCGracePeriod = vp.GetDuration(EndpointGCGracePeriod)
+	c.CRDGCDryRun = vp.GetBool(CRDGCDryRun)
 	c.SkipCNPStatusStartupClean = vp.GetBool(SkipCNPStatusStartupClean)
 	c.CNPStatusCleanupQPS = vp.GetFloat64(CNPStatusCleanupQPS)
 	c.CNPStatusCleanupBurst = vp.GetInt(CNPStatusCleanupBurst)
 	c.EnableMetrics = vp.GetBool(EnableMetrics)
+	c.IPAMAPIBudget = vp.GetInt(IPAMAPIBudget)
+	c.IPAMSimulation = vp.GetBool(IPAMSimulation)
 	c.EndpointGCInterval = vp.GetDuration(EndpointGCInterval)
 	c.IdentityGCInterval = vp.GetDuration(IdentityGCInterval)
 	c.IdentityGCRateInterval = vp.GetDuration(IdentityGCRateInterval)
 	c.IdentityGCRateLimit = vp.GetInt64(IdentityGCRateLimit)
 	c.IdentityHeartbeatTimeout = vp.GetDuration(IdentityHeartbeatTimeout)
 	c.OperatorAPIServeAddr = vp.GetString(OperatorAPIServeAddr)
+	c.OperatorAPIAdminToken = vp.GetString(OperatorAPIAdminToken)
 	c.OperatorPrometheusServeAddr = vp.GetString(OperatorPrometheusServeAddr)
 	c.PProf = vp.GetBool(PProf)
 	c.PProfPort = vp.GetInt(PProfPort)
@@ -597,9 +768,20 @@ func (c *OperatorConfig) Populate(vp *viper.Viper) {
 	c.NodeCIDRMaskSizeIPv6 = vp.GetInt(NodeCIDRMaskSizeIPv6)
 	c.ClusterPoolIPv4CIDR = vp.GetStringSlice(ClusterPoolIPv4CIDR)
 	c.ClusterPoolIPv6CIDR = vp.GetStringSlice(ClusterPoolIPv6CIDR)
+	c.ClusterPoolDefragInterval = vp.GetDuration(ClusterPoolDefragInterval)
 	c.LeaderElectionLeaseDuration = vp.GetDuration(LeaderElectionLeaseDuration)
 	c.LeaderElectionRenewDeadline = vp.GetDuration(LeaderElectionRenewDeadline)
 	c.LeaderElectionRetryPeriod = vp.GetDuration(LeaderElectionRetryPeriod)
+	c.CiliumNodeWorkers = vp.GetInt(CiliumNodeWorkers)
+	if c.CiliumNodeWorkers < 1 {
+		log.Fatalf("--%s must be at least 1", CiliumNodeWorkers)
+	}
+	c.CiliumNodeShardCount = vp.GetInt(CiliumNodeShardCount)
+	c.CiliumNodeShardIndex = vp.GetInt(CiliumNodeShardIndex)
+	if c.CiliumNodeShardCount > 1 &&
+		(c.CiliumNodeShardIndex < 0 || c.CiliumNodeShardIndex >= c.CiliumNodeShardCount) {
+		log.Fatalf("--%s must be in the range [0, %d)", CiliumNodeShardIndex, c.CiliumNodeShardCount)
+	}
 	c.BGPAnnounceLBIP = vp.GetBool(BGPAnnounceLBIP)
 	c.BGPConfigPath = vp.GetString(BGPConfigPath)
 	c.SkipCRDCreation = vp.GetBool(SkipCRDCreation)
@@ -608,6 +790,9 @@ func (c *OperatorConfig) Populate(vp *viper.Viper) {
 	c.EnforceIngressHTTPS = vp.GetBool(EnforceIngressHttps)
 	c.IngressSecretsNamespace = vp.GetString(IngressSecretsNamespace)
 	c.GatewayAPISecretsNamespace = vp.GetString(GatewayAPISecretsNamespace)
+	c.PolicyValidationWebhookListenAddress = vp.GetString(PolicyValidationWebhookListenAddress)
+	c.PolicyValidationWebhookCertFile = vp.GetString(PolicyValidationWebhookCertFile)
+	c.PolicyValidationWebhookKeyFile = vp.GetString(PolicyValidationWebhookKeyFile)
 	c.EnableIngressSecretsSync = vp.GetBool(EnableIngressSecretsSync)
 	c.EnableGatewayAPISecretsSync = vp.GetBool(EnableGatewayAPISecretsSync)
 	c.CiliumPodLabels = vp.GetString(CiliumPodLabels)
@@ -638,6 +823,7 @@ func (c *OperatorConfig) Populate(vp *viper.Viper) {
 
 	c.AWSReleaseExcessIPs = vp.GetBool(AWSReleaseExcessIPs)
 	c.AWSEnablePrefixDelegation = vp.GetBool(AWSEnablePrefixDelegation)
+	c.AWSStaticENIMode = vp.GetBool(AWSStaticENIMode)
 	c.AWSUsePrimaryAddress = vp.GetBool(AWSUsePrimaryAddress)
 	c.UpdateEC2AdapterLimitViaAPI = vp.GetBool(UpdateEC2AdapterLimitViaAPI)
 	c.EC2APIEndpoint = vp.GetString(EC2APIEndpoint)
@@ -650,11 +836,17 @@ func (c *OperatorConfig) Populate(vp *viper.Viper) {
 	c.AzureResourceGroup = vp.GetString(AzureResourceGroup)
 	c.AzureUsePrimaryAddress = vp.GetBool(AzureUsePrimaryAddress)
 	c.AzureUserAssignedIdentityID = vp.GetString(AzureUserAssignedIdentityID)
+	c.AzureDryRun = vp.GetBool(AzureDryRun)
 
 	// AlibabaCloud options
 
 	c.AlibabaCloudVPCID = vp.GetString(AlibabaCloudVPCID)
 	c.AlibabaCloudReleaseExcessIPs = vp.GetBool(AlibabaCloudReleaseExcessIPs)
+	c.AlibabaCloudENIGCInterval = vp.GetDuration(AlibabaCloudENIGCInterval)
+
+	// OpenStack options
+
+	c.OpenStackReleaseExcessIPs = vp.GetBool(OpenStackReleaseExcessIPs)
 
 	// CiliumEndpointSlice options
 	c.CESMaxCEPsInCES = vp.GetInt(CESMaxCEPsInCES)
@@ -684,6 +876,18 @@ func (c *OperatorConfig) Populate(vp *viper.Viper) {
 		c.AWSInstanceLimitMapping = m
 	}
 
+	if m, err := command.GetStringMapStringE(vp, AlibabaCloudInstanceLimitMapping); err != nil {
+		log.Fatalf("unable to parse %s: %s", AlibabaCloudInstanceLimitMapping, err)
+	} else {
+		c.AlibabaCloudInstanceLimitMapping = m
+	}
+
+	if m, err := command.GetStringMapStringE(vp, OpenStackFlavorLimitMapping); err != nil {
+		log.Fatalf("unable to parse %s: %s", OpenStackFlavorLimitMapping, err)
+	} else {
+		c.OpenStackFlavorLimitMapping = m
+	}
+
 	if m, err := command.GetStringMapStringE(vp, ENITags); err != nil {
 		log.Fatalf("unable to parse %s: %s", ENITags, err)
 	} else {
@@ -699,10 +903,12 @@ func (c *OperatorConfig) Populate(vp *viper.Viper) {
 
 // Config represents the operator configuration.
 var Config = &OperatorConfig{
-	IPAMSubnetsIDs:           make([]string, 0),
-	IPAMSubnetsTags:          make(map[string]string),
-	IPAMInstanceTags:         make(map[string]string),
-	AWSInstanceLimitMapping:  make(map[string]string),
-	ENITags:                  make(map[string]string),
-	ENIGarbageCollectionTags: make(map[string]string),
+	IPAMSubnetsIDs:                   make([]string, 0),
+	IPAMSubnetsTags:                  make(map[string]string),
+	IPAMInstanceTags:                 make(map[string]string),
+	AWSInstanceLimitMapping:          make(map[string]string),
+	AlibabaCloudInstanceLimitMapping: make(map[string]string),
+	OpenStackFlavorLimitMapping:      make(map[string]string),
+	ENITags:                          make(map[string]string),
+	ENIGarbageCollectionTags:         make(map[string]string),
 }
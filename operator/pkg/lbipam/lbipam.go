@@ -22,6 +22,7 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/util/workqueue"
 
+	operatorAPI "github.com/cilium/cilium/operator/api"
 	k8sClient "github.com/cilium/cilium/pkg/k8s/client"
 
 	"github.com/cilium/cilium/pkg/hive"
@@ -178,6 +179,10 @@ func (ipam *LBIPAM) restart() {
 	})
 }
 
+// lbIPAMSubsystem is the subsystem name under which LB-IPAM reports its
+// health to the operator API.
+const lbIPAMSubsystem = "lb-ipam"
+
 type ipPoolEvent = resource.Event[*cilium_api_v2alpha1.CiliumLoadBalancerIPPool]
 type svcEvent = resource.Event[*slim_core_v1.Service]
 
@@ -187,6 +192,7 @@ func (ipam *LBIPAM) Run(ctx context.Context) {
 	err := ipam.preInit(ctx)
 	if err != nil {
 		ipam.logger.WithError(err).Error("Error while pre-initializing LB-IPAM")
+		operatorAPI.ReportSubsystemError(lbIPAMSubsystem, err)
 		return
 	}
 	// If we exited preInit because we are shutting down, just return
@@ -205,11 +211,13 @@ func (ipam *LBIPAM) Run(ctx context.Context) {
 	err = ipam.init(ctx, poolChan, svcChan)
 	if err != nil {
 		ipam.logger.WithError(err).Error("Error while initializing LB-IPAM")
+		operatorAPI.ReportSubsystemError(lbIPAMSubsystem, err)
 		// Stop, don't go into main control loop
 		return
 	}
 
 	ipam.logger.Info("LB-IPAM done initializing")
+	operatorAPI.ReportSubsystemOK(lbIPAMSubsystem)
 	for _, cb := range ipam.initDoneCallbacks {
 		if cb != nil {
 			cb()
@@ -285,6 +293,7 @@ func (ipam *LBIPAM) Run(ctx context.Context) {
 			}
 
 			ipam.logger.WithError(err).Error("Unrecoverable error, triggering shutdown")
+			operatorAPI.ReportSubsystemError(lbIPAMSubsystem, err)
 			ipam.shutdowner.Shutdown(hive.ShutdownWithError(err))
 		}
 	}
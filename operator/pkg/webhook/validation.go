@@ -0,0 +1,176 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package webhook
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	cilium_v2 "github.com/cilium/cilium/pkg/k8s/apis/cilium.io/v2"
+	slim_metav1 "github.com/cilium/cilium/pkg/k8s/slim/k8s/apis/meta/v1"
+	"github.com/cilium/cilium/pkg/policy/api"
+)
+
+// validateSemantics runs the semantic checks on the given policy that are
+// not covered by the CRD schema: rules are sanitized, which catches invalid
+// L7 and FQDN patterns, port/protocol combinations may not appear in more
+// than one toPorts entry of a rule, and selectors must be able to match
+// something.
+func validateSemantics(obj *unstructured.Unstructured) error {
+	objBytes, err := obj.MarshalJSON()
+	if err != nil {
+		return err
+	}
+	// The CCNP spec has the same shape, so both kinds can be decoded as
+	// CNP here.
+	cnp := cilium_v2.CiliumNetworkPolicy{}
+	if err := json.Unmarshal(objBytes, &cnp); err != nil {
+		return err
+	}
+
+	if cnp.Spec != nil {
+		if err := validateRule(cnp.Spec); err != nil {
+			return fmt.Errorf("spec: %w", err)
+		}
+	}
+	for i, rule := range cnp.Specs {
+		if err := validateRule(rule); err != nil {
+			return fmt.Errorf("specs[%d]: %w", i, err)
+		}
+	}
+	return nil
+}
+
+func validateRule(rule *api.Rule) error {
+	// Sanitize modifies the rule, so run it on a copy.
+	if err := rule.DeepCopy().Sanitize(); err != nil {
+		return err
+	}
+
+	if reason := selectorMatchesNothing(rule.EndpointSelector); reason != "" {
+		return fmt.Errorf("endpointSelector cannot match anything: %s", reason)
+	}
+
+	for i, r := range rule.Ingress {
+		if err := checkDuplicatePorts(r.ToPorts); err != nil {
+			return fmt.Errorf("ingress[%d]: %w", i, err)
+		}
+		for _, sel := range r.FromEndpoints {
+			if reason := selectorMatchesNothing(sel); reason != "" {
+				return fmt.Errorf("ingress[%d]: fromEndpoints selector cannot match anything: %s", i, reason)
+			}
+		}
+	}
+	for i, r := range rule.Egress {
+		if err := checkDuplicatePorts(r.ToPorts); err != nil {
+			return fmt.Errorf("egress[%d]: %w", i, err)
+		}
+		for _, sel := range r.ToEndpoints {
+			if reason := selectorMatchesNothing(sel); reason != "" {
+				return fmt.Errorf("egress[%d]: toEndpoints selector cannot match anything: %s", i, reason)
+			}
+		}
+	}
+	return nil
+}
+
+// checkDuplicatePorts returns an error if the same port/protocol
+// combination appears in more than one of the given toPorts entries, as
+// only one of the entries would be enforced.
+func checkDuplicatePorts(portRules api.PortRules) error {
+	seen := map[api.PortProtocol]struct{}{}
+	for _, portRule := range portRules {
+		for _, pp := range portRule.Ports {
+			key := pp
+			if key.Protocol == "" {
+				key.Protocol = api.ProtoAny
+			}
+			if _, duplicate := seen[key]; duplicate {
+				return fmt.Errorf("port/protocol %s/%s is selected by multiple toPorts entries, combine them into one entry",
+					key.Port, key.Protocol)
+			}
+			seen[key] = struct{}{}
+		}
+	}
+	return nil
+}
+
+// selectorMatchesNothing returns a non-empty reason if no set of labels
+// can satisfy the given selector, i.e. the requirements on one of its keys
+// contradict each other.
+func selectorMatchesNothing(sel api.EndpointSelector) string {
+	if sel.LabelSelector == nil {
+		return ""
+	}
+
+	// allowed collects, per key, the values that all exact requirements
+	// on the key permit. A key that ends up with no permitted values
+	// cannot be satisfied.
+	allowed := map[string]map[string]struct{}{}
+	restrict := func(key string, values []string) {
+		set, ok := allowed[key]
+		if !ok {
+			set = make(map[string]struct{}, len(values))
+			for _, v := range values {
+				set[v] = struct{}{}
+			}
+			allowed[key] = set
+			return
+		}
+		permitted := make(map[string]struct{}, len(values))
+		for _, v := range values {
+			if _, ok := set[v]; ok {
+				permitted[v] = struct{}{}
+			}
+		}
+		allowed[key] = permitted
+	}
+
+	for key, value := range sel.MatchLabels {
+		restrict(key, []string{value})
+	}
+
+	forbidden := map[string]bool{}
+	for _, req := range sel.MatchExpressions {
+		switch req.Operator {
+		case slim_metav1.LabelSelectorOpIn:
+			if len(req.Values) == 0 {
+				return fmt.Sprintf("no values given for key %q with operator In", req.Key)
+			}
+			restrict(req.Key, req.Values)
+		case slim_metav1.LabelSelectorOpDoesNotExist:
+			forbidden[req.Key] = true
+		}
+	}
+	// NotIn requirements are applied once all exact requirements have been
+	// collected, their order relative to the other requirements does not
+	// matter.
+	for _, req := range sel.MatchExpressions {
+		if req.Operator != slim_metav1.LabelSelectorOpNotIn {
+			continue
+		}
+		if set, ok := allowed[req.Key]; ok {
+			for _, v := range req.Values {
+				delete(set, v)
+			}
+		}
+	}
+
+	for key, set := range allowed {
+		if len(set) == 0 {
+			return fmt.Sprintf("requirements on key %q exclude all values", key)
+		}
+		if forbidden[key] {
+			return fmt.Sprintf("key %q is both required and required to not exist", key)
+		}
+	}
+	for _, req := range sel.MatchExpressions {
+		if req.Operator == slim_metav1.LabelSelectorOpExists && forbidden[req.Key] {
+			return fmt.Sprintf("key %q is both required and required to not exist", req.Key)
+		}
+	}
+	return ""
+}
@@ -0,0 +1,134 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package webhook
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	cilium_v2 "github.com/cilium/cilium/pkg/k8s/apis/cilium.io/v2"
+	"github.com/cilium/cilium/pkg/k8s/apis/cilium.io/v2/validator"
+	"github.com/cilium/cilium/pkg/logging"
+	"github.com/cilium/cilium/pkg/logging/logfields"
+)
+
+const (
+	// Subsys is the value for logfields.LogSubsys of the webhook.
+	Subsys = "policy-webhook"
+
+	// validatePath is the HTTP path the validation handler is served on.
+	validatePath = "/validate-policy"
+)
+
+var log = logging.DefaultLogger.WithField(logfields.LogSubsys, Subsys)
+
+// Server is an admission webhook server that validates CiliumNetworkPolicy
+// and CiliumClusterwideNetworkPolicy objects against the CRD schema and a
+// set of semantic checks before they are persisted.
+type Server struct {
+	listenAddress string
+	certFile      string
+	keyFile       string
+	validator     *validator.NPValidator
+}
+
+// NewServer returns a policy validation webhook server listening on the
+// given address, serving TLS with the given certificate and key files.
+func NewServer(listenAddress, certFile, keyFile string) (*Server, error) {
+	npValidator, err := validator.NewNPValidator()
+	if err != nil {
+		return nil, err
+	}
+	return &Server{
+		listenAddress: listenAddress,
+		certFile:      certFile,
+		keyFile:       keyFile,
+		validator:     npValidator,
+	}, nil
+}
+
+// Run serves the webhook until the server fails. It is expected to be
+// called in a goroutine.
+func (s *Server) Run() {
+	mux := http.NewServeMux()
+	mux.HandleFunc(validatePath, s.handleValidate)
+	server := &http.Server{
+		Addr:    s.listenAddress,
+		Handler: mux,
+	}
+	log.WithField("address", s.listenAddress).Info("Starting policy validation webhook server")
+	if err := server.ListenAndServeTLS(s.certFile, s.keyFile); err != nil {
+		log.WithError(err).Fatal("Policy validation webhook server failed")
+	}
+}
+
+func (s *Server) handleValidate(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	review := admissionv1.AdmissionReview{}
+	if err := json.Unmarshal(body, &review); err != nil {
+		http.Error(w, fmt.Sprintf("cannot decode admission review: %s", err), http.StatusBadRequest)
+		return
+	}
+	if review.Request == nil {
+		http.Error(w, "admission review has no request", http.StatusBadRequest)
+		return
+	}
+
+	review.Response = s.review(review.Request)
+	review.Request = nil
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(&review); err != nil {
+		log.WithError(err).Error("Cannot encode admission review response")
+	}
+}
+
+// review validates the policy in the given admission request and returns
+// the admission response.
+func (s *Server) review(req *admissionv1.AdmissionRequest) *admissionv1.AdmissionResponse {
+	obj := &unstructured.Unstructured{}
+	err := obj.UnmarshalJSON(req.Object.Raw)
+	if err == nil {
+		switch req.Kind.Kind {
+		case cilium_v2.CNPKindDefinition:
+			err = s.validator.ValidateCNP(obj)
+		case cilium_v2.CCNPKindDefinition:
+			err = s.validator.ValidateCCNP(obj)
+		default:
+			err = fmt.Errorf("unexpected kind %q", req.Kind.Kind)
+		}
+	}
+	if err == nil {
+		err = validateSemantics(obj)
+	}
+	if err != nil {
+		log.WithError(err).WithFields(map[string]interface{}{
+			logfields.K8sNamespace:            req.Namespace,
+			logfields.CiliumNetworkPolicyName: req.Name,
+		}).Info("Rejecting policy")
+		return &admissionv1.AdmissionResponse{
+			UID:     req.UID,
+			Allowed: false,
+			Result: &metav1.Status{
+				Reason:  metav1.StatusReasonInvalid,
+				Message: err.Error(),
+			},
+		}
+	}
+	return &admissionv1.AdmissionResponse{
+		UID:     req.UID,
+		Allowed: true,
+	}
+}
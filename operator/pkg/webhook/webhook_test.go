@@ -0,0 +1,137 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package webhook
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	admissionv1 "k8s.io/api/admission/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	cilium_v2 "github.com/cilium/cilium/pkg/k8s/apis/cilium.io/v2"
+)
+
+func reviewCNP(t *testing.T, spec string) *admissionv1.AdmissionResponse {
+	t.Helper()
+	server, err := NewServer(":0", "", "")
+	require.NoError(t, err)
+
+	cnp := fmt.Sprintf(`{
+		"apiVersion": "cilium.io/v2",
+		"kind": "CiliumNetworkPolicy",
+		"metadata": {"name": "test", "namespace": "default"},
+		"spec": %s
+	}`, spec)
+	return server.review(&admissionv1.AdmissionRequest{
+		UID:       "test-uid",
+		Kind:      metav1.GroupVersionKind{Group: "cilium.io", Version: "v2", Kind: cilium_v2.CNPKindDefinition},
+		Namespace: "default",
+		Name:      "test",
+		Object:    runtime.RawExtension{Raw: []byte(cnp)},
+	})
+}
+
+func TestReviewValidCNP(t *testing.T) {
+	resp := reviewCNP(t, `{
+		"endpointSelector": {"matchLabels": {"app": "web"}},
+		"ingress": [{
+			"fromEndpoints": [{"matchLabels": {"app": "client"}}],
+			"toPorts": [{"ports": [{"port": "80", "protocol": "TCP"}]}]
+		}]
+	}`)
+	require.True(t, resp.Allowed)
+	require.Equal(t, "test-uid", string(resp.UID))
+}
+
+func TestReviewUnknownField(t *testing.T) {
+	resp := reviewCNP(t, `{
+		"endpointSelector": {"matchLabels": {"app": "web"}},
+		"ingres": [{"fromEntities": ["host"]}]
+	}`)
+	require.False(t, resp.Allowed)
+	require.Contains(t, resp.Result.Message, "ingres")
+}
+
+func TestReviewDuplicatePortProtocol(t *testing.T) {
+	resp := reviewCNP(t, `{
+		"endpointSelector": {"matchLabels": {"app": "web"}},
+		"ingress": [{
+			"toPorts": [
+				{"ports": [{"port": "80", "protocol": "TCP"}]},
+				{"ports": [{"port": "80", "protocol": "TCP"}], "rules": {"http": [{"method": "GET"}]}}
+			]
+		}]
+	}`)
+	require.False(t, resp.Allowed)
+	require.Contains(t, resp.Result.Message, "80/TCP")
+}
+
+func TestReviewInvalidFQDNPattern(t *testing.T) {
+	resp := reviewCNP(t, `{
+		"endpointSelector": {"matchLabels": {"app": "web"}},
+		"egress": [{
+			"toFQDNs": [{"matchPattern": "%%invalid%%"}]
+		}]
+	}`)
+	require.False(t, resp.Allowed)
+	require.Contains(t, resp.Result.Message, "Invalid")
+}
+
+func TestReviewImpossibleSelector(t *testing.T) {
+	resp := reviewCNP(t, `{
+		"endpointSelector": {
+			"matchLabels": {"app": "web"},
+			"matchExpressions": [{"key": "app", "operator": "NotIn", "values": ["web"]}]
+		}
+	}`)
+	require.False(t, resp.Allowed)
+	require.Contains(t, resp.Result.Message, "cannot match anything")
+}
+
+func TestReviewUnexpectedKind(t *testing.T) {
+	server, err := NewServer(":0", "", "")
+	require.NoError(t, err)
+	resp := server.review(&admissionv1.AdmissionRequest{
+		UID:    "test-uid",
+		Kind:   metav1.GroupVersionKind{Group: "cilium.io", Version: "v2", Kind: "CiliumEndpoint"},
+		Object: runtime.RawExtension{Raw: []byte(`{"kind": "CiliumEndpoint"}`)},
+	})
+	require.False(t, resp.Allowed)
+}
+
+func TestSelectorMatchesNothing(t *testing.T) {
+	resp := reviewCNP(t, `{
+		"endpointSelector": {
+			"matchExpressions": [
+				{"key": "app", "operator": "In", "values": ["a", "b"]},
+				{"key": "app", "operator": "In", "values": ["c"]}
+			]
+		}
+	}`)
+	require.False(t, resp.Allowed)
+	require.Contains(t, resp.Result.Message, "exclude all values")
+
+	resp = reviewCNP(t, `{
+		"endpointSelector": {
+			"matchExpressions": [
+				{"key": "app", "operator": "Exists"},
+				{"key": "app", "operator": "DoesNotExist"}
+			]
+		}
+	}`)
+	require.False(t, resp.Allowed)
+	require.Contains(t, resp.Result.Message, "required to not exist")
+
+	// Disjoint requirements on different keys are fine.
+	resp = reviewCNP(t, `{
+		"endpointSelector": {
+			"matchLabels": {"app": "web"},
+			"matchExpressions": [{"key": "tier", "operator": "NotIn", "values": ["db"]}]
+		}
+	}`)
+	require.True(t, resp.Allowed)
+}
@@ -11,6 +11,8 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/tools/cache"
 
+	"github.com/cilium/cilium/operator/metrics"
+	operatorOption "github.com/cilium/cilium/operator/option"
 	"github.com/cilium/cilium/pkg/controller"
 	cilium_v2 "github.com/cilium/cilium/pkg/k8s/apis/cilium.io/v2"
 	k8sClient "github.com/cilium/cilium/pkg/k8s/client"
@@ -80,6 +82,11 @@ func RunCiliumNodeGC(ctx context.Context, clientset k8sClient.Clientset, ciliumN
 
 func performCiliumNodeGC(ctx context.Context, client ciliumv2.CiliumNodeInterface, ciliumNodeStore cache.Store,
 	nodeGetter slimNodeGetter, interval time.Duration, candidateStore *ciliumNodeGCCandidate) error {
+	gracePeriod := interval
+	if operatorOption.Config.NodesGCGracePeriod > 0 {
+		gracePeriod = operatorOption.Config.NodesGCGracePeriod
+	}
+
 	for _, nodeName := range ciliumNodeStore.ListKeys() {
 		scopedLog := log.WithField(logfields.NodeName, nodeName)
 		_, err := nodeGetter.GetK8sSlimNode(nodeName)
@@ -117,15 +124,29 @@ func performCiliumNodeGC(ctx context.Context, client ciliumv2.CiliumNodeInterfac
 			continue
 		}
 
-		// only remove the node if last marked time is more than running interval
-		if lastMarkedTime.Before(time.Now().Add(-interval)) {
+		// only remove the node if last marked time is more than the grace period
+		if lastMarkedTime.Before(time.Now().Add(-gracePeriod)) {
+			if operatorOption.Config.CRDGCDryRun {
+				scopedLog.Info("Dry-run: invalid CiliumNode would be garbage collected")
+				if operatorOption.Config.EnableMetrics {
+					metrics.CiliumNodeGCObjects.WithLabelValues(metrics.LabelValueOutcomeDryRun).Inc()
+				}
+				continue
+			}
+
 			scopedLog.Info("Perform GC for invalid CiliumNode")
 			err = client.Delete(ctx, nodeName, metav1.DeleteOptions{})
 			if err != nil && !k8serrors.IsNotFound(err) {
 				scopedLog.WithError(err).Error("Failed to delete invalid CiliumNode")
+				if operatorOption.Config.EnableMetrics {
+					metrics.CiliumNodeGCObjects.WithLabelValues(metrics.LabelValueOutcomeFail).Inc()
+				}
 				return err
 			}
 			scopedLog.Info("CiliumNode is garbage collected successfully")
+			if operatorOption.Config.EnableMetrics {
+				metrics.CiliumNodeGCObjects.WithLabelValues(metrics.LabelValueOutcomeSuccess).Inc()
+			}
 			candidateStore.Delete(nodeName)
 		}
 	}
@@ -33,6 +33,10 @@ const (
 	// MaxResults is the number of entities on each page,
 	// it ranges from 1 to 50, the default value is 30.
 	MaxResults = 30
+
+	// eniStatusAvailable is the status of an ENI which is created but not
+	// attached to any instance.
+	eniStatusAvailable = "Available"
 )
 
 var maxAttachRetries = wait.Backoff{
@@ -59,11 +63,11 @@ type MetricsAPI interface {
 }
 
 // NewClient create the client
-func NewClient(vpcClient *vpc.Client, client *ecs.Client, metrics MetricsAPI, rateLimit float64, burst int, filters map[string]string) *Client {
+func NewClient(vpcClient *vpc.Client, client *ecs.Client, metrics MetricsAPI, rateLimit float64, burst int, budget *helpers.APIBudget, filters map[string]string) *Client {
 	return &Client{
 		vpcClient:  vpcClient,
 		ecsClient:  client,
-		limiter:    helpers.NewAPILimiter(metrics, rateLimit, burst),
+		limiter:    helpers.NewAPILimiterWithBudget(metrics, rateLimit, burst, budget, "alibabacloud"),
 		metricsAPI: metrics,
 		filters:    filters,
 	}
@@ -373,6 +377,49 @@ func (c *Client) DeleteNetworkInterface(ctx context.Context, eniID string) error
 	return nil
 }
 
+// GetDetachedNetworkInterfaces lists all ENIs in the VPC which are not
+// attached to any instance and carry the given set of tags. A tag with an
+// empty value matches any value of that tag key. The number of returned ENI
+// IDs is capped at maxResults.
+func (c *Client) GetDetachedNetworkInterfaces(ctx context.Context, tags ipamTypes.Tags, maxResults int) ([]string, error) {
+	result := make([]string, 0, maxResults)
+
+	reqTag := make([]ecs.DescribeNetworkInterfacesTag, 0, len(tags))
+	for k, v := range tags {
+		reqTag = append(reqTag, ecs.DescribeNetworkInterfacesTag{
+			Key:   k,
+			Value: v,
+		})
+	}
+
+	for i := 1; ; {
+		req := ecs.CreateDescribeNetworkInterfacesRequest()
+		req.PageNumber = requests.NewInteger(i)
+		req.PageSize = requests.NewInteger(50)
+		req.VpcId = c.filters[VPCID]
+		req.Status = eniStatusAvailable
+		req.Tag = &reqTag
+		c.limiter.Limit(ctx, "DescribeNetworkInterfaces")
+		resp, err := c.ecsClient.DescribeNetworkInterfaces(req)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range resp.NetworkInterfaceSets.NetworkInterfaceSet {
+			result = append(result, v.NetworkInterfaceId)
+			if len(result) >= maxResults {
+				return result, nil
+			}
+		}
+		if resp.TotalCount < resp.PageNumber*resp.PageSize {
+			break
+		}
+		i++
+	}
+
+	return result, nil
+}
+
 // AssignPrivateIPAddresses assigns the specified number of secondary IP
 // return allocated IPs
 func (c *Client) AssignPrivateIPAddresses(ctx context.Context, eniID string, toAllocate int) ([]string, error) {
@@ -386,6 +433,19 @@ func (c *Client) AssignPrivateIPAddresses(ctx context.Context, eniID string, toA
 	return resp.AssignedPrivateIpAddressesSet.PrivateIpSet.PrivateIpAddress, nil
 }
 
+// AssignIpv6Addresses assigns the specified number of IPv6 addresses to the
+// ENI, returns the assigned addresses
+func (c *Client) AssignIpv6Addresses(ctx context.Context, eniID string, toAllocate int) ([]string, error) {
+	req := ecs.CreateAssignIpv6AddressesRequest()
+	req.NetworkInterfaceId = eniID
+	req.Ipv6AddressCount = requests.NewInteger(toAllocate)
+	resp, err := c.ecsClient.AssignIpv6Addresses(req)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Ipv6Sets.Ipv6Address, nil
+}
+
 // PrUnassignivateIPAddresses unassign specified IP addresses from ENI
 // should not provide Primary IP
 func (c *Client) UnassignPrivateIPAddresses(ctx context.Context, eniID string, addresses []string) error {
@@ -454,6 +514,13 @@ func parseENI(iface *ecs.NetworkInterfaceSet, vpcs ipamTypes.VirtualNetworkMap,
 		})
 	}
 
+	var ipv6Sets []eniTypes.IPv6Set
+	for _, p := range iface.Ipv6Sets.Ipv6Set {
+		ipv6Sets = append(ipv6Sets, eniTypes.IPv6Set{
+			Ipv6Address: p.Ipv6Address,
+		})
+	}
+
 	eni = &eniTypes.ENI{
 		NetworkInterfaceID: iface.NetworkInterfaceId,
 		MACAddress:         iface.MacAddress,
@@ -469,6 +536,7 @@ func parseENI(iface *ecs.NetworkInterfaceSet, vpcs ipamTypes.VirtualNetworkMap,
 		},
 		PrimaryIPAddress: iface.PrivateIpAddress,
 		PrivateIPSets:    privateIPSets,
+		IPv6Sets:         ipv6Sets,
 		Tags:             parseECSTags(iface.Tags.Tag),
 	}
 	vpc, ok := vpcs[iface.VpcId]
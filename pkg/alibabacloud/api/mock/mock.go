@@ -28,6 +28,9 @@ type API struct {
 	vpcs           map[string]*ipamTypes.VirtualNetwork
 	securityGroups map[string]*types.SecurityGroup
 	allocator      *ipallocator.Range
+
+	// ipv6Next is a counter used to generate unique IPv6 addresses
+	ipv6Next int
 }
 
 // NewAPI returns a new mocked ECS API
@@ -165,6 +168,35 @@ func (a *API) GetSecurityGroups(ctx context.Context) (types.SecurityGroupMap, er
 	return securityGroups, nil
 }
 
+func (a *API) GetDetachedNetworkInterfaces(ctx context.Context, tags ipamTypes.Tags, maxResults int) ([]string, error) {
+	a.mutex.RLock()
+	defer a.mutex.RUnlock()
+
+	result := make([]string, 0, maxResults)
+	for eniID, eni := range a.unattached {
+		if !matchENITags(eni.Tags, tags) {
+			continue
+		}
+		result = append(result, eniID)
+		if len(result) >= maxResults {
+			break
+		}
+	}
+	return result, nil
+}
+
+// matchENITags returns true if the ENI tags contain all required tags. A
+// required tag with an empty value matches any value of that tag key.
+func matchENITags(eniTags map[string]string, required ipamTypes.Tags) bool {
+	for k, requiredValue := range required {
+		haveValue, ok := eniTags[k]
+		if !ok || (requiredValue != "" && requiredValue != haveValue) {
+			return false
+		}
+	}
+	return true
+}
+
 func (a *API) CreateNetworkInterface(ctx context.Context, secondaryPrivateIPCount int, vSwitchID string, groups []string, tags map[string]string) (string, *eniTypes.ENI, error) {
 	a.mutex.Lock()
 	defer a.mutex.Unlock()
@@ -237,11 +269,13 @@ func (a *API) DeleteNetworkInterface(ctx context.Context, eniID string) error {
 	a.mutex.Lock()
 	defer a.mutex.Unlock()
 
-	delete(a.unattached, eniID)
+	if _, ok := a.unattached[eniID]; ok {
+		delete(a.unattached, eniID)
+		return nil
+	}
 	for _, enis := range a.enis {
 		if _, ok := enis[eniID]; ok {
-			delete(enis, eniID)
-			return nil
+			return fmt.Errorf("ENI ID %s is attached and cannot be deleted", eniID)
 		}
 	}
 	return fmt.Errorf("ENI ID %s not found", eniID)
@@ -284,6 +318,25 @@ func (a *API) AssignPrivateIPAddresses(ctx context.Context, eniID string, toAllo
 	return nil, fmt.Errorf("unable to find ENI with ID %s", eniID)
 }
 
+func (a *API) AssignIpv6Addresses(ctx context.Context, eniID string, toAllocate int) ([]string, error) {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	for _, enis := range a.enis {
+		if eni, ok := enis[eniID]; ok {
+			addresses := make([]string, 0, toAllocate)
+			for i := 0; i < toAllocate; i++ {
+				a.ipv6Next++
+				address := fmt.Sprintf("fd00::%x", a.ipv6Next)
+				eni.IPv6Sets = append(eni.IPv6Sets, eniTypes.IPv6Set{Ipv6Address: address})
+				addresses = append(addresses, address)
+			}
+			return addresses, nil
+		}
+	}
+	return nil, fmt.Errorf("unable to find ENI with ID %s", eniID)
+}
+
 func (a *API) UnassignPrivateIPAddresses(ctx context.Context, eniID string, addresses []string) error {
 	a.mutex.Lock()
 	defer a.mutex.Unlock()
@@ -0,0 +1,122 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package eni
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/cilium/cilium/pkg/controller"
+	"github.com/cilium/cilium/pkg/ipam/types"
+)
+
+const gcENIControllerName = "ipam-alibabacloud-eni-gc"
+
+var controllerManager = controller.NewManager()
+
+// GarbageCollectionMetrics exposes the outcome of ENI garbage collection runs
+// via prometheus.
+type GarbageCollectionMetrics struct {
+	runs        *prometheus.CounterVec
+	deletedENIs prometheus.Counter
+}
+
+// NewGarbageCollectionMetrics returns garbage collection metrics registered
+// with the given registry.
+func NewGarbageCollectionMetrics(namespace string, registry *prometheus.Registry) *GarbageCollectionMetrics {
+	m := &GarbageCollectionMetrics{
+		runs: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "alibabacloud",
+			Name:      "eni_gc_runs",
+			Help:      "The number of times the ENI garbage collector has run",
+		}, []string{"outcome"}),
+		deletedENIs: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "alibabacloud",
+			Name:      "eni_gc_deleted",
+			Help:      "The number of unattached ENIs deleted by the garbage collector",
+		}),
+	}
+	registry.MustRegister(m.runs, m.deletedENIs)
+	return m
+}
+
+func (m *GarbageCollectionMetrics) observeRun(success bool) {
+	if m == nil {
+		return
+	}
+	outcome := "success"
+	if !success {
+		outcome = "failure"
+	}
+	m.runs.WithLabelValues(outcome).Inc()
+}
+
+func (m *GarbageCollectionMetrics) observeDeleted() {
+	if m == nil {
+		return
+	}
+	m.deletedENIs.Inc()
+}
+
+type GarbageCollectionParams struct {
+	// RunInterval is both the GC interval and also the minimum amount of time
+	// an ENI has to be available before it is garbage collected
+	RunInterval time.Duration
+	// MaxPerInterval is the maximum number of ENIs which are deleted in a
+	// single interval
+	MaxPerInterval int
+	// ENITags is used to only garbage collect ENIs with this set of tags. A
+	// tag with an empty value matches any value of that tag key.
+	ENITags types.Tags
+	// Metrics, if not nil, is used to record the outcome of garbage
+	// collection runs
+	Metrics *GarbageCollectionMetrics
+}
+
+func StartENIGarbageCollector(ctx context.Context, api AlibabaCloudAPI, params GarbageCollectionParams) {
+	log.Info("Starting to garbage collect detached ENIs")
+
+	var enisMarkedForDeletion []string
+	controllerManager.UpdateController(gcENIControllerName, controller.ControllerParams{
+		DoFunc: func(ctx context.Context) error {
+			// The ECS API does not allow us to determine the age of a network
+			// interface. To mitigate a race where Cilium just created a new
+			// ENI to be attached to a node, we wait for one run interval
+			// before we delete any ENIs. If the interface has been attached
+			// by the next run interval, the deletion will fail and the
+			// interface will not be garbage collected.
+			for _, eniID := range enisMarkedForDeletion {
+				log.WithField(fieldENIID, eniID).Debug("Garbage collecting ENI")
+				err := api.DeleteNetworkInterface(ctx, eniID)
+				if err != nil {
+					log.WithError(err).Debug("Failed to garbage collect ENI")
+					continue
+				}
+				params.Metrics.observeDeleted()
+			}
+
+			var err error
+			enisMarkedForDeletion, err = api.GetDetachedNetworkInterfaces(ctx, params.ENITags, params.MaxPerInterval)
+			if err != nil {
+				params.Metrics.observeRun(false)
+				return fmt.Errorf("failed to fetch available interfaces: %w", err)
+			}
+
+			if numENIs := len(enisMarkedForDeletion); numENIs > 0 {
+				log.WithField("numInterfaces", numENIs).
+					Debug("Marked unattached interfaces for garbage collection")
+			}
+
+			params.Metrics.observeRun(true)
+			return nil
+		},
+		RunInterval: params.RunInterval,
+		Context:     ctx,
+	})
+}
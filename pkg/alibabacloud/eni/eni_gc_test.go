@@ -0,0 +1,124 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package eni
+
+import (
+	"context"
+	"time"
+
+	"gopkg.in/check.v1"
+
+	"github.com/cilium/cilium/pkg/alibabacloud/api/mock"
+	"github.com/cilium/cilium/pkg/alibabacloud/utils"
+	"github.com/cilium/cilium/pkg/controller"
+	"github.com/cilium/cilium/pkg/inctimer"
+	ipamTypes "github.com/cilium/cilium/pkg/ipam/types"
+	"github.com/cilium/cilium/pkg/logging"
+)
+
+func waitForControllerRun(c *check.C, controller *controller.Manager, name string, expectedCount int64) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	for {
+		status := controller.GetStatusModel()
+		for _, st := range status {
+			if st.Name == name && st.Status.SuccessCount == expectedCount {
+				return
+			}
+		}
+
+		select {
+		case <-inctimer.After(10 * time.Millisecond):
+			continue
+		case <-ctx.Done():
+			c.Errorf("timed out waiting for controller %q to reach %d successful runs", name, expectedCount)
+			break
+		}
+	}
+}
+
+func (e *ENISuite) TestStartENIGarbageCollector(c *check.C) {
+	level := logging.GetLevel(logging.DefaultLogger)
+	logging.SetLogLevelToDebug()
+	defer logging.SetLogLevel(level)
+
+	gcTags := ipamTypes.Tags{utils.ENIIndexTagKey: ""}
+
+	alibabaAPI := mock.NewAPI(subnets, vpcs, securityGroups)
+	c.Assert(alibabaAPI, check.Not(check.IsNil))
+
+	untaggedENIs := map[string]bool{}
+	for i := 0; i < 8; i++ {
+		eniID, _, err := alibabaAPI.CreateNetworkInterface(context.TODO(), 0, "vsw-1", []string{"sg-1"}, nil)
+		c.Assert(err, check.IsNil)
+		untaggedENIs[eniID] = true
+	}
+
+	createTaggedENI := func() string {
+		eniID, _, err := alibabaAPI.CreateNetworkInterface(context.TODO(), 0, "vsw-2", []string{"sg-1"},
+			utils.FillTagWithENIIndex(map[string]string{}, 1))
+		c.Assert(err, check.IsNil)
+		return eniID
+	}
+	for i := 0; i < 8; i++ {
+		createTaggedENI()
+	}
+
+	ctx, cancel := context.WithCancel(context.TODO())
+	defer cancel()
+	StartENIGarbageCollector(ctx, alibabaAPI, GarbageCollectionParams{
+		RunInterval:    0, // for testing, we're triggering the controller manually
+		MaxPerInterval: 4,
+		ENITags:        gcTags,
+	})
+
+	waitForControllerRun(c, controllerManager, gcENIControllerName, 1)
+
+	// after the first run, no ENIs should have been deleted
+	enis, err := alibabaAPI.GetDetachedNetworkInterfaces(context.TODO(), nil, 25)
+	c.Assert(err, check.IsNil)
+	c.Assert(enis, check.HasLen, 16)
+
+	// Delete first batch of ENIs (4 ENIs should be deleted)
+	controllerManager.TriggerController(gcENIControllerName)
+	waitForControllerRun(c, controllerManager, gcENIControllerName, 2)
+
+	enis, err = alibabaAPI.GetDetachedNetworkInterfaces(context.TODO(), nil, 25)
+	c.Assert(err, check.IsNil)
+	c.Assert(enis, check.HasLen, 12)
+
+	// Create a new unattached ENI (it should _not_ be deleted in the next round)
+	newENI := createTaggedENI()
+
+	// Trigger deletion of second batch of ENIs (4 ENIs should be deleted)
+	controllerManager.TriggerController(gcENIControllerName)
+	waitForControllerRun(c, controllerManager, gcENIControllerName, 3)
+
+	// Now 8 untagged and 1 newENI should be the only ENIs left
+	enis, err = alibabaAPI.GetDetachedNetworkInterfaces(context.TODO(), nil, 25)
+	c.Assert(err, check.IsNil)
+	c.Assert(enis, check.HasLen, 9)
+	for _, eni := range enis {
+		if eni != newENI && !untaggedENIs[eni] {
+			c.Errorf("ENI not garbage collected: %s", eni)
+		}
+	}
+
+	// Attach newENI, this means it can no longer be garbage collected
+	err = alibabaAPI.AttachNetworkInterface(context.TODO(), "i-1", newENI)
+	c.Assert(err, check.IsNil)
+
+	controllerManager.TriggerController(gcENIControllerName)
+	waitForControllerRun(c, controllerManager, gcENIControllerName, 4)
+
+	// All remaining unattached ENIs should be untagged ones
+	enis, err = alibabaAPI.GetDetachedNetworkInterfaces(context.TODO(), nil, 25)
+	c.Assert(err, check.IsNil)
+	c.Assert(enis, check.HasLen, 8)
+	for _, eni := range enis {
+		if !untaggedENIs[eni] {
+			c.Errorf("ENI not garbage collected: %s", eni)
+		}
+	}
+}
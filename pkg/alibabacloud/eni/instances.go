@@ -24,12 +24,14 @@ type AlibabaCloudAPI interface {
 	GetVPC(ctx context.Context, vpcID string) (*ipamTypes.VirtualNetwork, error)
 	GetVPCs(ctx context.Context) (ipamTypes.VirtualNetworkMap, error)
 	GetSecurityGroups(ctx context.Context) (types.SecurityGroupMap, error)
+	GetDetachedNetworkInterfaces(ctx context.Context, tags ipamTypes.Tags, maxResults int) ([]string, error)
 	CreateNetworkInterface(ctx context.Context, secondaryPrivateIPCount int, vSwitchID string, groups []string, tags map[string]string) (string, *eniTypes.ENI, error)
 	AttachNetworkInterface(ctx context.Context, instanceID, eniID string) error
 	WaitENIAttached(ctx context.Context, eniID string) (string, error)
 	DeleteNetworkInterface(ctx context.Context, eniID string) error
 	AssignPrivateIPAddresses(ctx context.Context, eniID string, toAllocate int) ([]string, error)
 	UnassignPrivateIPAddresses(ctx context.Context, eniID string, addresses []string) error
+	AssignIpv6Addresses(ctx context.Context, eniID string, toAllocate int) ([]string, error)
 }
 
 // InstancesManager maintains the list of instances. It must be kept up to date
@@ -5,6 +5,9 @@ package limits
 
 import (
 	"context"
+	"fmt"
+	"strconv"
+	"strings"
 
 	openapi "github.com/cilium/cilium/pkg/alibabacloud/api"
 	ipamTypes "github.com/cilium/cilium/pkg/ipam/types"
@@ -41,6 +44,39 @@ func Get(instanceType string) (limit ipamTypes.Limits, ok bool) {
 	return
 }
 
+// UpdateFromUserDefinedMappings updates limits from the given map.
+func UpdateFromUserDefinedMappings(m map[string]string) (err error) {
+	limits.Lock()
+	defer limits.Unlock()
+
+	for instanceType, limitString := range m {
+		limit, err := parseLimitString(limitString)
+		if err != nil {
+			return err
+		}
+		// Add or overwrite limits
+		limits.m[instanceType] = limit
+	}
+	return nil
+}
+
+// parseLimitString returns the Limits struct parsed from config string.
+func parseLimitString(limitString string) (limit ipamTypes.Limits, err error) {
+	intSlice := make([]int, 3)
+	stringSlice := strings.Split(strings.ReplaceAll(limitString, " ", ""), ",")
+	if len(stringSlice) != 3 {
+		return limit, fmt.Errorf("invalid limit value")
+	}
+	for i, s := range stringSlice {
+		intLimit, err := strconv.Atoi(s)
+		if err != nil {
+			return limit, err
+		}
+		intSlice[i] = intLimit
+	}
+	return ipamTypes.Limits{Adapters: intSlice[0], IPv4: intSlice[1], IPv6: intSlice[2]}, nil
+}
+
 // UpdateFromAPI updates limits for instance
 // https://www.alibabacloud.com/help/doc-detail/25620.htm
 func UpdateFromAPI(ctx context.Context, ecs *openapi.Client) error {
@@ -18,6 +18,7 @@ import (
 	v2 "github.com/cilium/cilium/pkg/k8s/apis/cilium.io/v2"
 	"github.com/cilium/cilium/pkg/lock"
 	"github.com/cilium/cilium/pkg/math"
+	"github.com/cilium/cilium/pkg/option"
 )
 
 // The following error constants represent the error conditions for
@@ -284,7 +285,20 @@ func (n *Node) PrepareIPAllocation(scopedLog *logrus.Entry) (*ipam.AllocationAct
 // AllocateIPs performs the ENI allocation operation
 func (n *Node) AllocateIPs(ctx context.Context, a *ipam.AllocationAction) error {
 	_, err := n.manager.api.AssignPrivateIPAddresses(ctx, a.InterfaceID, a.AvailableForAllocation)
-	return err
+	if err != nil {
+		return err
+	}
+
+	// Assign a matching number of IPv6 addresses in dual-stack clusters.
+	// Failure to do so is not fatal, e.g. the vSwitch may not carry an
+	// IPv6 CIDR, and the IPv4 addresses allocated above remain usable.
+	if option.Config.EnableIPv6 {
+		if _, err := n.manager.api.AssignIpv6Addresses(ctx, a.InterfaceID, a.AvailableForAllocation); err != nil {
+			log.WithError(err).WithField(fieldENIID, a.InterfaceID).Warning("Unable to assign IPv6 addresses to ENI")
+		}
+	}
+
+	return nil
 }
 
 // PrepareIPRelease prepares the release of ENI IPs.
@@ -120,6 +120,11 @@ type ENI struct {
 	// +optional
 	PrivateIPSets []PrivateIPSet `json:"private-ipsets,omitempty"`
 
+	// IPv6Sets is the list of all IPv6 addresses on the ENI
+	//
+	// +optional
+	IPv6Sets []IPv6Set `json:"ipv6-sets,omitempty"`
+
 	// Tags is the tags on this ENI
 	//
 	// +optional
@@ -142,6 +147,12 @@ func (e *ENI) ForeachAddress(id string, fn types.AddressIterator) error {
 		}
 	}
 
+	for _, address := range e.IPv6Sets {
+		if err := fn(id, e.NetworkInterfaceID, address.Ipv6Address, "", address); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -159,6 +170,11 @@ type PrivateIPSet struct {
 	Primary          bool   `json:"primary,omitempty" `
 }
 
+// IPv6Set is a nested struct in ecs response
+type IPv6Set struct {
+	Ipv6Address string `json:"ipv6-address,omitempty"`
+}
+
 type VPC struct {
 	// VPCID is the vpc to which the ENI belongs
 	//
@@ -23,6 +23,11 @@ func (in *ENI) DeepCopyInto(out *ENI) {
 		*out = make([]PrivateIPSet, len(*in))
 		copy(*out, *in)
 	}
+	if in.IPv6Sets != nil {
+		in, out := &in.IPv6Sets, &out.IPv6Sets
+		*out = make([]IPv6Set, len(*in))
+		copy(*out, *in)
+	}
 	if in.Tags != nil {
 		in, out := &in.Tags, &out.Tags
 		*out = make(map[string]string, len(*in))
@@ -66,6 +71,22 @@ func (in *ENIStatus) DeepCopy() *ENIStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPv6Set) DeepCopyInto(out *IPv6Set) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPv6Set.
+func (in *IPv6Set) DeepCopy() *IPv6Set {
+	if in == nil {
+		return nil
+	}
+	out := new(IPv6Set)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PrivateIPSet) DeepCopyInto(out *PrivateIPSet) {
 	*out = *in
@@ -75,6 +75,23 @@ func (in *ENI) DeepEqual(other *ENI) bool {
 		}
 	}
 
+	if ((in.IPv6Sets != nil) && (other.IPv6Sets != nil)) || ((in.IPv6Sets == nil) != (other.IPv6Sets == nil)) {
+		in, other := &in.IPv6Sets, &other.IPv6Sets
+		if other == nil {
+			return false
+		}
+
+		if len(*in) != len(*other) {
+			return false
+		} else {
+			for i, inElement := range *in {
+				if !inElement.DeepEqual(&(*other)[i]) {
+					return false
+				}
+			}
+		}
+	}
+
 	if ((in.Tags != nil) && (other.Tags != nil)) || ((in.Tags == nil) != (other.Tags == nil)) {
 		in, other := &in.Tags, &other.Tags
 		if other == nil {
@@ -130,6 +147,20 @@ func (in *ENIStatus) DeepEqual(other *ENIStatus) bool {
 	return true
 }
 
+// DeepEqual is an autogenerated deepequal function, deeply comparing the
+// receiver with other. in must be non-nil.
+func (in *IPv6Set) DeepEqual(other *IPv6Set) bool {
+	if other == nil {
+		return false
+	}
+
+	if in.Ipv6Address != other.Ipv6Address {
+		return false
+	}
+
+	return true
+}
+
 // DeepEqual is an autogenerated deepequal function, deeply comparing the
 // receiver with other. in must be non-nil.
 func (in *PrivateIPSet) DeepEqual(other *PrivateIPSet) bool {
@@ -9,11 +9,14 @@ import (
 	"github.com/sirupsen/logrus"
 )
 
-const eniIndexTagKey = "cilium-eni-index"
+// ENIIndexTagKey is the tag added to every ENI created by Cilium. It holds
+// the index of the ENI on the instance and also marks the ENI as managed by
+// Cilium, e.g. for garbage collection purposes.
+const ENIIndexTagKey = "cilium-eni-index"
 
 // GetENIIndexFromTags get ENI index from tags
 func GetENIIndexFromTags(tags map[string]string) int {
-	v, ok := tags[eniIndexTagKey]
+	v, ok := tags[ENIIndexTagKey]
 	if !ok {
 		return 0
 	}
@@ -26,6 +29,6 @@ func GetENIIndexFromTags(tags map[string]string) int {
 
 // FillTagWithENIIndex set the index to tags
 func FillTagWithENIIndex(tags map[string]string, index int) map[string]string {
-	tags[eniIndexTagKey] = strconv.Itoa(index)
+	tags[ENIIndexTagKey] = strconv.Itoa(index)
 	return tags
 }
@@ -24,7 +24,7 @@ func TestGetENIIndexFromTags(t *testing.T) {
 		},
 		{
 			name: "index 1",
-			args: args{tags: map[string]string{eniIndexTagKey: "1"}},
+			args: args{tags: map[string]string{ENIIndexTagKey: "1"}},
 			want: 1,
 		},
 	}
@@ -53,7 +53,7 @@ func TestFillTagWithENIIndex(t *testing.T) {
 				tags:  map[string]string{"key": "val"},
 				index: 1,
 			},
-			want: map[string]string{"key": "val", eniIndexTagKey: "1"},
+			want: map[string]string{"key": "val", ENIIndexTagKey: "1"},
 		},
 	}
 	for _, tt := range tests {
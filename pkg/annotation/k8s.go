@@ -77,8 +77,26 @@ const (
 	// both TCP and UDP connection. Current use case is NodeLocalDNS.
 	NoTrack = Prefix + ".no-track-port"
 
+	// DSCP is the annotation name used to store the DSCP code point that
+	// is set on traffic sent by a pod, for clusters with underlay QoS
+	// requirements. Allowed values are 0-63, 0 leaves traffic unmarked.
+	DSCP = Prefix + ".dscp"
+
 	// WireguardPubKey is the annotation name used to store the Wireguard
 	// public key in the CiliumNode CRD that we need to use to encrypt traffic
 	// to that node.
 	WireguardPubKey = Prefix + ".network.wg-pub-key"
+
+	// DedicatedENI if set to true, requests a dedicated ENI for the pod in
+	// addition to the regular pod interface.
+	DedicatedENI = Prefix + ".eni.dedicated"
+
+	// DedicatedENISubnetID is the annotation name used to select the
+	// subnet in which the dedicated ENI of the pod is created.
+	DedicatedENISubnetID = Prefix + ".eni.dedicated-subnet-id"
+
+	// DedicatedENISecurityGroups is the annotation name used to specify
+	// the security groups attached to the dedicated ENI of the pod as a
+	// comma separated list of security group IDs.
+	DedicatedENISecurityGroups = Prefix + ".eni.dedicated-security-groups"
 )
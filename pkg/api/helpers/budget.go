@@ -0,0 +1,62 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package helpers
+
+import (
+	"context"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+// BudgetMetricsAPI represents the metrics maintained by an API budget
+type BudgetMetricsAPI interface {
+	// ObserveBudgetConsumed is called every time an API call is charged
+	// against the budget
+	ObserveBudgetConsumed(provider string)
+	// ObserveBudgetThrottle is called when an API call had to wait for
+	// budget to become available
+	ObserveBudgetThrottle(provider string, delay time.Duration)
+}
+
+// APIBudget caps the total number of cloud provider API calls per minute. A
+// single budget can be shared by multiple API clients, in which case the
+// calls of all clients are charged against the same budget.
+type APIBudget struct {
+	metrics BudgetMetricsAPI
+	limiter *rate.Limiter
+}
+
+// NewAPIBudget returns a new API budget allowing callsPerMinute API calls per
+// minute with the given burst configuration. The BudgetMetricsAPI interface
+// is called to account budget consumption per provider.
+func NewAPIBudget(metrics BudgetMetricsAPI, callsPerMinute, burst int) *APIBudget {
+	return &APIBudget{
+		metrics: metrics,
+		limiter: rate.NewLimiter(rate.Limit(float64(callsPerMinute)/60.0), burst),
+	}
+}
+
+// Wait charges a single API call of the given provider against the budget
+// and blocks until the budget allows the call to proceed. A nil budget
+// allows all calls to proceed immediately.
+func (b *APIBudget) Wait(ctx context.Context, provider string) {
+	if b == nil {
+		return
+	}
+	r := b.limiter.Reserve()
+	if delay := r.Delay(); delay != time.Duration(0) && delay != rate.InfDuration {
+		b.metrics.ObserveBudgetThrottle(provider, delay)
+		t := time.NewTimer(delay)
+		defer t.Stop()
+		select {
+		case <-t.C:
+			// proceed with the operation
+		case <-ctx.Done():
+			// cancel the reservation to allow other operations to go through
+			r.Cancel()
+		}
+	}
+	b.metrics.ObserveBudgetConsumed(provider)
+}
@@ -0,0 +1,68 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package helpers
+
+import (
+	"context"
+	"time"
+
+	"gopkg.in/check.v1"
+
+	"github.com/cilium/cilium/pkg/api/metrics/mock"
+	"github.com/cilium/cilium/pkg/checker"
+)
+
+func (e *HelpersSuite) TestBudgetBurst(c *check.C) {
+	metricsAPI := mock.NewMockMetrics()
+	budget := NewAPIBudget(metricsAPI, 60, 10)
+	c.Assert(budget, check.Not(check.IsNil))
+
+	// Exhaust bucket (budget should not kick in)
+	for i := 0; i < 10; i++ {
+		budget.Wait(context.TODO(), "provider1")
+	}
+	c.Assert(metricsAPI.BudgetConsumed("provider1"), check.Equals, int64(10))
+	c.Assert(metricsAPI.BudgetThrottle("provider1"), check.Equals, time.Duration(0))
+
+	// Budget should now kick in (use an expired context to avoid waiting 1sec)
+	ctx, cancel := context.WithTimeout(context.TODO(), time.Microsecond)
+	defer cancel()
+	budget.Wait(ctx, "provider2")
+	c.Assert(metricsAPI.BudgetThrottle("provider2"), check.Not(checker.Equals), time.Duration(0))
+}
+
+func (e *HelpersSuite) TestBudgetSharing(c *check.C) {
+	metricsAPI := mock.NewMockMetrics()
+	budget := NewAPIBudget(metricsAPI, 60, 4)
+
+	// Two limiters with ample local rate limits share the same budget
+	limiter1 := NewAPILimiterWithBudget(metricsAPI, 100, 100, budget, "provider1")
+	limiter2 := NewAPILimiterWithBudget(metricsAPI, 100, 100, budget, "provider2")
+
+	limiter1.Limit(context.TODO(), "op1")
+	limiter1.Limit(context.TODO(), "op1")
+	limiter2.Limit(context.TODO(), "op2")
+	limiter2.Limit(context.TODO(), "op2")
+
+	c.Assert(metricsAPI.BudgetConsumed("provider1"), check.Equals, int64(2))
+	c.Assert(metricsAPI.BudgetConsumed("provider2"), check.Equals, int64(2))
+	c.Assert(metricsAPI.BudgetThrottle("provider1"), check.Equals, time.Duration(0))
+	c.Assert(metricsAPI.BudgetThrottle("provider2"), check.Equals, time.Duration(0))
+
+	// The shared budget burst is exhausted, the next call of either
+	// provider is throttled (use an expired context to avoid waiting)
+	ctx, cancel := context.WithTimeout(context.TODO(), time.Microsecond)
+	defer cancel()
+	limiter1.Limit(ctx, "op1")
+	c.Assert(metricsAPI.BudgetThrottle("provider1"), check.Not(checker.Equals), time.Duration(0))
+}
+
+func (e *HelpersSuite) TestNilBudget(c *check.C) {
+	metricsAPI := mock.NewMockMetrics()
+	limiter := NewAPILimiterWithBudget(metricsAPI, 100, 100, nil, "provider1")
+
+	// A nil budget never blocks nor accounts for budget consumption
+	limiter.Limit(context.TODO(), "op1")
+	c.Assert(metricsAPI.BudgetConsumed("provider1"), check.Equals, int64(0))
+}
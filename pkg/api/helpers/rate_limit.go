@@ -12,8 +12,10 @@ import (
 
 // APILimiter allows to rate limit API calls
 type APILimiter struct {
-	metrics MetricsAPI
-	limiter *rate.Limiter
+	metrics  MetricsAPI
+	limiter  *rate.Limiter
+	budget   *APIBudget
+	provider string
 }
 
 // MetricsAPI represents the metrics maintained by the API limiter
@@ -31,8 +33,19 @@ func NewAPILimiter(metrics MetricsAPI, rateLimit float64, burst int) *APILimiter
 	}
 }
 
+// NewAPILimiterWithBudget returns a new API limiter which additionally
+// charges every operation against the given shared API budget under the
+// given provider name. A nil budget behaves like NewAPILimiter.
+func NewAPILimiterWithBudget(metrics MetricsAPI, rateLimit float64, burst int, budget *APIBudget, provider string) *APILimiter {
+	l := NewAPILimiter(metrics, rateLimit, burst)
+	l.budget = budget
+	l.provider = provider
+	return l
+}
+
 // Limit applies the rate limiting configuration for the given operation
 func (l *APILimiter) Limit(ctx context.Context, operation string) {
+	l.budget.Wait(ctx, l.provider)
 	r := l.limiter.Reserve()
 	if delay := r.Delay(); delay != time.Duration(0) && delay != rate.InfDuration {
 		l.metrics.ObserveRateLimit(operation, delay)
@@ -53,6 +53,49 @@ func (p *PrometheusMetrics) ObserveRateLimit(operation string, delay time.Durati
 	p.RateLimit.WithLabelValues(operation).Observe(delay.Seconds())
 }
 
+// BudgetMetrics is an implementation of Prometheus metrics for shared cloud
+// API budget usage
+type BudgetMetrics struct {
+	registry *prometheus.Registry
+	Consumed *prometheus.CounterVec
+	Throttle *prometheus.HistogramVec
+}
+
+// NewBudgetMetrics returns a new metrics tracking implementation to cover
+// consumption of the shared cloud API budget.
+func NewBudgetMetrics(namespace string, registry *prometheus.Registry) *BudgetMetrics {
+	m := &BudgetMetrics{registry: registry}
+
+	m.Consumed = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "api_budget_consumed",
+		Help:      "Number of API calls charged against the cloud API budget",
+	}, []string{"provider"})
+
+	m.Throttle = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "api_budget_throttle_duration_seconds",
+		Help:      "Duration of client-side blocking on the cloud API budget",
+	}, []string{"provider"})
+
+	registry.MustRegister(m.Consumed)
+	registry.MustRegister(m.Throttle)
+
+	return m
+}
+
+// ObserveBudgetConsumed must be called every time an API call is charged
+// against the budget
+func (p *BudgetMetrics) ObserveBudgetConsumed(provider string) {
+	p.Consumed.WithLabelValues(provider).Inc()
+}
+
+// ObserveBudgetThrottle must be called in case an API call had to wait for
+// budget to become available
+func (p *BudgetMetrics) ObserveBudgetThrottle(provider string, delay time.Duration) {
+	p.Throttle.WithLabelValues(provider).Observe(delay.Seconds())
+}
+
 // NoOpMetrics is a no-op implementation
 type NoOpMetrics struct{}
 
@@ -65,3 +108,13 @@ func (m *NoOpMetrics) ObserveAPICall(call, status string, duration float64) {}
 // limiting. This No-op implementation will perform no metrics accounting in
 // return.
 func (m *NoOpMetrics) ObserveRateLimit(operation string, duration time.Duration) {}
+
+// ObserveBudgetConsumed must be called every time an API call is charged
+// against the budget. This No-op implementation will perform no metrics
+// accounting in return.
+func (m *NoOpMetrics) ObserveBudgetConsumed(provider string) {}
+
+// ObserveBudgetThrottle must be called in case an API call had to wait for
+// budget to become available. This No-op implementation will perform no
+// metrics accounting in return.
+func (m *NoOpMetrics) ObserveBudgetThrottle(provider string, duration time.Duration) {}
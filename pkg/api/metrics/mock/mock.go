@@ -12,16 +12,20 @@ import (
 
 // MockMetrics is a mock implementation of pkg/api/metrics
 type MockMetrics struct {
-	mutex     lock.RWMutex
-	apiCall   map[string]float64
-	rateLimit map[string]time.Duration
+	mutex          lock.RWMutex
+	apiCall        map[string]float64
+	rateLimit      map[string]time.Duration
+	budgetConsumed map[string]int64
+	budgetThrottle map[string]time.Duration
 }
 
 // NewMockMetrics returns a new metrics implementation with a mocked backend
 func NewMockMetrics() *MockMetrics {
 	return &MockMetrics{
-		apiCall:   map[string]float64{},
-		rateLimit: map[string]time.Duration{},
+		apiCall:        map[string]float64{},
+		rateLimit:      map[string]time.Duration{},
+		budgetConsumed: map[string]int64{},
+		budgetThrottle: map[string]time.Duration{},
 	}
 }
 
@@ -59,3 +63,36 @@ func (m *MockMetrics) ObserveRateLimit(operation string, delay time.Duration) {
 	m.rateLimit[operation] += delay
 	m.mutex.Unlock()
 }
+
+// BudgetConsumed returns the number of API calls charged against the budget
+// for a given provider
+func (m *MockMetrics) BudgetConsumed(provider string) int64 {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+	return m.budgetConsumed[provider]
+}
+
+// ObserveBudgetConsumed must be called every time an API call is charged
+// against the budget. The total can be retrieved with BudgetConsumed().
+func (m *MockMetrics) ObserveBudgetConsumed(provider string) {
+	m.mutex.Lock()
+	m.budgetConsumed[provider]++
+	m.mutex.Unlock()
+}
+
+// BudgetThrottle returns the sum of all durations an API call of the given
+// provider had to wait for budget to become available
+func (m *MockMetrics) BudgetThrottle(provider string) time.Duration {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+	return m.budgetThrottle[provider]
+}
+
+// ObserveBudgetThrottle must be called in case an API call had to wait for
+// budget to become available. The total of all durations can be retrieved
+// with BudgetThrottle().
+func (m *MockMetrics) ObserveBudgetThrottle(provider string, delay time.Duration) {
+	m.mutex.Lock()
+	m.budgetThrottle[provider] += delay
+	m.mutex.Unlock()
+}
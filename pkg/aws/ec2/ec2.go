@@ -45,7 +45,7 @@ type MetricsAPI interface {
 }
 
 // NewClient returns a new EC2 client
-func NewClient(ec2Client *ec2.Client, metrics MetricsAPI, rateLimit float64, burst int, subnetsFilters, instancesFilters []ec2_types.Filter, eniTags map[string]string, usePrimary bool) *Client {
+func NewClient(ec2Client *ec2.Client, metrics MetricsAPI, rateLimit float64, burst int, budget *helpers.APIBudget, subnetsFilters, instancesFilters []ec2_types.Filter, eniTags map[string]string, usePrimary bool) *Client {
 	eniTagSpecification := ec2_types.TagSpecification{
 		ResourceType: ec2_types.ResourceTypeNetworkInterface,
 		Tags:         createAWSTagSlice(eniTags),
@@ -54,7 +54,7 @@ func NewClient(ec2Client *ec2.Client, metrics MetricsAPI, rateLimit float64, bur
 	return &Client{
 		ec2Client:           ec2Client,
 		metricsAPI:          metrics,
-		limiter:             helpers.NewAPILimiter(metrics, rateLimit, burst),
+		limiter:             helpers.NewAPILimiterWithBudget(metrics, rateLimit, burst, budget, "ec2"),
 		subnetsFilters:      subnetsFilters,
 		instancesFilters:    instancesFilters,
 		eniTagSpecification: eniTagSpecification,
@@ -238,6 +238,32 @@ func (c *Client) describeNetworkInterfaces(ctx context.Context, subnets ipamType
 	return result, nil
 }
 
+// describeNetworkInterfacesByInstance lists all ENIs attached to a particular
+// EC2 instance
+func (c *Client) describeNetworkInterfacesByInstance(ctx context.Context, instanceID string) ([]ec2_types.NetworkInterface, error) {
+	var result []ec2_types.NetworkInterface
+	input := &ec2.DescribeNetworkInterfacesInput{
+		Filters: []ec2_types.Filter{
+			{
+				Name:   aws.String("attachment.instance-id"),
+				Values: []string{instanceID},
+			},
+		},
+	}
+	paginator := ec2.NewDescribeNetworkInterfacesPaginator(c.ec2Client, input)
+	for paginator.HasMorePages() {
+		c.limiter.Limit(ctx, "DescribeNetworkInterfaces")
+		sinceStart := spanstat.Start()
+		output, err := paginator.NextPage(ctx)
+		c.metricsAPI.ObserveAPICall("DescribeNetworkInterfaces", deriveStatus(err), sinceStart.Seconds())
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, output.NetworkInterfaces...)
+	}
+	return result, nil
+}
+
 // describeNetworkInterfacesFromInstances lists all ENIs matching filtered EC2 instances
 func (c *Client) describeNetworkInterfacesFromInstances(ctx context.Context) ([]ec2_types.NetworkInterface, error) {
 	enisFromInstances := make(map[string]struct{})
@@ -328,6 +354,10 @@ func parseENI(iface *ec2_types.NetworkInterface, vpcs ipamTypes.VirtualNetworkMa
 		eni.Description = aws.ToString(iface.Description)
 	}
 
+	if iface.OwnerId != nil {
+		eni.OwnerID = aws.ToString(iface.OwnerId)
+	}
+
 	if iface.Attachment != nil {
 		eni.Number = int(aws.ToInt32(iface.Attachment.DeviceIndex))
 
@@ -421,6 +451,31 @@ func (c *Client) GetInstances(ctx context.Context, vpcs ipamTypes.VirtualNetwork
 	return instances, nil
 }
 
+// GetInstance returns the instance including its ENIs by the given instance
+// ID. Requires a single DescribeNetworkInterfaces call instead of the full
+// interface list fetched by GetInstances().
+func (c *Client) GetInstance(ctx context.Context, vpcs ipamTypes.VirtualNetworkMap, subnets ipamTypes.SubnetMap, instanceID string) (*ipamTypes.Instance, error) {
+	instance := ipamTypes.Instance{
+		Interfaces: map[string]ipamTypes.InterfaceRevision{},
+	}
+
+	networkInterfaces, err := c.describeNetworkInterfacesByInstance(ctx, instanceID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, iface := range networkInterfaces {
+		_, eni, err := parseENI(&iface, vpcs, subnets, c.usePrimary)
+		if err != nil {
+			return nil, err
+		}
+
+		instance.Interfaces[eni.ID] = ipamTypes.InterfaceRevision{Resource: eni}
+	}
+
+	return &instance, nil
+}
+
 // describeVpcs lists all VPCs
 func (c *Client) describeVpcs(ctx context.Context) ([]ec2_types.Vpc, error) {
 	var result []ec2_types.Vpc
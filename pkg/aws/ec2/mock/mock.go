@@ -556,6 +556,39 @@ func (e *API) GetInstances(ctx context.Context, vpcs ipamTypes.VirtualNetworkMap
 	return instances, nil
 }
 
+func (e *API) GetInstance(ctx context.Context, vpcs ipamTypes.VirtualNetworkMap, subnets ipamTypes.SubnetMap, instanceID string) (*ipamTypes.Instance, error) {
+	instance := ipamTypes.Instance{
+		Interfaces: map[string]ipamTypes.InterfaceRevision{},
+	}
+
+	e.mutex.RLock()
+	defer e.mutex.RUnlock()
+
+	enis, ok := e.enis[instanceID]
+	if !ok {
+		return nil, fmt.Errorf("instance %s not found", instanceID)
+	}
+
+	for _, eni := range enis {
+		if subnets != nil {
+			if subnet, ok := subnets[eni.Subnet.ID]; ok && subnet.CIDR != nil {
+				eni.Subnet.CIDR = subnet.CIDR.String()
+			}
+		}
+
+		if vpcs != nil {
+			if vpc, ok := vpcs[eni.VPC.ID]; ok {
+				eni.VPC.PrimaryCIDR = vpc.PrimaryCIDR
+				eni.VPC.CIDRs = vpc.CIDRs
+			}
+		}
+
+		instance.Interfaces[eni.ID] = ipamTypes.InterfaceRevision{Resource: eni.DeepCopy()}
+	}
+
+	return &instance, nil
+}
+
 func (e *API) GetVpcs(ctx context.Context) (ipamTypes.VirtualNetworkMap, error) {
 	vpcs := ipamTypes.VirtualNetworkMap{}
 
@@ -0,0 +1,157 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package eni
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/cilium/cilium/pkg/annotation"
+	eniTypes "github.com/cilium/cilium/pkg/aws/eni/types"
+)
+
+// DedicatedENISpec describes the dedicated ENI requested by a pod via
+// annotations. A dedicated ENI is attached to the node running the pod in
+// addition to the ENIs managed for the regular IP pool and is handed to the
+// pod as a secondary interface.
+type DedicatedENISpec struct {
+	// SubnetID is the subnet in which the dedicated ENI is created. If
+	// empty, the subnet is selected like for any other ENI of the node.
+	SubnetID string
+
+	// SecurityGroups is the list of security group IDs attached to the
+	// dedicated ENI. If empty, the security groups of the node's ENI spec
+	// are used.
+	SecurityGroups []string
+}
+
+// ParseDedicatedENIAnnotations extracts the dedicated ENI request from pod
+// annotations. It returns nil if the pod does not request a dedicated ENI.
+func ParseDedicatedENIAnnotations(annotations map[string]string) (*DedicatedENISpec, error) {
+	value, ok := annotations[annotation.DedicatedENI]
+	if !ok {
+		return nil, nil
+	}
+
+	enabled, err := strconv.ParseBool(value)
+	if err != nil {
+		return nil, fmt.Errorf("invalid value %q for annotation %s: %w", value, annotation.DedicatedENI, err)
+	}
+	if !enabled {
+		return nil, nil
+	}
+
+	spec := &DedicatedENISpec{
+		SubnetID: annotations[annotation.DedicatedENISubnetID],
+	}
+
+	if groups, ok := annotations[annotation.DedicatedENISecurityGroups]; ok {
+		for _, group := range strings.Split(groups, ",") {
+			group = strings.TrimSpace(group)
+			if group == "" {
+				return nil, fmt.Errorf("invalid value %q for annotation %s: empty security group ID", groups, annotation.DedicatedENISecurityGroups)
+			}
+			spec.SecurityGroups = append(spec.SecurityGroups, group)
+		}
+	}
+
+	return spec, nil
+}
+
+// CreateDedicatedENI creates an ENI dedicated to a single pod and attaches it
+// to the node. The ENI is not added to the regular IP pool, it is tagged with
+// the owning pod so the garbage collector can release it once the pod is
+// gone. The created ENI is returned so the caller can hand it to the pod.
+func (n *Node) CreateDedicatedENI(ctx context.Context, owner string, spec *DedicatedENISpec) (*eniTypes.ENI, error) {
+	if n.manager.staticENIMode {
+		return nil, fmt.Errorf(errStaticENIMode)
+	}
+
+	limits, limitsAvailable := n.getLimits()
+	if !limitsAvailable {
+		return nil, fmt.Errorf(errUnableToDetermineLimits)
+	}
+
+	n.mutex.RLock()
+	resource := *n.k8sObj
+	numENIs := len(n.enis)
+	n.mutex.RUnlock()
+
+	if numENIs >= limits.Adapters {
+		return nil, fmt.Errorf("no interface slot available for dedicated ENI, instance limit is %d", limits.Adapters)
+	}
+
+	subnetID := spec.SubnetID
+	if subnetID == "" {
+		subnet := n.findSuitableSubnet(resource.Spec.ENI, limits)
+		if subnet == nil {
+			return nil, fmt.Errorf(unableToFindSubnet)
+		}
+		subnetID = subnet.ID
+	} else if n.manager.GetSubnet(subnetID) == nil {
+		return nil, fmt.Errorf("subnet %s of dedicated ENI not found", subnetID)
+	}
+
+	securityGroupIDs := spec.SecurityGroups
+	if len(securityGroupIDs) == 0 {
+		var err error
+		securityGroupIDs, err = n.getSecurityGroupIDs(ctx, resource.Spec.ENI)
+		if err != nil {
+			return nil, fmt.Errorf("%s %s", errUnableToGetSecurityGroups, err)
+		}
+	}
+
+	scopedLog := n.loggerLocked().WithField("owner", owner)
+
+	desc := eniTypes.DedicatedENIDescriptionPrefix + " (" + owner + ")"
+	eniID, eni, err := n.manager.api.CreateNetworkInterface(ctx, 0, subnetID, desc, securityGroupIDs, false)
+	if err != nil {
+		return nil, fmt.Errorf("%s %s", errUnableToCreateENI, err)
+	}
+
+	scopedLog = scopedLog.WithField(fieldEniID, eniID)
+	scopedLog.Info("Created dedicated ENI")
+
+	index := n.findNextIndex(int32(*resource.Spec.ENI.FirstInterfaceIndex))
+
+	var attachmentID string
+	for attachRetries := 0; attachRetries < maxAttachRetries; attachRetries++ {
+		attachmentID, err = n.manager.api.AttachNetworkInterface(ctx, index, n.node.InstanceID(), eniID)
+
+		if !isAttachmentIndexConflict(err) {
+			break
+		}
+
+		index = n.findNextIndex(index + 1)
+	}
+
+	if err != nil {
+		delErr := n.manager.api.DeleteNetworkInterface(ctx, eniID)
+		if delErr != nil {
+			scopedLog.WithError(delErr).Warning("Unable to undo dedicated ENI creation after failure to attach")
+		}
+
+		return nil, fmt.Errorf("%s at index %d: %s", errUnableToAttachENI, index, err)
+	}
+
+	eni.Number = int(index)
+
+	scopedLog.WithField("attachmentID", attachmentID).Info("Attached dedicated ENI to instance")
+
+	// The dedicated ENI must not outlive the instance
+	err = n.manager.api.ModifyNetworkInterface(ctx, eniID, attachmentID, true)
+	if err != nil {
+		delErr := n.manager.api.DeleteNetworkInterface(ctx, eniID)
+		if delErr != nil {
+			scopedLog.WithError(delErr).Warning("Unable to undo dedicated ENI creation after failure to attach")
+		}
+
+		return nil, fmt.Errorf("unable to mark dedicated ENI for deletion on termination: %s", err)
+	}
+
+	n.manager.UpdateENI(n.node.InstanceID(), eni)
+	return eni, nil
+}
@@ -0,0 +1,56 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package eni
+
+import (
+	"gopkg.in/check.v1"
+
+	"github.com/cilium/cilium/pkg/annotation"
+)
+
+func (e *ENISuite) TestParseDedicatedENIAnnotations(c *check.C) {
+	// Pods without the annotation do not request a dedicated ENI
+	spec, err := ParseDedicatedENIAnnotations(map[string]string{})
+	c.Assert(err, check.IsNil)
+	c.Assert(spec, check.IsNil)
+
+	spec, err = ParseDedicatedENIAnnotations(map[string]string{
+		annotation.DedicatedENI: "false",
+	})
+	c.Assert(err, check.IsNil)
+	c.Assert(spec, check.IsNil)
+
+	// Invalid boolean value
+	_, err = ParseDedicatedENIAnnotations(map[string]string{
+		annotation.DedicatedENI: "yes please",
+	})
+	c.Assert(err, check.NotNil)
+
+	// Request without subnet and security groups
+	spec, err = ParseDedicatedENIAnnotations(map[string]string{
+		annotation.DedicatedENI: "true",
+	})
+	c.Assert(err, check.IsNil)
+	c.Assert(spec, check.NotNil)
+	c.Assert(spec.SubnetID, check.Equals, "")
+	c.Assert(spec.SecurityGroups, check.HasLen, 0)
+
+	// Fully specified request
+	spec, err = ParseDedicatedENIAnnotations(map[string]string{
+		annotation.DedicatedENI:               "true",
+		annotation.DedicatedENISubnetID:       "subnet-1",
+		annotation.DedicatedENISecurityGroups: "sg-1, sg-2",
+	})
+	c.Assert(err, check.IsNil)
+	c.Assert(spec, check.NotNil)
+	c.Assert(spec.SubnetID, check.Equals, "subnet-1")
+	c.Assert(spec.SecurityGroups, check.DeepEquals, []string{"sg-1", "sg-2"})
+
+	// Empty security group ID
+	_, err = ParseDedicatedENIAnnotations(map[string]string{
+		annotation.DedicatedENI:               "true",
+		annotation.DedicatedENISecurityGroups: "sg-1,,sg-2",
+	})
+	c.Assert(err, check.NotNil)
+}
@@ -22,6 +22,7 @@ import (
 // EC2API is the API surface used of the EC2 API
 type EC2API interface {
 	GetInstances(ctx context.Context, vpcs ipamTypes.VirtualNetworkMap, subnets ipamTypes.SubnetMap) (*ipamTypes.InstanceMap, error)
+	GetInstance(ctx context.Context, vpcs ipamTypes.VirtualNetworkMap, subnets ipamTypes.SubnetMap, instanceID string) (*ipamTypes.Instance, error)
 	GetSubnets(ctx context.Context) (ipamTypes.SubnetMap, error)
 	GetVpcs(ctx context.Context) (ipamTypes.VirtualNetworkMap, error)
 	GetSecurityGroups(ctx context.Context) (types.SecurityGroupMap, error)
@@ -45,6 +46,16 @@ type InstancesManager struct {
 	vpcs           ipamTypes.VirtualNetworkMap
 	securityGroups types.SecurityGroupMap
 	api            EC2API
+
+	// staticENIMode, if set, restricts allocation to ENIs and IPs which
+	// have been pre-attached by external tooling. No AWS resources are
+	// ever created, modified or deleted.
+	staticENIMode bool
+
+	// conflictingIPs is the set of private IPs which are assigned to more
+	// than one interface in the same VPC, keyed by "vpcID/ip". Such IPs
+	// are quarantined and never handed out to pods.
+	conflictingIPs map[string]struct{}
 }
 
 // NewInstancesManager returns a new instances manager
@@ -55,6 +66,13 @@ func NewInstancesManager(api EC2API) *InstancesManager {
 	}
 }
 
+// WithStaticENIMode returns the instances manager configured to never mutate
+// AWS resources. Only ENIs and IPs pre-attached by external tooling are used.
+func (m *InstancesManager) WithStaticENIMode(enabled bool) *InstancesManager {
+	m.staticENIMode = enabled
+	return m
+}
+
 // CreateNode is called on discovery of a new node and returns the ENI node
 // allocation implementation for the new node
 func (m *InstancesManager) CreateNode(obj *v2.CiliumNode, n *ipam.Node) ipam.NodeOperations {
@@ -148,6 +166,36 @@ func (m *InstancesManager) FindSubnetByTags(vpcID, availabilityZone string, requ
 	return
 }
 
+// FindSubnets returns all subnets matching the VPC ID and availability zone.
+// If subnetIDs is non-empty, only subnets with a matching ID are returned,
+// otherwise all subnets matching the required tags are returned.
+//
+// The returned subnets are immutable so they can be safely accessed
+func (m *InstancesManager) FindSubnets(vpcID, availabilityZone string, subnetIDs []string, required ipamTypes.Tags) []*ipamTypes.Subnet {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	subnets := []*ipamTypes.Subnet{}
+	for _, s := range m.subnets {
+		if s.VirtualNetworkID != vpcID || s.AvailabilityZone != availabilityZone {
+			continue
+		}
+
+		if len(subnetIDs) > 0 {
+			for _, subnetID := range subnetIDs {
+				if s.ID == subnetID {
+					subnets = append(subnets, s)
+					break
+				}
+			}
+		} else if s.Tags.Match(required) {
+			subnets = append(subnets, s)
+		}
+	}
+
+	return subnets
+}
+
 // FindSecurityGroupByTags returns the security groups matching VPC ID and all required tags
 //
 // The returned security groups slice is immutable so it can be safely accessed
@@ -165,6 +213,50 @@ func (m *InstancesManager) FindSecurityGroupByTags(vpcID string, required ipamTy
 	return securityGroups
 }
 
+// detectConflictingIPs returns the set of private IPs which appear on more
+// than one interface in the same VPC, keyed by "vpcID/ip". This can happen
+// when CiliumNode state is corrupted or when an IP has been assigned out of
+// band via the EC2 API. Each conflict is logged as it is discovered.
+func detectConflictingIPs(instances *ipamTypes.InstanceMap) map[string]struct{} {
+	owners := map[string]string{}
+	conflicting := map[string]struct{}{}
+
+	instances.ForeachInterface("", func(instanceID, interfaceID string, rev ipamTypes.InterfaceRevision) error {
+		e, ok := rev.Resource.(*eniTypes.ENI)
+		if !ok {
+			return nil
+		}
+
+		for _, ip := range e.Addresses {
+			key := e.VPC.ID + "/" + ip
+			if owner, ok := owners[key]; ok && owner != interfaceID {
+				conflicting[key] = struct{}{}
+				log.WithFields(logrus.Fields{
+					"ip":         ip,
+					"vpcID":      e.VPC.ID,
+					"interfaces": []string{owner, interfaceID},
+				}).Warning("Private IP is assigned to multiple interfaces, quarantining it from the allocation pool")
+			} else {
+				owners[key] = interfaceID
+			}
+		}
+		return nil
+	})
+
+	return conflicting
+}
+
+// IsIPConflicting returns true if the given private IP has been detected on
+// more than one interface in the VPC and must not be made available for
+// allocation
+func (m *InstancesManager) IsIPConflicting(vpcID, ip string) bool {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	_, ok := m.conflictingIPs[vpcID+"/"+ip]
+	return ok
+}
+
 // Resync fetches the list of EC2 instances and subnets and updates the local
 // cache in the instanceManager. It returns the time when the resync has
 // started or time.Time{} if it did not complete.
@@ -207,11 +299,43 @@ func (m *InstancesManager) Resync(ctx context.Context) time.Time {
 	m.subnets = subnets
 	m.vpcs = vpcs
 	m.securityGroups = securityGroups
+	m.conflictingIPs = detectConflictingIPs(instances)
 	m.mutex.Unlock()
 
 	return resyncStart
 }
 
+// InstanceSync fetches the ENIs of a single EC2 instance and updates the
+// local cache. The VPC, subnet and security group caches are left untouched,
+// they change rarely and are refreshed by the next full Resync. It returns
+// the time when the sync has started or time.Time{} if it did not complete.
+func (m *InstancesManager) InstanceSync(ctx context.Context, instanceID string) time.Time {
+	syncStart := time.Now()
+
+	m.mutex.RLock()
+	vpcs := m.vpcs
+	subnets := m.subnets
+	m.mutex.RUnlock()
+
+	instance, err := m.api.GetInstance(ctx, vpcs, subnets, instanceID)
+	if err != nil {
+		log.WithError(err).Warning("Unable to synchronize EC2 instance interface list")
+		return time.Time{}
+	}
+
+	log.WithFields(logrus.Fields{
+		"instanceID":    instanceID,
+		"numInterfaces": len(instance.Interfaces),
+	}).Info("Synchronized ENI information for the corresponding instance")
+
+	m.mutex.Lock()
+	m.instances.UpdateInstance(instanceID, instance)
+	m.conflictingIPs = detectConflictingIPs(m.instances)
+	m.mutex.Unlock()
+
+	return syncStart
+}
+
 // UpdateENI updates the ENI definition of an ENI for a particular instance. If
 // the ENI is already known, the definition is updated, otherwise the ENI is
 // added to the instance.
@@ -620,6 +620,20 @@ func Get(instanceType string) (limit ipamTypes.Limits, ok bool) {
 	return
 }
 
+// GetAll returns a copy of the full instance type limits table.
+func GetAll() map[string]ipamTypes.Limits {
+	limitsOnce.Do(populateStaticENILimits)
+
+	limits.RLock()
+	defer limits.RUnlock()
+
+	m := make(map[string]ipamTypes.Limits, len(limits.m))
+	for instanceType, limit := range limits.m {
+		m[instanceType] = limit
+	}
+	return m
+}
+
 // UpdateFromUserDefinedMappings updates limits from the given map.
 func UpdateFromUserDefinedMappings(m map[string]string) (err error) {
 	limitsOnce.Do(populateStaticENILimits)
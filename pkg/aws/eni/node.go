@@ -9,8 +9,10 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	stdmath "math"
 	"net"
 	"sort"
+	"strconv"
 	"strings"
 	"sync"
 
@@ -61,6 +63,10 @@ type Node struct {
 
 	// instanceID of the node
 	instanceID string
+
+	// nextSubnetIndex tracks the subnet to use next when the round-robin
+	// subnet allocation strategy is configured. Protected by Node.mutex.
+	nextSubnetIndex int
 }
 
 // NewNode returns a new Node
@@ -120,9 +126,36 @@ func (n *Node) getLimitsLocked() (ipamTypes.Limits, bool) {
 }
 
 // PrepareIPRelease prepares the release of ENI IPs.
+// AccountID returns the ID of the AWS account that owns the ENIs of the
+// node. It is used by the IPAM node manager to partition reconciliation per
+// account. Returns an empty string until the first ENI has been attached.
+func (n *Node) AccountID() string {
+	n.mutex.RLock()
+	defer n.mutex.RUnlock()
+
+	eniIds := make([]string, 0, len(n.enis))
+	for k := range n.enis {
+		eniIds = append(eniIds, k)
+	}
+	sort.Strings(eniIds)
+	for _, eniId := range eniIds {
+		if ownerID := n.enis[eniId].OwnerID; ownerID != "" {
+			return ownerID
+		}
+	}
+
+	return ""
+}
+
 func (n *Node) PrepareIPRelease(excessIPs int, scopedLog *logrus.Entry) *ipam.ReleaseAction {
 	r := &ipam.ReleaseAction{}
 
+	// In static ENI mode, IPs are managed by external tooling and are
+	// never released by Cilium.
+	if n.manager.staticENIMode {
+		return r
+	}
+
 	n.mutex.Lock()
 	defer n.mutex.Unlock()
 
@@ -149,7 +182,7 @@ func (n *Node) PrepareIPRelease(excessIPs int, scopedLog *logrus.Entry) *ipam.Re
 			"numAddresses": len(e.Addresses),
 		}).Debug("Considering ENI for IP release")
 
-		if e.IsExcludedBySpec(n.k8sObj.Spec.ENI) {
+		if e.IsExcludedBySpec(n.k8sObj.Spec.ENI) || e.IsDedicated() {
 			continue
 		}
 
@@ -191,6 +224,9 @@ func (n *Node) PrepareIPRelease(excessIPs int, scopedLog *logrus.Entry) *ipam.Re
 
 // ReleaseIPs performs the ENI IP release operation
 func (n *Node) ReleaseIPs(ctx context.Context, r *ipam.ReleaseAction) error {
+	if n.manager.staticENIMode {
+		return fmt.Errorf(errStaticENIMode)
+	}
 	return n.manager.api.UnassignPrivateIpAddresses(ctx, r.InterfaceID, r.IPsToRelease)
 }
 
@@ -204,6 +240,14 @@ func (n *Node) PrepareIPAllocation(scopedLog *logrus.Entry) (a *ipam.AllocationA
 
 	a = &ipam.AllocationAction{}
 
+	// In static ENI mode, the node can only use the IPs which external
+	// tooling has already assigned to its ENIs. No interfaces or IPs are
+	// proposed for allocation.
+	if n.manager.staticENIMode {
+		scopedLog.Debug("Static ENI mode enabled, skipping IP allocation preparation")
+		return a, nil
+	}
+
 	n.mutex.RLock()
 	defer n.mutex.RUnlock()
 
@@ -216,11 +260,16 @@ func (n *Node) PrepareIPAllocation(scopedLog *logrus.Entry) (a *ipam.AllocationA
 			"numAddresses": len(e.Addresses),
 		}).Debug("Considering ENI for allocation")
 
-		if e.IsExcludedBySpec(n.k8sObj.Spec.ENI) {
+		if e.IsExcludedBySpec(n.k8sObj.Spec.ENI) || e.IsDedicated() {
 			scopedLog.WithField(fieldEniID, e.ID).Debug("ENI is excluded by spec")
 			continue
 		}
 
+		if subnet := n.manager.GetSubnet(e.Subnet.ID); subnet != nil && isSubnetExcluded(n.k8sObj.Spec.ENI, subnet) {
+			scopedLog.WithField(fieldEniID, e.ID).Debug("Subnet of ENI is excluded by spec")
+			continue
+		}
+
 		effectiveLimits := n.getEffectiveIPLimits(&e, limits.IPv4)
 		availableOnENI := math.IntMax(effectiveLimits-len(e.Addresses), 0)
 		if availableOnENI <= 0 {
@@ -269,6 +318,9 @@ func isSubnetAtCapacity(err error) bool {
 
 // AllocateIPs performs the ENI allocation oepration
 func (n *Node) AllocateIPs(ctx context.Context, a *ipam.AllocationAction) error {
+	if n.manager.staticENIMode {
+		return fmt.Errorf(errStaticENIMode)
+	}
 	// Check if the interface to allocate on is prefix delegated
 	n.mutex.RLock()
 	isPrefixDelegated := n.node.Ops().IsPrefixDelegated()
@@ -391,6 +443,8 @@ const (
 	unableToAttachENI            = "unableToAttachENI"
 	unableToMarkENIForDeletion   = "unableToMarkENIForDeletion"
 	unableToFindSubnet           = "unableToFindSubnet"
+	errStaticENIMode             = "no AWS resources can be mutated in static ENI mode"
+	staticENIMode                = "staticENIMode"
 )
 
 // CreateInterface creates an additional interface with the instance and
@@ -398,6 +452,10 @@ const (
 // of secondary IPs are assigned to the interface up to the maximum number of
 // addresses as allowed by the instance.
 func (n *Node) CreateInterface(ctx context.Context, allocation *ipam.AllocationAction, scopedLog *logrus.Entry) (int, string, error) {
+	if n.manager.staticENIMode {
+		return 0, staticENIMode, fmt.Errorf(errStaticENIMode)
+	}
+
 	limits, limitsAvailable := n.getLimits()
 	if !limitsAvailable {
 		return 0, unableToDetermineLimits, fmt.Errorf(errUnableToDetermineLimits)
@@ -552,7 +610,7 @@ func (n *Node) ResyncInterfacesAndIPs(ctx context.Context, scopedLog *logrus.Ent
 			}
 
 			n.enis[e.ID] = *e
-			if e.IsExcludedBySpec(n.k8sObj.Spec.ENI) {
+			if e.IsExcludedBySpec(n.k8sObj.Spec.ENI) || e.IsDedicated() {
 				return nil
 			}
 
@@ -563,6 +621,14 @@ func (n *Node) ResyncInterfacesAndIPs(ctx context.Context, scopedLog *logrus.Ent
 			}
 
 			for _, ip := range e.Addresses {
+				if n.manager.IsIPConflicting(e.VPC.ID, ip) {
+					scopedLog.WithFields(logrus.Fields{
+						"ip":         ip,
+						fieldEniID:   e.ID,
+						"instanceID": instanceID,
+					}).Warning("IP is assigned to multiple interfaces, it will not be made available for allocation")
+					continue
+				}
 				available[ip] = ipamTypes.AllocationIP{Resource: e.ID}
 			}
 			return nil
@@ -801,26 +867,136 @@ func (n *Node) getEffectiveIPLimits(eni *eniTypes.ENI, limits int) (effectiveLim
 
 // findSuitableSubnet attempts to find a subnet to allocate an ENI in according to the following heuristic.
 //  0. In general, the subnet has to be in the same VPC and match the availability zone of the
-//     node. If there are multiple candidates, we choose the subnet with the most addresses
+//     node. If there are multiple candidates, the subnet allocation strategy configured in the
+//     spec decides which one is used, defaulting to the subnet with the most addresses
 //     available.
 //  1. If we have explicit ID or tag constraints, chose a matching subnet. ID constraints take
 //     precedence.
 //  2. If we have no explicit constraints, try to use the subnet the first ENI of the node was
 //     created in, to avoid putting the ENI in a surprising subnet if possible.
-//  3. If none of these work, fall back to just choosing the subnet with the most addresses
-//     available.
+//  3. If none of these work, fall back to just choosing among all subnets of the availability
+//     zone.
+//  4. If all preferred subnets are exhausted, spill over to the secondary subnet list if one
+//     is configured.
 func (n *Node) findSuitableSubnet(spec eniTypes.ENISpec, limits ipamTypes.Limits) *ipamTypes.Subnet {
-	var subnet *ipamTypes.Subnet
 	if len(spec.SubnetIDs) > 0 {
-		return n.manager.FindSubnetByIDs(spec.VpcID, spec.AvailabilityZone, spec.SubnetIDs)
+		subnet := n.selectSubnet(excludeSubnets(n.manager.FindSubnets(spec.VpcID, spec.AvailabilityZone, spec.SubnetIDs, nil), spec), spec.SubnetAllocationStrategy)
+		if subnet == nil {
+			subnet = n.findSpilloverSubnet(spec)
+		}
+		return subnet
 	} else if len(spec.SubnetTags) > 0 {
-		return n.manager.FindSubnetByTags(spec.VpcID, spec.AvailabilityZone, spec.SubnetTags)
+		subnet := n.selectSubnet(excludeSubnets(n.manager.FindSubnets(spec.VpcID, spec.AvailabilityZone, nil, spec.SubnetTags), spec), spec.SubnetAllocationStrategy)
+		if subnet == nil {
+			subnet = n.findSpilloverSubnet(spec)
+		}
+		return subnet
 	}
 
-	subnet = n.manager.GetSubnet(spec.NodeSubnetID)
-	if subnet != nil && subnet.AvailableAddresses >= limits.IPv4 {
+	if subnet := n.manager.GetSubnet(spec.NodeSubnetID); subnet != nil && !isSubnetExcluded(spec, subnet) && subnet.AvailableAddresses >= limits.IPv4 {
 		return subnet
 	}
 
-	return n.manager.FindSubnetByTags(spec.VpcID, spec.AvailabilityZone, nil)
+	subnet := n.selectSubnet(excludeSubnets(n.manager.FindSubnets(spec.VpcID, spec.AvailabilityZone, nil, nil), spec), spec.SubnetAllocationStrategy)
+	if subnet == nil {
+		subnet = n.findSpilloverSubnet(spec)
+	}
+	return subnet
+}
+
+// findSpilloverSubnet selects a subnet from the secondary spillover subnet
+// list. It is only consulted once all preferred subnets are exhausted.
+func (n *Node) findSpilloverSubnet(spec eniTypes.ENISpec) *ipamTypes.Subnet {
+	if len(spec.SpilloverSubnetIDs) == 0 {
+		return nil
+	}
+	return n.selectSubnet(excludeSubnets(n.manager.FindSubnets(spec.VpcID, spec.AvailabilityZone, spec.SpilloverSubnetIDs, nil), spec), spec.SubnetAllocationStrategy)
+}
+
+// isSubnetExcluded returns true if the subnet is excluded from ENI and IP
+// allocation by the ENI spec, either via its ID or its tags.
+func isSubnetExcluded(spec eniTypes.ENISpec, subnet *ipamTypes.Subnet) bool {
+	for _, subnetID := range spec.ExcludeSubnetIDs {
+		if subnet.ID == subnetID {
+			return true
+		}
+	}
+
+	if len(spec.ExcludeSubnetTags) > 0 && subnet.Tags.Match(spec.ExcludeSubnetTags) {
+		return true
+	}
+
+	return false
+}
+
+// excludeSubnets drops all subnets excluded by the ENI spec from the list of
+// candidates.
+func excludeSubnets(candidates []*ipamTypes.Subnet, spec eniTypes.ENISpec) []*ipamTypes.Subnet {
+	subnets := make([]*ipamTypes.Subnet, 0, len(candidates))
+	for _, s := range candidates {
+		if !isSubnetExcluded(spec, s) {
+			subnets = append(subnets, s)
+		}
+	}
+	return subnets
+}
+
+// selectSubnet picks one of the candidate subnets according to the given
+// subnet allocation strategy. Subnets without available addresses are never
+// selected.
+func (n *Node) selectSubnet(candidates []*ipamTypes.Subnet, strategy string) *ipamTypes.Subnet {
+	available := make([]*ipamTypes.Subnet, 0, len(candidates))
+	for _, s := range candidates {
+		if s.AvailableAddresses > 0 {
+			available = append(available, s)
+		}
+	}
+	if len(available) == 0 {
+		return nil
+	}
+
+	// Sort by subnet ID to make the selection deterministic across resyncs
+	sort.Slice(available, func(i, j int) bool { return available[i].ID < available[j].ID })
+
+	switch strategy {
+	case eniTypes.SubnetAllocationStrategyRoundRobin:
+		n.mutex.Lock()
+		subnet := available[n.nextSubnetIndex%len(available)]
+		n.nextSubnetIndex++
+		n.mutex.Unlock()
+		return subnet
+	case eniTypes.SubnetAllocationStrategyTagPriority:
+		best := available[0]
+		bestPriority := subnetPriority(best)
+		for _, s := range available[1:] {
+			if priority := subnetPriority(s); priority < bestPriority ||
+				(priority == bestPriority && s.AvailableAddresses > best.AvailableAddresses) {
+				best, bestPriority = s, priority
+			}
+		}
+		return best
+	default:
+		best := available[0]
+		for _, s := range available[1:] {
+			if s.AvailableAddresses > best.AvailableAddresses {
+				best = s
+			}
+		}
+		return best
+	}
+}
+
+// subnetPriority returns the priority of the subnet as configured via the
+// subnet priority tag. Lower values are preferred. Subnets without the tag or
+// with an unparseable value are given the lowest possible priority.
+func subnetPriority(s *ipamTypes.Subnet) int {
+	value, ok := s.Tags[eniTypes.SubnetPriorityTagKey]
+	if !ok {
+		return stdmath.MaxInt
+	}
+	priority, err := strconv.Atoi(value)
+	if err != nil {
+		return stdmath.MaxInt
+	}
+	return priority
 }
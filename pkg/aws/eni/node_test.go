@@ -33,6 +33,58 @@ func (e *ENISuite) TestGetMaximumAllocatableIPv4(c *check.C) {
 	c.Assert(n.GetMaximumAllocatableIPv4(), check.Equals, 0)
 }
 
+func (e *ENISuite) TestSelectSubnet(c *check.C) {
+	n := &Node{}
+
+	candidates := []*ipamTypes.Subnet{
+		{ID: "subnet-1", AvailableAddresses: 10, Tags: map[string]string{types.SubnetPriorityTagKey: "2"}},
+		{ID: "subnet-2", AvailableAddresses: 20, Tags: map[string]string{types.SubnetPriorityTagKey: "3"}},
+		{ID: "subnet-3", AvailableAddresses: 5, Tags: map[string]string{types.SubnetPriorityTagKey: "1"}},
+		{ID: "subnet-4", AvailableAddresses: 0, Tags: map[string]string{types.SubnetPriorityTagKey: "0"}},
+	}
+
+	// Default strategy selects the subnet with the most available addresses
+	c.Assert(n.selectSubnet(candidates, "").ID, check.Equals, "subnet-2")
+	c.Assert(n.selectSubnet(candidates, types.SubnetAllocationStrategyMostAddresses).ID, check.Equals, "subnet-2")
+
+	// Tag priority prefers the lowest priority value, exhausted subnets are skipped
+	c.Assert(n.selectSubnet(candidates, types.SubnetAllocationStrategyTagPriority).ID, check.Equals, "subnet-3")
+
+	// Round-robin cycles through all subnets with available addresses
+	c.Assert(n.selectSubnet(candidates, types.SubnetAllocationStrategyRoundRobin).ID, check.Equals, "subnet-1")
+	c.Assert(n.selectSubnet(candidates, types.SubnetAllocationStrategyRoundRobin).ID, check.Equals, "subnet-2")
+	c.Assert(n.selectSubnet(candidates, types.SubnetAllocationStrategyRoundRobin).ID, check.Equals, "subnet-3")
+	c.Assert(n.selectSubnet(candidates, types.SubnetAllocationStrategyRoundRobin).ID, check.Equals, "subnet-1")
+
+	// No candidates with available addresses
+	c.Assert(n.selectSubnet([]*ipamTypes.Subnet{{ID: "subnet-5"}}, ""), check.IsNil)
+	c.Assert(n.selectSubnet(nil, ""), check.IsNil)
+}
+
+func (e *ENISuite) TestIsSubnetExcluded(c *check.C) {
+	subnet := &ipamTypes.Subnet{
+		ID:   "subnet-1",
+		Tags: map[string]string{"reserved": "lb"},
+	}
+
+	// No exclusions configured
+	c.Assert(isSubnetExcluded(types.ENISpec{}, subnet), check.Equals, false)
+
+	// Excluded by ID
+	c.Assert(isSubnetExcluded(types.ENISpec{ExcludeSubnetIDs: []string{"subnet-1"}}, subnet), check.Equals, true)
+	c.Assert(isSubnetExcluded(types.ENISpec{ExcludeSubnetIDs: []string{"subnet-2"}}, subnet), check.Equals, false)
+
+	// Excluded by tags
+	c.Assert(isSubnetExcluded(types.ENISpec{ExcludeSubnetTags: map[string]string{"reserved": "lb"}}, subnet), check.Equals, true)
+	c.Assert(isSubnetExcluded(types.ENISpec{ExcludeSubnetTags: map[string]string{"reserved": "db"}}, subnet), check.Equals, false)
+
+	// Exclusions are dropped from candidate lists
+	candidates := []*ipamTypes.Subnet{subnet, {ID: "subnet-2"}}
+	remaining := excludeSubnets(candidates, types.ENISpec{ExcludeSubnetIDs: []string{"subnet-1"}})
+	c.Assert(remaining, check.HasLen, 1)
+	c.Assert(remaining[0].ID, check.Equals, "subnet-2")
+}
+
 // TestGetUsedIPWithPrefixes tests the logic computing used IPs on a node when prefix delegation is enabled.
 func (e *ENISuite) TestGetUsedIPWithPrefixes(c *check.C) {
 	cn := newCiliumNode("node1", withInstanceType("m5a.large"))
@@ -4,9 +4,34 @@
 package types
 
 import (
+	"strings"
+
 	"github.com/cilium/cilium/pkg/ipam/types"
 )
 
+const (
+	// DedicatedENIDescriptionPrefix is the description prefix of ENIs
+	// dedicated to a single pod. Such ENIs are not part of the regular IP
+	// pool of the node.
+	DedicatedENIDescriptionPrefix = "Cilium-CNI Dedicated"
+
+	// SubnetAllocationStrategyMostAddresses selects the subnet with the
+	// most available addresses. This is the default strategy.
+	SubnetAllocationStrategyMostAddresses = "most-free-ips"
+
+	// SubnetAllocationStrategyRoundRobin cycles through all matching
+	// subnets with available addresses.
+	SubnetAllocationStrategyRoundRobin = "round-robin"
+
+	// SubnetAllocationStrategyTagPriority prefers the subnet with the
+	// lowest value of the SubnetPriorityTagKey tag.
+	SubnetAllocationStrategyTagPriority = "tag-priority"
+
+	// SubnetPriorityTagKey is the subnet tag evaluated by the
+	// tag-priority subnet allocation strategy. Lower values are preferred.
+	SubnetPriorityTagKey = "io.cilium/subnet-priority"
+)
+
 // ENISpec is the ENI specification of a node. This specification is considered
 // by the cilium-operator to act as an IPAM operator and makes ENI IPs available
 // via the IPAMSpec section.
@@ -98,6 +123,38 @@ type ENISpec struct {
 	// +kubebuilder:validation:Optional
 	SubnetTags map[string]string `json:"subnet-tags,omitempty"`
 
+	// SubnetAllocationStrategy defines how to choose between multiple
+	// subnets matching the configured constraints in the availability zone
+	// of the node. "most-free-ips" selects the subnet with the most
+	// available addresses, "round-robin" cycles through all matching
+	// subnets and "tag-priority" prefers subnets with the lowest value of
+	// the "io.cilium/subnet-priority" tag. Defaults to "most-free-ips".
+	//
+	// +kubebuilder:validation:Enum=most-free-ips;round-robin;tag-priority
+	// +kubebuilder:validation:Optional
+	SubnetAllocationStrategy string `json:"subnet-allocation-strategy,omitempty"`
+
+	// SpilloverSubnetIDs is a secondary list of subnet ids to use for ENI
+	// and IP allocation when all subnets matching the primary constraints
+	// are exhausted.
+	//
+	// +kubebuilder:validation:Optional
+	SpilloverSubnetIDs []string `json:"spillover-subnet-ids,omitempty"`
+
+	// ExcludeSubnetIDs is the list of subnet ids to exclude from ENI and
+	// IP allocation even if they match the configured subnet IDs or tags,
+	// e.g. to protect subnets reserved for other infrastructure.
+	//
+	// +kubebuilder:validation:Optional
+	ExcludeSubnetIDs []string `json:"exclude-subnet-ids,omitempty"`
+
+	// ExcludeSubnetTags is the list of tags to use when excluding subnets
+	// from ENI and IP allocation. Any subnet matching this set of tags
+	// will not be used by Cilium.
+	//
+	// +kubebuilder:validation:Optional
+	ExcludeSubnetTags map[string]string `json:"exclude-subnet-tags,omitempty"`
+
 	// NodeSubnetID is the subnet of the primary ENI the instance was brought up
 	// with. It is used as a sensible default subnet to create ENIs in.
 	//
@@ -172,6 +229,11 @@ type ENI struct {
 	// +optional
 	Description string `json:"description,omitempty"`
 
+	// OwnerID is the ID of the AWS account that owns the ENI
+	//
+	// +optional
+	OwnerID string `json:"owner-id,omitempty"`
+
 	// Number is the interface index, it used in combination with
 	// FirstInterfaceIndex
 	//
@@ -224,6 +286,12 @@ func (e *ENI) ForeachAddress(id string, fn types.AddressIterator) error {
 	return nil
 }
 
+// IsDedicated returns true if the ENI is dedicated to a single pod and must
+// not be used for the regular IP pool of the node.
+func (e *ENI) IsDedicated() bool {
+	return strings.HasPrefix(e.Description, DedicatedENIDescriptionPrefix)
+}
+
 // IsExcludedBySpec returns true if the ENI is excluded by the provided spec and
 // therefore should not be managed by Cilium.
 func (e *ENI) IsExcludedBySpec(spec ENISpec) bool {
@@ -117,6 +117,23 @@ func (in *ENISpec) DeepCopyInto(out *ENISpec) {
 			(*out)[key] = val
 		}
 	}
+	if in.SpilloverSubnetIDs != nil {
+		in, out := &in.SpilloverSubnetIDs, &out.SpilloverSubnetIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ExcludeSubnetIDs != nil {
+		in, out := &in.ExcludeSubnetIDs, &out.ExcludeSubnetIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ExcludeSubnetTags != nil {
+		in, out := &in.ExcludeSubnetTags, &out.ExcludeSubnetTags
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 	if in.ExcludeInterfaceTags != nil {
 		in, out := &in.ExcludeInterfaceTags, &out.ExcludeInterfaceTags
 		*out = make(map[string]string, len(*in))
@@ -80,6 +80,9 @@ func (in *ENI) DeepEqual(other *ENI) bool {
 	if in.Description != other.Description {
 		return false
 	}
+	if in.OwnerID != other.OwnerID {
+		return false
+	}
 	if in.Number != other.Number {
 		return false
 	}
@@ -272,6 +275,64 @@ func (in *ENISpec) DeepEqual(other *ENISpec) bool {
 		}
 	}
 
+	if in.SubnetAllocationStrategy != other.SubnetAllocationStrategy {
+		return false
+	}
+	if ((in.SpilloverSubnetIDs != nil) && (other.SpilloverSubnetIDs != nil)) || ((in.SpilloverSubnetIDs == nil) != (other.SpilloverSubnetIDs == nil)) {
+		in, other := &in.SpilloverSubnetIDs, &other.SpilloverSubnetIDs
+		if other == nil {
+			return false
+		}
+
+		if len(*in) != len(*other) {
+			return false
+		} else {
+			for i, inElement := range *in {
+				if inElement != (*other)[i] {
+					return false
+				}
+			}
+		}
+	}
+
+	if ((in.ExcludeSubnetIDs != nil) && (other.ExcludeSubnetIDs != nil)) || ((in.ExcludeSubnetIDs == nil) != (other.ExcludeSubnetIDs == nil)) {
+		in, other := &in.ExcludeSubnetIDs, &other.ExcludeSubnetIDs
+		if other == nil {
+			return false
+		}
+
+		if len(*in) != len(*other) {
+			return false
+		} else {
+			for i, inElement := range *in {
+				if inElement != (*other)[i] {
+					return false
+				}
+			}
+		}
+	}
+
+	if ((in.ExcludeSubnetTags != nil) && (other.ExcludeSubnetTags != nil)) || ((in.ExcludeSubnetTags == nil) != (other.ExcludeSubnetTags == nil)) {
+		in, other := &in.ExcludeSubnetTags, &other.ExcludeSubnetTags
+		if other == nil {
+			return false
+		}
+
+		if len(*in) != len(*other) {
+			return false
+		} else {
+			for key, inValue := range *in {
+				if otherValue, present := (*other)[key]; !present {
+					return false
+				} else {
+					if inValue != otherValue {
+						return false
+					}
+				}
+			}
+		}
+	}
+
 	if in.NodeSubnetID != other.NodeSubnetID {
 		return false
 	}
@@ -7,9 +7,13 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"strconv"
+	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/config"
 	"github.com/aws/aws-sdk-go-v2/feature/ec2/imds"
+
+	eniTypes "github.com/cilium/cilium/pkg/aws/eni/types"
 )
 
 func newClient() (*imds.Client, error) {
@@ -78,3 +82,99 @@ func GetInstanceMetadata() (instanceID, instanceType, availabilityZone, vpcID, s
 
 	return
 }
+
+// GetAttachedENIs returns all ENIs attached to the local instance as seen by
+// the instance metadata service. It provides a degraded view of the
+// already-attached interfaces and their IPs which remains available when the
+// EC2 control plane cannot be reached.
+func GetAttachedENIs() ([]eniTypes.ENI, error) {
+	client, err := newClient()
+	if err != nil {
+		return nil, err
+	}
+
+	macs, err := getMetadata(client, "network/interfaces/macs/")
+	if err != nil {
+		return nil, err
+	}
+
+	availabilityZone, err := getMetadata(client, "placement/availability-zone")
+	if err != nil {
+		return nil, err
+	}
+
+	enis := []eniTypes.ENI{}
+	for _, mac := range strings.Fields(macs) {
+		mac = strings.TrimSuffix(mac, "/")
+		eni, err := getAttachedENI(client, mac)
+		if err != nil {
+			return nil, err
+		}
+		eni.AvailabilityZone = availabilityZone
+		enis = append(enis, eni)
+	}
+
+	return enis, nil
+}
+
+// getAttachedENI derives the ENI attached with the given MAC address from the
+// instance metadata service.
+func getAttachedENI(client *imds.Client, mac string) (eni eniTypes.ENI, err error) {
+	macPath := fmt.Sprintf("network/interfaces/macs/%s/", mac)
+	eni.MAC = mac
+
+	eni.ID, err = getMetadata(client, macPath+"interface-id")
+	if err != nil {
+		return
+	}
+
+	deviceNumber, err := getMetadata(client, macPath+"device-number")
+	if err != nil {
+		return
+	}
+	eni.Number, err = strconv.Atoi(strings.TrimSpace(deviceNumber))
+	if err != nil {
+		return eni, fmt.Errorf("unable to parse device-number of interface %s: %w", eni.ID, err)
+	}
+
+	eni.Subnet.ID, err = getMetadata(client, macPath+"subnet-id")
+	if err != nil {
+		return
+	}
+	eni.Subnet.CIDR, err = getMetadata(client, macPath+"subnet-ipv4-cidr-block")
+	if err != nil {
+		return
+	}
+
+	eni.VPC.ID, err = getMetadata(client, macPath+"vpc-id")
+	if err != nil {
+		return
+	}
+	vpcCIDRs, err := getMetadata(client, macPath+"vpc-ipv4-cidr-blocks")
+	if err != nil {
+		return eni, err
+	}
+	if cidrs := strings.Fields(vpcCIDRs); len(cidrs) > 0 {
+		eni.VPC.PrimaryCIDR = cidrs[0]
+		eni.VPC.CIDRs = cidrs[1:]
+	}
+
+	// The first address returned is the primary IP of the interface
+	localIPs, err := getMetadata(client, macPath+"local-ipv4s")
+	if err != nil {
+		return eni, err
+	}
+	addresses := strings.Fields(localIPs)
+	if len(addresses) > 0 {
+		eni.IP = addresses[0]
+		eni.Addresses = addresses
+	}
+
+	securityGroupIDs, err := getMetadata(client, macPath+"security-group-ids")
+	if err != nil {
+		return eni, err
+	}
+	eni.SecurityGroups = strings.Fields(securityGroupIDs)
+
+	return eni, nil
+}
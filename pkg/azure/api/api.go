@@ -5,8 +5,12 @@ package api
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net"
+	"net/http"
+	"os"
+	"strconv"
 	"strings"
 	"time"
 
@@ -17,11 +21,13 @@ import (
 	"github.com/Azure/go-autorest/autorest/azure"
 	"github.com/Azure/go-autorest/autorest/azure/auth"
 	"github.com/Azure/go-autorest/autorest/to"
+	"github.com/sirupsen/logrus"
 
 	"github.com/cilium/cilium/pkg/api/helpers"
 	"github.com/cilium/cilium/pkg/azure/types"
 	"github.com/cilium/cilium/pkg/cidr"
 	ipamTypes "github.com/cilium/cilium/pkg/ipam/types"
+	"github.com/cilium/cilium/pkg/lock"
 	"github.com/cilium/cilium/pkg/logging"
 	"github.com/cilium/cilium/pkg/logging/logfields"
 	"github.com/cilium/cilium/pkg/rand"
@@ -41,9 +47,19 @@ type Client struct {
 	virtualnetworks network.VirtualNetworksClient
 	vmss            compute.VirtualMachineScaleSetVMsClient
 	vmscalesets     compute.VirtualMachineScaleSetsClient
+	resourceskus    compute.ResourceSkusClient
 	limiter         *helpers.APILimiter
 	metricsAPI      MetricsAPI
 	usePrimary      bool
+	dryRun          bool
+
+	// throttle delays API calls when the ARM API asked to back off via a
+	// Retry-After response header. The backoff window is shared between
+	// all goroutines using the client.
+	throttle struct {
+		lock.RWMutex
+		retryAfter time.Time
+	}
 }
 
 // MetricsAPI represents the metrics maintained by the Azure API client
@@ -52,7 +68,52 @@ type MetricsAPI interface {
 	ObserveRateLimit(operation string, duration time.Duration)
 }
 
+const (
+	// The following environment variables are injected by the Azure AD
+	// workload identity mutating webhook when a service account is
+	// annotated with a client identity.
+	azureClientIDEnv           = "AZURE_CLIENT_ID"
+	azureTenantIDEnv           = "AZURE_TENANT_ID"
+	azureFederatedTokenFileEnv = "AZURE_FEDERATED_TOKEN_FILE"
+
+	// availabilityZoneTag is the tag on a virtual network which assigns
+	// all of its subnets to an availability zone. Azure subnets are
+	// regional resources and carry no zone information themselves.
+	availabilityZoneTag = "cilium-availability-zone"
+)
+
+// constructWorkloadIdentityAuthorizer returns an authorizer based on the
+// Azure AD workload identity of the pod. The projected service account token
+// is exchanged for an Azure AD token of the federated identity, no client
+// secret or managed identity is required.
+func constructWorkloadIdentityAuthorizer(env azure.Environment, clientID, tenantID, tokenFile string) (autorest.Authorizer, error) {
+	oauthConfig, err := adal.NewOAuthConfig(env.ActiveDirectoryEndpoint, tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	jwt, err := os.ReadFile(tokenFile)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read federated token file %s: %w", tokenFile, err)
+	}
+
+	spToken, err := adal.NewServicePrincipalTokenFromFederatedToken(*oauthConfig, clientID, string(jwt), env.ResourceManagerEndpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	return autorest.NewBearerAuthorizer(spToken), nil
+}
+
 func constructAuthorizer(env azure.Environment, userAssignedIdentityID string) (autorest.Authorizer, error) {
+	clientID := os.Getenv(azureClientIDEnv)
+	tenantID := os.Getenv(azureTenantIDEnv)
+	tokenFile := os.Getenv(azureFederatedTokenFileEnv)
+	if clientID != "" && tenantID != "" && tokenFile != "" {
+		log.WithField("clientID", clientID).Debug("Using Azure AD workload identity for authentication")
+		return constructWorkloadIdentityAuthorizer(env, clientID, tenantID, tokenFile)
+	}
+
 	if userAssignedIdentityID != "" {
 		spToken, err := adal.NewServicePrincipalTokenFromManagedIdentity(env.ServiceManagementEndpoint, &adal.ManagedIdentityOptions{
 			ClientID: userAssignedIdentityID,
@@ -73,7 +134,7 @@ func constructAuthorizer(env azure.Environment, userAssignedIdentityID string) (
 }
 
 // NewClient returns a new Azure client
-func NewClient(cloudName, subscriptionID, resourceGroup, userAssignedIdentityID string, metrics MetricsAPI, rateLimit float64, burst int, usePrimary bool) (*Client, error) {
+func NewClient(cloudName, subscriptionID, resourceGroup, userAssignedIdentityID string, metrics MetricsAPI, rateLimit float64, burst int, budget *helpers.APIBudget, usePrimary, dryRun bool) (*Client, error) {
 	azureEnv, err := azure.EnvironmentFromName(cloudName)
 	if err != nil {
 		return nil, err
@@ -85,9 +146,11 @@ func NewClient(cloudName, subscriptionID, resourceGroup, userAssignedIdentityID
 		virtualnetworks: network.NewVirtualNetworksClientWithBaseURI(azureEnv.ResourceManagerEndpoint, subscriptionID),
 		vmss:            compute.NewVirtualMachineScaleSetVMsClientWithBaseURI(azureEnv.ResourceManagerEndpoint, subscriptionID),
 		vmscalesets:     compute.NewVirtualMachineScaleSetsClientWithBaseURI(azureEnv.ResourceManagerEndpoint, subscriptionID),
+		resourceskus:    compute.NewResourceSkusClientWithBaseURI(azureEnv.ResourceManagerEndpoint, subscriptionID),
 		metricsAPI:      metrics,
-		limiter:         helpers.NewAPILimiter(metrics, rateLimit, burst),
+		limiter:         helpers.NewAPILimiterWithBudget(metrics, rateLimit, burst, budget, "azure"),
 		usePrimary:      usePrimary,
+		dryRun:          dryRun,
 	}
 
 	authorizer, err := constructAuthorizer(azureEnv, userAssignedIdentityID)
@@ -103,10 +166,62 @@ func NewClient(cloudName, subscriptionID, resourceGroup, userAssignedIdentityID
 	c.vmss.AddToUserAgent(userAgent)
 	c.vmscalesets.Authorizer = authorizer
 	c.vmscalesets.AddToUserAgent(userAgent)
+	c.resourceskus.Authorizer = authorizer
+	c.resourceskus.AddToUserAgent(userAgent)
 
 	return c, nil
 }
 
+// GetVMSkuLimits lists the virtual machine SKUs of the subscription and
+// returns the interface limits and the accelerated networking capability per
+// VM size
+func (c *Client) GetVMSkuLimits(ctx context.Context) (map[string]ipamTypes.Limits, map[string]bool, error) {
+	vmLimits := map[string]ipamTypes.Limits{}
+	vmAccelerated := map[string]bool{}
+
+	c.throttleDelay(ctx)
+	c.limiter.Limit(ctx, "ResourceSkus.List")
+	sinceStart := spanstat.Start()
+	result, err := c.resourceskus.ListComplete(ctx, "")
+	c.metricsAPI.ObserveAPICall("ResourceSkus.List", deriveStatus(err), sinceStart.Seconds())
+	c.observeThrottle("ResourceSkus.List", err)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for result.NotDone() {
+		sku := result.Value()
+		err = result.Next()
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if to.String(sku.ResourceType) != "virtualMachines" || sku.Name == nil || sku.Capabilities == nil {
+			continue
+		}
+
+		for _, capability := range *sku.Capabilities {
+			switch to.String(capability.Name) {
+			case "MaxNetworkInterfaces":
+				adapters, err := strconv.Atoi(to.String(capability.Value))
+				if err != nil {
+					continue
+				}
+
+				vmLimits[*sku.Name] = ipamTypes.Limits{
+					Adapters: adapters,
+					IPv4:     types.InterfaceAddressLimit,
+					IPv6:     types.InterfaceAddressLimit,
+				}
+			case "AcceleratedNetworkingEnabled":
+				vmAccelerated[*sku.Name] = strings.EqualFold(to.String(capability.Value), "True")
+			}
+		}
+	}
+
+	return vmLimits, vmAccelerated, nil
+}
+
 // deriveStatus returns a status string
 func deriveStatus(err error) string {
 	if err != nil {
@@ -116,6 +231,59 @@ func deriveStatus(err error) string {
 	return "OK"
 }
 
+// parseRetryAfter extracts the Retry-After backoff hint from an Azure API
+// error, if any
+func parseRetryAfter(err error) time.Duration {
+	var detailed autorest.DetailedError
+	if !errors.As(err, &detailed) || detailed.Response == nil {
+		return 0
+	}
+
+	seconds, err2 := strconv.Atoi(detailed.Response.Header.Get("Retry-After"))
+	if err2 != nil || seconds <= 0 {
+		return 0
+	}
+
+	return time.Duration(seconds) * time.Second
+}
+
+// observeThrottle extends the shared backoff window when the ARM API asked to
+// back off via a Retry-After response header
+func (c *Client) observeThrottle(operation string, err error) {
+	retryAfter := parseRetryAfter(err)
+	if retryAfter == 0 {
+		return
+	}
+
+	until := time.Now().Add(retryAfter)
+	c.throttle.Lock()
+	if until.After(c.throttle.retryAfter) {
+		c.throttle.retryAfter = until
+	}
+	c.throttle.Unlock()
+
+	c.metricsAPI.ObserveRateLimit(operation, retryAfter)
+	log.WithField("operation", operation).WithField("retryAfter", retryAfter).
+		Warning("Azure API request was throttled, delaying further API calls")
+}
+
+// throttleDelay blocks until the backoff window requested by the ARM API has
+// passed or the context is cancelled
+func (c *Client) throttleDelay(ctx context.Context) {
+	c.throttle.RLock()
+	delay := time.Until(c.throttle.retryAfter)
+	c.throttle.RUnlock()
+
+	if delay <= 0 {
+		return
+	}
+
+	select {
+	case <-time.After(delay):
+	case <-ctx.Done():
+	}
+}
+
 // describeNetworkInterfaces lists all Azure Interfaces in the client's resource group
 func (c *Client) describeNetworkInterfaces(ctx context.Context) ([]network.Interface, error) {
 	networkInterfaces, err := c.vmssNetworkInterfaces(ctx)
@@ -135,10 +303,12 @@ func (c *Client) describeNetworkInterfaces(ctx context.Context) ([]network.Inter
 func (c *Client) vmNetworkInterfaces(ctx context.Context) ([]network.Interface, error) {
 	var networkInterfaces []network.Interface
 
+	c.throttleDelay(ctx)
 	c.limiter.Limit(ctx, "Interfaces.ListComplete")
 	sinceStart := spanstat.Start()
 	result, err := c.interfaces.ListComplete(ctx, c.resourceGroup)
 	c.metricsAPI.ObserveAPICall("Interfaces.ListComplete", deriveStatus(err), sinceStart.Seconds())
+	c.observeThrottle("Interfaces.ListComplete", err)
 	if err != nil {
 		return nil, err
 	}
@@ -164,10 +334,12 @@ func (c *Client) vmNetworkInterfaces(ctx context.Context) ([]network.Interface,
 func (c *Client) vmssNetworkInterfaces(ctx context.Context) ([]network.Interface, error) {
 	var networkInterfaces []network.Interface
 
+	c.throttleDelay(ctx)
 	c.limiter.Limit(ctx, "VirtualMachineScaleSets.ListAll")
 	sinceStart := spanstat.Start()
 	result, err := c.vmscalesets.ListComplete(ctx, c.resourceGroup)
 	c.metricsAPI.ObserveAPICall("VirtualMachineScaleSets.ListAll", deriveStatus(err), sinceStart.Seconds())
+	c.observeThrottle("VirtualMachineScaleSets.ListAll", err)
 	if err != nil {
 		return nil, err
 	}
@@ -184,10 +356,12 @@ func (c *Client) vmssNetworkInterfaces(ctx context.Context) ([]network.Interface
 			continue
 		}
 
+		c.throttleDelay(ctx)
 		c.limiter.Limit(ctx, "Interfaces.ListAll")
 		sinceStart := spanstat.Start()
 		result2, err2 := c.interfaces.ListVirtualMachineScaleSetNetworkInterfacesComplete(ctx, c.resourceGroup, *scaleset.Name)
 		c.metricsAPI.ObserveAPICall("Interfaces.ListVirtualMachineScaleSetNetworkInterfacesComplete", deriveStatus(err2), sinceStart.Seconds())
+		c.observeThrottle("Interfaces.ListVirtualMachineScaleSetNetworkInterfacesComplete", err2)
 		if err2 != nil {
 			return nil, err2
 		}
@@ -233,6 +407,8 @@ func parseInterface(iface *network.Interface, subnets ipamTypes.SubnetMap, usePr
 		}
 	}
 
+	i.Accelerated = to.Bool(iface.EnableAcceleratedNetworking)
+
 	if iface.IPConfigurations != nil {
 		for _, ip := range *iface.IPConfigurations {
 			if !usePrimary && ip.Primary != nil && *ip.Primary {
@@ -294,11 +470,13 @@ func (c *Client) GetInstances(ctx context.Context, subnets ipamTypes.SubnetMap)
 
 // describeVpcs lists all VPCs
 func (c *Client) describeVpcs(ctx context.Context) ([]network.VirtualNetwork, error) {
+	c.throttleDelay(ctx)
 	c.limiter.Limit(ctx, "VirtualNetworks.List")
 
 	sinceStart := spanstat.Start()
 	result, err := c.virtualnetworks.ListAllComplete(ctx)
 	c.metricsAPI.ObserveAPICall("virtualnetworks.ListAll", deriveStatus(err), sinceStart.Seconds())
+	c.observeThrottle("virtualnetworks.ListAll", err)
 	if err != nil {
 		return nil, err
 	}
@@ -354,12 +532,18 @@ func (c *Client) GetVpcsAndSubnets(ctx context.Context) (ipamTypes.VirtualNetwor
 		vpc := &ipamTypes.VirtualNetwork{ID: *v.ID}
 		vpcs[vpc.ID] = vpc
 
+		// Deployments which dedicate virtual networks to availability
+		// zones can assign the zone of all subnets via a tag on the
+		// virtual network.
+		availabilityZone := to.String(v.Tags[availabilityZoneTag])
+
 		if v.Subnets != nil {
 			for _, subnet := range *v.Subnets {
 				if subnet.ID == nil {
 					continue
 				}
 				if s := parseSubnet(&subnet); s != nil {
+					s.AvailabilityZone = availabilityZone
 					subnets[*subnet.ID] = s
 				}
 			}
@@ -373,12 +557,32 @@ func generateIpConfigName() string {
 	return rand.RandomStringWithPrefix("Cilium-", 8)
 }
 
+// auditMutation emits a structured log entry for an attempted ARM mutation.
+// The request ID assigned by ARM allows correlating the mutation with the
+// Azure activity log during incident reviews.
+func (c *Client) auditMutation(operation string, fields logrus.Fields, resp *http.Response, err error) {
+	fields["operation"] = operation
+	fields["dryRun"] = c.dryRun
+	if resp != nil {
+		fields["requestID"] = resp.Header.Get("x-ms-request-id")
+	}
+
+	scopedLog := log.WithFields(fields)
+	if err != nil {
+		scopedLog.WithError(err).Warning("Azure API mutation failed")
+	} else {
+		scopedLog.Info("Azure API mutation")
+	}
+}
+
 // AssignPrivateIpAddressesVMSS assign a private IP to an interface attached to a VMSS instance
 func (c *Client) AssignPrivateIpAddressesVMSS(ctx context.Context, instanceID, vmssName, subnetID, interfaceName string, addresses int) error {
 	var netIfConfig *compute.VirtualMachineScaleSetNetworkConfiguration
 
+	c.throttleDelay(ctx)
 	c.limiter.Limit(ctx, "VirtualMachineScaleSetVMs.Get")
 	result, err := c.vmss.Get(ctx, c.resourceGroup, vmssName, instanceID, compute.InstanceViewTypesInstanceView)
+	c.observeThrottle("VirtualMachineScaleSetVMs.Get", err)
 	if err != nil {
 		return fmt.Errorf("failed to get VM %s from VMSS %s: %s", instanceID, vmssName, err)
 	}
@@ -432,13 +636,31 @@ func (c *Client) AssignPrivateIpAddressesVMSS(ctx context.Context, instanceID, v
 		result.StorageProfile.ImageReference = nil
 	}
 
+	auditFields := logrus.Fields{
+		"vmssName":   vmssName,
+		"instanceID": instanceID,
+		"interface":  interfaceName,
+		"subnetID":   subnetID,
+		"addresses":  addresses,
+	}
+
+	if c.dryRun {
+		c.auditMutation("VirtualMachineScaleSetVMs.Update", auditFields, nil, nil)
+		return nil
+	}
+
+	c.throttleDelay(ctx)
 	c.limiter.Limit(ctx, "VirtualMachineScaleSetVMs.Update")
 	future, err := c.vmss.Update(ctx, c.resourceGroup, vmssName, instanceID, result)
+	c.observeThrottle("VirtualMachineScaleSetVMs.Update", err)
 	if err != nil {
+		c.auditMutation("VirtualMachineScaleSetVMs.Update", auditFields, nil, err)
 		return fmt.Errorf("unable to update virtualmachinescaleset: %s", err)
 	}
 
-	if err := future.WaitForCompletionRef(ctx, c.vmss.Client); err != nil {
+	err = future.WaitForCompletionRef(ctx, c.vmss.Client)
+	c.auditMutation("VirtualMachineScaleSetVMs.Update", auditFields, future.Response(), err)
+	if err != nil {
 		return fmt.Errorf("error while waiting for virtualmachinescalesets.Update() to complete: %s", err)
 	}
 
@@ -447,8 +669,10 @@ func (c *Client) AssignPrivateIpAddressesVMSS(ctx context.Context, instanceID, v
 
 // AssignPrivateIpAddressesVM assign a private IP to an interface attached to a standalone instance
 func (c *Client) AssignPrivateIpAddressesVM(ctx context.Context, subnetID, interfaceName string, addresses int) error {
+	c.throttleDelay(ctx)
 	c.limiter.Limit(ctx, "Interfaces.Get")
 	iface, err := c.interfaces.Get(ctx, c.resourceGroup, interfaceName, "")
+	c.observeThrottle("Interfaces.Get", err)
 	if err != nil {
 		return fmt.Errorf("failed to get standalone instance's interface %s: %s", interfaceName, err)
 	}
@@ -478,13 +702,29 @@ func (c *Client) AssignPrivateIpAddressesVM(ctx context.Context, subnetID, inter
 	ipConfigurations = append(*iface.IPConfigurations, ipConfigurations...)
 	iface.IPConfigurations = &ipConfigurations
 
+	auditFields := logrus.Fields{
+		"interface": interfaceName,
+		"subnetID":  subnetID,
+		"addresses": addresses,
+	}
+
+	if c.dryRun {
+		c.auditMutation("Interfaces.CreateOrUpdate", auditFields, nil, nil)
+		return nil
+	}
+
+	c.throttleDelay(ctx)
 	c.limiter.Limit(ctx, "Interfaces.CreateOrUpdate")
 	future, err := c.interfaces.CreateOrUpdate(ctx, c.resourceGroup, interfaceName, iface)
+	c.observeThrottle("Interfaces.CreateOrUpdate", err)
 	if err != nil {
+		c.auditMutation("Interfaces.CreateOrUpdate", auditFields, nil, err)
 		return fmt.Errorf("unable to update interface %s: %s", interfaceName, err)
 	}
 
-	if err := future.WaitForCompletionRef(ctx, c.interfaces.Client); err != nil {
+	err = future.WaitForCompletionRef(ctx, c.interfaces.Client)
+	c.auditMutation("Interfaces.CreateOrUpdate", auditFields, future.Response(), err)
+	if err != nil {
 		return fmt.Errorf("error while waiting for interface.CreateOrUpdate() to complete for %s: %s", interfaceName, err)
 	}
 
@@ -59,13 +59,42 @@ func (m *InstancesManager) GetPoolQuota() (quota ipamTypes.PoolQuotaMap) {
 	pool := ipamTypes.PoolQuotaMap{}
 	for subnetID, subnet := range m.subnets {
 		pool[ipamTypes.PoolID(subnetID)] = ipamTypes.PoolQuota{
-			AvailableIPs: subnet.AvailableAddresses,
+			AvailabilityZone: subnet.AvailabilityZone,
+			AvailableIPs:     subnet.AvailableAddresses,
 		}
 	}
 	m.mutex.RUnlock()
 	return pool
 }
 
+// allowedSubnetsLocked returns the subnets from which IPs may be allocated
+// according to the given list of subnet names and the availability zone of
+// the node. If the list is empty, all subnets are eligible by name. Subnets
+// without an availability zone are eligible regardless of the zone of the
+// node. The caller must hold m.mutex for reading.
+func (m *InstancesManager) allowedSubnetsLocked(subnetNames []string, availabilityZone string) ipamTypes.SubnetMap {
+	allowed := ipamTypes.SubnetMap{}
+	for id, subnet := range m.subnets {
+		if availabilityZone != "" && subnet.AvailabilityZone != "" && subnet.AvailabilityZone != availabilityZone {
+			continue
+		}
+
+		if len(subnetNames) == 0 {
+			allowed[id] = subnet
+			continue
+		}
+
+		for _, name := range subnetNames {
+			if subnet.Name == name {
+				allowed[id] = subnet
+				break
+			}
+		}
+	}
+
+	return allowed
+}
+
 // Resync fetches the list of EC2 instances and subnets and updates the local
 // cache in the instanceManager. It returns the time when the resync has
 // started or time.Time{} if it did not complete.
@@ -90,6 +119,23 @@ func (m *InstancesManager) Resync(ctx context.Context) time.Time {
 		"numSubnets":         len(subnets),
 	}).Info("Synchronized Azure IPAM information")
 
+	// Log instances which have disappeared, e.g. due to VMSS scale-in.
+	// Their stale interfaces are dropped with the update of the instances
+	// map below and the CiliumNode status of the corresponding nodes is
+	// cleared on the next node resync.
+	goneInstances := map[string]struct{}{}
+	m.mutex.RLock()
+	m.instances.ForeachInterface("", func(instanceID, interfaceID string, rev ipamTypes.InterfaceRevision) error {
+		if !instances.Exists(instanceID) {
+			goneInstances[instanceID] = struct{}{}
+		}
+		return nil
+	})
+	m.mutex.RUnlock()
+	for instanceID := range goneInstances {
+		log.WithField("instanceID", instanceID).Info("Instance has disappeared, releasing its interfaces")
+	}
+
 	m.mutex.Lock()
 	m.instances = instances
 	m.vnets = vnets
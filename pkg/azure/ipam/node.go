@@ -9,6 +9,7 @@ import (
 
 	"github.com/sirupsen/logrus"
 
+	"github.com/cilium/cilium/pkg/azure/limits"
 	"github.com/cilium/cilium/pkg/azure/types"
 	"github.com/cilium/cilium/pkg/defaults"
 	"github.com/cilium/cilium/pkg/ipam"
@@ -64,6 +65,7 @@ func (n *Node) ReleaseIPs(ctx context.Context, r *ipam.ReleaseAction) error {
 func (n *Node) PrepareIPAllocation(scopedLog *logrus.Entry) (a *ipam.AllocationAction, err error) {
 	a = &ipam.AllocationAction{}
 	requiredIfaceName := n.k8sObj.Spec.Azure.InterfaceName
+	pickedAccelerated := false
 	n.manager.mutex.RLock()
 	defer n.manager.mutex.RUnlock()
 	err = n.manager.instances.ForeachInterface(n.node.InstanceID(), func(instanceID, interfaceID string, interfaceObj ipamTypes.InterfaceRevision) error {
@@ -79,7 +81,10 @@ func (n *Node) PrepareIPAllocation(scopedLog *logrus.Entry) (a *ipam.AllocationA
 
 		a.InterfaceCandidates++
 
-		if a.InterfaceID == "" {
+		// Prefer interfaces with accelerated networking enabled, i.e. a
+		// previously picked non-accelerated interface is replaced by an
+		// accelerated candidate.
+		if a.InterfaceID == "" || (iface.Accelerated && !pickedAccelerated) {
 			scopedLog.WithFields(logrus.Fields{
 				"id":                   iface.ID,
 				"availableOnInterface": availableOnInterface,
@@ -92,7 +97,8 @@ func (n *Node) PrepareIPAllocation(scopedLog *logrus.Entry) (a *ipam.AllocationA
 				}
 			}
 
-			poolID, available := n.manager.subnets.FirstSubnetWithAvailableAddresses(preferredPoolIDs)
+			subnets := n.manager.allowedSubnetsLocked(n.k8sObj.Spec.Azure.SubnetNames, n.k8sObj.Spec.Azure.AvailabilityZone)
+			poolID, available := subnets.FirstSubnetWithAvailableAddresses(preferredPoolIDs)
 			if poolID != ipamTypes.PoolNotExists {
 				scopedLog.WithFields(logrus.Fields{
 					"subnetID":           poolID,
@@ -103,6 +109,7 @@ func (n *Node) PrepareIPAllocation(scopedLog *logrus.Entry) (a *ipam.AllocationA
 				a.Interface = interfaceObj
 				a.PoolID = poolID
 				a.AvailableForAllocation = math.IntMin(available, availableOnInterface)
+				pickedAccelerated = iface.Accelerated
 			}
 		}
 		return nil
@@ -126,8 +133,19 @@ func (n *Node) AllocateIPs(ctx context.Context, a *ipam.AllocationAction) error
 }
 
 // CreateInterface is called to create a new interface. This operation is
-// currently not supported on Azure.
+// currently not supported on Azure. If the VM size of the node is known, a
+// request exceeding the interface limit of the VM size is reported as such
+// instead.
 func (n *Node) CreateInterface(ctx context.Context, allocation *ipam.AllocationAction, scopedLog *logrus.Entry) (int, string, error) {
+	if n.k8sObj != nil && n.k8sObj.Spec.Azure.InstanceType != "" {
+		if limit, ok := limits.Get(n.k8sObj.Spec.Azure.InstanceType); ok {
+			if len(n.k8sObj.Status.Azure.Interfaces) >= limit.Adapters {
+				return 0, "", fmt.Errorf("VM size %s does not support more than %d interfaces",
+					n.k8sObj.Spec.Azure.InstanceType, limit.Adapters)
+			}
+		}
+	}
+
 	return 0, "", fmt.Errorf("not implemented")
 }
 
@@ -141,6 +159,16 @@ func (n *Node) ResyncInterfacesAndIPs(ctx context.Context, scopedLog *logrus.Ent
 	available = ipamTypes.AllocationMap{}
 	n.manager.mutex.RLock()
 	defer n.manager.mutex.RUnlock()
+
+	// The instance may have disappeared, e.g. due to VMSS scale-in. Its
+	// interfaces are gone, release all IPs from the IP pool immediately
+	// instead of serving stale interface information until the instance is
+	// rediscovered.
+	if !n.manager.instances.Exists(n.node.InstanceID()) {
+		scopedLog.Info("Instance has disappeared, releasing all IPs from the IP pool of the node")
+		return available, 0, nil
+	}
+
 	err = n.manager.instances.ForeachAddress(n.node.InstanceID(), func(instanceID, interfaceID, ip, poolID string, addressObj ipamTypes.Address) error {
 		address, ok := addressObj.(types.AzureAddress)
 		if !ok {
@@ -185,6 +213,14 @@ func (n *Node) ResyncInterfacesAndIPs(ctx context.Context, scopedLog *logrus.Ent
 // GetMaximumAllocatableIPv4 returns the maximum amount of IPv4 addresses
 // that can be allocated to the instance
 func (n *Node) GetMaximumAllocatableIPv4() int {
+	// If the VM size of the node is known, derive the maximum from the
+	// interface limits of the VM size
+	if n.k8sObj != nil && n.k8sObj.Spec.Azure.InstanceType != "" {
+		if limit, ok := limits.Get(n.k8sObj.Spec.Azure.InstanceType); ok {
+			return limit.Adapters * limit.IPv4
+		}
+	}
+
 	// An Azure node can allocate up to 256 private IP addresses
 	// source: https://github.com/MicrosoftDocs/azure-docs/blob/master/includes/azure-virtual-network-limits.md#networking-limits---azure-resource-manager
 	return types.InterfaceAddressLimit
@@ -0,0 +1,135 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package limits
+
+import (
+	"context"
+	"sync"
+
+	azureAPI "github.com/cilium/cilium/pkg/azure/api"
+	"github.com/cilium/cilium/pkg/azure/types"
+	ipamTypes "github.com/cilium/cilium/pkg/ipam/types"
+	"github.com/cilium/cilium/pkg/lock"
+)
+
+var limitsOnce sync.Once
+
+// limit contains limits for the interface count per VM size. Each interface
+// can hold up to types.InterfaceAddressLimit addresses regardless of the VM
+// size.
+//
+// Source: https://learn.microsoft.com/en-us/azure/virtual-machines/sizes
+var limits struct {
+	lock.RWMutex
+	m map[string]ipamTypes.Limits
+
+	// accelerated tracks whether a VM size supports accelerated
+	// networking. It is only populated via UpdateFromAPI().
+	accelerated map[string]bool
+}
+
+func populateStaticVMLimits() {
+	limits.accelerated = map[string]bool{}
+	limits.m = map[string]ipamTypes.Limits{
+		"Standard_A1_v2":   {Adapters: 2, IPv4: types.InterfaceAddressLimit, IPv6: types.InterfaceAddressLimit},
+		"Standard_A2_v2":   {Adapters: 2, IPv4: types.InterfaceAddressLimit, IPv6: types.InterfaceAddressLimit},
+		"Standard_A4_v2":   {Adapters: 4, IPv4: types.InterfaceAddressLimit, IPv6: types.InterfaceAddressLimit},
+		"Standard_A8_v2":   {Adapters: 8, IPv4: types.InterfaceAddressLimit, IPv6: types.InterfaceAddressLimit},
+		"Standard_B1ms":    {Adapters: 2, IPv4: types.InterfaceAddressLimit, IPv6: types.InterfaceAddressLimit},
+		"Standard_B1s":     {Adapters: 2, IPv4: types.InterfaceAddressLimit, IPv6: types.InterfaceAddressLimit},
+		"Standard_B2ms":    {Adapters: 3, IPv4: types.InterfaceAddressLimit, IPv6: types.InterfaceAddressLimit},
+		"Standard_B2s":     {Adapters: 3, IPv4: types.InterfaceAddressLimit, IPv6: types.InterfaceAddressLimit},
+		"Standard_B4ms":    {Adapters: 4, IPv4: types.InterfaceAddressLimit, IPv6: types.InterfaceAddressLimit},
+		"Standard_B8ms":    {Adapters: 4, IPv4: types.InterfaceAddressLimit, IPv6: types.InterfaceAddressLimit},
+		"Standard_D16s_v3": {Adapters: 8, IPv4: types.InterfaceAddressLimit, IPv6: types.InterfaceAddressLimit},
+		"Standard_D16s_v4": {Adapters: 8, IPv4: types.InterfaceAddressLimit, IPv6: types.InterfaceAddressLimit},
+		"Standard_D16s_v5": {Adapters: 8, IPv4: types.InterfaceAddressLimit, IPv6: types.InterfaceAddressLimit},
+		"Standard_D2s_v3":  {Adapters: 2, IPv4: types.InterfaceAddressLimit, IPv6: types.InterfaceAddressLimit},
+		"Standard_D2s_v4":  {Adapters: 2, IPv4: types.InterfaceAddressLimit, IPv6: types.InterfaceAddressLimit},
+		"Standard_D2s_v5":  {Adapters: 2, IPv4: types.InterfaceAddressLimit, IPv6: types.InterfaceAddressLimit},
+		"Standard_D32s_v3": {Adapters: 8, IPv4: types.InterfaceAddressLimit, IPv6: types.InterfaceAddressLimit},
+		"Standard_D32s_v4": {Adapters: 8, IPv4: types.InterfaceAddressLimit, IPv6: types.InterfaceAddressLimit},
+		"Standard_D32s_v5": {Adapters: 8, IPv4: types.InterfaceAddressLimit, IPv6: types.InterfaceAddressLimit},
+		"Standard_D48s_v4": {Adapters: 8, IPv4: types.InterfaceAddressLimit, IPv6: types.InterfaceAddressLimit},
+		"Standard_D48s_v5": {Adapters: 8, IPv4: types.InterfaceAddressLimit, IPv6: types.InterfaceAddressLimit},
+		"Standard_D4s_v3":  {Adapters: 2, IPv4: types.InterfaceAddressLimit, IPv6: types.InterfaceAddressLimit},
+		"Standard_D4s_v4":  {Adapters: 2, IPv4: types.InterfaceAddressLimit, IPv6: types.InterfaceAddressLimit},
+		"Standard_D4s_v5":  {Adapters: 2, IPv4: types.InterfaceAddressLimit, IPv6: types.InterfaceAddressLimit},
+		"Standard_D64s_v3": {Adapters: 8, IPv4: types.InterfaceAddressLimit, IPv6: types.InterfaceAddressLimit},
+		"Standard_D64s_v4": {Adapters: 8, IPv4: types.InterfaceAddressLimit, IPv6: types.InterfaceAddressLimit},
+		"Standard_D64s_v5": {Adapters: 8, IPv4: types.InterfaceAddressLimit, IPv6: types.InterfaceAddressLimit},
+		"Standard_D8s_v3":  {Adapters: 4, IPv4: types.InterfaceAddressLimit, IPv6: types.InterfaceAddressLimit},
+		"Standard_D8s_v4":  {Adapters: 4, IPv4: types.InterfaceAddressLimit, IPv6: types.InterfaceAddressLimit},
+		"Standard_D8s_v5":  {Adapters: 4, IPv4: types.InterfaceAddressLimit, IPv6: types.InterfaceAddressLimit},
+		"Standard_E16s_v3": {Adapters: 8, IPv4: types.InterfaceAddressLimit, IPv6: types.InterfaceAddressLimit},
+		"Standard_E16s_v4": {Adapters: 8, IPv4: types.InterfaceAddressLimit, IPv6: types.InterfaceAddressLimit},
+		"Standard_E2s_v3":  {Adapters: 2, IPv4: types.InterfaceAddressLimit, IPv6: types.InterfaceAddressLimit},
+		"Standard_E2s_v4":  {Adapters: 2, IPv4: types.InterfaceAddressLimit, IPv6: types.InterfaceAddressLimit},
+		"Standard_E32s_v3": {Adapters: 8, IPv4: types.InterfaceAddressLimit, IPv6: types.InterfaceAddressLimit},
+		"Standard_E32s_v4": {Adapters: 8, IPv4: types.InterfaceAddressLimit, IPv6: types.InterfaceAddressLimit},
+		"Standard_E4s_v3":  {Adapters: 2, IPv4: types.InterfaceAddressLimit, IPv6: types.InterfaceAddressLimit},
+		"Standard_E4s_v4":  {Adapters: 2, IPv4: types.InterfaceAddressLimit, IPv6: types.InterfaceAddressLimit},
+		"Standard_E64s_v3": {Adapters: 8, IPv4: types.InterfaceAddressLimit, IPv6: types.InterfaceAddressLimit},
+		"Standard_E64s_v4": {Adapters: 8, IPv4: types.InterfaceAddressLimit, IPv6: types.InterfaceAddressLimit},
+		"Standard_E8s_v3":  {Adapters: 4, IPv4: types.InterfaceAddressLimit, IPv6: types.InterfaceAddressLimit},
+		"Standard_E8s_v4":  {Adapters: 4, IPv4: types.InterfaceAddressLimit, IPv6: types.InterfaceAddressLimit},
+		"Standard_F16s_v2": {Adapters: 4, IPv4: types.InterfaceAddressLimit, IPv6: types.InterfaceAddressLimit},
+		"Standard_F2s_v2":  {Adapters: 2, IPv4: types.InterfaceAddressLimit, IPv6: types.InterfaceAddressLimit},
+		"Standard_F32s_v2": {Adapters: 8, IPv4: types.InterfaceAddressLimit, IPv6: types.InterfaceAddressLimit},
+		"Standard_F4s_v2":  {Adapters: 2, IPv4: types.InterfaceAddressLimit, IPv6: types.InterfaceAddressLimit},
+		"Standard_F64s_v2": {Adapters: 8, IPv4: types.InterfaceAddressLimit, IPv6: types.InterfaceAddressLimit},
+		"Standard_F72s_v2": {Adapters: 8, IPv4: types.InterfaceAddressLimit, IPv6: types.InterfaceAddressLimit},
+		"Standard_F8s_v2":  {Adapters: 4, IPv4: types.InterfaceAddressLimit, IPv6: types.InterfaceAddressLimit},
+		"Standard_DS1_v2":  {Adapters: 2, IPv4: types.InterfaceAddressLimit, IPv6: types.InterfaceAddressLimit},
+		"Standard_DS2_v2":  {Adapters: 2, IPv4: types.InterfaceAddressLimit, IPv6: types.InterfaceAddressLimit},
+		"Standard_DS3_v2":  {Adapters: 4, IPv4: types.InterfaceAddressLimit, IPv6: types.InterfaceAddressLimit},
+		"Standard_DS4_v2":  {Adapters: 8, IPv4: types.InterfaceAddressLimit, IPv6: types.InterfaceAddressLimit},
+		"Standard_DS5_v2":  {Adapters: 8, IPv4: types.InterfaceAddressLimit, IPv6: types.InterfaceAddressLimit},
+	}
+}
+
+// Get returns the instance limits of a particular VM size.
+func Get(vmType string) (limit ipamTypes.Limits, ok bool) {
+	limitsOnce.Do(populateStaticVMLimits)
+
+	limits.RLock()
+	limit, ok = limits.m[vmType]
+	limits.RUnlock()
+	return
+}
+
+// AcceleratedNetworking returns whether a particular VM size supports
+// accelerated networking. The second return value indicates whether the
+// capability of the VM size is known.
+func AcceleratedNetworking(vmType string) (enabled bool, ok bool) {
+	limitsOnce.Do(populateStaticVMLimits)
+
+	limits.RLock()
+	enabled, ok = limits.accelerated[vmType]
+	limits.RUnlock()
+	return
+}
+
+// UpdateFromAPI updates limits from the Azure API via calling
+// https://learn.microsoft.com/en-us/rest/api/compute/resource-skus/list.
+func UpdateFromAPI(ctx context.Context, client *azureAPI.Client) error {
+	vmLimits, vmAccelerated, err := client.GetVMSkuLimits(ctx)
+	if err != nil {
+		return err
+	}
+
+	limitsOnce.Do(populateStaticVMLimits)
+
+	limits.Lock()
+	defer limits.Unlock()
+
+	for vmType, limit := range vmLimits {
+		limits.m[vmType] = limit
+	}
+	for vmType, accelerated := range vmAccelerated {
+		limits.accelerated[vmType] = accelerated
+	}
+
+	return nil
+}
@@ -0,0 +1,45 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package limits
+
+import (
+	"testing"
+
+	"gopkg.in/check.v1"
+
+	"github.com/cilium/cilium/pkg/azure/types"
+)
+
+func Test(t *testing.T) {
+	check.TestingT(t)
+}
+
+type AzureLimitsSuite struct{}
+
+var _ = check.Suite(&AzureLimitsSuite{})
+
+func (s *AzureLimitsSuite) TestGet(c *check.C) {
+	_, ok := Get("unknown")
+	c.Assert(ok, check.Equals, false)
+
+	l, ok := Get("Standard_D4s_v3")
+	c.Assert(ok, check.Equals, true)
+	c.Assert(l.Adapters, check.Not(check.Equals), 0)
+	c.Assert(l.IPv4, check.Equals, types.InterfaceAddressLimit)
+}
+
+func (s *AzureLimitsSuite) TestAcceleratedNetworking(c *check.C) {
+	// The capability is only known after syncing with the API
+	enabled, ok := AcceleratedNetworking("Standard_D4s_v3")
+	c.Assert(ok, check.Equals, false)
+	c.Assert(enabled, check.Equals, false)
+
+	limits.Lock()
+	limits.accelerated["Standard_D4s_v3"] = true
+	limits.Unlock()
+
+	enabled, ok = AcceleratedNetworking("Standard_D4s_v3")
+	c.Assert(ok, check.Equals, true)
+	c.Assert(enabled, check.Equals, true)
+}
@@ -40,6 +40,27 @@ type AzureSpec struct {
 	//
 	// +kubebuilder:validation:Optional
 	InterfaceName string `json:"interface-name,omitempty"`
+
+	// InstanceType is the Azure VM size of the node, e.g.
+	// "Standard_D4s_v3". It is used to derive the interface and address
+	// limits of the instance.
+	//
+	// +kubebuilder:validation:Optional
+	InstanceType string `json:"instance-type,omitempty"`
+
+	// SubnetNames is the list of subnet names from which secondary IP
+	// configurations may be allocated. If empty, all subnets of the
+	// virtual network are eligible.
+	//
+	// +kubebuilder:validation:Optional
+	SubnetNames []string `json:"subnet-names,omitempty"`
+
+	// AvailabilityZone is the availability zone of the node. When set,
+	// subnets carrying an availability zone are only considered for
+	// allocation if the zones match.
+	//
+	// +kubebuilder:validation:Optional
+	AvailabilityZone string `json:"availability-zone,omitempty"`
 }
 
 // AzureStatus is the status of Azure addressing of the node.
@@ -89,6 +110,12 @@ type AzureInterface struct {
 	// +optional
 	State string `json:"state,omitempty"`
 
+	// Accelerated reports whether accelerated networking is enabled on
+	// the interface
+	//
+	// +optional
+	Accelerated bool `json:"accelerated,omitempty"`
+
 	// Addresses is the list of all IPs associated with the interface,
 	// including all secondary addresses
 	//
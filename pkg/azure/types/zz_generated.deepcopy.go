@@ -32,6 +32,11 @@ func (in *AzureInterface) DeepCopy() *AzureInterface {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AzureSpec) DeepCopyInto(out *AzureSpec) {
 	*out = *in
+	if in.SubnetNames != nil {
+		in, out := &in.SubnetNames, &out.SubnetNames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -47,6 +47,9 @@ func (in *AzureInterface) DeepEqual(other *AzureInterface) bool {
 	if in.State != other.State {
 		return false
 	}
+	if in.Accelerated != other.Accelerated {
+		return false
+	}
 	if ((in.Addresses != nil) && (other.Addresses != nil)) || ((in.Addresses == nil) != (other.Addresses == nil)) {
 		in, other := &in.Addresses, &other.Addresses
 		if other == nil {
@@ -100,6 +103,31 @@ func (in *AzureSpec) DeepEqual(other *AzureSpec) bool {
 		return false
 	}
 
+	if in.InstanceType != other.InstanceType {
+		return false
+	}
+
+	if ((in.SubnetNames != nil) && (other.SubnetNames != nil)) || ((in.SubnetNames == nil) != (other.SubnetNames == nil)) {
+		in, other := &in.SubnetNames, &other.SubnetNames
+		if other == nil {
+			return false
+		}
+
+		if len(*in) != len(*other) {
+			return false
+		} else {
+			for i, inElement := range *in {
+				if inElement != (*other)[i] {
+					return false
+				}
+			}
+		}
+	}
+
+	if in.AvailabilityZone != other.AvailabilityZone {
+		return false
+	}
+
 	return true
 }
 
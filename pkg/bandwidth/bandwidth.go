@@ -27,6 +27,14 @@ const (
 	EgressBandwidth = "kubernetes.io/egress-bandwidth"
 	// IngressBandwidth is the K8s Pod annotation.
 	IngressBandwidth = "kubernetes.io/ingress-bandwidth"
+
+	// BBRPacing is the K8s Pod annotation to opt the Pod into BBR-based
+	// pacing. Only "enabled" is recognized as a value. It is a no-op if
+	// BBR has already been enabled for the whole node via --enable-bbr.
+	BBRPacing = "io.cilium.bbr"
+	// BBRPacingEnabled is the only recognized value of the BBRPacing
+	// annotation.
+	BBRPacingEnabled = "enabled"
 )
 
 var log = logging.DefaultLogger.WithField(logfields.LogSubsys, subsystem)
@@ -62,17 +70,16 @@ func ProbeBandwidthManager() {
 		option.Config.EnableBandwidthManager = false
 		return
 	}
-	if option.Config.EnableBBR {
-		// We at least need 5.18 kernel for Pod-based BBR TCP congestion
-		// control since earlier kernels just clear the skb->tstamp upon
-		// netns traversal. See also:
-		//
-		// - https://lpc.events/event/11/contributions/953/
-		// - https://lore.kernel.org/bpf/20220302195519.3479274-1-kafai@fb.com/
-		if probes.HaveProgramHelper(ebpf.SchedCLS, asm.FnSkbSetTstamp) != nil {
-			log.Fatalf("Cannot enable --%s, needs kernel 5.18 or newer.",
-				option.EnableBBR)
-		}
+	// We at least need 5.18 kernel for Pod-based BBR TCP congestion
+	// control since earlier kernels just clear the skb->tstamp upon
+	// netns traversal. See also:
+	//
+	// - https://lpc.events/event/11/contributions/953/
+	// - https://lore.kernel.org/bpf/20220302195519.3479274-1-kafai@fb.com/
+	option.Config.BBRSupported = probes.HaveProgramHelper(ebpf.SchedCLS, asm.FnSkbSetTstamp) == nil
+	if option.Config.EnableBBR && !option.Config.BBRSupported {
+		log.Fatalf("Cannot enable --%s, needs kernel 5.18 or newer.",
+			option.EnableBBR)
 	}
 }
 
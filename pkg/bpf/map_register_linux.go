@@ -45,6 +45,31 @@ func GetMap(name string) *Map {
 	return mapRegister[name]
 }
 
+// MapsNearCapacity returns the name and fill ratio of all registered maps
+// whose number of cached entries meets or exceeds the given ratio of their
+// maximum size. Only maps which cache their entries are considered.
+func MapsNearCapacity(threshold float64) map[string]float64 {
+	mutex.RLock()
+	maps := make([]*Map, 0, len(mapRegister))
+	for _, m := range mapRegister {
+		maps = append(maps, m)
+	}
+	mutex.RUnlock()
+
+	result := map[string]float64{}
+	for _, m := range maps {
+		m.lock.RLock()
+		if m.cache != nil && m.MaxEntries > 0 {
+			if ratio := float64(len(m.cache)) / float64(m.MaxEntries); ratio >= threshold {
+				result[m.NonPrefixedName()] = ratio
+			}
+		}
+		m.lock.RUnlock()
+	}
+
+	return result
+}
+
 // GetOpenMaps returns a slice of all open BPF maps. This is identical to
 // calling GetMap() on all open maps.
 func GetOpenMaps() []*models.BPFMap {
@@ -362,6 +362,23 @@ func FormatStatusResponse(w io.Writer, sr *models.StatusResponse, sd StatusDetai
 
 	if sr.Ipam != nil {
 		fmt.Fprintf(w, "IPAM:\t%s\n", sr.Ipam.Status)
+		if sd.AllAddresses && len(sr.Ipam.PoolUsage) > 0 {
+			fmt.Fprintf(w, "Pool usage:\n")
+			families := make([]string, 0, len(sr.Ipam.PoolUsage))
+			for family := range sr.Ipam.PoolUsage {
+				families = append(families, family)
+			}
+			sort.Strings(families)
+			for _, family := range families {
+				usage := sr.Ipam.PoolUsage[family]
+				available := "unknown"
+				if usage.Available >= 0 {
+					available = fmt.Sprintf("%d", usage.Available)
+				}
+				fmt.Fprintf(w, "  %s:\t%d allocated, %s available, %d reserved\n",
+					family, usage.Allocated, available, usage.Reserved)
+			}
+		}
 		if sd.AllAddresses {
 			fmt.Fprintf(w, "Allocated addresses:\n")
 			out := make([]string, 0, len(sr.Ipam.Allocations))
@@ -373,6 +390,12 @@ func FormatStatusResponse(w io.Writer, sr *models.StatusResponse, sd StatusDetai
 				fmt.Fprintln(w, line)
 			}
 		}
+		if len(sr.Ipam.QuarantinedIps) > 0 {
+			fmt.Fprintf(w, "Quarantined addresses:\n")
+			for _, ip := range sr.Ipam.QuarantinedIps {
+				fmt.Fprintf(w, "  %s\n", ip)
+			}
+		}
 	}
 
 	if sr.ClusterMesh != nil {
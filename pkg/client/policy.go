@@ -49,6 +49,16 @@ func (c *Client) PolicyDelete(labels []string) (*models.Policy, error) {
 	return resp.Payload, Hint(err)
 }
 
+// PolicyImpactGet evaluates the impact of the proposed `policyJSON` without importing it
+func (c *Client) PolicyImpactGet(policyJSON string) (*models.PolicyImpactResult, error) {
+	params := policy.NewGetPolicyImpactParams().WithPolicy(policyJSON).WithTimeout(api.ClientTimeout)
+	resp, err := c.Policy.GetPolicyImpact(params)
+	if err != nil {
+		return nil, Hint(err)
+	}
+	return resp.Payload, nil
+}
+
 // PolicyResolveGet resolves policy for a Trace Selector with source and destination identity.
 func (c *Client) PolicyResolveGet(traceSelector *models.TraceSelector) (*models.PolicyTraceResult, error) {
 	params := policy.NewGetPolicyResolveParams().WithTraceSelector(traceSelector).WithTimeout(api.ClientTimeout)
@@ -17,6 +17,7 @@ import (
 	ipcachemap "github.com/cilium/cilium/pkg/maps/ipcache"
 	"github.com/cilium/cilium/pkg/maps/lbmap"
 	"github.com/cilium/cilium/pkg/maps/lxcmap"
+	"github.com/cilium/cilium/pkg/maps/mcastmap"
 	"github.com/cilium/cilium/pkg/maps/metricsmap"
 	"github.com/cilium/cilium/pkg/maps/neighborsmap"
 	"github.com/cilium/cilium/pkg/maps/policymap"
@@ -26,6 +27,7 @@ import (
 	"github.com/cilium/cilium/pkg/maps/srv6map"
 	"github.com/cilium/cilium/pkg/maps/tunnel"
 	"github.com/cilium/cilium/pkg/maps/vtep"
+	"github.com/cilium/cilium/pkg/maps/xdpportmap"
 )
 
 // CheckStructAlignments checks whether size and offsets of the C and Go
@@ -89,6 +91,10 @@ func CheckStructAlignments(path string) error {
 		"lb6_src_range_key":      {reflect.TypeOf(lbmap.SourceRangeKey6{})},
 		"edt_id":                 {reflect.TypeOf(bwmap.EdtId{})},
 		"edt_info":               {reflect.TypeOf(bwmap.EdtInfo{})},
+		"mcast_group_key":        {reflect.TypeOf(mcastmap.McastGroupKey{})},
+		"mcast_group_entry":      {reflect.TypeOf(mcastmap.McastGroupEntry{})},
+		"xdp_port_filter_key":    {reflect.TypeOf(xdpportmap.PortFilterKey{})},
+		"xdp_port_filter_entry":  {reflect.TypeOf(xdpportmap.PortFilterEntry{})},
 		"egress_gw_policy_key":   {reflect.TypeOf(egressmap.EgressPolicyKey4{})},
 		"egress_gw_policy_entry": {reflect.TypeOf(egressmap.EgressPolicyVal4{})},
 		"srv6_vrf_key4":          {reflect.TypeOf(srv6map.VRFKey4{})},
@@ -78,6 +78,10 @@ type CompileTimeConfiguration interface {
 	// GetPolicyVerdictLogFilter returns the PolicyVerdictLogFilter for the endpoint
 	GetPolicyVerdictLogFilter() uint32
 
+	// GetDSCP returns the DSCP code point to set on traffic sent by the
+	// endpoint, zero if traffic is left unmarked
+	GetDSCP() uint8
+
 	// IsHost returns true if the endpoint is the host endpoint.
 	IsHost() bool
 
@@ -0,0 +1,67 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package connector
+
+import (
+	"fmt"
+
+	"github.com/containernetworking/plugins/pkg/ns"
+	"github.com/vishvananda/netlink"
+)
+
+// SetupTapRemoteNs bridges the endpoint interface to a newly created tap
+// device inside the container network namespace so that a VM workload, e.g. a
+// KubeVirt guest, can attach to the Cilium-managed interface. Traffic of the
+// VM traverses the veth pair of the endpoint, policy enforcement keeps
+// happening at the tc hooks on the host side. Returns the names of the
+// created bridge and tap device.
+func SetupTapRemoteNs(netNs ns.NetNS, epIfName string, mtu int) (string, string, error) {
+	bridgeIfName := "br-" + epIfName
+	tapIfName := "tap-" + epIfName
+
+	return bridgeIfName, tapIfName, netNs.Do(func(_ ns.NetNS) error {
+		bridge := &netlink.Bridge{
+			LinkAttrs: netlink.LinkAttrs{
+				Name: bridgeIfName,
+				MTU:  mtu,
+			},
+		}
+		if err := netlink.LinkAdd(bridge); err != nil {
+			return fmt.Errorf("unable to create bridge %q: %s", bridgeIfName, err)
+		}
+
+		tap := &netlink.Tuntap{
+			LinkAttrs: netlink.LinkAttrs{
+				Name: tapIfName,
+				MTU:  mtu,
+			},
+			Mode:  netlink.TUNTAP_MODE_TAP,
+			Flags: netlink.TUNTAP_DEFAULTS | netlink.TUNTAP_MULTI_QUEUE,
+		}
+		if err := netlink.LinkAdd(tap); err != nil {
+			return fmt.Errorf("unable to create tap device %q: %s", tapIfName, err)
+		}
+
+		epLink, err := netlink.LinkByName(epIfName)
+		if err != nil {
+			return fmt.Errorf("unable to lookup endpoint interface %q: %s", epIfName, err)
+		}
+
+		if err := netlink.LinkSetMaster(epLink, bridge); err != nil {
+			return fmt.Errorf("unable to enslave %q to bridge %q: %s", epIfName, bridgeIfName, err)
+		}
+		if err := netlink.LinkSetMaster(tap, bridge); err != nil {
+			return fmt.Errorf("unable to enslave %q to bridge %q: %s", tapIfName, bridgeIfName, err)
+		}
+
+		if err := netlink.LinkSetUp(tap); err != nil {
+			return fmt.Errorf("unable to bring up tap device %q: %s", tapIfName, err)
+		}
+		if err := netlink.LinkSetUp(bridge); err != nil {
+			return fmt.Errorf("unable to bring up bridge %q: %s", bridgeIfName, err)
+		}
+
+		return nil
+	})
+}
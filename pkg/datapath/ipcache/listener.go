@@ -16,6 +16,7 @@ import (
 	"github.com/cilium/cilium/pkg/bpf"
 	"github.com/cilium/cilium/pkg/controller"
 	"github.com/cilium/cilium/pkg/ipcache"
+	"github.com/cilium/cilium/pkg/lock"
 	"github.com/cilium/cilium/pkg/logging"
 	"github.com/cilium/cilium/pkg/logging/logfields"
 	ipcacheMap "github.com/cilium/cilium/pkg/maps/ipcache"
@@ -23,10 +24,32 @@ import (
 	"github.com/cilium/cilium/pkg/node"
 	"github.com/cilium/cilium/pkg/option"
 	"github.com/cilium/cilium/pkg/source"
+	"github.com/cilium/cilium/pkg/trigger"
 )
 
 var log = logging.DefaultLogger.WithField(logfields.LogSubsys, "datapath-ipcache")
 
+const (
+	// flushMinInterval is the minimum interval between two flushes of
+	// pending entries into the BPF map. Updates arriving while a flush is
+	// in progress or within the interval are coalesced into the next
+	// flush, which dampens ipcache churn during large policy or node
+	// events.
+	flushMinInterval = 50 * time.Millisecond
+
+	// flushBatchSize bounds the number of pending entries taken off the
+	// queue at once so that the queue lock is never held for an unbounded
+	// amount of time.
+	flushBatchSize = 1024
+)
+
+// pendingEntry is a queued update of the BPF map. A nil value indicates that
+// the key is to be deleted.
+type pendingEntry struct {
+	key   *ipcacheMap.Key
+	value *ipcacheMap.RemoteEndpointInfo
+}
+
 // datapath is an interface to the datapath implementation, used to apply
 // changes that are made within this module.
 type datapath interface {
@@ -56,6 +79,19 @@ type BPFListener struct {
 	monitorNotify monitorNotify
 
 	ipcache *ipcache.IPCache
+
+	// mutex protects pending. It is never held while updating the BPF
+	// map.
+	mutex lock.Mutex
+
+	// pending are the queued BPF map updates, keyed by the string
+	// representation of the map key so that rapid churn on the same
+	// prefix coalesces into a single map update.
+	pending map[string]pendingEntry
+
+	// flushTrigger serializes and rate-limits the flushing of pending
+	// entries into the BPF map. If nil, updates are applied synchronously.
+	flushTrigger *trigger.Trigger
 }
 
 func newListener(m *ipcacheMap.Map, d datapath, mn monitorNotify, ipc *ipcache.IPCache) *BPFListener {
@@ -69,7 +105,66 @@ func newListener(m *ipcacheMap.Map, d datapath, mn monitorNotify, ipc *ipcache.I
 
 // NewListener returns a new listener to push IPCache entries into BPF maps.
 func NewListener(d datapath, mn monitorNotify, ipc *ipcache.IPCache) *BPFListener {
-	return newListener(ipcacheMap.IPCacheMap(), d, mn, ipc)
+	l := newListener(ipcacheMap.IPCacheMap(), d, mn, ipc)
+	l.pending = map[string]pendingEntry{}
+
+	t, err := trigger.NewTrigger(trigger.Parameters{
+		Name:        "ipcache-bpf-flush",
+		MinInterval: flushMinInterval,
+		TriggerFunc: l.flush,
+	})
+	if err != nil {
+		log.WithError(err).Fatal("Unable to initialize ipcache BPF flush trigger")
+	}
+	l.flushTrigger = t
+
+	return l
+}
+
+// apply performs the queued update of the BPF map.
+func (l *BPFListener) apply(entry pendingEntry) {
+	if entry.value != nil {
+		if err := l.bpfMap.Update(entry.key, entry.value); err != nil {
+			log.WithError(err).WithFields(logrus.Fields{
+				"key":   entry.key.String(),
+				"value": entry.value.String(),
+			}).Warning("unable to update bpf map")
+		}
+	} else {
+		if err := l.bpfMap.DeleteWithOverwrite(entry.key); err != nil {
+			log.WithError(err).WithFields(logrus.Fields{
+				"key": entry.key.String(),
+			}).Warning("unable to delete from bpf map")
+		}
+	}
+}
+
+// flush applies all pending entries to the BPF map. Entries are taken off the
+// queue in bounded batches to avoid holding the queue lock while churn is
+// still ongoing.
+func (l *BPFListener) flush(reasons []string) {
+	for {
+		batch := make([]pendingEntry, 0, flushBatchSize)
+
+		l.mutex.Lock()
+		for k, entry := range l.pending {
+			batch = append(batch, entry)
+			delete(l.pending, k)
+			if len(batch) == flushBatchSize {
+				break
+			}
+		}
+		remaining := len(l.pending)
+		l.mutex.Unlock()
+
+		for _, entry := range batch {
+			l.apply(entry)
+		}
+
+		if remaining == 0 {
+			return
+		}
+	}
 }
 
 func (l *BPFListener) notifyMonitor(modType ipcache.CacheModification,
@@ -139,10 +234,11 @@ func (l *BPFListener) OnIPIdentityCacheChange(modType ipcache.CacheModification,
 	// Update BPF Maps.
 
 	key := ipcacheMap.NewKey(cidr.IP, cidr.Mask)
+	entry := pendingEntry{key: &key}
 
 	switch modType {
 	case ipcache.Upsert:
-		value := ipcacheMap.RemoteEndpointInfo{
+		value := &ipcacheMap.RemoteEndpointInfo{
 			SecurityIdentity: uint32(newID.ID),
 			Key:              encryptKey,
 		}
@@ -151,35 +247,33 @@ func (l *BPFListener) OnIPIdentityCacheChange(modType ipcache.CacheModification,
 			// If the hostIP is specified and it doesn't point to
 			// the local host, then the ipcache should be populated
 			// with the hostIP so that this traffic can be guided
-			// to a tunnel endpoint destination.
+			// to a tunnel endpoint destination. Hosts within the
+			// direct routing node CIDRs are excluded, traffic to
+			// them is passed to the stack for native routing.
 			nodeIPv4 := node.GetIPv4()
-			if ip4 := newHostIP.To4(); ip4 != nil && !ip4.Equal(nodeIPv4) {
+			if ip4 := newHostIP.To4(); ip4 != nil && !ip4.Equal(nodeIPv4) &&
+				!option.Config.DirectRoutingNode(newHostIP) {
 				copy(value.TunnelEndpoint[:], ip4)
 			}
 		}
-		err := l.bpfMap.Update(&key, &value)
-		if err != nil {
-			scopedLog.WithError(err).WithFields(logrus.Fields{
-				"key":                  key.String(),
-				"value":                value.String(),
-				logfields.IPAddr:       cidr,
-				logfields.Identity:     newID,
-				logfields.Modification: modType,
-			}).Warning("unable to update bpf map")
-		}
+		entry.value = value
 	case ipcache.Delete:
-		err := l.bpfMap.DeleteWithOverwrite(&key)
-		if err != nil {
-			scopedLog.WithError(err).WithFields(logrus.Fields{
-				"key":                  key.String(),
-				logfields.IPAddr:       cidr,
-				logfields.Identity:     newID,
-				logfields.Modification: modType,
-			}).Warning("unable to delete from bpf map")
-		}
 	default:
 		scopedLog.Warning("cache modification type not supported")
+		return
 	}
+
+	// Queue and coalesce the update per key if this listener flushes in
+	// batches, otherwise write through to the BPF map directly.
+	if l.flushTrigger != nil {
+		l.mutex.Lock()
+		l.pending[key.String()] = entry
+		l.mutex.Unlock()
+		l.flushTrigger.Trigger()
+		return
+	}
+
+	l.apply(entry)
 }
 
 // updateStaleEntriesFunction returns a DumpCallback that will update the
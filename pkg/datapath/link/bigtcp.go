@@ -0,0 +1,44 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package link
+
+import (
+	"github.com/vishvananda/netlink"
+	"github.com/vishvananda/netlink/nl"
+	"golang.org/x/sys/unix"
+)
+
+const (
+	// iflaGSOIPv4MaxSize and iflaGROIPv4MaxSize are the IFLA_GSO_IPV4_MAX_SIZE
+	// and IFLA_GRO_IPV4_MAX_SIZE netlink attributes used to configure IPv4
+	// BIG TCP, added in kernel 6.3. The vendored netlink library does not
+	// know them yet, so the requests are constructed by hand below.
+	iflaGSOIPv4MaxSize = 0x3f
+	iflaGROIPv4MaxSize = 0x40
+)
+
+func linkSetU32Attr(link netlink.Link, attrType int, value uint32) error {
+	req := nl.NewNetlinkRequest(unix.RTM_SETLINK, unix.NLM_F_ACK)
+
+	msg := nl.NewIfInfomsg(unix.AF_UNSPEC)
+	msg.Index = int32(link.Attrs().Index)
+	req.AddData(msg)
+
+	req.AddData(nl.NewRtAttr(attrType, nl.Uint32Attr(value)))
+
+	_, err := req.Execute(unix.NETLINK_ROUTE, 0)
+	return err
+}
+
+// SetGSOIPv4MaxSize sets the IPv4 GSO maximum size of the link device.
+// Equivalent to: `ip link set $link gso_ipv4_max_size $maxSize`
+func SetGSOIPv4MaxSize(link netlink.Link, maxSize int) error {
+	return linkSetU32Attr(link, iflaGSOIPv4MaxSize, uint32(maxSize))
+}
+
+// SetGROIPv4MaxSize sets the IPv4 GRO maximum size of the link device.
+// Equivalent to: `ip link set $link gro_ipv4_max_size $maxSize`
+func SetGROIPv4MaxSize(link netlink.Link, maxSize int) error {
+	return linkSetU32Attr(link, iflaGROIPv4MaxSize, uint32(maxSize))
+}
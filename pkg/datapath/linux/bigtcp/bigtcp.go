@@ -8,6 +8,7 @@ import (
 	"github.com/cilium/ebpf/asm"
 	"github.com/vishvananda/netlink"
 
+	"github.com/cilium/cilium/pkg/datapath/link"
 	"github.com/cilium/cilium/pkg/datapath/linux/probes"
 	datapathOption "github.com/cilium/cilium/pkg/datapath/option"
 	"github.com/cilium/cilium/pkg/option"
@@ -19,11 +20,13 @@ const (
 
 	bigTCPGROMaxSize = 196608
 	bigTCPGSOMaxSize = 196608
+
+	probeDevice = "lo"
 )
 
 // Configuration is a BIG TCP configuration as returned by NewConfiguration
 type Configuration struct {
-	// gsoMaxSize is the GSO maximum size used when configuring devices
+	// gsoMaxSize is the IPv6 GSO maximum size used when configuring devices
 	//
 	// Note that this is a singleton for the process including this
 	// package. This means, for instance, that when using this from the
@@ -31,13 +34,23 @@ type Configuration struct {
 	// configured inside the ``daemon/``.
 	gsoMaxSize int
 
-	// groMaxSize is the GRO maximum size used when configuring devices
+	// groMaxSize is the IPv6 GRO maximum size used when configuring devices
 	//
 	// Note that this is a singleton for the process including this
 	// package. This means, for instance, that when using this from the
 	// ``pkg/plugins/*`` sources, it will not respect the settings
 	// configured inside the ``daemon/``.
 	groMaxSize int
+
+	// gsoIPv4MaxSize is the IPv4 GSO maximum size used when configuring
+	// devices. It stays zero on kernels without IPv4 BIG TCP support
+	// (6.3), in which case the IPv4 sizes are left untouched.
+	gsoIPv4MaxSize int
+
+	// groIPv4MaxSize is the IPv4 GRO maximum size used when configuring
+	// devices. It stays zero on kernels without IPv4 BIG TCP support
+	// (6.3), in which case the IPv4 sizes are left untouched.
+	groIPv4MaxSize int
 }
 
 func (c *Configuration) GetGROMaxSize() int {
@@ -48,6 +61,26 @@ func (c *Configuration) GetGSOMaxSize() int {
 	return c.gsoMaxSize
 }
 
+func (c *Configuration) GetGROIPv4MaxSize() int {
+	return c.groIPv4MaxSize
+}
+
+func (c *Configuration) GetGSOIPv4MaxSize() int {
+	return c.gsoIPv4MaxSize
+}
+
+// haveIPv4MaxSize probes whether the kernel knows the IPv4 BIG TCP link
+// attributes (kernel 6.3) by re-applying the default size on the loopback
+// device, which is a no-op on supported kernels.
+func haveIPv4MaxSize() bool {
+	lo, err := netlink.LinkByName(probeDevice)
+	if err != nil {
+		return false
+	}
+
+	return link.SetGSOIPv4MaxSize(lo, defaultGSOMaxSize) == nil
+}
+
 // if an error is returned the caller is responsible for rolling back
 // any partial changes
 func setGROGSOMaxSize(device string, GROMaxSize, GSOMaxSize int) error {
@@ -76,6 +109,23 @@ func setGROGSOMaxSize(device string, GROMaxSize, GSOMaxSize int) error {
 	return netlink.LinkSetGSOMaxSize(link, GSOMaxSize)
 }
 
+// if an error is returned the caller is responsible for rolling back
+// any partial changes
+func setGROGSOIPv4MaxSize(device string, GROMaxSize, GSOMaxSize int) error {
+	l, err := netlink.LinkByName(device)
+	if err != nil {
+		log.WithError(err).WithField("device", device).Warn("Link does not exist")
+		return nil
+	}
+
+	err = link.SetGROIPv4MaxSize(l, GROMaxSize)
+	if err != nil {
+		return err
+	}
+
+	return link.SetGSOIPv4MaxSize(l, GSOMaxSize)
+}
+
 func InitBIGTCP(bigTCPConfig *Configuration) {
 	var err error
 
@@ -84,9 +134,10 @@ func InitBIGTCP(bigTCPConfig *Configuration) {
 	}
 
 	if len(option.Config.GetDevices()) == 0 {
-		if option.Config.EnableIPv6BIGTCP {
-			log.Warn("IPv6 BIG TCP could not detect host devices. Disabling the feature.")
+		if option.Config.EnableIPv4BIGTCP || option.Config.EnableIPv6BIGTCP {
+			log.Warn("BIG TCP could not detect host devices. Disabling the feature.")
 		}
+		option.Config.EnableIPv4BIGTCP = false
 		option.Config.EnableIPv6BIGTCP = false
 		return
 	}
@@ -97,29 +148,45 @@ func InitBIGTCP(bigTCPConfig *Configuration) {
 				option.EnableIPv6BIGTCP)
 		}
 		option.Config.EnableIPv6BIGTCP = false
-		return
+	}
+
+	haveIPv4 := haveIPv4MaxSize()
+	if option.Config.EnableIPv4BIGTCP && !haveIPv4 {
+		log.Warnf("Cannot enable --%s, needs kernel 6.3 or newer",
+			option.EnableIPv4BIGTCP)
+		option.Config.EnableIPv4BIGTCP = false
 	}
 
 	disableMsg := ""
 	bigTCPConfig.groMaxSize = defaultGROMaxSize
 	bigTCPConfig.gsoMaxSize = defaultGSOMaxSize
-	if option.Config.EnableIPv6BIGTCP {
+	if haveIPv4 {
+		bigTCPConfig.groIPv4MaxSize = defaultGROMaxSize
+		bigTCPConfig.gsoIPv4MaxSize = defaultGSOMaxSize
+	}
+	if option.Config.EnableIPv4BIGTCP || option.Config.EnableIPv6BIGTCP {
 		if option.Config.DatapathMode != datapathOption.DatapathModeVeth {
-			log.Fatal("IPv6 BIG TCP is supported only in veth datapath mode")
+			log.Fatal("BIG TCP is supported only in veth datapath mode")
 		}
 		if option.Config.TunnelingEnabled() {
-			log.Fatal("IPv6 BIG TCP is not supported in tunneling mode")
+			log.Fatal("BIG TCP is not supported in tunneling mode")
 		}
 		if option.Config.EncryptionEnabled() {
-			log.Fatal("IPv6 BIG TCP is not supported with encryption enabled")
+			log.Fatal("BIG TCP is not supported with encryption enabled")
 		}
 		if option.Config.EnableHostLegacyRouting {
-			log.Fatal("IPv6 BIG TCP is not supported with legacy host routing")
+			log.Fatal("BIG TCP is not supported with legacy host routing")
 		}
 
-		log.Info("Setting up IPv6 BIG TCP")
-		bigTCPConfig.groMaxSize = bigTCPGROMaxSize
-		bigTCPConfig.gsoMaxSize = bigTCPGSOMaxSize
+		log.Info("Setting up BIG TCP")
+		if option.Config.EnableIPv6BIGTCP {
+			bigTCPConfig.groMaxSize = bigTCPGROMaxSize
+			bigTCPConfig.gsoMaxSize = bigTCPGSOMaxSize
+		}
+		if option.Config.EnableIPv4BIGTCP {
+			bigTCPConfig.groIPv4MaxSize = bigTCPGROMaxSize
+			bigTCPConfig.gsoIPv4MaxSize = bigTCPGSOMaxSize
+		}
 		disableMsg = ", disabling BIG TCP"
 	}
 
@@ -132,9 +199,19 @@ func InitBIGTCP(bigTCPConfig *Configuration) {
 		err = setGROGSOMaxSize(device, bigTCPConfig.groMaxSize, bigTCPConfig.gsoMaxSize)
 		if err != nil {
 			log.WithError(err).WithField("device", device).Warnf("Could not modify gro_max_size and gso_max_size%s", disableMsg)
+			option.Config.EnableIPv4BIGTCP = false
 			option.Config.EnableIPv6BIGTCP = false
 			break
 		}
+		if haveIPv4 {
+			err = setGROGSOIPv4MaxSize(device, bigTCPConfig.groIPv4MaxSize, bigTCPConfig.gsoIPv4MaxSize)
+			if err != nil {
+				log.WithError(err).WithField("device", device).Warnf("Could not modify gro_ipv4_max_size and gso_ipv4_max_size%s", disableMsg)
+				option.Config.EnableIPv4BIGTCP = false
+				option.Config.EnableIPv6BIGTCP = false
+				break
+			}
+		}
 		log.WithField("device", device).Infof("Setting gso_max_size to %d and gro_max_size to %d",
 			bigTCPConfig.gsoMaxSize, bigTCPConfig.groMaxSize)
 	}
@@ -142,6 +219,10 @@ func InitBIGTCP(bigTCPConfig *Configuration) {
 	if err != nil {
 		bigTCPConfig.groMaxSize = defaultGROMaxSize
 		bigTCPConfig.gsoMaxSize = defaultGSOMaxSize
+		if haveIPv4 {
+			bigTCPConfig.groIPv4MaxSize = defaultGROMaxSize
+			bigTCPConfig.gsoIPv4MaxSize = defaultGSOMaxSize
+		}
 		for _, device := range modifiedDevices {
 			err = setGROGSOMaxSize(device, defaultGROMaxSize,
 				defaultGSOMaxSize)
@@ -149,6 +230,14 @@ func InitBIGTCP(bigTCPConfig *Configuration) {
 				log.WithError(err).WithField("device", device).Warn("Could not reset gro_max_size and gso_max_size")
 				continue
 			}
+			if haveIPv4 {
+				err = setGROGSOIPv4MaxSize(device, defaultGROMaxSize,
+					defaultGSOMaxSize)
+				if err != nil {
+					log.WithError(err).WithField("device", device).Warn("Could not reset gro_ipv4_max_size and gso_ipv4_max_size")
+					continue
+				}
+			}
 			log.WithField("device", device).Info("Resetting gso_max_size and gro_max_size")
 		}
 	}
@@ -41,7 +41,9 @@ import (
 	ipcachemap "github.com/cilium/cilium/pkg/maps/ipcache"
 	"github.com/cilium/cilium/pkg/maps/ipmasq"
 	"github.com/cilium/cilium/pkg/maps/lbmap"
+	"github.com/cilium/cilium/pkg/maps/lbskipmap"
 	"github.com/cilium/cilium/pkg/maps/lxcmap"
+	"github.com/cilium/cilium/pkg/maps/mcastmap"
 	"github.com/cilium/cilium/pkg/maps/metricsmap"
 	"github.com/cilium/cilium/pkg/maps/nat"
 	"github.com/cilium/cilium/pkg/maps/neighborsmap"
@@ -52,6 +54,7 @@ import (
 	"github.com/cilium/cilium/pkg/maps/srv6map"
 	"github.com/cilium/cilium/pkg/maps/tunnel"
 	"github.com/cilium/cilium/pkg/maps/vtep"
+	"github.com/cilium/cilium/pkg/maps/xdpportmap"
 	"github.com/cilium/cilium/pkg/netns"
 	"github.com/cilium/cilium/pkg/node"
 	"github.com/cilium/cilium/pkg/option"
@@ -250,6 +253,8 @@ func (h *HeaderfileWriter) WriteNodeConfig(w io.Writer, cfg *datapath.LocalNodeC
 
 	if option.Config.EnableXDPPrefilter {
 		cDefinesMap["ENABLE_PREFILTER"] = "1"
+		cDefinesMap["XDP_PORT_FILTER_MAP"] = xdpportmap.MapName
+		cDefinesMap["XDP_PORT_FILTER_MAP_SIZE"] = fmt.Sprintf("%d", xdpportmap.MapSize)
 	}
 
 	if option.Config.EnableIPv4EgressGateway {
@@ -276,6 +281,11 @@ func (h *HeaderfileWriter) WriteNodeConfig(w io.Writer, cfg *datapath.LocalNodeC
 		if option.Config.EnableSocketLBTracing {
 			cDefinesMap["TRACE_SOCK_NOTIFY"] = "1"
 		}
+		if option.Config.SocketLBSkipSelector != nil {
+			cDefinesMap["ENABLE_SOCKET_LB_SKIP_NETNS"] = "1"
+			cDefinesMap["LB_SKIP_NETNS_MAP"] = lbskipmap.MapName
+			cDefinesMap["LB_SKIP_NETNS_MAP_SIZE"] = fmt.Sprintf("%d", lbskipmap.MapSize)
+		}
 
 		if cookie, err := netns.GetNetNSCookie(); err == nil {
 			// When running in nested environments (e.g. Kind), cilium-agent does
@@ -511,6 +521,12 @@ func (h *HeaderfileWriter) WriteNodeConfig(w io.Writer, cfg *datapath.LocalNodeC
 		cDefinesMap["THROTTLE_MAP_SIZE"] = fmt.Sprintf("%d", bwmap.MapSize)
 	}
 
+	if option.Config.EnableMulticast {
+		cDefinesMap["ENABLE_MULTICAST"] = "1"
+		cDefinesMap["MCAST_GROUP_MAP"] = mcastmap.MapName
+		cDefinesMap["MCAST_GROUP_MAP_SIZE"] = fmt.Sprintf("%d", mcastmap.MapSize)
+	}
+
 	if option.Config.EnableHostFirewall {
 		cDefinesMap["ENABLE_HOST_FIREWALL"] = "1"
 	}
@@ -881,6 +897,10 @@ func (h *HeaderfileWriter) writeTemplateConfig(fw *bufio.Writer, e datapath.Endp
 		fmt.Fprintf(fw, "#define FORCE_LOCAL_POLICY_EVAL_AT_SOURCE 1\n")
 	}
 
+	if dscp := e.GetDSCP(); dscp != 0 {
+		fmt.Fprintf(fw, "#define ENDPOINT_DSCP %d\n", dscp)
+	}
+
 	if e.RequireRouting() {
 		fmt.Fprintf(fw, "#define ENABLE_ROUTING 1\n")
 	}
@@ -205,6 +205,7 @@ func createDirectRouteSpec(CIDR *cidr.CIDR, nodeIP net.IP) (routeSpec *netlink.R
 	}
 
 	linkIndex := routes[0].LinkIndex
+	pathMTU := routes[0].MTU
 
 	// Special treatment if the route points to the loopback, lookup the
 	// local route and use that ifindex
@@ -237,9 +238,29 @@ func createDirectRouteSpec(CIDR *cidr.CIDR, nodeIP net.IP) (routeSpec *netlink.R
 
 	routeSpec.LinkIndex = linkIndex
 
+	// The kernel tracks a lower MTU of the path towards the node either
+	// via an MTU metric configured on the underlay route or via path MTU
+	// discovery. Program it on the direct route so that pods see the
+	// correct MTU for this particular path.
+	if option.Config.EnableRouteMTUDiscovery && pathMTU != 0 {
+		routeSpec.MTU = pathMTU
+	}
+
 	return
 }
 
+// discoverPathMTU returns the MTU of the path towards the given node IP as
+// reported by the kernel routing table, or 0 if no MTU is known for the path
+// or it cannot be determined.
+func discoverPathMTU(nodeIP net.IP) int {
+	routes, err := netlink.RouteGet(nodeIP)
+	if err != nil || len(routes) == 0 {
+		return 0
+	}
+
+	return routes[0].MTU
+}
+
 func installDirectRoute(CIDR *cidr.CIDR, nodeIP net.IP) (routeSpec *netlink.Route, err error) {
 	routeSpec, err = createDirectRouteSpec(CIDR, nodeIP)
 	if err != nil {
@@ -330,7 +351,7 @@ func (n *linuxNodeHandler) deleteDirectRoute(CIDR *cidr.CIDR, nodeIP net.IP) {
 // Example:
 // 10.10.0.0/24 via 10.10.0.1 dev cilium_host src 10.10.0.1
 // f00d::a0a:0:0:0/112 via f00d::a0a:0:0:1 dev cilium_host src fd04::11 metric 1024 pref medium
-func (n *linuxNodeHandler) createNodeRouteSpec(prefix *cidr.CIDR, isLocalNode bool) (route.Route, error) {
+func (n *linuxNodeHandler) createNodeRouteSpec(prefix *cidr.CIDR, isLocalNode bool, pathMTU int) (route.Route, error) {
 	var (
 		local, nexthop net.IP
 		mtu            int
@@ -364,7 +385,7 @@ func (n *linuxNodeHandler) createNodeRouteSpec(prefix *cidr.CIDR, isLocalNode bo
 	}
 
 	if !isLocalNode {
-		mtu = n.nodeConfig.MtuConfig.GetRouteMTU()
+		mtu = n.nodeConfig.MtuConfig.GetRouteMTUForPath(pathMTU)
 	}
 
 	// The default routing table accounts for encryption overhead for encrypt-node traffic
@@ -383,7 +404,7 @@ func (n *linuxNodeHandler) lookupNodeRoute(prefix *cidr.CIDR, isLocalNode bool)
 		return nil, nil
 	}
 
-	routeSpec, err := n.createNodeRouteSpec(prefix, isLocalNode)
+	routeSpec, err := n.createNodeRouteSpec(prefix, isLocalNode, 0)
 	if err != nil {
 		return nil, err
 	}
@@ -391,12 +412,12 @@ func (n *linuxNodeHandler) lookupNodeRoute(prefix *cidr.CIDR, isLocalNode bool)
 	return route.Lookup(routeSpec)
 }
 
-func (n *linuxNodeHandler) updateNodeRoute(prefix *cidr.CIDR, addressFamilyEnabled bool, isLocalNode bool) error {
+func (n *linuxNodeHandler) updateNodeRoute(prefix *cidr.CIDR, addressFamilyEnabled bool, isLocalNode bool, pathMTU int) error {
 	if prefix == nil || !addressFamilyEnabled {
 		return nil
 	}
 
-	nodeRoute, err := n.createNodeRouteSpec(prefix, isLocalNode)
+	nodeRoute, err := n.createNodeRouteSpec(prefix, isLocalNode, pathMTU)
 	if err != nil {
 		return err
 	}
@@ -413,7 +434,7 @@ func (n *linuxNodeHandler) deleteNodeRoute(prefix *cidr.CIDR, isLocalNode bool)
 		return nil
 	}
 
-	nodeRoute, err := n.createNodeRouteSpec(prefix, isLocalNode)
+	nodeRoute, err := n.createNodeRouteSpec(prefix, isLocalNode, 0)
 	if err != nil {
 		return err
 	}
@@ -429,11 +450,11 @@ func (n *linuxNodeHandler) familyEnabled(c *cidr.CIDR) bool {
 	return (c.IP.To4() != nil && n.nodeConfig.EnableIPv4) || (c.IP.To4() == nil && n.nodeConfig.EnableIPv6)
 }
 
-func (n *linuxNodeHandler) updateOrRemoveNodeRoutes(old, new []*cidr.CIDR, isLocalNode bool) {
+func (n *linuxNodeHandler) updateOrRemoveNodeRoutes(old, new []*cidr.CIDR, isLocalNode bool, pathMTU int) {
 	addedAuxRoutes, removedAuxRoutes := cidr.DiffCIDRLists(old, new)
 	for _, prefix := range addedAuxRoutes {
 		if prefix != nil {
-			n.updateNodeRoute(prefix, n.familyEnabled(prefix), isLocalNode)
+			n.updateNodeRoute(prefix, n.familyEnabled(prefix), isLocalNode, pathMTU)
 		}
 	}
 	for _, prefix := range removedAuxRoutes {
@@ -1053,7 +1074,42 @@ func (n *linuxNodeHandler) subnetEncryption() bool {
 	return len(n.nodeConfig.IPv4PodSubnets) > 0 || len(n.nodeConfig.IPv6PodSubnets) > 0
 }
 
+// directRoutingNode returns true if one of the given node IPs falls into the
+// configured direct routing node CIDRs, in which case the node is reached via
+// direct routes even though encapsulation is enabled.
+func (n *linuxNodeHandler) directRoutingNode(nodeIP4, nodeIP6 net.IP) bool {
+	for _, drCIDR := range n.nodeConfig.DirectRoutingNodeCIDRs {
+		if nodeIP4 != nil && drCIDR.Contains(nodeIP4) {
+			return true
+		}
+		if nodeIP6 != nil && drCIDR.Contains(nodeIP6) {
+			return true
+		}
+	}
+
+	return false
+}
+
 // Must be called with linuxNodeHandler.mutex held.
+// nodePathMTU returns the discovered MTU of the underlay path towards the
+// given node, or 0 if per-route MTU discovery is disabled or no path MTU is
+// known to the kernel.
+func (n *linuxNodeHandler) nodePathMTU(nodeIP4, nodeIP6 net.IP) int {
+	if !option.Config.EnableRouteMTUDiscovery {
+		return 0
+	}
+
+	nodeIP := nodeIP4
+	if nodeIP == nil {
+		nodeIP = nodeIP6
+	}
+	if nodeIP == nil {
+		return 0
+	}
+
+	return discoverPathMTU(nodeIP)
+}
+
 func (n *linuxNodeHandler) nodeUpdate(oldNode, newNode *nodeTypes.Node, firstAddition bool) error {
 	var (
 		oldIP4Cidr, oldIP6Cidr                   *cidr.CIDR
@@ -1097,8 +1153,8 @@ func (n *linuxNodeHandler) nodeUpdate(oldNode, newNode *nodeTypes.Node, firstAdd
 	if newNode.IsLocal() {
 		isLocalNode = true
 		if n.nodeConfig.EnableLocalNodeRoute {
-			n.updateOrRemoveNodeRoutes(oldAllIP4AllocCidrs, newAllIP4AllocCidrs, isLocalNode)
-			n.updateOrRemoveNodeRoutes(oldAllIP6AllocCidrs, newAllIP6AllocCidrs, isLocalNode)
+			n.updateOrRemoveNodeRoutes(oldAllIP4AllocCidrs, newAllIP4AllocCidrs, isLocalNode, 0)
+			n.updateOrRemoveNodeRoutes(oldAllIP6AllocCidrs, newAllIP6AllocCidrs, isLocalNode, 0)
 		}
 		if n.subnetEncryption() {
 			n.enableSubnetIPsec(n.nodeConfig.IPv4PodSubnets, n.nodeConfig.IPv6PodSubnets)
@@ -1123,6 +1179,20 @@ func (n *linuxNodeHandler) nodeUpdate(oldNode, newNode *nodeTypes.Node, firstAdd
 		return nil
 	}
 
+	if n.nodeConfig.EnableEncapsulation && n.directRoutingNode(newIP4, newIP6) {
+		// Mixed routing mode: the node is covered by the direct routing
+		// node CIDRs and is reached via direct routes. Remove any tunnel
+		// mapping left behind from before the node was covered.
+		deleteTunnelMapping(oldIP4Cidr, true)
+		deleteTunnelMapping(oldIP6Cidr, true)
+		deleteTunnelMapping(newNode.IPv4AllocCIDR, true)
+		deleteTunnelMapping(newNode.IPv6AllocCIDR, true)
+
+		n.updateDirectRoutes(oldAllIP4AllocCidrs, newAllIP4AllocCidrs, oldIP4, newIP4, firstAddition, n.nodeConfig.EnableIPv4)
+		n.updateDirectRoutes(oldAllIP6AllocCidrs, newAllIP6AllocCidrs, oldIP6, newIP6, firstAddition, n.nodeConfig.EnableIPv6)
+		return nil
+	}
+
 	if n.nodeConfig.EnableEncapsulation {
 		// Update the tunnel mapping of the node. In case the
 		// node has changed its CIDR range, a new entry in the
@@ -1132,8 +1202,9 @@ func (n *linuxNodeHandler) nodeUpdate(oldNode, newNode *nodeTypes.Node, firstAdd
 		updateTunnelMapping(oldIP6Cidr, newNode.IPv6AllocCIDR, oldIP4, newIP4, firstAddition, n.nodeConfig.EnableIPv6, oldKey, newKey)
 
 		if !n.nodeConfig.UseSingleClusterRoute {
-			n.updateOrRemoveNodeRoutes(oldAllIP4AllocCidrs, newAllIP4AllocCidrs, isLocalNode)
-			n.updateOrRemoveNodeRoutes(oldAllIP6AllocCidrs, newAllIP6AllocCidrs, isLocalNode)
+			pathMTU := n.nodePathMTU(newIP4, newIP6)
+			n.updateOrRemoveNodeRoutes(oldAllIP4AllocCidrs, newAllIP4AllocCidrs, isLocalNode, pathMTU)
+			n.updateOrRemoveNodeRoutes(oldAllIP6AllocCidrs, newAllIP6AllocCidrs, isLocalNode, pathMTU)
 		}
 
 		return nil
@@ -1181,16 +1252,20 @@ func (n *linuxNodeHandler) nodeDelete(oldNode *nodeTypes.Node) error {
 	oldIP4 := oldNode.GetNodeIP(false)
 	oldIP6 := oldNode.GetNodeIP(true)
 
-	if n.nodeConfig.EnableAutoDirectRouting {
+	directRouting := n.nodeConfig.EnableEncapsulation && n.directRoutingNode(oldIP4, oldIP6)
+
+	if n.nodeConfig.EnableAutoDirectRouting || directRouting {
 		n.deleteDirectRoute(oldNode.IPv4AllocCIDR, oldIP4)
 		n.deleteDirectRoute(oldNode.IPv6AllocCIDR, oldIP6)
 	}
 
 	if n.nodeConfig.EnableEncapsulation {
-		deleteTunnelMapping(oldNode.IPv4AllocCIDR, false)
-		deleteTunnelMapping(oldNode.IPv6AllocCIDR, false)
+		// In mixed routing mode no tunnel mapping was installed for the
+		// node, delete quietly to avoid spurious error logs.
+		deleteTunnelMapping(oldNode.IPv4AllocCIDR, directRouting)
+		deleteTunnelMapping(oldNode.IPv6AllocCIDR, directRouting)
 
-		if !n.nodeConfig.UseSingleClusterRoute {
+		if !n.nodeConfig.UseSingleClusterRoute && !directRouting {
 			n.deleteNodeRoute(oldNode.IPv4AllocCIDR, false)
 			n.deleteNodeRoute(oldNode.IPv6AllocCIDR, false)
 		}
@@ -1218,7 +1293,7 @@ func (n *linuxNodeHandler) nodeDelete(oldNode *nodeTypes.Node) error {
 func (n *linuxNodeHandler) updateOrRemoveClusterRoute(addressing types.NodeAddressingFamily, addressFamilyEnabled bool) {
 	allocCIDR := addressing.AllocationCIDR()
 	if addressFamilyEnabled {
-		n.updateNodeRoute(allocCIDR, addressFamilyEnabled, false)
+		n.updateNodeRoute(allocCIDR, addressFamilyEnabled, false, 0)
 	} else if rt, _ := n.lookupNodeRoute(allocCIDR, false); rt != nil {
 		n.deleteNodeRoute(allocCIDR, false)
 	}
@@ -1547,7 +1622,7 @@ func (n *linuxNodeHandler) NodeConfigurationChanged(newConfig datapath.LocalNode
 		}
 	}
 
-	n.updateOrRemoveNodeRoutes(prevConfig.AuxiliaryPrefixes, newConfig.AuxiliaryPrefixes, true)
+	n.updateOrRemoveNodeRoutes(prevConfig.AuxiliaryPrefixes, newConfig.AuxiliaryPrefixes, true, 0)
 
 	if newConfig.EnableIPSec {
 		// For the ENI ipam mode on EKS, this will be the interface that
@@ -205,7 +205,7 @@ func (s *linuxPrivilegedBaseTestSuite) TestUpdateNodeRoute(c *check.C) {
 
 	if s.enableIPv4 {
 		// add & remove IPv4 node route
-		err = linuxNodeHandler.updateNodeRoute(ip4CIDR, true, false)
+		err = linuxNodeHandler.updateNodeRoute(ip4CIDR, true, false, 0)
 		c.Assert(err, check.IsNil)
 
 		foundRoute, err := linuxNodeHandler.lookupNodeRoute(ip4CIDR, false)
@@ -222,7 +222,7 @@ func (s *linuxPrivilegedBaseTestSuite) TestUpdateNodeRoute(c *check.C) {
 
 	if s.enableIPv6 {
 		// add & remove IPv6 node route
-		err = linuxNodeHandler.updateNodeRoute(ip6CIDR, true, false)
+		err = linuxNodeHandler.updateNodeRoute(ip6CIDR, true, false, 0)
 		c.Assert(err, check.IsNil)
 
 		foundRoute, err := linuxNodeHandler.lookupNodeRoute(ip6CIDR, false)
@@ -970,7 +970,7 @@ func (s *linuxPrivilegedBaseTestSuite) TestAgentRestartOptionChanges(c *check.C)
 }
 
 func insertFakeRoute(c *check.C, n *linuxNodeHandler, prefix *cidr.CIDR) {
-	nodeRoute, err := n.createNodeRouteSpec(prefix, false)
+	nodeRoute, err := n.createNodeRouteSpec(prefix, false, 0)
 	c.Assert(err, check.IsNil)
 
 	nodeRoute.Device = dummyExternalDeviceName
@@ -980,7 +980,7 @@ func insertFakeRoute(c *check.C, n *linuxNodeHandler, prefix *cidr.CIDR) {
 }
 
 func lookupFakeRoute(c *check.C, n *linuxNodeHandler, prefix *cidr.CIDR) bool {
-	routeSpec, err := n.createNodeRouteSpec(prefix, false)
+	routeSpec, err := n.createNodeRouteSpec(prefix, false, 0)
 	c.Assert(err, check.IsNil)
 
 	routeSpec.Device = dummyExternalDeviceName
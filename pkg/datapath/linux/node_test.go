@@ -68,7 +68,7 @@ func (s *linuxTestSuite) TestCreateNodeRoute(c *check.C) {
 	nodeHandler := NewNodeHandler(dpConfig, fakeNodeAddressing, nil)
 
 	c1 := cidr.MustParseCIDR("10.10.0.0/16")
-	generatedRoute, err := nodeHandler.(*linuxNodeHandler).createNodeRouteSpec(c1, false)
+	generatedRoute, err := nodeHandler.(*linuxNodeHandler).createNodeRouteSpec(c1, false, 0)
 	c.Assert(err, check.IsNil)
 	c.Assert(generatedRoute.Prefix, checker.DeepEquals, *c1.IPNet)
 	c.Assert(generatedRoute.Device, check.Equals, dpConfig.HostDevice)
@@ -76,7 +76,7 @@ func (s *linuxTestSuite) TestCreateNodeRoute(c *check.C) {
 	c.Assert(generatedRoute.Local, checker.DeepEquals, fakeNodeAddressing.IPv4().Router())
 
 	c1 = cidr.MustParseCIDR("beef:beef::/48")
-	generatedRoute, err = nodeHandler.(*linuxNodeHandler).createNodeRouteSpec(c1, false)
+	generatedRoute, err = nodeHandler.(*linuxNodeHandler).createNodeRouteSpec(c1, false, 0)
 	c.Assert(err, check.IsNil)
 	c.Assert(generatedRoute.Prefix, checker.DeepEquals, *c1.IPNet)
 	c.Assert(generatedRoute.Device, check.Equals, dpConfig.HostDevice)
@@ -85,12 +85,12 @@ func (s *linuxTestSuite) TestCreateNodeRoute(c *check.C) {
 }
 
 func (s *linuxTestSuite) TestCreateNodeRouteSpecMtu(c *check.C) {
-	generatedRoute, err := nh.createNodeRouteSpec(cr1, false)
+	generatedRoute, err := nh.createNodeRouteSpec(cr1, false, 0)
 
 	c.Assert(err, check.IsNil)
 	c.Assert(generatedRoute.MTU, check.Not(check.Equals), 0)
 
-	generatedRoute, err = nh.createNodeRouteSpec(cr1, true)
+	generatedRoute, err = nh.createNodeRouteSpec(cr1, true, 0)
 
 	c.Assert(err, check.IsNil)
 	c.Assert(generatedRoute.MTU, check.Equals, 0)
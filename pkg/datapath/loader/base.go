@@ -295,6 +295,17 @@ func (l *Loader) Reinitialize(ctx context.Context, o datapath.BaseProgramOwner,
 		args[initArgIPv4NodeIP] = "<nil>"
 	}
 
+	if option.Config.EnableIPv6NDP && !option.Config.TunnelingEnabled() {
+		// Answer neighbor solicitations for local pod addresses on the
+		// native devices, so that IPv6 native routing works on L2
+		// segments without further router configuration. The proxy
+		// neighbor entries are managed per endpoint by the loader.
+		for _, device := range option.Config.GetDevices() {
+			sysSettings = append(sysSettings, sysctl.Setting{
+				Name: fmt.Sprintf("net.ipv6.conf.%s.proxy_ndp", device), Val: "1", IgnoreErr: false})
+		}
+	}
+
 	if option.Config.EnableIPv6 {
 		args[initArgIPv6NodeIP] = node.GetIPv6().String()
 		// Docker <17.05 has an issue which causes IPv6 to be disabled in the initns for all
@@ -101,6 +101,47 @@ func removeEndpointRoute(ep datapath.Endpoint, ip net.IPNet) error {
 	})
 }
 
+// ndpProxyRequired returns true if proxy neighbor entries must be managed for
+// the endpoint's IPv6 address on the native devices.
+func ndpProxyRequired(ep datapath.Endpoint) bool {
+	return option.Config.EnableIPv6NDP && !option.Config.TunnelingEnabled() &&
+		!ep.IsHost() && ep.IPv6Address().IsValid()
+}
+
+// upsertNDPProxyEntry installs a proxy neighbor entry for the given endpoint
+// IP on the given native device. Together with the proxy_ndp setting of the
+// device, this makes the kernel join the solicited-node multicast group and
+// answer neighbor solicitations for the address, so that pods are reachable
+// via native routing on L2 segments without further router configuration,
+// analogous to the ARP handling for IPv4.
+func upsertNDPProxyEntry(ip net.IP, device string) error {
+	link, err := netlink.LinkByName(device)
+	if err != nil {
+		return err
+	}
+	return netlink.NeighSet(&netlink.Neigh{
+		LinkIndex: link.Attrs().Index,
+		Family:    netlink.FAMILY_V6,
+		Flags:     netlink.NTF_PROXY,
+		IP:        ip,
+	})
+}
+
+// removeNDPProxyEntry removes the proxy neighbor entry for the given endpoint
+// IP from the given native device.
+func removeNDPProxyEntry(ip net.IP, device string) error {
+	link, err := netlink.LinkByName(device)
+	if err != nil {
+		return err
+	}
+	return netlink.NeighDel(&netlink.Neigh{
+		LinkIndex: link.Attrs().Index,
+		Family:    netlink.FAMILY_V6,
+		Flags:     netlink.NTF_PROXY,
+		IP:        ip,
+	})
+}
+
 // We need this function when patching an object file for which symbols were
 // already substituted. During the first symbol substitutions, string symbols
 // were replaced such that:
@@ -244,10 +285,7 @@ func (l *Loader) reloadHostDatapath(ctx context.Context, ep datapath.Endpoint, o
 		} else {
 			// Remove any previously attached device from egress path if BPF
 			// NodePort and host firewall are disabled.
-			err := RemoveTCFilters(device, netlink.HANDLE_MIN_EGRESS)
-			if err != nil {
-				log.WithField("device", device).Error(err)
-			}
+			removeObsoleteTCFilters(device, netlink.HANDLE_MIN_EGRESS)
 		}
 	}
 
@@ -329,6 +367,16 @@ func (l *Loader) reloadDatapath(ctx context.Context, ep datapath.Endpoint, dirs
 		}
 	}
 
+	if ndpProxyRequired(ep) {
+		ip := ep.IPv6Address()
+		for _, device := range option.Config.GetDevices() {
+			if err := upsertNDPProxyEntry(ip.AsSlice(), device); err != nil {
+				ep.Logger(Subsystem).WithError(err).WithField("device", device).
+					Warn("Failed to install NDP proxy entry")
+			}
+		}
+	}
+
 	return nil
 }
 
@@ -470,6 +518,13 @@ func (l *Loader) Unload(ep datapath.Endpoint) {
 			removeEndpointRoute(ep, *iputil.AddrToIPNet(ip))
 		}
 	}
+
+	if ndpProxyRequired(ep) {
+		ip := ep.IPv6Address()
+		for _, device := range option.Config.GetDevices() {
+			removeNDPProxyEntry(ip.AsSlice(), device)
+		}
+	}
 }
 
 // EndpointHash hashes the specified endpoint configuration with the current
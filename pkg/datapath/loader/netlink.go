@@ -8,6 +8,7 @@ import (
 	"errors"
 	"fmt"
 	"net"
+	"time"
 
 	"github.com/vishvananda/netlink"
 	"golang.org/x/sys/unix"
@@ -221,6 +222,36 @@ func RemoveTCFilters(ifName string, tcDir uint32) error {
 	return nil
 }
 
+// removeObsoleteTCFilters removes tc filters which are no longer needed from
+// the given interface. If a datapath drain timeout is configured, the removal
+// is postponed until the timeout has elapsed so that established connections
+// through the previously attached program can drain first. The obsolete
+// program keeps forwarding in the meantime.
+func removeObsoleteTCFilters(ifName string, tcDir uint32) {
+	if option.Config.DatapathDrainTimeout == 0 {
+		if err := RemoveTCFilters(ifName, tcDir); err != nil {
+			log.WithField("device", ifName).Error(err)
+		}
+		return
+	}
+
+	log.WithField("device", ifName).
+		Infof("Keeping obsolete tc program attached for %s to drain established connections",
+			option.Config.DatapathDrainTimeout)
+	time.AfterFunc(option.Config.DatapathDrainTimeout, func() {
+		// The egress program might have been reattached in the meantime,
+		// e.g. if BPF NodePort or the host firewall was enabled again, in
+		// which case the filter must be left alone.
+		if option.Config.AreDevicesRequired() {
+			return
+		}
+		log.WithField("device", ifName).Info("Removing obsolete tc program after drain")
+		if err := RemoveTCFilters(ifName, tcDir); err != nil {
+			log.WithField("device", ifName).Error(err)
+		}
+	})
+}
+
 func setupDev(link netlink.Link) error {
 	ifName := link.Attrs().Name
 
@@ -125,6 +125,13 @@ func (t *templateCfg) GetPolicyVerdictLogFilter() uint32 {
 	return templatePolicyVerdictFilter
 }
 
+// GetDSCP returns zero to leave traffic of the template unmarked. Endpoints
+// with a DSCP code point configured receive their own program as the value is
+// part of the compile-time configuration.
+func (t *templateCfg) GetDSCP() uint8 {
+	return 0
+}
+
 // wrap takes an endpoint configuration and optional stats tracker and wraps
 // it inside a templateCfg which hides static data from callers that wish to
 // generate header files based on the configuration, substituting it for
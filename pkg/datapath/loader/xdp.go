@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"path"
 	"strings"
+	"time"
 
 	"github.com/vishvananda/netlink"
 	"github.com/vishvananda/netlink/nl"
@@ -60,10 +61,40 @@ func maybeUnloadObsoleteXDPPrograms(xdpDevs []string, xdpMode string) {
 			}
 		}
 		if !used {
-			netlink.LinkSetXdpFdWithFlags(link, -1, int(xdpModeToFlag(option.XDPModeLinkGeneric)))
-			netlink.LinkSetXdpFdWithFlags(link, -1, int(xdpModeToFlag(option.XDPModeLinkDriver)))
+			if timeout := option.Config.DatapathDrainTimeout; timeout > 0 {
+				log.WithField("device", link.Attrs().Name).
+					Infof("Keeping obsolete XDP program attached for %s to drain established connections", timeout)
+				name := link.Attrs().Name
+				time.AfterFunc(timeout, func() { detachObsoleteXDPProgram(name) })
+			} else {
+				detachXDPProgram(link)
+			}
+		}
+	}
+}
+
+func detachXDPProgram(link netlink.Link) {
+	netlink.LinkSetXdpFdWithFlags(link, -1, int(xdpModeToFlag(option.XDPModeLinkGeneric)))
+	netlink.LinkSetXdpFdWithFlags(link, -1, int(xdpModeToFlag(option.XDPModeLinkDriver)))
+}
+
+// detachObsoleteXDPProgram detaches the XDP program from the given device
+// after the drain timeout has elapsed, unless the device has been added back
+// to the managed devices in the meantime, in which case the attached program
+// is no longer obsolete.
+func detachObsoleteXDPProgram(name string) {
+	for _, xdpDev := range option.Config.GetDevices() {
+		if name == xdpDev {
+			return
 		}
 	}
+
+	link, err := netlink.LinkByName(name)
+	if err != nil {
+		return
+	}
+	log.WithField("device", name).Info("Detaching obsolete XDP program after drain")
+	detachXDPProgram(link)
 }
 
 // xdpCompileArgs derives compile arguments for bpf_xdp.c.
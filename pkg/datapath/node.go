@@ -75,6 +75,15 @@ type LocalNodeConfiguration struct {
 	// subsequent calls to NodeConfigurationChanged().
 	EnableAutoDirectRouting bool
 
+	// DirectRoutingNodeCIDRs enables mixed routing mode when
+	// EnableEncapsulation is set. Nodes with an IP within one of the CIDRs
+	// are reached via direct routes while encapsulation remains in use
+	// towards all other nodes.
+	//
+	// This field is immutable at runtime. The value will not change in
+	// subsequent calls to NodeConfigurationChanged().
+	DirectRoutingNodeCIDRs []*cidr.CIDR
+
 	// EnableLocalNodeRoute enables installation of the route which points
 	// the allocation prefix of the local node. Disabling this option is
 	// useful when another component is responsible for the routing of the
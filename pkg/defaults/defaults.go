@@ -167,6 +167,10 @@ const (
 	// option.IdentityRestoreGracePeriod
 	IdentityRestoreGracePeriod = 10 * time.Minute
 
+	// PolicyDefaultDenyAuditDuration is the default value for
+	// option.PolicyDefaultDenyAuditDuration
+	PolicyDefaultDenyAuditDuration = 1 * time.Hour
+
 	// ExecTimeout is a timeout for executing commands.
 	ExecTimeout = 300 * time.Second
 
@@ -199,6 +203,9 @@ const (
 	// EnableSCTP is the default value for SCTP support enablement
 	EnableSCTP = false
 
+	// EnableMulticast is the default value for multicast forwarding
+	EnableMulticast = false
+
 	// EnableL7Proxy is the default value for L7 proxy enablement
 	EnableL7Proxy = true
 
@@ -506,6 +513,9 @@ const (
 	// EnableICMPRules enables ICMP-based rule support for Cilium Network Policies.
 	EnableICMPRules = true
 
+	// EnableNodeSelectorLabels is the default value for option.EnableNodeSelectorLabels
+	EnableNodeSelectorLabels = false
+
 	// TunnelPortVXLAN is the default VXLAN port
 	TunnelPortVXLAN = 8472
 	// TunnelPortGeneve is the default Geneve port
@@ -58,6 +58,15 @@ type Manager struct {
 
 	// identityAllocator is used to fetch identity labels for endpoint updates
 	identityAllocator identityCache.IdentityAllocator
+
+	// egressIPAllocator manages the per-namespace egress IP pools, it is
+	// set once IPAM has been bootstrapped and is nil if no pools are
+	// configured
+	egressIPAllocator EgressIPAllocator
+
+	// namespaceEgressRules is the desired set of egress policy map entries
+	// derived from the per-namespace egress IP pools
+	namespaceEgressRules map[egressmap.EgressPolicyKey4]egressmap.EgressPolicyVal4
 }
 
 // NewEgressGatewayManager returns a new Egress Gateway Manager.
@@ -68,6 +77,7 @@ func NewEgressGatewayManager(k8sCacheSyncedChecker k8sCacheSyncedChecker, identi
 		policyConfigs:         make(map[policyID]*PolicyConfig),
 		epDataStore:           make(map[endpointID]*endpointMetadata),
 		identityAllocator:     identityAlocator,
+		namespaceEgressRules:  make(map[egressmap.EgressPolicyKey4]egressmap.EgressPolicyVal4),
 	}
 
 	manager.runReconciliationAfterK8sSync()
@@ -374,6 +384,11 @@ func (manager *Manager) removeUnusedEgressRules() {
 
 nextPolicyKey:
 	for policyKey, policyVal := range egressPolicies {
+		// Namespace egress IP entries are not backed by a policy
+		if _, ok := manager.namespaceEgressRules[policyKey]; ok {
+			continue
+		}
+
 		matchPolicy := func(endpointIP net.IP, dstCIDR *net.IPNet, gwc *gatewayConfig) bool {
 			return policyKey.Match(endpointIP, dstCIDR) && policyVal.Match(gwc.egressIP.IP, gwc.gatewayIP)
 		}
@@ -420,6 +435,10 @@ func (manager *Manager) reconcile() {
 		}
 	}
 
+	if manager.egressIPAllocator != nil {
+		manager.updateNamespaceEgressRules()
+	}
+
 	// The order of the next 2 function calls matters, as by first adding missing policies and
 	// only then removing obsolete ones we make sure there will be no connectivity disruption
 	manager.addMissingEgressRules()
@@ -0,0 +1,108 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package egressgateway
+
+import (
+	"net"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/cilium/cilium/pkg/logging/logfields"
+	"github.com/cilium/cilium/pkg/maps/egressmap"
+	"github.com/cilium/cilium/pkg/node"
+	"github.com/cilium/cilium/pkg/option"
+)
+
+// EgressIPAllocator is the interface implemented by the IPAM subsystem to
+// manage the per-namespace egress IP pools.
+type EgressIPAllocator interface {
+	// EgressIPPoolExists returns true if an egress IP pool has been
+	// configured for the given namespace
+	EgressIPPoolExists(namespace string) bool
+
+	// AllocateNextEgressIP returns the egress IP the local node
+	// masquerades the given namespace's traffic with
+	AllocateNextEgressIP(namespace string) (net.IP, error)
+
+	// ReleaseEgressIP returns the egress IP allocated for the given
+	// namespace back into the namespace's pool
+	ReleaseEgressIP(namespace string) error
+}
+
+// allIPv4DestinationsCIDR matches all traffic of an endpoint, namespace
+// egress IPs apply to the namespace's traffic regardless of its destination.
+var allIPv4DestinationsCIDR = net.IPNet{
+	IP:   net.IPv4zero,
+	Mask: net.CIDRMask(0, net.IPv4len*8),
+}
+
+// SetEgressIPAllocator hands the manager the IPAM allocator backing the
+// per-namespace egress IP pools. It is invoked once IPAM has been
+// bootstrapped as the manager is created earlier during daemon startup.
+func (manager *Manager) SetEgressIPAllocator(allocator EgressIPAllocator) {
+	manager.Lock()
+	defer manager.Unlock()
+
+	manager.egressIPAllocator = allocator
+	manager.reconcile()
+}
+
+// updateNamespaceEgressRules recomputes the desired set of egress policy map
+// entries derived from the per-namespace egress IP pools and installs the
+// missing ones. Entries of each endpoint in a namespace with a pool match all
+// of the endpoint's traffic and masquerade it with the namespace's egress IP,
+// using the local node as gateway. Egress IPs of namespaces which no longer
+// have any endpoint on the node are released back into their pool.
+func (manager *Manager) updateNamespaceEgressRules() {
+	gatewayIP := node.GetIPv4()
+	activeNamespaces := map[string]struct{}{}
+	manager.namespaceEgressRules = map[egressmap.EgressPolicyKey4]egressmap.EgressPolicyVal4{}
+
+	for _, endpoint := range manager.epDataStore {
+		namespace := endpoint.id.Namespace
+		if !manager.egressIPAllocator.EgressIPPoolExists(namespace) {
+			continue
+		}
+
+		egressIP, err := manager.egressIPAllocator.AllocateNextEgressIP(namespace)
+		if err != nil {
+			log.WithError(err).WithField(logfields.K8sNamespace, namespace).
+				Error("Unable to allocate namespace egress IP")
+			continue
+		}
+		activeNamespaces[namespace] = struct{}{}
+
+		for _, endpointIP := range endpoint.ips {
+			key := egressmap.NewEgressPolicyKey4(endpointIP, allIPv4DestinationsCIDR.IP, allIPv4DestinationsCIDR.Mask)
+			manager.namespaceEgressRules[key] = egressmap.NewEgressPolicyVal4(egressIP, gatewayIP)
+
+			if val, err := egressmap.EgressPolicyMap.Lookup(endpointIP, allIPv4DestinationsCIDR); err == nil && val.Match(egressIP, gatewayIP) {
+				continue
+			}
+
+			logger := log.WithFields(logrus.Fields{
+				logfields.K8sNamespace: namespace,
+				logfields.SourceIP:     endpointIP,
+				logfields.EgressIP:     egressIP,
+				logfields.GatewayIP:    gatewayIP,
+			})
+
+			if err := egressmap.EgressPolicyMap.Update(endpointIP, allIPv4DestinationsCIDR, egressIP, gatewayIP); err != nil {
+				logger.WithError(err).Error("Error applying namespace egress IP")
+			} else {
+				logger.Debug("Namespace egress IP applied")
+			}
+		}
+	}
+
+	for namespace := range option.Config.EgressIPPools {
+		if _, ok := activeNamespaces[namespace]; ok {
+			continue
+		}
+		if err := manager.egressIPAllocator.ReleaseEgressIP(namespace); err != nil {
+			log.WithError(err).WithField(logfields.K8sNamespace, namespace).
+				Error("Unable to release namespace egress IP")
+		}
+	}
+}
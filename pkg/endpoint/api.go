@@ -65,6 +65,7 @@ func NewEndpointFromChangeModel(ctx context.Context, owner regeneration.Owner, p
 
 	ep := createEndpoint(owner, policyGetter, namedPortsGetter, proxy, allocator, uint16(base.ID), base.InterfaceName)
 	ep.ifIndex = int(base.InterfaceIndex)
+	ep.NetNsCookie = uint64(base.NetnsCookie)
 	ep.containerName = base.ContainerName
 	ep.containerID = base.ContainerID
 	ep.dockerNetworkID = base.DockerNetworkID
@@ -995,6 +995,11 @@ func (e *Endpoint) deleteMaps() []error {
 			errors = append(errors, fmt.Errorf("unable to remote endpoint from bandwidth manager map: %s", err))
 		}
 	}
+	if e.ingressBps != 0 && option.Config.EnableBandwidthManager {
+		if err := bwmap.DeleteIngress(e.ID); err != nil {
+			errors = append(errors, fmt.Errorf("unable to remote endpoint from bandwidth manager map: %s", err))
+		}
+	}
 
 	return errors
 }
@@ -1492,6 +1497,12 @@ func (e *Endpoint) GetPolicyVerdictLogFilter() uint32 {
 	return filter
 }
 
+// GetDSCP returns the DSCP code point to set on traffic sent by the endpoint,
+// as configured through the pod's annotation.
+func (e *Endpoint) GetDSCP() uint8 {
+	return e.dscp
+}
+
 type linkCheckerFunc func(string) error
 
 // ValidateConnectorPlumbing checks whether the endpoint is correctly plumbed.
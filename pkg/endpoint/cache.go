@@ -40,6 +40,7 @@ type epInfoCache struct {
 	requireEndpointRoute                   bool
 	disableSIPVerification                 bool
 	policyVerdictLogFilter                 uint32
+	dscp                                   uint8
 	cidr4PrefixLengths, cidr6PrefixLengths []int
 	options                                *option.IntOptions
 	lxcMAC                                 mac.MAC
@@ -75,6 +76,7 @@ func (e *Endpoint) createEpInfoCache(epdir string) *epInfoCache {
 		requireEndpointRoute:   e.RequireEndpointRoute(),
 		disableSIPVerification: e.DisableSIPVerification(),
 		policyVerdictLogFilter: e.GetPolicyVerdictLogFilter(),
+		dscp:                   e.GetDSCP(),
 		cidr4PrefixLengths:     cidr4,
 		cidr6PrefixLengths:     cidr6,
 		options:                e.Options.DeepCopy(),
@@ -184,6 +186,11 @@ func (ep *epInfoCache) GetPolicyVerdictLogFilter() uint32 {
 	return ep.policyVerdictLogFilter
 }
 
+// GetDSCP returns the DSCP code point to set on traffic sent by the endpoint.
+func (ep *epInfoCache) GetDSCP() uint8 {
+	return ep.dscp
+}
+
 func (ep *epInfoCache) IsHost() bool {
 	return ep.endpoint.IsHost()
 }
@@ -147,6 +147,10 @@ type Endpoint struct {
 	// ifIndex is the interface index of the host face interface (veth pair)
 	ifIndex int
 
+	// NetNsCookie is the network namespace cookie of the endpoint, zero if
+	// the kernel does not support SO_NETNS_COOKIE
+	NetNsCookie uint64
+
 	// OpLabels is the endpoint's label configuration
 	//
 	// FIXME: Rename this field to Labels
@@ -159,6 +163,9 @@ type Endpoint struct {
 	// bps is the egress rate of the endpoint
 	bps uint64
 
+	// ingressBps is the ingress rate of the endpoint
+	ingressBps uint64
+
 	// mac is the MAC address of the endpoint
 	//
 	mac mac.MAC // Container MAC address.
@@ -338,6 +345,10 @@ type Endpoint struct {
 
 	noTrackPort uint16
 
+	// dscp is the DSCP code point set on traffic sent by the endpoint,
+	// zero if traffic is left unmarked
+	dscp uint8
+
 	ciliumEndpointUID k8sTypes.UID
 }
 
@@ -1614,12 +1625,21 @@ func (e *Endpoint) RunMetadataResolver(resolveMetadata MetadataResolverCB) {
 					}
 					return annotations[annotation.ProxyVisibility], nil
 				})
-				e.UpdateBandwidthPolicy(func(ns, podName string) (bandwidthEgress string, err error) {
+				e.UpdateBandwidthPolicy(func(ns, podName string) (bandwidthEgress, bandwidthIngress, bbrPacing string, err error) {
+					_, _, _, _, annotations, err := resolveMetadata(ns, podName)
+					if err != nil {
+						return "", "", "", err
+					}
+					return annotations[bandwidth.EgressBandwidth],
+						annotations[bandwidth.IngressBandwidth],
+						annotations[bandwidth.BBRPacing], nil
+				})
+				e.UpdateDSCP(func(_, _ string) (dscp string, err error) {
 					_, _, _, _, annotations, err := resolveMetadata(ns, podName)
 					if err != nil {
 						return "", err
 					}
-					return annotations[bandwidth.EgressBandwidth], nil
+					return annotations[annotation.DSCP], nil
 				})
 				e.UpdateLabels(ctx, identityLabels, info, true)
 				close(done)
@@ -2153,7 +2173,7 @@ func (e *Endpoint) Delete(conf DeleteConfig) []error {
 		}
 	}
 
-	if option.Config.IPAM == ipamOption.IPAMENI || option.Config.IPAM == ipamOption.IPAMAzure || option.Config.IPAM == ipamOption.IPAMAlibabaCloud {
+	if option.Config.IPAM == ipamOption.IPAMENI || option.Config.IPAM == ipamOption.IPAMAzure || option.Config.IPAM == ipamOption.IPAMAlibabaCloud || option.Config.IPAM == ipamOption.IPAMOpenStack {
 		e.getLogger().WithFields(logrus.Fields{
 			"ep":     e.GetID(),
 			"ipAddr": e.GetIPv4Address(),
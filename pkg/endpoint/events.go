@@ -9,7 +9,9 @@ import (
 
 	"github.com/sirupsen/logrus"
 
+	"github.com/cilium/cilium/pkg/annotation"
 	"github.com/cilium/cilium/pkg/bandwidth"
+	"github.com/cilium/cilium/pkg/endpoint/regeneration"
 	"github.com/cilium/cilium/pkg/eventqueue"
 	"github.com/cilium/cilium/pkg/logging/logfields"
 	"github.com/cilium/cilium/pkg/maps/bwmap"
@@ -251,16 +253,107 @@ func (ev *EndpointPolicyVisibilityEvent) Handle(res chan interface{}) {
 	return
 }
 
+// EndpointDSCPEvent contains all fields necessary to update the DSCP code
+// point set on traffic sent by the Pod.
+type EndpointDSCPEvent struct {
+	ep     *Endpoint
+	annoCB AnnotationsResolverCB
+}
+
+// Handle handles the DSCP update.
+func (ev *EndpointDSCPEvent) Handle(res chan interface{}) {
+	var dscp uint8
+
+	e := ev.ep
+
+	dscpStr, err := ev.annoCB(e.K8sNamespace, e.K8sPodName)
+	if err != nil {
+		res <- &EndpointRegenerationResult{
+			err: err,
+		}
+		return
+	}
+
+	if dscpStr != "" {
+		// Validate the annotation before we do any actual alteration to
+		// the endpoint. The DSCP field is 6 bits wide.
+		d64, err := strconv.ParseUint(dscpStr, 10, 8)
+		if err != nil || d64 > 63 {
+			e.getLogger().Warningf("Endpoint has invalid %s annotation %q, allowed values are 0-63. This annotation is ignored.",
+				annotation.DSCP, dscpStr)
+			res <- &EndpointRegenerationResult{
+				err: nil,
+			}
+			return
+		}
+		dscp = uint8(d64)
+	}
+
+	if err := e.lockAlive(); err != nil {
+		// If the endpoint is being deleted, we don't need to update its
+		// DSCP code point.
+		res <- &EndpointRegenerationResult{
+			err: nil,
+		}
+		return
+	}
+
+	if dscp == e.dscp {
+		e.unlock()
+		res <- &EndpointRegenerationResult{
+			err: nil,
+		}
+		return
+	}
+	e.dscp = dscp
+	e.unlock()
+
+	// The DSCP code point is part of the endpoint's datapath configuration,
+	// regenerate to apply it. Do not wait for the regeneration to finish as
+	// it is processed on this very event queue.
+	e.RegenerateIfAlive(&regeneration.ExternalRegenerationMetadata{
+		Reason:            "DSCP annotation updated",
+		RegenerationLevel: regeneration.RegenerateWithDatapathRewrite,
+	})
+
+	res <- &EndpointRegenerationResult{
+		err: nil,
+	}
+}
+
 // EndpointPolicyBandwidthEvent contains all fields necessary to update
 // the Pod's bandwidth policy.
 type EndpointPolicyBandwidthEvent struct {
 	ep     *Endpoint
-	annoCB AnnotationsResolverCB
+	annoCB BandwidthAnnotationsResolverCB
+}
+
+// bbrFlags resolves the BBR pacing annotation value of the Pod into throttle
+// map flags. The annotation is ignored with a warning if the kernel or the
+// datapath configuration cannot honor it, and is a no-op if BBR has already
+// been enabled for the whole node.
+func (ev *EndpointPolicyBandwidthEvent) bbrFlags(bbrPacing string) uint64 {
+	e := ev.ep
+
+	if bbrPacing != bandwidth.BBRPacingEnabled || option.Config.EnableBBR {
+		return 0
+	}
+	if !option.Config.BBRSupported {
+		e.getLogger().Warningf("Endpoint has %s annotation, but the kernel does not support BBR pacing (needs 5.18 or newer). This annotation is ignored.",
+			bandwidth.BBRPacing)
+		return 0
+	}
+	if option.Config.EnableHostLegacyRouting {
+		e.getLogger().Warningf("Endpoint has %s annotation, but BBR pacing requires BPF host routing. This annotation is ignored.",
+			bandwidth.BBRPacing)
+		return 0
+	}
+	return bwmap.EdtFlagBBR
 }
 
 // Handle handles the policy bandwidth update.
 func (ev *EndpointPolicyBandwidthEvent) Handle(res chan interface{}) {
-	var bps uint64
+	var bps, ingressBps uint64
 
 	e := ev.ep
 	if err := e.lockAlive(); err != nil {
@@ -275,17 +368,20 @@ func (ev *EndpointPolicyBandwidthEvent) Handle(res chan interface{}) {
 		e.unlock()
 	}()
 
-	bandwidthEgress, err := ev.annoCB(e.K8sNamespace, e.K8sPodName)
+	bandwidthEgress, bandwidthIngress, bbrPacing, err := ev.annoCB(e.K8sNamespace, e.K8sPodName)
 	if err != nil || !option.Config.EnableBandwidthManager {
 		res <- &EndpointRegenerationResult{
 			err: err,
 		}
 		return
 	}
-	if bandwidthEgress != "" {
-		bps, err = bandwidth.GetBytesPerSec(bandwidthEgress)
+	flags := ev.bbrFlags(bbrPacing)
+	if bandwidthEgress != "" || flags != 0 {
+		if bandwidthEgress != "" {
+			bps, err = bandwidth.GetBytesPerSec(bandwidthEgress)
+		}
 		if err == nil {
-			err = bwmap.Update(e.ID, bps)
+			err = bwmap.Update(e.ID, bps, flags)
 		}
 	} else {
 		err = bwmap.SilentDelete(e.ID)
@@ -296,6 +392,20 @@ func (ev *EndpointPolicyBandwidthEvent) Handle(res chan interface{}) {
 		}
 		return
 	}
+	if bandwidthIngress != "" {
+		ingressBps, err = bandwidth.GetBytesPerSec(bandwidthIngress)
+		if err == nil {
+			err = bwmap.UpdateIngress(e.ID, ingressBps)
+		}
+	} else {
+		err = bwmap.SilentDeleteIngress(e.ID)
+	}
+	if err != nil {
+		res <- &EndpointRegenerationResult{
+			err: err,
+		}
+		return
+	}
 
 	bpsOld := "inf"
 	bpsNew := "inf"
@@ -308,6 +418,19 @@ func (ev *EndpointPolicyBandwidthEvent) Handle(res chan interface{}) {
 	e.getLogger().Debugf("Updating %s from %s to %s bytes/sec", bandwidth.EgressBandwidth,
 		bpsOld, bpsNew)
 	e.bps = bps
+
+	bpsOld = "inf"
+	bpsNew = "inf"
+	if e.ingressBps != 0 {
+		bpsOld = strconv.FormatUint(e.ingressBps, 10)
+	}
+	if ingressBps != 0 {
+		bpsNew = strconv.FormatUint(ingressBps, 10)
+	}
+	e.getLogger().Debugf("Updating %s from %s to %s bytes/sec", bandwidth.IngressBandwidth,
+		bpsOld, bpsNew)
+	e.ingressBps = ingressBps
+
 	res <- &EndpointRegenerationResult{
 		err: nil,
 	}
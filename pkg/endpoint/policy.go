@@ -192,6 +192,17 @@ func (e *Endpoint) regeneratePolicy() (retErr error) {
 		return nil
 	}
 
+	// If the endpoint's namespace is part of a staged default-deny rollout,
+	// run in policy audit mode while the namespace is in the audit phase,
+	// and enforce once it moves to the enforcing phase.
+	if phase, ok := policy.DefaultDenyRolloutPhase(e.K8sNamespace); ok {
+		e.Options.SetBool(option.PolicyAuditMode, phase == policy.DefaultDenyPhaseAudit)
+	} else if audit, ok := policy.NamespaceAuditMode(e.K8sNamespace); ok {
+		// The namespace is not part of a rollout but has a policy
+		// defaults object requesting an audit mode override.
+		e.Options.SetBool(option.PolicyAuditMode, audit)
+	}
+
 	e.getLogger().Debug("Starting policy recalculation...")
 	stats := &policyRegenerationStatistics{}
 	stats.totalTime.Start()
@@ -817,6 +828,10 @@ func (e *Endpoint) GetCIDRPrefixLengths() (s6, s4 []int) {
 // annotations.
 type AnnotationsResolverCB func(ns, podName string) (proxyVisibility string, err error)
 
+// BandwidthAnnotationsResolverCB provides an implementation for resolving
+// the bandwidth-related annotations of the pod.
+type BandwidthAnnotationsResolverCB func(ns, podName string) (bandwidthEgress, bandwidthIngress, bbrPacing string, err error)
+
 // UpdateNoTrackRules updates the NOTRACK iptable rules for this endpoint. If anno
 // is empty, then any existing NOTRACK rules will be removed. If anno cannot be parsed,
 // we remove existing NOTRACK rules too if there's any.
@@ -859,9 +874,9 @@ func (e *Endpoint) UpdateVisibilityPolicy(annoCB AnnotationsResolverCB) {
 	}
 }
 
-// UpdateBandwidthPolicy updates the egress bandwidth of this endpoint to
-// progagate the throttle rate to the BPF data path.
-func (e *Endpoint) UpdateBandwidthPolicy(annoCB AnnotationsResolverCB) {
+// UpdateBandwidthPolicy updates the ingress and egress bandwidth of this
+// endpoint to progagate the throttle rates to the BPF data path.
+func (e *Endpoint) UpdateBandwidthPolicy(annoCB BandwidthAnnotationsResolverCB) {
 	ch, err := e.eventQueue.Enqueue(eventqueue.NewEvent(&EndpointPolicyBandwidthEvent{
 		ep:     e,
 		annoCB: annoCB,
@@ -878,6 +893,26 @@ func (e *Endpoint) UpdateBandwidthPolicy(annoCB AnnotationsResolverCB) {
 	}
 }
 
+// UpdateDSCP updates the DSCP code point set on traffic sent by this endpoint
+// to reflect the state stored in the provided annotation. If the annotation is
+// empty, traffic is left unmarked.
+func (e *Endpoint) UpdateDSCP(annoCB AnnotationsResolverCB) {
+	ch, err := e.eventQueue.Enqueue(eventqueue.NewEvent(&EndpointDSCPEvent{
+		ep:     e,
+		annoCB: annoCB,
+	}))
+	if err != nil {
+		e.getLogger().WithError(err).Error("Unable to enqueue endpoint DSCP event")
+		return
+	}
+
+	updateRes := <-ch
+	regenResult, ok := updateRes.(*EndpointRegenerationResult)
+	if ok && regenResult.err != nil {
+		e.getLogger().WithError(regenResult.err).Error("EndpointDSCPEvent event failed")
+	}
+}
+
 // GetRealizedPolicyRuleLabelsForKey returns the list of policy rule labels
 // which match a given flow key (in host byte-order). The returned
 // LabelArrayList is shallow-copied and therefore must not be mutated.
@@ -384,6 +384,7 @@ func (e *Endpoint) toSerializedEndpoint() *serializableEndpoint {
 		DockerEndpointID:      e.dockerEndpointID,
 		IfName:                e.ifName,
 		IfIndex:               e.ifIndex,
+		NetNsCookie:           e.NetNsCookie,
 		OpLabels:              e.OpLabels,
 		LXCMAC:                e.mac,
 		IPv6:                  e.IPv6,
@@ -435,6 +436,9 @@ type serializableEndpoint struct {
 	// ifIndex is the interface index of the host face interface (veth pair)
 	IfIndex int
 
+	// NetNsCookie is the network namespace cookie of the endpoint
+	NetNsCookie uint64
+
 	// OpLabels is the endpoint's label configuration
 	//
 	// FIXME: Rename this field to Labels
@@ -517,6 +521,7 @@ func (ep *Endpoint) fromSerializedEndpoint(r *serializableEndpoint) {
 	ep.dockerEndpointID = r.DockerEndpointID
 	ep.ifName = r.IfName
 	ep.ifIndex = r.IfIndex
+	ep.NetNsCookie = r.NetNsCookie
 	ep.OpLabels = r.OpLabels
 	ep.mac = r.LXCMAC
 	ep.IPv6 = r.IPv6
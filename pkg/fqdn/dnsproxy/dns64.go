@@ -0,0 +1,73 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package dnsproxy
+
+import (
+	"fmt"
+	"net"
+
+	"github.com/miekg/dns"
+)
+
+// needsDNS64 returns true if the query asked for AAAA records and the
+// upstream server answered successfully without any, meaning the name only
+// resolves to IPv4 addresses and a synthesized answer is needed for
+// IPv6-only endpoints.
+func needsDNS64(request, response *dns.Msg) bool {
+	if request.Question[0].Qtype != dns.TypeAAAA ||
+		response.Rcode != dns.RcodeSuccess {
+		return false
+	}
+
+	for _, rr := range response.Answer {
+		if rr.Header().Rrtype == dns.TypeAAAA {
+			return false
+		}
+	}
+
+	return true
+}
+
+// synthesizeDNS64 re-queries the upstream server for the A records of the
+// name and appends an AAAA record per A record to the original response,
+// embedding the IPv4 address into the lower 32 bits of the DNS64 prefix as
+// described in RFC 6147. The synthesized addresses fall into the NAT46x64
+// gateway prefix and are translated back to IPv4 by the datapath. TTLs are
+// carried over from the A records.
+func (p *DNSProxy) synthesizeDNS64(client *dns.Client, conn *dns.Conn, request, response *dns.Msg) (*dns.Msg, error) {
+	aRequest := new(dns.Msg)
+	aRequest.SetQuestion(request.Question[0].Name, dns.TypeA)
+	aRequest.Id = dns.Id()
+
+	aResponse, _, err := client.ExchangeWithConn(aRequest, conn)
+	if err != nil {
+		return nil, fmt.Errorf("cannot query A records for DNS64 synthesis: %w", err)
+	}
+	if aResponse.Rcode != dns.RcodeSuccess {
+		return response, nil
+	}
+
+	prefix := p.DNS64Prefix.As16()
+	synth := response.Copy()
+	for _, rr := range aResponse.Answer {
+		a, ok := rr.(*dns.A)
+		if !ok {
+			continue
+		}
+		v4 := a.A.To4()
+		if v4 == nil {
+			continue
+		}
+		addr := prefix
+		copy(addr[12:], v4)
+		hdr := a.Hdr
+		hdr.Rrtype = dns.TypeAAAA
+		synth.Answer = append(synth.Answer, &dns.AAAA{
+			Hdr:  hdr,
+			AAAA: net.IP(addr[:]),
+		})
+	}
+
+	return synth, nil
+}
@@ -0,0 +1,38 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package dnsproxy
+
+import (
+	"github.com/miekg/dns"
+	. "gopkg.in/check.v1"
+)
+
+func (s *DNSProxyTestSuite) TestNeedsDNS64(c *C) {
+	aaaaRequest := new(dns.Msg)
+	aaaaRequest.SetQuestion("cilium.io.", dns.TypeAAAA)
+
+	aRequest := new(dns.Msg)
+	aRequest.SetQuestion("cilium.io.", dns.TypeA)
+
+	// AAAA query answered without AAAA records needs synthesis.
+	emptyResponse := new(dns.Msg)
+	emptyResponse.SetReply(aaaaRequest)
+	c.Assert(needsDNS64(aaaaRequest, emptyResponse), Equals, true)
+
+	// A queries are never synthesized.
+	c.Assert(needsDNS64(aRequest, emptyResponse), Equals, false)
+
+	// Native AAAA records take precedence.
+	nativeResponse := new(dns.Msg)
+	nativeResponse.SetReply(aaaaRequest)
+	rr, err := dns.NewRR("cilium.io. 60 IN AAAA f00d::1")
+	c.Assert(err, IsNil)
+	nativeResponse.Answer = append(nativeResponse.Answer, rr)
+	c.Assert(needsDNS64(aaaaRequest, nativeResponse), Equals, false)
+
+	// Failed lookups are passed through unmodified.
+	nxdomainResponse := new(dns.Msg)
+	nxdomainResponse.SetRcode(aaaaRequest, dns.RcodeNameError)
+	c.Assert(needsDNS64(aaaaRequest, nxdomainResponse), Equals, false)
+}
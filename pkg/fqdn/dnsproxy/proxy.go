@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"math"
 	"net"
+	"net/netip"
 	"regexp"
 	"strconv"
 	"strings"
@@ -109,6 +110,15 @@ type DNSProxy struct {
 	// endpoints that are larger than 512 Bytes or the EDNS0 option, if present.
 	EnableDNSCompression bool
 
+	// EnableDNS64 makes the proxy synthesize AAAA records out of A records
+	// for names which do not have native AAAA records, embedding the IPv4
+	// address into DNS64Prefix. The synthesized addresses are translated
+	// back to IPv4 by the NAT46x64 gateway in the datapath.
+	EnableDNS64 bool
+
+	// DNS64Prefix is the IPv6 /96 prefix used for synthesized AAAA records.
+	DNS64Prefix netip.Addr
+
 	// ConcurrencyLimit limits parallel goroutines number that serve DNS
 	ConcurrencyLimit *semaphore.Weighted
 	// ConcurrencyGracePeriod is the grace period for waiting on
@@ -141,6 +151,11 @@ type DNSProxy struct {
 	// Note: Simple DNS names, e.g. bar.foo.com, will treat the "." as a literal.
 	allowed perEPAllow
 
+	// denied tracks all denied L7 DNS rules by endpointID, destination port,
+	// and L3 Selector. A query matching any of these is rejected before the
+	// allowed rules are consulted.
+	denied perEPAllow
+
 	// restored is a set of rules restored from a previous instance that can be
 	// used until 'allowed' rules for an endpoint are first initialized after
 	// a restart
@@ -572,6 +587,7 @@ func (proxyStat *ProxyRequestContext) IsTimeout() bool {
 // callback.
 func StartDNSProxy(
 	address string, port uint16, enableDNSCompression bool, maxRestoreDNSIPs int,
+	enableDNS64 bool, dns64Prefix netip.Addr,
 	lookupEPFunc LookupEndpointIDByIPFunc,
 	lookupSecIDFunc LookupSecIDByIPFunc,
 	lookupIPsFunc LookupIPsBySecIDFunc,
@@ -599,10 +615,13 @@ func StartDNSProxy(
 		lookupTargetDNSServer:    lookupTargetDNSServer,
 		usedServers:              make(map[string]struct{}),
 		allowed:                  make(perEPAllow),
+		denied:                   make(perEPAllow),
 		restored:                 make(perEPRestored),
 		restoredEPs:              make(restoredEPs),
 		cache:                    make(regexCache),
 		EnableDNSCompression:     enableDNSCompression,
+		EnableDNS64:              enableDNS64,
+		DNS64Prefix:              dns64Prefix,
 		maxIPsPerRestoredDNSRule: maxRestoreDNSIPs,
 	}
 	if concurrencyLimit > 0 {
@@ -685,12 +704,27 @@ func (p *DNSProxy) LookupEndpointByIP(ip net.IP) (endpoint *endpoint.Endpoint, e
 }
 
 // UpdateAllowed sets newRules for endpointID and destPort. It compiles the DNS
-// rules into regexes that are then used in CheckAllowed.
+// rules into regexes that are then used in CheckAllowed. Rulesets from deny
+// policies are kept separately so that matching queries can be rejected while
+// the remaining traffic stays subject to the allow rules.
 func (p *DNSProxy) UpdateAllowed(endpointID uint64, destPort uint16, newRules policy.L7DataMap) error {
+	allowRules := make(policy.L7DataMap, len(newRules))
+	denyRules := make(policy.L7DataMap)
+	for selector, l7Rules := range newRules {
+		if l7Rules != nil && l7Rules.IsDeny {
+			denyRules[selector] = l7Rules
+		} else {
+			allowRules[selector] = l7Rules
+		}
+	}
+
 	p.Lock()
 	defer p.Unlock()
 
-	err := p.allowed.setPortRulesForID(p.cache, endpointID, destPort, newRules)
+	err := p.denied.setPortRulesForID(p.cache, endpointID, destPort, denyRules)
+	if err == nil {
+		err = p.allowed.setPortRulesForID(p.cache, endpointID, destPort, allowRules)
+	}
 	if err == nil {
 		// Rules were updated based on policy, remove restored rules
 		p.removeRestoredRulesLocked(endpointID)
@@ -719,6 +753,15 @@ func (p *DNSProxy) CheckAllowed(endpointID uint64, destPort uint16, destID ident
 	p.RLock()
 	defer p.RUnlock()
 
+	if epDeny, exists := p.denied.getPortRulesForID(endpointID, destPort); exists {
+		for selector, regex := range epDeny {
+			// The port was matched in getPortRulesForID, above.
+			if regex != nil && selector.Selects(destID) && regex.MatchString(name) {
+				return false, nil
+			}
+		}
+	}
+
 	epAllow, exists := p.allowed.getPortRulesForID(endpointID, destPort)
 	if !exists {
 		return p.checkRestored(endpointID, destPort, destIP.String(), name), nil
@@ -930,6 +973,15 @@ func (p *DNSProxy) ServeDNS(w dns.ResponseWriter, request *dns.Msg) {
 	scopedLog.WithField(logfields.Response, response).Debug("Received DNS response to proxied lookup")
 	stat.Success = true
 
+	if p.EnableDNS64 && needsDNS64(request, response) {
+		synth, err := p.synthesizeDNS64(client, conn, request, response)
+		if err != nil {
+			scopedLog.WithError(err).Warn("Cannot synthesize DNS64 records, returning upstream response unmodified")
+		} else {
+			response = synth
+		}
+	}
+
 	scopedLog.Debug("Notifying with DNS response to original DNS query")
 	p.NotifyOnDNSMsg(time.Now(), ep, epIPPort, targetServerID, targetServerAddr, response, protocol, true, &stat)
 
@@ -196,6 +196,7 @@ func (s *DNSProxyTestSuite) SetUpTest(c *C) {
 
 	option.Config.FQDNRegexCompileLRUSize = 1024
 	proxy, err := StartDNSProxy("", 0, true, 1000, // any address, any port, enable compression, max 1000 restore IPs
+		false, netip.Addr{}, // no DNS64
 		// LookupEPByIP
 		func(ip net.IP) (*endpoint.Endpoint, error) {
 			if s.restoring {
@@ -39,6 +39,11 @@ type NameManager struct {
 	// use these selectors to map selectors --> IPs.
 	allSelectors map[api.FQDNSelector]*regexp.Regexp
 
+	// selectorTrie indexes allSelectors by the labels of the DNS names
+	// they match, so that DNS updates only pay for the selectors they
+	// affect.
+	selectorTrie *selectorTrie
+
 	// cache is a private copy of the pointer from config.
 	cache *DNSCache
 
@@ -99,6 +104,7 @@ func (n *NameManager) RegisterForIdentityUpdatesLocked(selector api.FQDNSelector
 	}
 
 	n.allSelectors[selector] = regex
+	n.selectorTrie.insert(selector, regex)
 }
 
 // UnregisterForIdentityUpdatesLocked removes this FQDNSelector from the set of
@@ -106,6 +112,7 @@ func (n *NameManager) RegisterForIdentityUpdatesLocked(selector api.FQDNSelector
 // for IPs which correspond to said selector are propagated.
 func (n *NameManager) UnregisterForIdentityUpdatesLocked(selector api.FQDNSelector) {
 	delete(n.allSelectors, selector)
+	n.selectorTrie.remove(selector)
 }
 
 // NewNameManager creates an initialized NameManager.
@@ -125,6 +132,7 @@ func NewNameManager(config Config) *NameManager {
 	return &NameManager{
 		config:       config,
 		allSelectors: make(map[api.FQDNSelector]*regexp.Regexp),
+		selectorTrie: newSelectorTrie(),
 		cache:        config.Cache,
 	}
 
@@ -171,11 +179,9 @@ func (n *NameManager) ForceGenerateDNS(ctx context.Context, namesToRegen []strin
 
 	affectedFQDNSels := make(map[api.FQDNSelector]struct{}, 0)
 	for _, dnsName := range namesToRegen {
-		for fqdnSel, fqdnRegEx := range n.allSelectors {
-			if fqdnRegEx.MatchString(dnsName) {
-				affectedFQDNSels[fqdnSel] = struct{}{}
-			}
-		}
+		n.selectorTrie.match(dnsName, func(fqdnSel api.FQDNSelector) {
+			affectedFQDNSels[fqdnSel] = struct{}{}
+		})
 	}
 
 	namesMissingIPs, selectorIPMapping := n.MapSelectorsToIPsLocked(affectedFQDNSels)
@@ -228,12 +234,9 @@ func (n *NameManager) updateDNSIPs(lookupTime time.Time, updatedDNSIPs map[strin
 				"lookupIPs": lookupIPs,
 			}).Debug("FQDN: No selectors registered for updates")
 		}
-		for fqdnSel, fqdnRegex := range n.allSelectors {
-			matches := fqdnRegex.MatchString(dnsName)
-			if matches {
-				affectedSelectors[fqdnSel] = struct{}{}
-			}
-		}
+		n.selectorTrie.match(dnsName, func(fqdnSel api.FQDNSelector) {
+			affectedSelectors[fqdnSel] = struct{}{}
+		})
 	}
 
 	return affectedSelectors, updatedNames
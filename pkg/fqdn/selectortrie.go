@@ -0,0 +1,199 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package fqdn
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/cilium/cilium/pkg/fqdn/dns"
+	"github.com/cilium/cilium/pkg/fqdn/matchpattern"
+	"github.com/cilium/cilium/pkg/policy/api"
+)
+
+// selectorTrie indexes registered FQDNSelectors by the labels of the DNS
+// names they match, so that the selectors matching an updated name can be
+// found without testing the regular expression of every selector. Exact
+// matchNames and matchPatterns of the common '*.example.com' form are stored
+// in a trie keyed on the name labels in reversed order. Only patterns which
+// cannot be expressed as a trie key, such as 'api-*.example.com', fall back
+// to being matched by regular expression. This keeps the cost of a DNS
+// update proportional to the number of updated names and the selectors they
+// match, instead of the total number of registered selectors.
+type selectorTrie struct {
+	root *selectorTrieNode
+
+	// generic holds the selectors which cannot be indexed by name labels,
+	// mapped to their compiled matching regular expression.
+	generic map[api.FQDNSelector]*regexp.Regexp
+}
+
+type selectorTrieNode struct {
+	children map[string]*selectorTrieNode
+
+	// exact holds the selectors whose matchName terminates at this node.
+	exact map[api.FQDNSelector]struct{}
+
+	// wildcard holds the selectors whose matchPattern matches one
+	// arbitrary label below this node.
+	wildcard map[api.FQDNSelector]struct{}
+}
+
+const (
+	trieKeyExact = iota
+	trieKeyWildcard
+	trieKeyGeneric
+)
+
+func newSelectorTrie() *selectorTrie {
+	return &selectorTrie{
+		root:    newSelectorTrieNode(),
+		generic: make(map[api.FQDNSelector]*regexp.Regexp),
+	}
+}
+
+func newSelectorTrieNode() *selectorTrieNode {
+	return &selectorTrieNode{
+		children: make(map[string]*selectorTrieNode),
+		exact:    make(map[api.FQDNSelector]struct{}),
+		wildcard: make(map[api.FQDNSelector]struct{}),
+	}
+}
+
+// reverseLabels returns the labels of the given name in reversed order,
+// starting at the TLD.
+func reverseLabels(name string) []string {
+	name = strings.TrimSuffix(name, ".")
+	if name == "" {
+		return nil
+	}
+	labels := strings.Split(name, ".")
+	for i, j := 0, len(labels)-1; i < j; i, j = i+1, j-1 {
+		labels[i], labels[j] = labels[j], labels[i]
+	}
+	return labels
+}
+
+// selectorTrieKey returns the reversed labels under which the given selector
+// is indexed and the kind of trie entry to create. Selectors which cannot be
+// indexed are stored as trieKeyGeneric with a nil label list.
+func selectorTrieKey(selector api.FQDNSelector) ([]string, int) {
+	if len(selector.MatchName) > 0 {
+		return reverseLabels(strings.ToLower(dns.FQDN(selector.MatchName))), trieKeyExact
+	}
+
+	pattern := strings.ToLower(matchpattern.Sanitize(selector.MatchPattern))
+	if pattern == "*" {
+		return nil, trieKeyGeneric
+	}
+
+	labels := reverseLabels(pattern)
+	if len(labels) == 0 {
+		return nil, trieKeyGeneric
+	}
+
+	// the leftmost label of the pattern is the last reversed label
+	leftmost := len(labels) - 1
+	if labels[leftmost] == "*" {
+		labels = labels[:leftmost]
+		if !labelsContainWildcard(labels) {
+			return labels, trieKeyWildcard
+		}
+		return nil, trieKeyGeneric
+	}
+	if !labelsContainWildcard(labels) {
+		return labels, trieKeyExact
+	}
+	return nil, trieKeyGeneric
+}
+
+func labelsContainWildcard(labels []string) bool {
+	for _, label := range labels {
+		if strings.Contains(label, "*") {
+			return true
+		}
+	}
+	return false
+}
+
+// insert adds the given selector and its compiled regular expression to the
+// index.
+func (t *selectorTrie) insert(selector api.FQDNSelector, regex *regexp.Regexp) {
+	labels, kind := selectorTrieKey(selector)
+	if kind == trieKeyGeneric {
+		t.generic[selector] = regex
+		return
+	}
+
+	node := t.root
+	for _, label := range labels {
+		child := node.children[label]
+		if child == nil {
+			child = newSelectorTrieNode()
+			node.children[label] = child
+		}
+		node = child
+	}
+	if kind == trieKeyExact {
+		node.exact[selector] = struct{}{}
+	} else {
+		node.wildcard[selector] = struct{}{}
+	}
+}
+
+// remove deletes the given selector from the index, pruning trie nodes which
+// become empty.
+func (t *selectorTrie) remove(selector api.FQDNSelector) {
+	labels, kind := selectorTrieKey(selector)
+	if kind == trieKeyGeneric {
+		delete(t.generic, selector)
+		return
+	}
+	removeFromTrieNode(t.root, labels, kind, selector)
+}
+
+func removeFromTrieNode(node *selectorTrieNode, labels []string, kind int, selector api.FQDNSelector) (empty bool) {
+	if len(labels) == 0 {
+		if kind == trieKeyExact {
+			delete(node.exact, selector)
+		} else {
+			delete(node.wildcard, selector)
+		}
+	} else if child := node.children[labels[0]]; child != nil {
+		if removeFromTrieNode(child, labels[1:], kind, selector) {
+			delete(node.children, labels[0])
+		}
+	}
+	return len(node.children) == 0 && len(node.exact) == 0 && len(node.wildcard) == 0
+}
+
+// match calls found for every selector matching the given name. A selector
+// is reported at most once.
+func (t *selectorTrie) match(name string, found func(api.FQDNSelector)) {
+	for selector, regex := range t.generic {
+		if regex.MatchString(name) {
+			found(selector)
+		}
+	}
+
+	labels := reverseLabels(strings.ToLower(dns.FQDN(name)))
+	node := t.root
+	for i, label := range labels {
+		if i == len(labels)-1 {
+			// the last reversed label is the leftmost label of the
+			// name, one level below the wildcard selectors matching
+			// the name
+			for selector := range node.wildcard {
+				found(selector)
+			}
+		}
+		node = node.children[label]
+		if node == nil {
+			return
+		}
+	}
+	for selector := range node.exact {
+		found(selector)
+	}
+}
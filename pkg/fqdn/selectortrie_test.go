@@ -0,0 +1,82 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package fqdn
+
+import (
+	. "gopkg.in/check.v1"
+
+	"github.com/cilium/cilium/pkg/checker"
+
+	"github.com/cilium/cilium/pkg/policy/api"
+)
+
+func (ds *FQDNTestSuite) trieMatches(c *C, trie *selectorTrie, name string) []api.FQDNSelector {
+	var matches []api.FQDNSelector
+	trie.match(name, func(selector api.FQDNSelector) {
+		matches = append(matches, selector)
+	})
+	return matches
+}
+
+func (ds *FQDNTestSuite) TestSelectorTrieMatch(c *C) {
+	trie := newSelectorTrie()
+
+	exact := api.FQDNSelector{MatchName: "cilium.io"}
+	wildcard := api.FQDNSelector{MatchPattern: "*.cilium.io"}
+	generic := api.FQDNSelector{MatchPattern: "api-*.cilium.io"}
+
+	for _, selector := range []api.FQDNSelector{exact, wildcard, generic} {
+		regex, err := selector.ToRegex()
+		c.Assert(err, IsNil)
+		trie.insert(selector, regex)
+	}
+
+	// the exact name matches neither the wildcard nor the generic pattern
+	c.Assert(ds.trieMatches(c, trie, "cilium.io."), checker.DeepEquals, []api.FQDNSelector{exact})
+
+	// a single extra label matches the wildcard pattern only
+	c.Assert(ds.trieMatches(c, trie, "docs.cilium.io."), checker.DeepEquals, []api.FQDNSelector{wildcard})
+
+	// the generic pattern is matched by regular expression fallback
+	matches := ds.trieMatches(c, trie, "api-1.cilium.io.")
+	c.Assert(matches, HasLen, 2)
+
+	// two extra labels match no selector
+	c.Assert(ds.trieMatches(c, trie, "too.deep.cilium.io."), HasLen, 0)
+	c.Assert(ds.trieMatches(c, trie, "cilium.net."), HasLen, 0)
+}
+
+func (ds *FQDNTestSuite) TestSelectorTrieMatchAll(c *C) {
+	trie := newSelectorTrie()
+
+	matchAll := api.FQDNSelector{MatchPattern: "*"}
+	regex, err := matchAll.ToRegex()
+	c.Assert(err, IsNil)
+	trie.insert(matchAll, regex)
+
+	c.Assert(ds.trieMatches(c, trie, "cilium.io."), checker.DeepEquals, []api.FQDNSelector{matchAll})
+	c.Assert(ds.trieMatches(c, trie, "docs.cilium.io."), checker.DeepEquals, []api.FQDNSelector{matchAll})
+}
+
+func (ds *FQDNTestSuite) TestSelectorTrieRemove(c *C) {
+	trie := newSelectorTrie()
+
+	exact := api.FQDNSelector{MatchName: "cilium.io"}
+	wildcard := api.FQDNSelector{MatchPattern: "*.cilium.io"}
+
+	for _, selector := range []api.FQDNSelector{exact, wildcard} {
+		regex, err := selector.ToRegex()
+		c.Assert(err, IsNil)
+		trie.insert(selector, regex)
+	}
+
+	trie.remove(wildcard)
+	c.Assert(ds.trieMatches(c, trie, "docs.cilium.io."), HasLen, 0)
+	c.Assert(ds.trieMatches(c, trie, "cilium.io."), checker.DeepEquals, []api.FQDNSelector{exact})
+
+	// removing the last selector prunes the trie
+	trie.remove(exact)
+	c.Assert(ds.trieMatches(c, trie, "cilium.io."), HasLen, 0)
+	c.Assert(trie.root.children, HasLen, 0)
+}
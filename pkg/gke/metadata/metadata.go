@@ -0,0 +1,69 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package metadata
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const (
+	metadataURL = "http://metadata.google.internal/computeMetadata/v1"
+)
+
+// GetAliasIPRanges returns the alias IP ranges assigned to the primary
+// network interface of the instance
+func GetAliasIPRanges(ctx context.Context) ([]string, error) {
+	index, err := getMetadata(ctx, "instance/network-interfaces/0/ip-aliases/")
+	if err != nil {
+		return nil, err
+	}
+
+	var ranges []string
+	for _, entry := range strings.Fields(index) {
+		entry = strings.TrimSuffix(entry, "/")
+		aliasRange, err := getMetadata(ctx, "instance/network-interfaces/0/ip-aliases/"+entry)
+		if err != nil {
+			return nil, err
+		}
+		ranges = append(ranges, strings.TrimSpace(aliasRange))
+	}
+
+	return ranges, nil
+}
+
+// getMetadata gets metadata
+// see https://cloud.google.com/compute/docs/metadata/overview
+func getMetadata(ctx context.Context, path string) (string, error) {
+	client := &http.Client{
+		Timeout: time.Second * 10,
+	}
+	url := fmt.Sprintf("%s/%s", metadataURL, path)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Metadata-Flavor", "Google")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return "", err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("metadata service returned status code %d", resp.StatusCode)
+	}
+
+	defer resp.Body.Close()
+	respBytes, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", err
+	}
+
+	return string(respBytes), nil
+}
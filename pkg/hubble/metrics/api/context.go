@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"regexp"
 	"sort"
+	"strconv"
 	"strings"
 
 	"k8s.io/utils/strings/slices"
@@ -14,6 +15,7 @@ import (
 	pb "github.com/cilium/cilium/api/v1/flow"
 	k8sConst "github.com/cilium/cilium/pkg/k8s/apis/cilium.io"
 	ciliumLabels "github.com/cilium/cilium/pkg/labels"
+	"github.com/cilium/cilium/pkg/lock"
 )
 
 // ContextIdentifier describes the identification method of a transmission or
@@ -51,10 +53,17 @@ const ContextOptionsHelp = `
  sourceContext          ::= identifier , { "|", identifier }
  destinationContext     ::= identifier , { "|", identifier }
  labels                 ::= label , { ",", label }
+ cardinalityBudget      ::= non-negative integer
  identifier             ::= identity | namespace | pod | pod-short | dns | ip | reserved-identity | workload-name | app
  label                  ::= source_ip | source_pod | source_namespace | source_workload | source_app | destination_ip | destination_pod | destination_namespace | destination_workload | destination_app | traffic_direction
 `
 
+// rollupLabelValue is the label value reported for all context labels of a
+// metric once its cardinality budget is exhausted. Rolling excess label value
+// combinations up into a single series keeps the metric usable without
+// growing the number of series any further.
+const rollupLabelValue = "__rollup__"
+
 var (
 	shortPodPattern    = regexp.MustCompile("^(.+?)(-[a-z0-9]+){1,2}$")
 	kubeAPIServerLabel = ciliumLabels.LabelKubeAPIServer.String()
@@ -134,6 +143,16 @@ type ContextOptions struct {
 	// Labels is the full set of labels that have been allowlisted when using the
 	// ContextLabels ContextIdentifier.
 	Labels labelsSet
+
+	// CardinalityBudget bounds the number of distinct label value
+	// combinations reported for the metric. Once the budget is exhausted,
+	// new combinations are rolled up into a single series with all context
+	// label values set to rollupLabelValue. A budget of zero disables the
+	// limit.
+	CardinalityBudget int
+
+	mutex      lock.Mutex
+	seenSeries map[string]struct{}
 }
 
 func parseContextIdentifier(s string) (ContextIdentifier, error) {
@@ -206,6 +225,11 @@ func ParseContextOptions(options Options) (*ContextOptions, error) {
 			if err != nil {
 				return nil, err
 			}
+		case "cardinalitybudget":
+			o.CardinalityBudget, err = strconv.Atoi(value)
+			if err != nil || o.CardinalityBudget < 0 {
+				return nil, fmt.Errorf("invalid cardinalityBudget value: %s", value)
+			}
 		}
 	}
 
@@ -383,7 +407,37 @@ func (o *ContextOptions) getLabelValues(invert bool, flow *pb.Flow) (labels []st
 	if len(o.Destination) != 0 {
 		labels = append(labels, destinationLabel)
 	}
-	return
+	return o.applyCardinalityBudget(labels), nil
+}
+
+// applyCardinalityBudget enforces the configured cardinality budget on the
+// given label values. Label value combinations which have been reported
+// before are passed through unchanged. New combinations exceeding the budget
+// are replaced by the rollup combination.
+func (o *ContextOptions) applyCardinalityBudget(labels []string) []string {
+	if o.CardinalityBudget == 0 || len(labels) == 0 {
+		return labels
+	}
+	series := strings.Join(labels, "\x00")
+
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+
+	if o.seenSeries == nil {
+		o.seenSeries = make(map[string]struct{}, o.CardinalityBudget)
+	}
+	if _, seen := o.seenSeries[series]; seen {
+		return labels
+	}
+	if len(o.seenSeries) >= o.CardinalityBudget {
+		rollup := make([]string, len(labels))
+		for i := range rollup {
+			rollup[i] = rollupLabelValue
+		}
+		return rollup
+	}
+	o.seenSeries[series] = struct{}{}
+	return labels
 }
 
 func getContextIDLabelValue(contextID ContextIdentifier, flow *pb.Flow, source bool) string {
@@ -462,6 +516,10 @@ func (o *ContextOptions) GetLabelNames() (labels []string) {
 // with Handler.Status
 func (o *ContextOptions) Status() string {
 	var status []string
+	if o.CardinalityBudget != 0 {
+		status = append(status, "cardinality-budget="+strconv.Itoa(o.CardinalityBudget))
+	}
+
 	if len(o.Labels) != 0 {
 		status = append(status, "labels="+o.Labels.String())
 	}
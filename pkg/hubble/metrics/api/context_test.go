@@ -324,3 +324,34 @@ func Test_labelsSetString(t *testing.T) {
 		})
 	}
 }
+
+func TestCardinalityBudget(t *testing.T) {
+	_, err := ParseContextOptions(Options{"cardinalityBudget": "invalid"})
+	assert.NotNil(t, err)
+
+	_, err = ParseContextOptions(Options{"cardinalityBudget": "-1"})
+	assert.NotNil(t, err)
+
+	opts, err := ParseContextOptions(Options{"sourceContext": "pod", "cardinalityBudget": "2"})
+	assert.Nil(t, err)
+	assert.Equal(t, "cardinality-budget=2,source=pod", opts.Status())
+
+	podFlow := func(pod string) *pb.Flow {
+		return &pb.Flow{Source: &pb.Endpoint{Namespace: "foo", PodName: pod}}
+	}
+
+	// the first combinations within the budget are reported unchanged
+	assert.Equal(t, []string{"foo/foo-123"}, mustGetLabelValues(opts, podFlow("foo-123")))
+	assert.Equal(t, []string{"foo/foo-456"}, mustGetLabelValues(opts, podFlow("foo-456")))
+
+	// new combinations exceeding the budget are rolled up
+	assert.Equal(t, []string{rollupLabelValue}, mustGetLabelValues(opts, podFlow("foo-789")))
+
+	// combinations seen within the budget are still reported unchanged
+	assert.Equal(t, []string{"foo/foo-123"}, mustGetLabelValues(opts, podFlow("foo-123")))
+
+	// without a budget, cardinality is unbounded
+	opts, err = ParseContextOptions(Options{"sourceContext": "pod"})
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"foo/foo-789"}, mustGetLabelValues(opts, podFlow("foo-789")))
+}
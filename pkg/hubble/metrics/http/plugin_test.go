@@ -23,6 +23,7 @@ Options:
  sourceContext          ::= identifier , { "|", identifier }
  destinationContext     ::= identifier , { "|", identifier }
  labels                 ::= label , { ",", label }
+ cardinalityBudget      ::= non-negative integer
  identifier             ::= identity | namespace | pod | pod-short | dns | ip | reserved-identity | workload-name | app
  label                  ::= source_ip | source_pod | source_namespace | source_workload | source_app | destination_ip | destination_pod | destination_namespace | destination_workload | destination_app | traffic_direction
 `
@@ -24,6 +24,7 @@ import (
 	_ "github.com/cilium/cilium/pkg/hubble/metrics/kafka"             // invoke init
 	_ "github.com/cilium/cilium/pkg/hubble/metrics/policy"            // invoke init
 	_ "github.com/cilium/cilium/pkg/hubble/metrics/port-distribution" // invoke init
+	_ "github.com/cilium/cilium/pkg/hubble/metrics/service-latency"   // invoke init
 	_ "github.com/cilium/cilium/pkg/hubble/metrics/tcp"               // invoke init
 )
 
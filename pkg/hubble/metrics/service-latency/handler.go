@@ -0,0 +1,104 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package service_latency
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	flowpb "github.com/cilium/cilium/api/v1/flow"
+	"github.com/cilium/cilium/pkg/hubble/metrics/api"
+)
+
+type serviceLatencyHandler struct {
+	latency *prometheus.HistogramVec
+	context *api.ContextOptions
+}
+
+func (h *serviceLatencyHandler) Init(registry *prometheus.Registry, options api.Options) error {
+	c, err := api.ParseContextOptions(options)
+	if err != nil {
+		return err
+	}
+	h.context = c
+
+	h.latency = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: api.DefaultPrometheusNamespace,
+		Name:      "service_latency_seconds",
+		Help:      "Quantiles of L7 request latency between service pairs in seconds",
+	}, append(h.context.GetLabelNames(), "source_service", "destination_service", "protocol", "reporter"))
+	registry.MustRegister(h.latency)
+	return nil
+}
+
+func (h *serviceLatencyHandler) Status() string {
+	if h.context == nil {
+		return ""
+	}
+	return h.context.Status()
+}
+
+func (h *serviceLatencyHandler) ProcessFlow(ctx context.Context, flow *flowpb.Flow) error {
+	// Only responses carry the request latency.
+	if flow.GetL7().GetType() != flowpb.L7FlowType_RESPONSE || flow.GetL7().GetHttp() == nil {
+		return nil
+	}
+	latency := flow.GetL7().GetLatencyNs()
+	if latency == 0 {
+		return nil
+	}
+
+	reporter := "unknown"
+	switch flow.GetTrafficDirection() {
+	case flowpb.TrafficDirection_EGRESS:
+		reporter = "client"
+	case flowpb.TrafficDirection_INGRESS:
+		reporter = "server"
+	}
+
+	labelValues, err := h.context.GetLabelValuesInvertSourceDestination(flow)
+	if err != nil {
+		return err
+	}
+
+	// The response flows from the server back to the client, so the
+	// service pair of the request is the inverse of the flow endpoints.
+	sourceService := serviceName(flow.GetDestination())
+	destinationService := serviceName(flow.GetSource())
+
+	h.latency.WithLabelValues(append(labelValues,
+		sourceService, destinationService, l7Protocol(flow), reporter,
+	)...).Observe(float64(latency) / float64(time.Second))
+	return nil
+}
+
+// serviceName derives a stable service identifier for an endpoint. The
+// workload name is preferred as it typically maps to the Kubernetes service.
+func serviceName(ep *flowpb.Endpoint) string {
+	name := ""
+	if workloads := ep.GetWorkloads(); len(workloads) != 0 {
+		name = workloads[0].Name
+	} else {
+		name = ep.GetPodName()
+	}
+	if name != "" && ep.GetNamespace() != "" {
+		name = ep.GetNamespace() + "/" + name
+	}
+	return name
+}
+
+// l7Protocol distinguishes gRPC requests from plain HTTP requests based on
+// the content type of the request or response.
+func l7Protocol(flow *flowpb.Flow) string {
+	for _, header := range flow.GetL7().GetHttp().GetHeaders() {
+		if strings.EqualFold(header.GetKey(), "Content-Type") &&
+			strings.HasPrefix(header.GetValue(), "application/grpc") {
+			return "grpc"
+		}
+	}
+	return "http"
+}
@@ -0,0 +1,83 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package service_latency
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	flowpb "github.com/cilium/cilium/api/v1/flow"
+	"github.com/cilium/cilium/pkg/hubble/metrics/api"
+)
+
+func responseFlow(latencyNs uint64, headers []*flowpb.HTTPHeader) *flowpb.Flow {
+	return &flowpb.Flow{
+		TrafficDirection: flowpb.TrafficDirection_INGRESS,
+		Source: &flowpb.Endpoint{
+			Namespace: "backend-ns",
+			PodName:   "backend-5d4f8b9c6-xp2ts",
+			Workloads: []*flowpb.Workload{{Name: "backend", Kind: "Deployment"}},
+		},
+		Destination: &flowpb.Endpoint{
+			Namespace: "frontend-ns",
+			PodName:   "frontend-7f9d6b5c8-2lmqh",
+			Workloads: []*flowpb.Workload{{Name: "frontend", Kind: "Deployment"}},
+		},
+		L7: &flowpb.Layer7{
+			Type:      flowpb.L7FlowType_RESPONSE,
+			LatencyNs: latencyNs,
+			Record: &flowpb.Layer7_Http{
+				Http: &flowpb.HTTP{
+					Code:     200,
+					Method:   "GET",
+					Protocol: "HTTP/1.1",
+					Headers:  headers,
+				},
+			},
+		},
+	}
+}
+
+func TestServiceLatencyHandler(t *testing.T) {
+	registry := prometheus.NewRegistry()
+	h := &serviceLatencyHandler{}
+	require.NoError(t, h.Init(registry, api.Options{}))
+
+	// requests and flows without latency information are ignored
+	request := responseFlow(0, nil)
+	request.L7.Type = flowpb.L7FlowType_REQUEST
+	assert.NoError(t, h.ProcessFlow(context.Background(), request))
+	assert.NoError(t, h.ProcessFlow(context.Background(), responseFlow(0, nil)))
+	assert.Equal(t, 0, testutil.CollectAndCount(h.latency))
+
+	assert.NoError(t, h.ProcessFlow(context.Background(), responseFlow(25_000_000, nil)))
+	assert.Equal(t, 1, testutil.CollectAndCount(h.latency))
+
+	grpcHeaders := []*flowpb.HTTPHeader{{Key: "Content-Type", Value: "application/grpc"}}
+	assert.NoError(t, h.ProcessFlow(context.Background(), responseFlow(25_000_000, grpcHeaders)))
+	assert.Equal(t, 2, testutil.CollectAndCount(h.latency))
+
+	metricFamilies, err := registry.Gather()
+	require.NoError(t, err)
+	require.Len(t, metricFamilies, 1)
+	assert.Equal(t, "hubble_service_latency_seconds", metricFamilies[0].GetName())
+	for _, metric := range metricFamilies[0].GetMetric() {
+		labels := map[string]string{}
+		for _, label := range metric.GetLabel() {
+			labels[label.GetName()] = label.GetValue()
+		}
+		// the service pair of the request is the inverse of the response flow
+		assert.Equal(t, "frontend-ns/frontend", labels["source_service"])
+		assert.Equal(t, "backend-ns/backend", labels["destination_service"])
+		assert.Equal(t, "server", labels["reporter"])
+		assert.Contains(t, []string{"http", "grpc"}, labels["protocol"])
+		assert.Equal(t, uint64(1), metric.GetHistogram().GetSampleCount())
+		assert.InDelta(t, 0.025, metric.GetHistogram().GetSampleSum(), 0.0001)
+	}
+}
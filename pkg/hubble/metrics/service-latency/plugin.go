@@ -0,0 +1,30 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package service_latency
+
+import (
+	"github.com/cilium/cilium/pkg/hubble/metrics/api"
+)
+
+type serviceLatencyPlugin struct{}
+
+func (p *serviceLatencyPlugin) NewHandler() api.Handler {
+	return &serviceLatencyHandler{}
+}
+
+func (p *serviceLatencyPlugin) HelpText() string {
+	return `service-latency - L7 latency metrics per service pair
+Histograms of HTTP and gRPC request latency keyed by the source and
+destination service of the request
+
+Metrics:
+  service_latency_seconds - Quantiles of L7 request latency between service pairs.
+
+Options:` +
+		api.ContextOptionsHelp
+}
+
+func init() {
+	api.DefaultRegistry().Register("service-latency", &serviceLatencyPlugin{})
+}
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"net/url"
 	"sort"
+	"strings"
 	"time"
 
 	flowpb "github.com/cilium/cilium/api/v1/flow"
@@ -58,8 +59,26 @@ func decodeHTTP(flowType accesslog.FlowType, http *accesslog.LogRecordHTTP) *flo
 	}
 }
 
+// grpcMethod returns the fully qualified service and method name of the gRPC
+// call carried in the given HTTP record, or the empty string if the record
+// does not describe a gRPC call. gRPC calls are identified by their
+// "application/grpc" content type; their request path is composed of the
+// service and method name.
+func grpcMethod(http *accesslog.LogRecordHTTP) string {
+	if !strings.HasPrefix(http.Headers.Get("Content-Type"), "application/grpc") {
+		return ""
+	}
+	if http.URL == nil {
+		return ""
+	}
+	return strings.TrimPrefix(http.URL.Path, "/")
+}
+
 func (p *Parser) httpSummary(flowType accesslog.FlowType, http *accesslog.LogRecordHTTP, flow *flowpb.Flow) string {
 	httpRequest := fmt.Sprintf("%s %s", http.Method, http.URL)
+	if method := grpcMethod(http); method != "" {
+		httpRequest = fmt.Sprintf("gRPC %s", method)
+	}
 	switch flowType {
 	case accesslog.TypeRequest:
 		return fmt.Sprintf("%s %s", http.Protocol, httpRequest)
@@ -305,6 +305,42 @@ func TestDecodeL7HTTPResponseTime(t *testing.T) {
 	assert.False(t, ok, "request id should not be in the cache")
 }
 
+func TestDecodeL7GRPCRequestSummary(t *testing.T) {
+	headers := http.Header{}
+	headers.Add("Content-Type", "application/grpc")
+	httpRecord := &accesslog.LogRecordHTTP{
+		Headers:  headers,
+		Method:   "POST",
+		Protocol: "HTTP/2",
+		URL: &url.URL{
+			Scheme: "http",
+			Host:   "example.com",
+			Path:   "/helloworld.Greeter/SayHello",
+		},
+	}
+
+	parser, err := New(log, nil, nil, nil, nil)
+	require.NoError(t, err)
+
+	request := &accesslog.LogRecord{
+		Type:      accesslog.TypeRequest,
+		Timestamp: time.Unix(0, 0).Format(time.RFC3339Nano),
+		HTTP:      httpRecord,
+	}
+
+	f := &flowpb.Flow{}
+	err = parser.Decode(request, f)
+	require.NoError(t, err)
+	assert.Equal(t, "HTTP/2 gRPC helloworld.Greeter/SayHello", f.GetSummary())
+
+	// Requests without the gRPC content type are summarized as plain HTTP.
+	httpRecord.Headers = http.Header{}
+	f = &flowpb.Flow{}
+	err = parser.Decode(request, f)
+	require.NoError(t, err)
+	assert.Equal(t, "HTTP/2 POST http://example.com/helloworld.Greeter/SayHello", f.GetSummary())
+}
+
 func TestGetL7HTTPResponseTraceID(t *testing.T) {
 	requestID := "req-id"
 	requestRecord := &accesslog.LogRecordHTTP{
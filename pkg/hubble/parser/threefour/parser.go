@@ -20,9 +20,13 @@ import (
 	"github.com/cilium/cilium/pkg/hubble/parser/common"
 	"github.com/cilium/cilium/pkg/hubble/parser/errors"
 	"github.com/cilium/cilium/pkg/hubble/parser/getters"
+	k8sConst "github.com/cilium/cilium/pkg/k8s/apis/cilium.io"
+	"github.com/cilium/cilium/pkg/labels"
 	"github.com/cilium/cilium/pkg/lock"
 	"github.com/cilium/cilium/pkg/monitor"
 	monitorAPI "github.com/cilium/cilium/pkg/monitor/api"
+	"github.com/cilium/cilium/pkg/policy"
+	"github.com/cilium/cilium/pkg/policy/trafficdirection"
 )
 
 // Parser is a parser for L3/L4 payloads
@@ -208,6 +212,7 @@ func (p *Parser) Decode(data []byte, decoded *pb.Flow) error {
 	decoded.SourceService = sourceService
 	decoded.DestinationService = destinationService
 	decoded.PolicyMatchType = decodePolicyMatchType(pvn)
+	p.correlatePolicy(pvn, decoded)
 	decoded.DebugCapturePoint = decodeDebugCapturePoint(dbg)
 	decoded.Interface = p.decodeNetworkInterface(tn, dbg)
 	decoded.ProxyPort = decodeProxyPort(dbg, tn)
@@ -299,6 +304,112 @@ func decodePolicyMatchType(pvn *monitor.PolicyVerdictNotify) uint32 {
 	return 0
 }
 
+// policyGetter is implemented by endpoints which can map a realized datapath
+// policy key back to the labels of the policy rules from which it was
+// derived.
+type policyGetter interface {
+	GetRealizedPolicyRuleLabelsForKey(key policy.Key) (
+		derivedFrom labels.LabelArrayList,
+		revision uint64,
+		ok bool,
+	)
+}
+
+// correlatePolicy annotates a policy verdict flow with the policy rules
+// which realized the verdict, by looking up the matched policy map entry of
+// the local endpoint.
+func (p *Parser) correlatePolicy(pvn *monitor.PolicyVerdictNotify, decoded *pb.Flow) {
+	if pvn == nil || p.endpointGetter == nil {
+		return
+	}
+
+	key := policy.Key{
+		Identity: uint32(pvn.RemoteLabel),
+		DestPort: byteorder.NetworkToHost16(pvn.DstPort),
+		Nexthdr:  pvn.Proto,
+	}
+	switch pvn.GetPolicyMatchType() {
+	case monitorAPI.PolicyMatchL3L4:
+		// the key is already fully populated
+	case monitorAPI.PolicyMatchL3Only:
+		key.DestPort = 0
+		key.Nexthdr = 0
+	case monitorAPI.PolicyMatchL4Only:
+		key.Identity = 0
+	case monitorAPI.PolicyMatchAll:
+		key.Identity = 0
+		key.DestPort = 0
+		key.Nexthdr = 0
+	default:
+		// PolicyMatchNone: no policy entry was matched, e.g. the flow
+		// was dropped by the default deny posture.
+		return
+	}
+
+	// the local endpoint is the source for egress verdicts and the
+	// destination for ingress verdicts
+	var localIP string
+	ingress := pvn.IsTrafficIngress()
+	if ingress {
+		key.TrafficDirection = trafficdirection.Ingress.Uint8()
+		localIP = decoded.GetIP().GetDestination()
+	} else {
+		key.TrafficDirection = trafficdirection.Egress.Uint8()
+		localIP = decoded.GetIP().GetSource()
+	}
+
+	ep, ok := p.endpointGetter.GetEndpointInfo(net.ParseIP(localIP))
+	if !ok {
+		return
+	}
+	epPolicyGetter, ok := ep.(policyGetter)
+	if !ok {
+		return
+	}
+	derivedFrom, rev, ok := epPolicyGetter.GetRealizedPolicyRuleLabelsForKey(key)
+	if !ok {
+		return
+	}
+
+	// a negative verdict means the flow matched a deny policy map entry
+	policies := toProtoPolicies(derivedFrom, rev)
+	switch {
+	case pvn.Verdict < 0 && ingress:
+		decoded.IngressDeniedBy = policies
+	case pvn.Verdict < 0:
+		decoded.EgressDeniedBy = policies
+	case ingress:
+		decoded.IngressAllowedBy = policies
+	default:
+		decoded.EgressAllowedBy = policies
+	}
+}
+
+// toProtoPolicies converts the labels of the policy rules from which a
+// policy map entry was derived into the API policy representation.
+func toProtoPolicies(derivedFrom labels.LabelArrayList, revision uint64) []*pb.Policy {
+	policies := make([]*pb.Policy, 0, len(derivedFrom))
+	for _, lbl := range derivedFrom {
+		policy := &pb.Policy{
+			Labels:   lbl.GetModel(),
+			Revision: revision,
+		}
+		for _, l := range lbl {
+			if l.Source == labels.LabelSourceK8s {
+				switch l.Key {
+				case k8sConst.PolicyLabelName:
+					policy.Name = l.Value
+				case k8sConst.PolicyLabelNamespace:
+					policy.Namespace = l.Value
+				}
+			}
+		}
+		policies = append(policies, policy)
+	}
+
+	return policies
+}
+
 func decodeEthernet(ethernet *layers.Ethernet) *pb.Ethernet {
 	return &pb.Ethernet{
 		Source:      ethernet.SrcMAC.String(),
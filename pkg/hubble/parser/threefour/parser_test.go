@@ -491,13 +491,19 @@ func TestDecodeTrafficDirection(t *testing.T) {
 			ok bool,
 		)
 	}
-	policyLabel := labels.LabelArrayList{labels.ParseLabelArray("foo=bar")}
+	policyLabel := labels.LabelArrayList{labels.ParseLabelArray("foo=bar", "k8s:io.cilium.k8s.policy.name=policy-name", "k8s:io.cilium.k8s.policy.namespace=policy-namespace")}
 	policyKey := policy.Key{
 		Identity:         remoteID,
 		DestPort:         0,
 		Nexthdr:          0,
 		TrafficDirection: trafficdirection.Egress.Uint8(),
 	}
+	denyPolicyKey := policy.Key{
+		Identity:         remoteID,
+		DestPort:         0,
+		Nexthdr:          0,
+		TrafficDirection: trafficdirection.Ingress.Uint8(),
+	}
 
 	endpointGetter := &testutils.FakeEndpointGetter{
 		OnGetEndpointInfo: func(ip net.IP) (endpoint v1.EndpointInfo, ok bool) {
@@ -505,7 +511,8 @@ func TestDecodeTrafficDirection(t *testing.T) {
 				return &testutils.FakeEndpointInfo{
 					ID: uint64(localEP),
 					PolicyMap: map[policy.Key]labels.LabelArrayList{
-						policyKey: policyLabel,
+						policyKey:     policyLabel,
+						denyPolicyKey: policyLabel,
 					},
 					PolicyRevision: 1,
 				}, true
@@ -623,7 +630,7 @@ func TestDecodeTrafficDirection(t *testing.T) {
 	pvn := monitor.PolicyVerdictNotify{
 		Type:        byte(monitorAPI.MessageTypePolicyVerdict),
 		Source:      localEP,
-		Flags:       monitorAPI.PolicyEgress,
+		Flags:       monitorAPI.PolicyEgress | monitorAPI.PolicyMatchL3Only<<monitor.PolicyVerdictNotifyFlagMatchTypeBitOffset,
 		RemoteLabel: identity.NumericIdentity(remoteID),
 	}
 	f = parseFlow(pvn, localIP, remoteIP)
@@ -640,6 +647,13 @@ func TestDecodeTrafficDirection(t *testing.T) {
 	assert.Equal(t, lbls, policyLabel)
 	assert.Equal(t, uint64(1), rev)
 
+	// the allowing policy rule is attributed to the flow
+	assert.Len(t, f.GetEgressAllowedBy(), 1)
+	assert.Equal(t, "policy-name", f.GetEgressAllowedBy()[0].GetName())
+	assert.Equal(t, "policy-namespace", f.GetEgressAllowedBy()[0].GetNamespace())
+	assert.Equal(t, uint64(1), f.GetEgressAllowedBy()[0].GetRevision())
+	assert.Empty(t, f.GetEgressDeniedBy())
+
 	// PolicyVerdictNotify Ingress
 	pvn = monitor.PolicyVerdictNotify{
 		Type:   byte(monitorAPI.MessageTypePolicyVerdict),
@@ -649,6 +663,27 @@ func TestDecodeTrafficDirection(t *testing.T) {
 	f = parseFlow(pvn, remoteIP, localIP)
 	assert.Equal(t, flowpb.TrafficDirection_INGRESS, f.GetTrafficDirection())
 	assert.Equal(t, uint32(localEP), f.GetDestination().GetID())
+
+	// no policy entry was matched, no rule is attributed to the flow
+	assert.Empty(t, f.GetIngressAllowedBy())
+	assert.Empty(t, f.GetIngressDeniedBy())
+
+	// PolicyVerdictNotify Ingress deny
+	pvn = monitor.PolicyVerdictNotify{
+		Type:        byte(monitorAPI.MessageTypePolicyVerdict),
+		Source:      localEP,
+		Flags:       monitorAPI.PolicyIngress | monitorAPI.PolicyMatchL3Only<<monitor.PolicyVerdictNotifyFlagMatchTypeBitOffset,
+		RemoteLabel: identity.NumericIdentity(remoteID),
+		Verdict:     -151, // drop reason: policy denied
+	}
+	f = parseFlow(pvn, remoteIP, localIP)
+	assert.Equal(t, flowpb.TrafficDirection_INGRESS, f.GetTrafficDirection())
+
+	// the denying policy rule is attributed to the flow
+	assert.Len(t, f.GetIngressDeniedBy(), 1)
+	assert.Equal(t, "policy-name", f.GetIngressDeniedBy()[0].GetName())
+	assert.Equal(t, "policy-namespace", f.GetIngressDeniedBy()[0].GetNamespace())
+	assert.Empty(t, f.GetIngressAllowedBy())
 }
 
 func TestDecodeIsReply(t *testing.T) {
@@ -0,0 +1,163 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+// Package persist implements a size-capped on-disk store for Hubble flows.
+// Decoded flows are appended to the store as they are observed and are read
+// back into the ring buffer when the agent restarts, so that flows survive
+// agent restarts and short observation gaps.
+package persist
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	flowpb "github.com/cilium/cilium/api/v1/flow"
+	v1 "github.com/cilium/cilium/pkg/hubble/api/v1"
+	"github.com/cilium/cilium/pkg/lock"
+)
+
+// maxLineLength is the maximum length of a single serialized flow in the
+// store. Lines exceeding this length are skipped on restore.
+const maxLineLength = 1 << 20
+
+// Store is a size-capped on-disk store for Hubble flows. It consists of two
+// segments: flows are appended to the active segment until it reaches half of
+// the configured size cap, at which point the active segment replaces the
+// backup segment and a new active segment is started. Store implements the
+// observeroption.OnDecodedFlow interface.
+type Store struct {
+	logger  logrus.FieldLogger
+	path    string
+	maxSize int64
+
+	mutex lock.Mutex
+	file  *os.File
+	size  int64
+}
+
+// NewStore opens the on-disk flow store at the given path, creating it if it
+// does not exist. maxSizeMB caps the total size of the store on disk.
+func NewStore(logger logrus.FieldLogger, path string, maxSizeMB int) (*Store, error) {
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open flow store: %w", err)
+	}
+	info, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return nil, fmt.Errorf("failed to stat flow store: %w", err)
+	}
+	return &Store{
+		logger: logger,
+		path:   path,
+		// the cap is split between the active and the backup segment
+		maxSize: int64(maxSizeMB) * 1024 * 1024 / 2,
+		file:    f,
+		size:    info.Size(),
+	}, nil
+}
+
+// backupPath returns the path of the backup segment.
+func (s *Store) backupPath() string {
+	return s.path + ".old"
+}
+
+// OnDecodedFlow appends the flow to the store. It never filters the flow.
+func (s *Store) OnDecodedFlow(_ context.Context, flow *flowpb.Flow) (bool, error) {
+	line, err := protojson.Marshal(flow)
+	if err != nil {
+		return false, fmt.Errorf("failed to marshal flow: %w", err)
+	}
+	line = append(line, '\n')
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.size+int64(len(line)) > s.maxSize {
+		if err := s.rotate(); err != nil {
+			return false, err
+		}
+	}
+	n, err := s.file.Write(line)
+	s.size += int64(n)
+	if err != nil {
+		return false, fmt.Errorf("failed to write flow to store: %w", err)
+	}
+	return false, nil
+}
+
+// rotate replaces the backup segment with the active segment and starts a new
+// active segment. The caller must hold the store mutex.
+func (s *Store) rotate() error {
+	if err := s.file.Close(); err != nil {
+		return fmt.Errorf("failed to close flow store segment: %w", err)
+	}
+	if err := os.Rename(s.path, s.backupPath()); err != nil {
+		return fmt.Errorf("failed to rotate flow store segment: %w", err)
+	}
+	f, err := os.OpenFile(s.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return fmt.Errorf("failed to open flow store segment: %w", err)
+	}
+	s.file = f
+	s.size = 0
+	return nil
+}
+
+// Restore reads all flows persisted in the store, oldest first, and invokes
+// the given callback for each of them. Lines which cannot be decoded, such as
+// a flow truncated by an agent crash, are skipped.
+func (s *Store) Restore(callback func(*v1.Event)) error {
+	for _, path := range []string{s.backupPath(), s.path} {
+		if err := s.restoreSegment(path, callback); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// restoreSegment reads a single segment of the store.
+func (s *Store) restoreSegment(path string, callback func(*v1.Event)) error {
+	f, err := os.Open(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to open flow store segment: %w", err)
+	}
+	defer f.Close()
+
+	skipped := 0
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(nil, maxLineLength)
+	for scanner.Scan() {
+		flow := &flowpb.Flow{}
+		if err := protojson.Unmarshal(scanner.Bytes(), flow); err != nil {
+			skipped++
+			continue
+		}
+		callback(&v1.Event{
+			Timestamp: flow.GetTime(),
+			Event:     flow,
+		})
+	}
+	if skipped > 0 {
+		s.logger.WithFields(logrus.Fields{
+			"path":    path,
+			"skipped": skipped,
+		}).Warn("Skipped undecodable flows while restoring flow store")
+	}
+	return scanner.Err()
+}
+
+// Close closes the store.
+func (s *Store) Close() error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.file.Close()
+}
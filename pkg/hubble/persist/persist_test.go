@@ -0,0 +1,108 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package persist
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	flowpb "github.com/cilium/cilium/api/v1/flow"
+	v1 "github.com/cilium/cilium/pkg/hubble/api/v1"
+)
+
+func restoreAll(t *testing.T, s *Store) []*flowpb.Flow {
+	t.Helper()
+	var flows []*flowpb.Flow
+	require.NoError(t, s.Restore(func(ev *v1.Event) {
+		flows = append(flows, ev.GetFlow())
+	}))
+	return flows
+}
+
+func TestStoreRoundTrip(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "flowstore")
+	s, err := NewStore(logrus.New(), path, 1)
+	require.NoError(t, err)
+	defer s.Close()
+
+	for i := 0; i < 3; i++ {
+		stop, err := s.OnDecodedFlow(context.Background(), &flowpb.Flow{
+			NodeName: fmt.Sprintf("node-%d", i),
+			Verdict:  flowpb.Verdict_FORWARDED,
+		})
+		require.NoError(t, err)
+		assert.False(t, stop)
+	}
+
+	// reopen the store as the agent would after a restart
+	require.NoError(t, s.Close())
+	s, err = NewStore(logrus.New(), path, 1)
+	require.NoError(t, err)
+
+	flows := restoreAll(t, s)
+	require.Len(t, flows, 3)
+	for i, flow := range flows {
+		assert.Equal(t, fmt.Sprintf("node-%d", i), flow.GetNodeName())
+		assert.Equal(t, flowpb.Verdict_FORWARDED, flow.GetVerdict())
+	}
+}
+
+func TestStoreRotation(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "flowstore")
+	s, err := NewStore(logrus.New(), path, 1)
+	require.NoError(t, err)
+	defer s.Close()
+
+	// lower the segment size so that each segment holds roughly two flows
+	s.maxSize = 64
+
+	for i := 0; i < 10; i++ {
+		_, err := s.OnDecodedFlow(context.Background(), &flowpb.Flow{
+			NodeName: fmt.Sprintf("node-%d", i),
+		})
+		require.NoError(t, err)
+	}
+
+	// the backup segment must exist and the total size must stay capped
+	info, err := os.Stat(s.backupPath())
+	require.NoError(t, err)
+	assert.LessOrEqual(t, info.Size(), s.maxSize)
+
+	// the most recent flows are retained, oldest first
+	flows := restoreAll(t, s)
+	require.NotEmpty(t, flows)
+	assert.Less(t, len(flows), 10)
+	assert.Equal(t, "node-9", flows[len(flows)-1].GetNodeName())
+	for i := 1; i < len(flows); i++ {
+		assert.Less(t, flows[i-1].GetNodeName(), flows[i].GetNodeName())
+	}
+}
+
+func TestStoreSkipsCorruptLines(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "flowstore")
+	s, err := NewStore(logrus.New(), path, 1)
+	require.NoError(t, err)
+	defer s.Close()
+
+	_, err = s.OnDecodedFlow(context.Background(), &flowpb.Flow{NodeName: "node-0"})
+	require.NoError(t, err)
+
+	// simulate a flow truncated by an agent crash
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_WRONLY, 0600)
+	require.NoError(t, err)
+	_, err = f.WriteString(`{"nodeName":"node-`)
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	flows := restoreAll(t, s)
+	require.Len(t, flows, 1)
+	assert.Equal(t, "node-0", flows[0].GetNodeName())
+}
@@ -0,0 +1,95 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+// Package auth implements namespace-scoped authorization for Hubble Relay
+// clients. Clients are identified by the common name of their mutual TLS
+// certificate and are mapped to the set of namespaces whose flows they are
+// allowed to observe.
+package auth
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	grpcPeer "google.golang.org/grpc/peer"
+	grpcStatus "google.golang.org/grpc/status"
+
+	flowpb "github.com/cilium/cilium/api/v1/flow"
+)
+
+// ClientScopes maps a client identity, as given by the common name of the
+// client TLS certificate, to the namespaces whose flows the client is allowed
+// to observe. An empty ClientScopes disables scoping altogether.
+type ClientScopes map[string][]string
+
+// ParseScopes parses a list of client scope entries of the form
+// "<common-name>=<namespace>[,<namespace>...]", e.g.
+// "tenant-a.hubble-relay-client.cilium.io=tenant-a,tenant-a-system".
+func ParseScopes(entries []string) (ClientScopes, error) {
+	scopes := make(ClientScopes, len(entries))
+	for _, entry := range entries {
+		identity, list, ok := strings.Cut(entry, "=")
+		if !ok || identity == "" {
+			return nil, fmt.Errorf("invalid client scope %q: expected format '<common-name>=<namespace>[,<namespace>...]'", entry)
+		}
+		var namespaces []string
+		for _, ns := range strings.Split(list, ",") {
+			if ns == "" {
+				return nil, fmt.Errorf("invalid client scope %q: empty namespace", entry)
+			}
+			namespaces = append(namespaces, ns)
+		}
+		scopes[identity] = append(scopes[identity], namespaces...)
+	}
+	return scopes, nil
+}
+
+// PeerIdentity returns the identity of the calling client, as given by the
+// common name of its TLS certificate. It returns false if the client did not
+// present a certificate.
+func PeerIdentity(ctx context.Context) (string, bool) {
+	p, ok := grpcPeer.FromContext(ctx)
+	if !ok || p.AuthInfo == nil {
+		return "", false
+	}
+	tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok || len(tlsInfo.State.PeerCertificates) == 0 {
+		return "", false
+	}
+	return tlsInfo.State.PeerCertificates[0].Subject.CommonName, true
+}
+
+// AllowedNamespaces returns the namespaces the calling client is allowed to
+// observe. A nil slice with a nil error means the client is unrestricted,
+// which is always the case when no scopes are configured. An error is
+// returned when scopes are configured and the client cannot be identified or
+// is not mapped to any namespace.
+func (s ClientScopes) AllowedNamespaces(ctx context.Context) ([]string, error) {
+	if len(s) == 0 {
+		return nil, nil
+	}
+	identity, ok := PeerIdentity(ctx)
+	if !ok {
+		return nil, grpcStatus.Error(codes.Unauthenticated, "client certificate required to observe flows")
+	}
+	namespaces, ok := s[identity]
+	if !ok {
+		return nil, grpcStatus.Errorf(codes.PermissionDenied, "client %q is not authorized to observe flows", identity)
+	}
+	return namespaces, nil
+}
+
+// FlowAllowed reports whether a client restricted to the given namespaces is
+// allowed to observe the flow. A flow is visible to the client if either of
+// its endpoints belongs to one of the namespaces.
+func FlowAllowed(namespaces []string, flow *flowpb.Flow) bool {
+	for _, ns := range namespaces {
+		if flow.GetSource().GetNamespace() == ns || flow.GetDestination().GetNamespace() == ns {
+			return true
+		}
+	}
+	return false
+}
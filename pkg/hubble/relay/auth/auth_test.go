@@ -0,0 +1,89 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package auth
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	grpcPeer "google.golang.org/grpc/peer"
+	grpcStatus "google.golang.org/grpc/status"
+
+	flowpb "github.com/cilium/cilium/api/v1/flow"
+)
+
+func contextWithPeerIdentity(commonName string) context.Context {
+	return grpcPeer.NewContext(context.Background(), &grpcPeer.Peer{
+		AuthInfo: credentials.TLSInfo{
+			State: tls.ConnectionState{
+				PeerCertificates: []*x509.Certificate{
+					{Subject: pkix.Name{CommonName: commonName}},
+				},
+			},
+		},
+	})
+}
+
+func TestParseScopes(t *testing.T) {
+	scopes, err := ParseScopes([]string{
+		"tenant-a=ns-1,ns-2",
+		"tenant-b=ns-3",
+		"tenant-b=ns-4",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, ClientScopes{
+		"tenant-a": {"ns-1", "ns-2"},
+		"tenant-b": {"ns-3", "ns-4"},
+	}, scopes)
+
+	for _, invalid := range []string{
+		"tenant-a",      // missing namespaces
+		"=ns-1",         // missing common name
+		"tenant-a=ns-,", // empty namespace
+	} {
+		_, err := ParseScopes([]string{invalid})
+		assert.Error(t, err, "expected error for scope %q", invalid)
+	}
+}
+
+func TestAllowedNamespaces(t *testing.T) {
+	scopes, err := ParseScopes([]string{"tenant-a=ns-1,ns-2"})
+	require.NoError(t, err)
+
+	// known clients are restricted to their namespaces
+	namespaces, err := scopes.AllowedNamespaces(contextWithPeerIdentity("tenant-a"))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"ns-1", "ns-2"}, namespaces)
+
+	// unknown clients are denied
+	_, err = scopes.AllowedNamespaces(contextWithPeerIdentity("tenant-b"))
+	assert.Equal(t, codes.PermissionDenied, grpcStatus.Code(err))
+
+	// clients without a certificate are denied
+	_, err = scopes.AllowedNamespaces(context.Background())
+	assert.Equal(t, codes.Unauthenticated, grpcStatus.Code(err))
+
+	// without scopes, all clients are unrestricted
+	namespaces, err = ClientScopes(nil).AllowedNamespaces(context.Background())
+	require.NoError(t, err)
+	assert.Nil(t, namespaces)
+}
+
+func TestFlowAllowed(t *testing.T) {
+	flow := &flowpb.Flow{
+		Source:      &flowpb.Endpoint{Namespace: "ns-1"},
+		Destination: &flowpb.Endpoint{Namespace: "ns-2"},
+	}
+	assert.True(t, FlowAllowed([]string{"ns-1"}, flow))
+	assert.True(t, FlowAllowed([]string{"ns-2"}, flow))
+	assert.False(t, FlowAllowed([]string{"ns-3"}, flow))
+	assert.False(t, FlowAllowed(nil, flow))
+}
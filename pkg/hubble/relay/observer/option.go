@@ -11,6 +11,7 @@ import (
 	"google.golang.org/grpc"
 
 	observerpb "github.com/cilium/cilium/api/v1/observer"
+	"github.com/cilium/cilium/pkg/hubble/relay/auth"
 	"github.com/cilium/cilium/pkg/hubble/relay/defaults"
 	poolTypes "github.com/cilium/cilium/pkg/hubble/relay/pool/types"
 	"github.com/cilium/cilium/pkg/logging"
@@ -50,6 +51,7 @@ type options struct {
 	sortBufferMaxLen       int
 	sortBufferDrainTimeout time.Duration
 	errorAggregationWindow time.Duration
+	clientScopes           auth.ClientScopes
 	log                    logrus.FieldLogger
 
 	// this is not meant to be user configurable as it's only useful to
@@ -103,6 +105,17 @@ func WithErrorAggregationWindow(d time.Duration) Option {
 	}
 }
 
+// WithClientScopes restricts clients to observing flows of the namespaces
+// they are mapped to. Clients not present in the given scopes are denied
+// access to flows altogether. When no scopes are set, all clients may
+// observe all flows.
+func WithClientScopes(scopes auth.ClientScopes) Option {
+	return func(o *options) error {
+		o.clientScopes = scopes
+		return nil
+	}
+}
+
 // WithLogger sets the logger to use for logging.
 func WithLogger(l logrus.FieldLogger) Option {
 	return func(o *options) error {
@@ -17,7 +17,9 @@ import (
 	observerpb "github.com/cilium/cilium/api/v1/observer"
 	relaypb "github.com/cilium/cilium/api/v1/relay"
 	"github.com/cilium/cilium/pkg/hubble/build"
+	"github.com/cilium/cilium/pkg/hubble/relay/auth"
 	poolTypes "github.com/cilium/cilium/pkg/hubble/relay/pool/types"
+	"github.com/cilium/cilium/pkg/hubble/relay/query"
 )
 
 // numUnavailableNodesReportMax represents the maximum number of unavailable
@@ -72,6 +74,14 @@ func NewServer(peers PeerListReporter, options ...Option) (*Server, error) {
 // the hubble instance the proxy is connected to.
 func (s *Server) GetFlows(req *observerpb.GetFlowsRequest, stream observerpb.Observer_GetFlowsServer) error {
 	ctx := stream.Context()
+	namespaces, err := s.opts.clientScopes.AllowedNamespaces(ctx)
+	if err != nil {
+		return err
+	}
+	queryFilter, err := query.FromIncomingContext(ctx)
+	if err != nil {
+		return err
+	}
 	md, ok := metadata.FromIncomingContext(ctx)
 	if ok {
 		ctx = metadata.NewOutgoingContext(ctx, md)
@@ -150,6 +160,14 @@ sortedFlowsLoop:
 			if !ok {
 				break sortedFlowsLoop
 			}
+			if !authorizedResponse(namespaces, flow) {
+				continue
+			}
+			if queryFilter != nil {
+				if f := flow.GetFlow(); f != nil && !queryFilter(f) {
+					continue
+				}
+			}
 			if err := stream.Send(flow); err != nil {
 				return err
 			}
@@ -160,6 +178,21 @@ sortedFlowsLoop:
 	return g.Wait()
 }
 
+// authorizedResponse reports whether the response may be sent to a client
+// restricted to the given namespaces. A nil namespace list means the client
+// is unrestricted. Responses which do not carry a flow, such as node status
+// events, are always allowed.
+func authorizedResponse(namespaces []string, res *observerpb.GetFlowsResponse) bool {
+	if namespaces == nil {
+		return true
+	}
+	flow := res.GetFlow()
+	if flow == nil {
+		return true
+	}
+	return auth.FlowAllowed(namespaces, flow)
+}
+
 // GetAgentEvents implements observerpb.ObserverServer.GetAgentEvents by proxying requests to
 // the hubble instance the proxy is connected to.
 func (s *Server) GetAgentEvents(req *observerpb.GetAgentEventsRequest, stream observerpb.Observer_GetAgentEventsServer) error {
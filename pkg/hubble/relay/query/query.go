@@ -0,0 +1,375 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+// Package query implements a small flow query language evaluated server-side
+// by Hubble Relay. Queries are boolean combinations of predicates over flow
+// attributes, e.g.:
+//
+//	verdict=DROPPED and (dst_ip=10.0.0.0/8 or dst_port=8000-9000)
+//	http_status=5xx and not src_namespace=kube-system
+//	since=2023-01-01T00:00:00Z and protocol=tcp
+//
+// Predicates are combined with "and", "or" and "not", with "not" binding
+// tighter than "and", and "and" binding tighter than "or". Parentheses may be
+// used for grouping. Evaluating queries in Relay avoids shipping all flows to
+// the client for filtering.
+package query
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	grpcStatus "google.golang.org/grpc/status"
+
+	flowpb "github.com/cilium/cilium/api/v1/flow"
+)
+
+// MetadataKey is the gRPC metadata key under which clients may supply a flow
+// query to be evaluated server-side by Hubble Relay.
+const MetadataKey = "hubble-flow-query"
+
+// FilterFunc reports whether a flow matches a parsed query.
+type FilterFunc func(*flowpb.Flow) bool
+
+// FromIncomingContext returns the filter for the flow query supplied by the
+// calling client, or nil if the client did not supply a query. Multiple query
+// values are combined conjunctively.
+func FromIncomingContext(ctx context.Context) (FilterFunc, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil, nil
+	}
+	queries := md.Get(MetadataKey)
+	if len(queries) == 0 {
+		return nil, nil
+	}
+	filter, err := Parse(strings.Join(queries, " and "))
+	if err != nil {
+		return nil, grpcStatus.Errorf(codes.InvalidArgument, "invalid flow query: %v", err)
+	}
+	return filter, nil
+}
+
+// Parse parses a flow query and returns the filter evaluating it.
+func Parse(query string) (FilterFunc, error) {
+	p := &parser{tokens: tokenize(query)}
+	filter, err := p.parseExpression()
+	if err != nil {
+		return nil, err
+	}
+	if tok, ok := p.peek(); ok {
+		return nil, fmt.Errorf("unexpected token %q", tok)
+	}
+	return filter, nil
+}
+
+// tokenize splits a query into tokens. Parentheses are tokens of their own,
+// everything else is split on whitespace.
+func tokenize(s string) []string {
+	var tokens []string
+	var b strings.Builder
+	flush := func() {
+		if b.Len() > 0 {
+			tokens = append(tokens, b.String())
+			b.Reset()
+		}
+	}
+	for _, r := range s {
+		switch {
+		case unicode.IsSpace(r):
+			flush()
+		case r == '(' || r == ')':
+			flush()
+			tokens = append(tokens, string(r))
+		default:
+			b.WriteRune(r)
+		}
+	}
+	flush()
+	return tokens
+}
+
+type parser struct {
+	tokens []string
+	pos    int
+}
+
+func (p *parser) peek() (string, bool) {
+	if p.pos >= len(p.tokens) {
+		return "", false
+	}
+	return p.tokens[p.pos], true
+}
+
+func (p *parser) next() (string, bool) {
+	tok, ok := p.peek()
+	if ok {
+		p.pos++
+	}
+	return tok, ok
+}
+
+// parseExpression parses a disjunction of conjunctions.
+func (p *parser) parseExpression() (FilterFunc, error) {
+	left, err := p.parseConjunction()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		tok, ok := p.peek()
+		if !ok || !strings.EqualFold(tok, "or") {
+			return left, nil
+		}
+		p.next()
+		right, err := p.parseConjunction()
+		if err != nil {
+			return nil, err
+		}
+		l, r := left, right
+		left = func(flow *flowpb.Flow) bool {
+			return l(flow) || r(flow)
+		}
+	}
+}
+
+func (p *parser) parseConjunction() (FilterFunc, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		tok, ok := p.peek()
+		if !ok || !strings.EqualFold(tok, "and") {
+			return left, nil
+		}
+		p.next()
+		right, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		l, r := left, right
+		left = func(flow *flowpb.Flow) bool {
+			return l(flow) && r(flow)
+		}
+	}
+}
+
+func (p *parser) parseUnary() (FilterFunc, error) {
+	tok, ok := p.peek()
+	if !ok {
+		return nil, fmt.Errorf("unexpected end of query")
+	}
+	if strings.EqualFold(tok, "not") {
+		p.next()
+		inner, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return func(flow *flowpb.Flow) bool {
+			return !inner(flow)
+		}, nil
+	}
+	return p.parsePrimary()
+}
+
+func (p *parser) parsePrimary() (FilterFunc, error) {
+	tok, ok := p.next()
+	if !ok {
+		return nil, fmt.Errorf("unexpected end of query")
+	}
+	if tok == "(" {
+		inner, err := p.parseExpression()
+		if err != nil {
+			return nil, err
+		}
+		if closing, ok := p.next(); !ok || closing != ")" {
+			return nil, fmt.Errorf("missing closing parenthesis")
+		}
+		return inner, nil
+	}
+	if tok == ")" {
+		return nil, fmt.Errorf("unexpected token %q", tok)
+	}
+	return parsePredicate(tok)
+}
+
+func parsePredicate(s string) (FilterFunc, error) {
+	key, value, ok := strings.Cut(s, "=")
+	if !ok || value == "" {
+		return nil, fmt.Errorf("invalid predicate %q: expected '<key>=<value>'", s)
+	}
+	key = strings.ToLower(key)
+	switch key {
+	case "verdict":
+		v, ok := flowpb.Verdict_value[strings.ToUpper(value)]
+		if !ok {
+			return nil, fmt.Errorf("invalid predicate %q: unknown verdict", s)
+		}
+		return func(flow *flowpb.Flow) bool {
+			return flow.GetVerdict() == flowpb.Verdict(v)
+		}, nil
+	case "ip", "src_ip", "dst_ip":
+		return parseIPPredicate(key, value)
+	case "port", "src_port", "dst_port":
+		return parsePortPredicate(key, value)
+	case "namespace", "src_namespace", "dst_namespace":
+		src := strings.EqualFold(key, "namespace") || strings.HasPrefix(key, "src")
+		dst := strings.EqualFold(key, "namespace") || strings.HasPrefix(key, "dst")
+		return func(flow *flowpb.Flow) bool {
+			return (src && flow.GetSource().GetNamespace() == value) ||
+				(dst && flow.GetDestination().GetNamespace() == value)
+		}, nil
+	case "pod", "src_pod", "dst_pod":
+		src := strings.EqualFold(key, "pod") || strings.HasPrefix(key, "src")
+		dst := strings.EqualFold(key, "pod") || strings.HasPrefix(key, "dst")
+		return func(flow *flowpb.Flow) bool {
+			return (src && podName(flow.GetSource()) == value) ||
+				(dst && podName(flow.GetDestination()) == value)
+		}, nil
+	case "protocol":
+		return parseProtocolPredicate(value)
+	case "http_status":
+		return parseHTTPStatusPredicate(value)
+	case "since", "until":
+		t, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid predicate %q: time must be in RFC3339 format", s)
+		}
+		since := strings.EqualFold(key, "since")
+		return func(flow *flowpb.Flow) bool {
+			if flow.GetTime() == nil {
+				return false
+			}
+			if since {
+				return !flow.GetTime().AsTime().Before(t)
+			}
+			return !flow.GetTime().AsTime().After(t)
+		}, nil
+	default:
+		return nil, fmt.Errorf("invalid predicate %q: unknown key %q", s, key)
+	}
+}
+
+func podName(ep *flowpb.Endpoint) string {
+	if ep.GetNamespace() == "" {
+		return ep.GetPodName()
+	}
+	return ep.GetNamespace() + "/" + ep.GetPodName()
+}
+
+func parseIPPredicate(key, value string) (FilterFunc, error) {
+	var contains func(ip net.IP) bool
+	if strings.Contains(value, "/") {
+		_, cidr, err := net.ParseCIDR(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid predicate %q: %v", key+"="+value, err)
+		}
+		contains = func(ip net.IP) bool {
+			return ip != nil && cidr.Contains(ip)
+		}
+	} else {
+		addr := net.ParseIP(value)
+		if addr == nil {
+			return nil, fmt.Errorf("invalid predicate %q: invalid IP address", key+"="+value)
+		}
+		contains = func(ip net.IP) bool {
+			return ip != nil && addr.Equal(ip)
+		}
+	}
+	src := strings.EqualFold(key, "ip") || strings.HasPrefix(key, "src")
+	dst := strings.EqualFold(key, "ip") || strings.HasPrefix(key, "dst")
+	return func(flow *flowpb.Flow) bool {
+		return (src && contains(net.ParseIP(flow.GetIP().GetSource()))) ||
+			(dst && contains(net.ParseIP(flow.GetIP().GetDestination())))
+	}, nil
+}
+
+func parsePortPredicate(key, value string) (FilterFunc, error) {
+	lo, hi := value, value
+	if from, to, isRange := strings.Cut(value, "-"); isRange {
+		lo, hi = from, to
+	}
+	min, err := strconv.ParseUint(lo, 10, 16)
+	if err != nil {
+		return nil, fmt.Errorf("invalid predicate %q: invalid port", key+"="+value)
+	}
+	max, err := strconv.ParseUint(hi, 10, 16)
+	if err != nil || max < min {
+		return nil, fmt.Errorf("invalid predicate %q: invalid port range", key+"="+value)
+	}
+	src := strings.EqualFold(key, "port") || strings.HasPrefix(key, "src")
+	dst := strings.EqualFold(key, "port") || strings.HasPrefix(key, "dst")
+	inRange := func(port uint32) bool {
+		return port >= uint32(min) && port <= uint32(max)
+	}
+	return func(flow *flowpb.Flow) bool {
+		srcPort, dstPort, ok := flowPorts(flow)
+		if !ok {
+			return false
+		}
+		return (src && inRange(srcPort)) || (dst && inRange(dstPort))
+	}, nil
+}
+
+func flowPorts(flow *flowpb.Flow) (srcPort, dstPort uint32, ok bool) {
+	switch l4 := flow.GetL4().GetProtocol().(type) {
+	case *flowpb.Layer4_TCP:
+		return l4.TCP.GetSourcePort(), l4.TCP.GetDestinationPort(), true
+	case *flowpb.Layer4_UDP:
+		return l4.UDP.GetSourcePort(), l4.UDP.GetDestinationPort(), true
+	case *flowpb.Layer4_SCTP:
+		return l4.SCTP.GetSourcePort(), l4.SCTP.GetDestinationPort(), true
+	}
+	return 0, 0, false
+}
+
+func parseProtocolPredicate(value string) (FilterFunc, error) {
+	switch proto := strings.ToLower(value); proto {
+	case "tcp", "udp", "sctp", "icmp", "icmpv4", "icmpv6":
+		return func(flow *flowpb.Flow) bool {
+			switch flow.GetL4().GetProtocol().(type) {
+			case *flowpb.Layer4_TCP:
+				return proto == "tcp"
+			case *flowpb.Layer4_UDP:
+				return proto == "udp"
+			case *flowpb.Layer4_SCTP:
+				return proto == "sctp"
+			case *flowpb.Layer4_ICMPv4:
+				return proto == "icmp" || proto == "icmpv4"
+			case *flowpb.Layer4_ICMPv6:
+				return proto == "icmp" || proto == "icmpv6"
+			}
+			return false
+		}, nil
+	default:
+		return nil, fmt.Errorf("invalid predicate %q: unknown protocol", "protocol="+value)
+	}
+}
+
+func parseHTTPStatusPredicate(value string) (FilterFunc, error) {
+	// status classes, e.g. "5xx", match any code of the class
+	if len(value) == 3 && strings.HasSuffix(strings.ToLower(value), "xx") {
+		class, err := strconv.ParseUint(value[:1], 10, 32)
+		if err == nil && class >= 1 && class <= 5 {
+			return func(flow *flowpb.Flow) bool {
+				code := flow.GetL7().GetHttp().GetCode()
+				return code/100 == uint32(class)
+			}, nil
+		}
+	}
+	code, err := strconv.ParseUint(value, 10, 32)
+	if err != nil {
+		return nil, fmt.Errorf("invalid predicate %q: expected a status code or class such as 5xx", "http_status="+value)
+	}
+	return func(flow *flowpb.Flow) bool {
+		http := flow.GetL7().GetHttp()
+		return http != nil && http.GetCode() == uint32(code)
+	}, nil
+}
@@ -0,0 +1,135 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package query
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	grpcStatus "google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	flowpb "github.com/cilium/cilium/api/v1/flow"
+)
+
+func testFlow() *flowpb.Flow {
+	return &flowpb.Flow{
+		Time:    timestamppb.New(time.Date(2023, 6, 1, 12, 0, 0, 0, time.UTC)),
+		Verdict: flowpb.Verdict_DROPPED,
+		IP:      &flowpb.IP{Source: "10.1.2.3", Destination: "192.168.0.1"},
+		L4: &flowpb.Layer4{
+			Protocol: &flowpb.Layer4_TCP{
+				TCP: &flowpb.TCP{SourcePort: 40122, DestinationPort: 8080},
+			},
+		},
+		Source:      &flowpb.Endpoint{Namespace: "tenant-a", PodName: "client-1"},
+		Destination: &flowpb.Endpoint{Namespace: "tenant-b", PodName: "server-1"},
+		L7: &flowpb.Layer7{
+			Record: &flowpb.Layer7_Http{
+				Http: &flowpb.HTTP{Code: 503},
+			},
+		},
+	}
+}
+
+func TestParseAndEvaluate(t *testing.T) {
+	tests := []struct {
+		query string
+		want  bool
+	}{
+		{"verdict=DROPPED", true},
+		{"verdict=FORWARDED", false},
+		{"not verdict=FORWARDED", true},
+		{"src_ip=10.0.0.0/8", true},
+		{"dst_ip=10.0.0.0/8", false},
+		{"ip=192.168.0.1", true},
+		{"dst_port=8080", true},
+		{"dst_port=8000-9000", true},
+		{"dst_port=9000-9100", false},
+		{"src_port=40122", true},
+		{"port=8080", true},
+		{"namespace=tenant-a", true},
+		{"src_namespace=tenant-b", false},
+		{"dst_namespace=tenant-b", true},
+		{"pod=tenant-a/client-1", true},
+		{"dst_pod=tenant-a/client-1", false},
+		{"protocol=tcp", true},
+		{"protocol=udp", false},
+		{"http_status=503", true},
+		{"http_status=5xx", true},
+		{"http_status=2xx", false},
+		{"since=2023-01-01T00:00:00Z", true},
+		{"until=2023-01-01T00:00:00Z", false},
+		{"verdict=DROPPED and dst_port=8080", true},
+		{"verdict=FORWARDED or dst_port=8080", true},
+		{"verdict=FORWARDED and dst_port=8080 or protocol=tcp", true},
+		{"verdict=FORWARDED and (dst_port=8080 or protocol=tcp)", false},
+		{"not (verdict=DROPPED or protocol=udp)", false},
+		{"http_status=5xx and not src_namespace=kube-system", true},
+	}
+	for _, tt := range tests {
+		filter, err := Parse(tt.query)
+		require.NoError(t, err, "query %q", tt.query)
+		assert.Equal(t, tt.want, filter(testFlow()), "query %q", tt.query)
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	for _, invalid := range []string{
+		"",
+		"verdict",
+		"verdict=",
+		"verdict=BOGUS",
+		"unknown_key=foo",
+		"src_ip=not-an-ip",
+		"dst_ip=10.0.0.0/99",
+		"port=x",
+		"port=9000-8000",
+		"protocol=carrier-pigeon",
+		"http_status=xxx",
+		"since=yesterday",
+		"verdict=DROPPED and",
+		"verdict=DROPPED or or verdict=FORWARDED",
+		"(verdict=DROPPED",
+		"verdict=DROPPED)",
+	} {
+		_, err := Parse(invalid)
+		assert.Error(t, err, "expected error for query %q", invalid)
+	}
+}
+
+func TestFromIncomingContext(t *testing.T) {
+	// no metadata, no filter
+	filter, err := FromIncomingContext(context.Background())
+	require.NoError(t, err)
+	assert.Nil(t, filter)
+
+	// metadata without a query, no filter
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.MD{})
+	filter, err = FromIncomingContext(ctx)
+	require.NoError(t, err)
+	assert.Nil(t, filter)
+
+	// multiple query values are combined conjunctively
+	ctx = metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+		MetadataKey, "verdict=DROPPED",
+		MetadataKey, "dst_port=8080",
+	))
+	filter, err = FromIncomingContext(ctx)
+	require.NoError(t, err)
+	require.NotNil(t, filter)
+	assert.True(t, filter(testFlow()))
+
+	// invalid queries are rejected with InvalidArgument
+	ctx = metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+		MetadataKey, "bogus",
+	))
+	_, err = FromIncomingContext(ctx)
+	assert.Equal(t, codes.InvalidArgument, grpcStatus.Code(err))
+}
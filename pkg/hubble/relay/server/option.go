@@ -12,6 +12,7 @@ import (
 	"google.golang.org/grpc"
 
 	"github.com/cilium/cilium/pkg/crypto/certloader"
+	"github.com/cilium/cilium/pkg/hubble/relay/auth"
 	"github.com/cilium/cilium/pkg/hubble/relay/defaults"
 	"github.com/cilium/cilium/pkg/hubble/relay/observer"
 	"github.com/cilium/cilium/pkg/logging"
@@ -136,6 +137,16 @@ func WithErrorAggregationWindow(d time.Duration) Option {
 	}
 }
 
+// WithClientScopes restricts clients to observing flows of the namespaces
+// they are mapped to. Scoping clients requires mutual TLS so that clients can
+// be identified by the common name of their certificate.
+func WithClientScopes(scopes auth.ClientScopes) Option {
+	return func(o *options) error {
+		o.observerOptions = append(o.observerOptions, observer.WithClientScopes(scopes))
+		return nil
+	}
+}
+
 // WithLogger set the logger used by hubble-relay.
 func WithLogger(log logrus.FieldLogger) Option {
 	return func(o *options) error {
@@ -0,0 +1,184 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+// Package sampling implements sampling and aggregation of Hubble flows to
+// reduce the flow volume on high-throughput nodes. Flows can be sampled per
+// verdict and namespace, and forwarded flows of the same connection can be
+// deduplicated within an aggregation window. Flows with other verdicts, such
+// as dropped flows, are never aggregated so that drop visibility is retained.
+package sampling
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"strconv"
+	"strings"
+	"time"
+
+	flowpb "github.com/cilium/cilium/api/v1/flow"
+	"github.com/cilium/cilium/pkg/lock"
+)
+
+// maxAggregationEntries bounds the size of the aggregation cache. When the
+// bound is hit, expired entries are evicted before new ones are recorded.
+const maxAggregationEntries = 1 << 16
+
+// Spec is a single sampling rule. The first spec matching a flow determines
+// the rate at which the flow is sampled.
+type Spec struct {
+	// Verdict is the verdict the flow must carry to match this spec.
+	Verdict flowpb.Verdict
+
+	// Namespace is the namespace of either flow endpoint. If empty, flows
+	// of all namespaces match.
+	Namespace string
+
+	// Rate is the fraction of matching flows to keep, in the range [0.0, 1.0].
+	Rate float64
+}
+
+// ParseSpecs parses a list of sampling specs of the form
+// "<verdict>[/<namespace>]:<rate>", e.g. "FORWARDED:0.1" or
+// "FORWARDED/kube-system:0.01".
+func ParseSpecs(specs []string) ([]Spec, error) {
+	parsed := make([]Spec, 0, len(specs))
+	for _, s := range specs {
+		match, rate, ok := strings.Cut(s, ":")
+		if !ok {
+			return nil, fmt.Errorf("invalid sampling spec %q: missing rate", s)
+		}
+		verdict, namespace, _ := strings.Cut(match, "/")
+		v, ok := flowpb.Verdict_value[verdict]
+		if !ok || v == int32(flowpb.Verdict_VERDICT_UNKNOWN) {
+			return nil, fmt.Errorf("invalid sampling spec %q: unknown verdict %q", s, verdict)
+		}
+		r, err := strconv.ParseFloat(rate, 64)
+		if err != nil || r < 0.0 || r > 1.0 {
+			return nil, fmt.Errorf("invalid sampling spec %q: rate must be in the range [0.0, 1.0]", s)
+		}
+		parsed = append(parsed, Spec{
+			Verdict:   flowpb.Verdict(v),
+			Namespace: namespace,
+			Rate:      r,
+		})
+	}
+	return parsed, nil
+}
+
+// aggregationKey identifies the flows which are considered duplicates of
+// each other within the aggregation window.
+type aggregationKey struct {
+	sourceIP      string
+	destinationIP string
+	destPort      uint32
+	protocol      string
+	verdict       flowpb.Verdict
+}
+
+// Sampler samples and aggregates decoded flows. It implements the
+// observeroption.OnDecodedFlow interface and is inserted into the decoding
+// path of the Hubble observer.
+type Sampler struct {
+	specs  []Spec
+	window time.Duration
+
+	mutex    lock.Mutex
+	lastSeen map[aggregationKey]time.Time
+
+	// rng and now are overridable for testing.
+	rng func() float64
+	now func() time.Time
+}
+
+// NewSampler returns a Sampler applying the given sampling specs and
+// deduplicating forwarded flows within the given aggregation window. A zero
+// window disables aggregation.
+func NewSampler(specs []Spec, window time.Duration) *Sampler {
+	return &Sampler{
+		specs:    specs,
+		window:   window,
+		lastSeen: make(map[aggregationKey]time.Time),
+		rng:      rand.Float64,
+		now:      time.Now,
+	}
+}
+
+// OnDecodedFlow returns true if the flow should not be kept, either because
+// it was sampled out or because an equivalent flow was already seen within
+// the aggregation window.
+func (s *Sampler) OnDecodedFlow(_ context.Context, flow *flowpb.Flow) (bool, error) {
+	for _, spec := range s.specs {
+		if !spec.matches(flow) {
+			continue
+		}
+		if s.rng() >= spec.Rate {
+			return true, nil
+		}
+		break
+	}
+
+	// Only forwarded flows are aggregated so that every drop remains
+	// visible.
+	if s.window > 0 && flow.GetVerdict() == flowpb.Verdict_FORWARDED {
+		return s.aggregate(flow), nil
+	}
+
+	return false, nil
+}
+
+// matches returns true if the flow is selected by the spec.
+func (spec Spec) matches(flow *flowpb.Flow) bool {
+	if flow.GetVerdict() != spec.Verdict {
+		return false
+	}
+	if spec.Namespace != "" &&
+		flow.GetSource().GetNamespace() != spec.Namespace &&
+		flow.GetDestination().GetNamespace() != spec.Namespace {
+		return false
+	}
+	return true
+}
+
+// aggregate records the flow in the aggregation cache and returns true if an
+// equivalent flow was already seen within the aggregation window.
+func (s *Sampler) aggregate(flow *flowpb.Flow) bool {
+	key := aggregationKey{
+		sourceIP:      flow.GetIP().GetSource(),
+		destinationIP: flow.GetIP().GetDestination(),
+		verdict:       flow.GetVerdict(),
+	}
+	switch l4 := flow.GetL4().GetProtocol().(type) {
+	case *flowpb.Layer4_TCP:
+		key.protocol = "tcp"
+		key.destPort = l4.TCP.GetDestinationPort()
+	case *flowpb.Layer4_UDP:
+		key.protocol = "udp"
+		key.destPort = l4.UDP.GetDestinationPort()
+	case *flowpb.Layer4_SCTP:
+		key.protocol = "sctp"
+		key.destPort = l4.SCTP.GetDestinationPort()
+	case *flowpb.Layer4_ICMPv4:
+		key.protocol = "icmpv4"
+	case *flowpb.Layer4_ICMPv6:
+		key.protocol = "icmpv6"
+	}
+
+	now := s.now()
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if seen, exists := s.lastSeen[key]; exists && now.Sub(seen) < s.window {
+		return true
+	}
+	if len(s.lastSeen) >= maxAggregationEntries {
+		for k, seen := range s.lastSeen {
+			if now.Sub(seen) >= s.window {
+				delete(s.lastSeen, k)
+			}
+		}
+	}
+	s.lastSeen[key] = now
+	return false
+}
@@ -0,0 +1,115 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package sampling
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	flowpb "github.com/cilium/cilium/api/v1/flow"
+)
+
+func TestParseSpecs(t *testing.T) {
+	specs, err := ParseSpecs([]string{"FORWARDED:0.1", "DROPPED/kube-system:1.0"})
+	require.NoError(t, err)
+	require.Len(t, specs, 2)
+	assert.Equal(t, Spec{Verdict: flowpb.Verdict_FORWARDED, Rate: 0.1}, specs[0])
+	assert.Equal(t, Spec{Verdict: flowpb.Verdict_DROPPED, Namespace: "kube-system", Rate: 1.0}, specs[1])
+
+	specs, err = ParseSpecs(nil)
+	require.NoError(t, err)
+	assert.Empty(t, specs)
+
+	for _, invalid := range []string{
+		"FORWARDED",           // missing rate
+		"BOGUS:0.5",           // unknown verdict
+		"VERDICT_UNKNOWN:0.5", // unknown verdict
+		"FORWARDED:1.5",       // rate out of range
+		"FORWARDED:-0.1",      // rate out of range
+		"FORWARDED:abc",       // rate not a number
+	} {
+		_, err := ParseSpecs([]string{invalid})
+		assert.Error(t, err, "expected error for spec %q", invalid)
+	}
+}
+
+func newTestFlow(verdict flowpb.Verdict, srcNS, dstNS string) *flowpb.Flow {
+	return &flowpb.Flow{
+		Verdict:     verdict,
+		IP:          &flowpb.IP{Source: "10.0.0.1", Destination: "10.0.0.2"},
+		L4:          &flowpb.Layer4{Protocol: &flowpb.Layer4_TCP{TCP: &flowpb.TCP{DestinationPort: 80}}},
+		Source:      &flowpb.Endpoint{Namespace: srcNS},
+		Destination: &flowpb.Endpoint{Namespace: dstNS},
+	}
+}
+
+func TestSampling(t *testing.T) {
+	specs, err := ParseSpecs([]string{"FORWARDED/foo:0.5"})
+	require.NoError(t, err)
+	s := NewSampler(specs, 0)
+
+	// flows below the rate threshold are kept
+	s.rng = func() float64 { return 0.25 }
+	stop, err := s.OnDecodedFlow(context.Background(), newTestFlow(flowpb.Verdict_FORWARDED, "foo", "bar"))
+	require.NoError(t, err)
+	assert.False(t, stop)
+
+	// flows at or above the rate threshold are sampled out
+	s.rng = func() float64 { return 0.75 }
+	stop, err = s.OnDecodedFlow(context.Background(), newTestFlow(flowpb.Verdict_FORWARDED, "foo", "bar"))
+	require.NoError(t, err)
+	assert.True(t, stop)
+
+	// the namespace may match either endpoint
+	stop, err = s.OnDecodedFlow(context.Background(), newTestFlow(flowpb.Verdict_FORWARDED, "bar", "foo"))
+	require.NoError(t, err)
+	assert.True(t, stop)
+
+	// flows not matching any spec are kept
+	stop, err = s.OnDecodedFlow(context.Background(), newTestFlow(flowpb.Verdict_FORWARDED, "bar", "baz"))
+	require.NoError(t, err)
+	assert.False(t, stop)
+	stop, err = s.OnDecodedFlow(context.Background(), newTestFlow(flowpb.Verdict_DROPPED, "foo", "bar"))
+	require.NoError(t, err)
+	assert.False(t, stop)
+}
+
+func TestAggregation(t *testing.T) {
+	s := NewSampler(nil, time.Minute)
+	now := time.Now()
+	s.now = func() time.Time { return now }
+
+	// the first flow of a connection is kept, duplicates within the window
+	// are not
+	stop, err := s.OnDecodedFlow(context.Background(), newTestFlow(flowpb.Verdict_FORWARDED, "foo", "bar"))
+	require.NoError(t, err)
+	assert.False(t, stop)
+	stop, err = s.OnDecodedFlow(context.Background(), newTestFlow(flowpb.Verdict_FORWARDED, "foo", "bar"))
+	require.NoError(t, err)
+	assert.True(t, stop)
+
+	// a different connection is not deduplicated
+	other := newTestFlow(flowpb.Verdict_FORWARDED, "foo", "bar")
+	other.L4 = &flowpb.Layer4{Protocol: &flowpb.Layer4_UDP{UDP: &flowpb.UDP{DestinationPort: 53}}}
+	stop, err = s.OnDecodedFlow(context.Background(), other)
+	require.NoError(t, err)
+	assert.False(t, stop)
+
+	// once the window has passed, the flow is kept again
+	s.now = func() time.Time { return now.Add(2 * time.Minute) }
+	stop, err = s.OnDecodedFlow(context.Background(), newTestFlow(flowpb.Verdict_FORWARDED, "foo", "bar"))
+	require.NoError(t, err)
+	assert.False(t, stop)
+
+	// dropped flows are never aggregated
+	for i := 0; i < 2; i++ {
+		stop, err = s.OnDecodedFlow(context.Background(), newTestFlow(flowpb.Verdict_DROPPED, "foo", "bar"))
+		require.NoError(t, err)
+		assert.False(t, stop)
+	}
+}
@@ -780,6 +780,23 @@ func KeepUniqueAddrs(addrs []netip.Addr) []netip.Addr {
 	return slices.Compact(addrs)
 }
 
+// KeepUniquePrefixes transforms the provided multiset of IP prefixes into a
+// single set, sorted via comparison of the prefix addresses and lengths
+// (i.e. IPv4 prefixes show up before IPv6).
+// The slice is manipulated in-place destructively; it does not create a new slice.
+func KeepUniquePrefixes(prefixes []netip.Prefix) []netip.Prefix {
+	if len(prefixes) == 0 {
+		return prefixes
+	}
+	sort.Slice(prefixes, func(i, j int) bool {
+		if cmp := prefixes[i].Addr().Compare(prefixes[j].Addr()); cmp != 0 {
+			return cmp < 0
+		}
+		return prefixes[i].Bits() < prefixes[j].Bits()
+	})
+	return slices.Compact(prefixes)
+}
+
 var privateIPBlocks []*net.IPNet
 
 func initPrivatePrefixes() {
@@ -14,6 +14,7 @@ import (
 	"github.com/sirupsen/logrus"
 
 	"github.com/cilium/cilium/pkg/metrics"
+	"github.com/cilium/cilium/pkg/option"
 )
 
 const (
@@ -86,6 +87,15 @@ func (ipam *IPAM) allocateIP(ip net.IP, owner string, needSyncUpstream bool) (re
 		return
 	}
 
+	if name := ipam.reservedByLocked(ip); name != "" {
+		err = fmt.Errorf("IP %s is reserved by CiliumIPReservation %s", ip.String(), name)
+		return
+	}
+
+	// An explicit request for a quarantined IP returns it to the pool
+	// immediately
+	ipam.dequarantineIPLocked(ip)
+
 	family := familyIPv4
 	if ip.To4() != nil {
 		if ipam.IPv4Allocator == nil {
@@ -126,6 +136,8 @@ func (ipam *IPAM) allocateIP(ip net.IP, owner string, needSyncUpstream bool) (re
 	}).Debugf("Allocated specific IP")
 
 	ipam.owner[ip.String()] = owner
+	ipam.updateStickyIPLocked(owner, ip)
+	ipam.recordIPAMEvent(metricAllocate, ip, owner, result)
 	metrics.IpamEvent.WithLabelValues(metricAllocate, family).Inc()
 	return
 }
@@ -148,6 +160,38 @@ func (ipam *IPAM) allocateNextFamily(family Family, owner string, needSyncUpstre
 		return
 	}
 
+	// Pods with a stable identity are handed back the IP they were last
+	// allocated if it is still part of the pool and available.
+	if ip := ipam.stickyIPLocked(owner, family); ip != nil && !ipam.blacklist.Contains(ip) && ipam.reservedByLocked(ip) == "" {
+		// A quarantined IP may be reclaimed by the owner it was
+		// allocated to before it was released
+		if q, ok := ipam.quarantine[ip.String()]; ok && q.owner == owner {
+			ipam.dequarantineIPLocked(ip)
+		}
+
+		if needSyncUpstream {
+			result, err = allocator.Allocate(ip, owner)
+		} else {
+			result, err = allocator.AllocateWithoutSyncUpstream(ip, owner)
+		}
+		if err == nil {
+			log.WithFields(logrus.Fields{
+				"ip":    ip.String(),
+				"owner": owner,
+			}).Debugf("Re-assigned sticky IP")
+			ipam.owner[ip.String()] = owner
+			ipam.recordIPAMEvent(metricAllocate, ip, owner, result)
+			metrics.IpamEvent.WithLabelValues(metricAllocate, string(family)).Inc()
+			return
+		}
+
+		log.WithFields(logrus.Fields{
+			"ip":    ip.String(),
+			"owner": owner,
+		}).WithError(err).Debug("Unable to re-assign sticky IP, allocating a new IP")
+		result, err = nil, nil
+	}
+
 	for {
 		if needSyncUpstream {
 			result, err = allocator.AllocateNext(owner)
@@ -158,12 +202,22 @@ func (ipam *IPAM) allocateNextFamily(family Family, owner string, needSyncUpstre
 			return
 		}
 
+		if name := ipam.reservedByLocked(result.IP); name != "" {
+			// As with blacklisted IPs below, keep the reserved IP
+			// allocated so it is not returned again in the next
+			// iteration.
+			ipam.owner[result.IP.String()] = fmt.Sprintf("%s (reserved by %s)", owner, name)
+			continue
+		}
+
 		if !ipam.blacklist.Contains(result.IP) {
 			log.WithFields(logrus.Fields{
 				"ip":    result.IP.String(),
 				"owner": owner,
 			}).Debugf("Allocated random IP")
 			ipam.owner[result.IP.String()] = owner
+			ipam.updateStickyIPLocked(owner, result.IP)
+			ipam.recordIPAMEvent(metricAllocate, result.IP, owner, result)
 			metrics.IpamEvent.WithLabelValues(metricAllocate, string(family)).Inc()
 			return
 		}
@@ -280,14 +334,24 @@ func (ipam *IPAM) releaseIPLocked(ip net.IP) error {
 	delete(ipam.owner, ip.String())
 	delete(ipam.expirationTimers, ip.String())
 
+	ipam.recordIPAMEvent(metricRelease, ip, owner, nil)
 	metrics.IpamEvent.WithLabelValues(metricRelease, family).Inc()
 	return nil
 }
 
-// ReleaseIP release a IP address.
+// ReleaseIP release a IP address. If a release grace period is configured,
+// the IP is quarantined and only returned to the pool after the grace period
+// has passed.
 func (ipam *IPAM) ReleaseIP(ip net.IP) error {
 	ipam.allocatorMutex.Lock()
 	defer ipam.allocatorMutex.Unlock()
+
+	if option.Config.IPAMReleaseGracePeriod > 0 {
+		if _, ok := ipam.owner[ip.String()]; ok {
+			return ipam.quarantineIPLocked(ip)
+		}
+	}
+
 	return ipam.releaseIPLocked(ip)
 }
 
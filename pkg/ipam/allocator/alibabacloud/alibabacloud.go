@@ -13,13 +13,20 @@ import (
 	operatorMetrics "github.com/cilium/cilium/operator/metrics"
 	operatorOption "github.com/cilium/cilium/operator/option"
 	openapi "github.com/cilium/cilium/pkg/alibabacloud/api"
+	apimock "github.com/cilium/cilium/pkg/alibabacloud/api/mock"
 	"github.com/cilium/cilium/pkg/alibabacloud/eni"
 	"github.com/cilium/cilium/pkg/alibabacloud/eni/limits"
 	"github.com/cilium/cilium/pkg/alibabacloud/metadata"
+	alibabaTypes "github.com/cilium/cilium/pkg/alibabacloud/types"
+	"github.com/cilium/cilium/pkg/alibabacloud/utils"
+	"github.com/cilium/cilium/pkg/api/helpers"
 	apiMetrics "github.com/cilium/cilium/pkg/api/metrics"
+	"github.com/cilium/cilium/pkg/cidr"
+	"github.com/cilium/cilium/pkg/defaults"
 	"github.com/cilium/cilium/pkg/ipam"
 	"github.com/cilium/cilium/pkg/ipam/allocator"
 	ipamMetrics "github.com/cilium/cilium/pkg/ipam/metrics"
+	ipamTypes "github.com/cilium/cilium/pkg/ipam/types"
 	"github.com/cilium/cilium/pkg/logging"
 	"github.com/cilium/cilium/pkg/logging/logfields"
 )
@@ -31,11 +38,56 @@ type AllocatorAlibabaCloud struct {
 	client *openapi.Client
 }
 
+// newSimulationAPI returns a fake ECS backend serving all API requests from
+// memory. The simulated topology is large enough to never constrain the
+// allocator, actual instance capacity is modeled via the CiliumNode
+// resources fed into the node manager.
+func newSimulationAPI() *apimock.API {
+	subnets := []*ipamTypes.Subnet{
+		{
+			ID:                 "vsw-sim-1",
+			AvailabilityZone:   "sim-zone-a",
+			VirtualNetworkID:   "vpc-sim",
+			CIDR:               cidr.MustParseCIDR("10.10.0.0/17"),
+			AvailableAddresses: 30000,
+		},
+		{
+			ID:                 "vsw-sim-2",
+			AvailabilityZone:   "sim-zone-b",
+			VirtualNetworkID:   "vpc-sim",
+			CIDR:               cidr.MustParseCIDR("10.10.128.0/17"),
+			AvailableAddresses: 30000,
+		},
+	}
+	vpcs := []*ipamTypes.VirtualNetwork{
+		{
+			ID:          "vpc-sim",
+			PrimaryCIDR: "10.10.0.0/16",
+		},
+	}
+	securityGroups := []*alibabaTypes.SecurityGroup{
+		{
+			ID:    "sg-sim",
+			VPCID: "vpc-sim",
+		},
+	}
+	return apimock.NewAPI(subnets, vpcs, securityGroups)
+}
+
 // Init sets up ENI limits based on given options
 // Credential ref https://github.com/aliyun/alibaba-cloud-sdk-go/blob/master/docs/2-Client-EN.md
 func (a *AllocatorAlibabaCloud) Init(ctx context.Context) error {
 	var aMetrics openapi.MetricsAPI
 
+	// In simulation mode all API requests are served from memory, no
+	// AlibabaCloud credentials or API access is required.
+	if operatorOption.Config.IPAMSimulation {
+		if err := limits.UpdateFromUserDefinedMappings(operatorOption.Config.AlibabaCloudInstanceLimitMapping); err != nil {
+			return fmt.Errorf("failed to parse alibaba-cloud-instance-limit-mapping: %w", err)
+		}
+		return nil
+	}
+
 	if operatorOption.Config.EnableMetrics {
 		aMetrics = apiMetrics.NewPrometheusMetrics(operatorMetrics.Namespace, "alibabacloud", operatorMetrics.Registry)
 	} else {
@@ -64,13 +116,28 @@ func (a *AllocatorAlibabaCloud) Init(ctx context.Context) error {
 		return err
 	}
 
+	var budget *helpers.APIBudget
+	if operatorOption.Config.IPAMAPIBudget > 0 {
+		var bMetrics helpers.BudgetMetricsAPI = &apiMetrics.NoOpMetrics{}
+		if operatorOption.Config.EnableMetrics {
+			bMetrics = apiMetrics.NewBudgetMetrics(operatorMetrics.Namespace, operatorMetrics.Registry)
+		}
+		budget = helpers.NewAPIBudget(bMetrics, operatorOption.Config.IPAMAPIBudget, operatorOption.Config.IPAMAPIBurst)
+	}
+
 	a.client = openapi.NewClient(vpcClient, ecsClient, aMetrics, operatorOption.Config.IPAMAPIQPSLimit,
-		operatorOption.Config.IPAMAPIBurst, map[string]string{openapi.VPCID: vpcID})
+		operatorOption.Config.IPAMAPIBurst, budget, map[string]string{openapi.VPCID: vpcID})
 
 	if err := limits.UpdateFromAPI(ctx, a.client); err != nil {
 		return fmt.Errorf("unable to update instance type to adapter limits from AlibabaCloud API: %w", err)
 	}
 
+	// User defined mappings are applied on top of the limits retrieved
+	// from the API so that they win over the discovered values.
+	if err := limits.UpdateFromUserDefinedMappings(operatorOption.Config.AlibabaCloudInstanceLimitMapping); err != nil {
+		return fmt.Errorf("failed to parse alibaba-cloud-instance-limit-mapping: %w", err)
+	}
+
 	return nil
 }
 
@@ -87,7 +154,13 @@ func (a *AllocatorAlibabaCloud) Start(ctx context.Context, getterUpdater ipam.Ci
 	} else {
 		iMetrics = &ipamMetrics.NoOpMetrics{}
 	}
-	instances := eni.NewInstancesManager(a.client)
+	var instances *eni.InstancesManager
+	if operatorOption.Config.IPAMSimulation {
+		log.Info("Running in IPAM simulation mode, serving ECS API requests from an in-memory fake backend")
+		instances = eni.NewInstancesManager(newSimulationAPI())
+	} else {
+		instances = eni.NewInstancesManager(a.client)
+	}
 	nodeManager, err := ipam.NewNodeManager(instances, getterUpdater, iMetrics,
 		operatorOption.Config.ParallelAllocWorkers, operatorOption.Config.AlibabaCloudReleaseExcessIPs, false)
 	if err != nil {
@@ -98,5 +171,22 @@ func (a *AllocatorAlibabaCloud) Start(ctx context.Context, getterUpdater ipam.Ci
 		return nil, err
 	}
 
+	// The ENI garbage collector deletes AlibabaCloud resources and must
+	// not run in simulation mode.
+	if operatorOption.Config.AlibabaCloudENIGCInterval > 0 && !operatorOption.Config.IPAMSimulation {
+		var gcMetrics *eni.GarbageCollectionMetrics
+		if operatorOption.Config.EnableMetrics {
+			gcMetrics = eni.NewGarbageCollectionMetrics(operatorMetrics.Namespace, operatorMetrics.Registry)
+		}
+		eni.StartENIGarbageCollector(ctx, a.client, eni.GarbageCollectionParams{
+			RunInterval:    operatorOption.Config.AlibabaCloudENIGCInterval,
+			MaxPerInterval: defaults.ENIGarbageCollectionMaxPerInterval,
+			// ENIs created by Cilium carry the ENI index tag, the value
+			// depends on the instance the ENI was created for.
+			ENITags: ipamTypes.Tags{utils.ENIIndexTagKey: ""},
+			Metrics: gcMetrics,
+		})
+	}
+
 	return nodeManager, nil
 }
@@ -12,14 +12,18 @@ import (
 
 	operatorMetrics "github.com/cilium/cilium/operator/metrics"
 	operatorOption "github.com/cilium/cilium/operator/option"
+	"github.com/cilium/cilium/pkg/api/helpers"
 	apiMetrics "github.com/cilium/cilium/pkg/api/metrics"
 	ec2shim "github.com/cilium/cilium/pkg/aws/ec2"
+	ec2mock "github.com/cilium/cilium/pkg/aws/ec2/mock"
 	"github.com/cilium/cilium/pkg/aws/eni"
 	"github.com/cilium/cilium/pkg/aws/eni/limits"
+	awsTypes "github.com/cilium/cilium/pkg/aws/types"
 	"github.com/cilium/cilium/pkg/defaults"
 	"github.com/cilium/cilium/pkg/ipam"
 	"github.com/cilium/cilium/pkg/ipam/allocator"
 	ipamMetrics "github.com/cilium/cilium/pkg/ipam/metrics"
+	ipamTypes "github.com/cilium/cilium/pkg/ipam/types"
 	"github.com/cilium/cilium/pkg/logging"
 	"github.com/cilium/cilium/pkg/logging/logfields"
 	"github.com/cilium/cilium/pkg/option"
@@ -33,6 +37,52 @@ type AllocatorAWS struct {
 	eniGCTags map[string]string
 }
 
+// InstanceLimits returns the instance type limits table of the AWS ENI
+// allocator. It can be served by any operator replica, the table is only
+// refreshed from the EC2 API by the leader.
+func (a *AllocatorAWS) InstanceLimits() map[string]ipamTypes.Limits {
+	return limits.GetAll()
+}
+
+// Preflight implements allocator.MigrationPreflighter. It validates that
+// the EC2 API is reachable with the configured credentials and reports the
+// available capacity of the eligible subnets. No cloud resources are
+// mutated.
+func (a *AllocatorAWS) Preflight(ctx context.Context) []allocator.PreflightCheck {
+	checks := []allocator.PreflightCheck{}
+
+	if a.client == nil {
+		if err := a.Init(ctx); err != nil {
+			return append(checks, allocator.PreflightCheck{
+				Name:  "ec2-credentials",
+				Error: fmt.Errorf("unable to initialize EC2 client: %w", err),
+			})
+		}
+	}
+	checks = append(checks, allocator.PreflightCheck{
+		Name:    "ec2-credentials",
+		Details: "EC2 client initialized with the configured credentials",
+	})
+
+	subnets, err := a.client.GetSubnets(ctx)
+	check := allocator.PreflightCheck{Name: "ec2-describe-subnets", Error: err}
+	if err == nil {
+		availableAddresses := 0
+		for _, subnet := range subnets {
+			availableAddresses += subnet.AvailableAddresses
+		}
+		check.Details = fmt.Sprintf("%d eligible subnets with %d available addresses", len(subnets), availableAddresses)
+	}
+	checks = append(checks, check)
+
+	securityGroups, err := a.client.GetSecurityGroups(ctx)
+	check = allocator.PreflightCheck{Name: "ec2-describe-security-groups", Error: err}
+	if err == nil {
+		check.Details = fmt.Sprintf("%d security groups visible", len(securityGroups))
+	}
+	return append(checks, check)
+}
+
 func initENIGarbageCollectionTags(ctx context.Context, cfg aws.Config) (eniTags map[string]string) {
 	// Use user-provided tags if available
 	if len(operatorOption.Config.ENIGarbageCollectionTags) != 0 {
@@ -67,10 +117,53 @@ func initENIGarbageCollectionTags(ctx context.Context, cfg aws.Config) (eniTags
 	return eniTags
 }
 
+// newSimulationAPI returns a fake EC2 backend serving all API requests from
+// memory. The simulated topology is large enough to never constrain the
+// allocator, actual instance capacity is modeled via the CiliumNode
+// resources fed into the node manager.
+func newSimulationAPI() *ec2mock.API {
+	subnets := []*ipamTypes.Subnet{
+		{
+			ID:                 "subnet-sim-1",
+			AvailabilityZone:   "sim-1a",
+			VirtualNetworkID:   "vpc-sim",
+			AvailableAddresses: 65535,
+		},
+		{
+			ID:                 "subnet-sim-2",
+			AvailabilityZone:   "sim-1b",
+			VirtualNetworkID:   "vpc-sim",
+			AvailableAddresses: 65535,
+		},
+	}
+	vpcs := []*ipamTypes.VirtualNetwork{
+		{
+			ID:          "vpc-sim",
+			PrimaryCIDR: "10.10.0.0/16",
+		},
+	}
+	securityGroups := []*awsTypes.SecurityGroup{
+		{
+			ID:    "sg-sim",
+			VpcID: "vpc-sim",
+		},
+	}
+	return ec2mock.NewAPI(subnets, vpcs, securityGroups)
+}
+
 // Init sets up ENI limits based on given options
 func (a *AllocatorAWS) Init(ctx context.Context) error {
 	var aMetrics ec2shim.MetricsAPI
 
+	// In simulation mode all API requests are served from memory, no AWS
+	// credentials or API access is required.
+	if operatorOption.Config.IPAMSimulation {
+		if err := limits.UpdateFromUserDefinedMappings(operatorOption.Config.AWSInstanceLimitMapping); err != nil {
+			return fmt.Errorf("failed to parse aws-instance-limit-mapping: %w", err)
+		}
+		return nil
+	}
+
 	cfg, err := ec2shim.NewConfig(ctx)
 	if err != nil {
 		return err
@@ -91,8 +184,17 @@ func (a *AllocatorAWS) Init(ctx context.Context) error {
 		eniCreationTags = ec2shim.MergeTags(eniCreationTags, a.eniGCTags)
 	}
 
+	var budget *helpers.APIBudget
+	if operatorOption.Config.IPAMAPIBudget > 0 {
+		var bMetrics helpers.BudgetMetricsAPI = &apiMetrics.NoOpMetrics{}
+		if operatorOption.Config.EnableMetrics {
+			bMetrics = apiMetrics.NewBudgetMetrics(operatorMetrics.Namespace, operatorMetrics.Registry)
+		}
+		budget = helpers.NewAPIBudget(bMetrics, operatorOption.Config.IPAMAPIBudget, operatorOption.Config.IPAMAPIBurst)
+	}
+
 	a.client = ec2shim.NewClient(ec2.NewFromConfig(cfg), aMetrics, operatorOption.Config.IPAMAPIQPSLimit,
-		operatorOption.Config.IPAMAPIBurst, subnetsFilters, instancesFilters, eniCreationTags,
+		operatorOption.Config.IPAMAPIBurst, budget, subnetsFilters, instancesFilters, eniCreationTags,
 		operatorOption.Config.AWSUsePrimaryAddress)
 
 	if err := limits.UpdateFromUserDefinedMappings(operatorOption.Config.AWSInstanceLimitMapping); err != nil {
@@ -119,7 +221,17 @@ func (a *AllocatorAWS) Start(ctx context.Context, getterUpdater ipam.CiliumNodeG
 	} else {
 		iMetrics = &ipamMetrics.NoOpMetrics{}
 	}
-	instances := eni.NewInstancesManager(a.client)
+	var instances *eni.InstancesManager
+	if operatorOption.Config.IPAMSimulation {
+		log.Info("Running in IPAM simulation mode, serving EC2 API requests from an in-memory fake backend")
+		instances = eni.NewInstancesManager(newSimulationAPI())
+	} else {
+		instances = eni.NewInstancesManager(a.client)
+	}
+	if operatorOption.Config.AWSStaticENIMode {
+		log.Info("Static ENI mode enabled, no AWS resources will be created, modified or deleted")
+		instances = instances.WithStaticENIMode(true)
+	}
 	nodeManager, err := ipam.NewNodeManager(instances, getterUpdater, iMetrics,
 		operatorOption.Config.ParallelAllocWorkers, operatorOption.Config.AWSReleaseExcessIPs,
 		operatorOption.Config.AWSEnablePrefixDelegation)
@@ -131,7 +243,10 @@ func (a *AllocatorAWS) Start(ctx context.Context, getterUpdater ipam.CiliumNodeG
 		return nil, err
 	}
 
-	if operatorOption.Config.ENIGarbageCollectionInterval > 0 {
+	// The ENI garbage collector deletes AWS resources and must not run in
+	// static ENI mode or in simulation mode.
+	if operatorOption.Config.ENIGarbageCollectionInterval > 0 && !operatorOption.Config.AWSStaticENIMode &&
+		!operatorOption.Config.IPAMSimulation {
 		eni.StartENIGarbageCollector(ctx, a.client, eni.GarbageCollectionParams{
 			RunInterval:    operatorOption.Config.ENIGarbageCollectionInterval,
 			MaxPerInterval: defaults.ENIGarbageCollectionMaxPerInterval,
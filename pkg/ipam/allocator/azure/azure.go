@@ -9,12 +9,17 @@ import (
 
 	operatorMetrics "github.com/cilium/cilium/operator/metrics"
 	operatorOption "github.com/cilium/cilium/operator/option"
+	"github.com/cilium/cilium/pkg/api/helpers"
 	apiMetrics "github.com/cilium/cilium/pkg/api/metrics"
 	azureAPI "github.com/cilium/cilium/pkg/azure/api"
+	apimock "github.com/cilium/cilium/pkg/azure/api/mock"
 	azureIPAM "github.com/cilium/cilium/pkg/azure/ipam"
+	azureLimits "github.com/cilium/cilium/pkg/azure/limits"
+	"github.com/cilium/cilium/pkg/cidr"
 	"github.com/cilium/cilium/pkg/ipam"
 	"github.com/cilium/cilium/pkg/ipam/allocator"
 	ipamMetrics "github.com/cilium/cilium/pkg/ipam/metrics"
+	ipamTypes "github.com/cilium/cilium/pkg/ipam/types"
 	"github.com/cilium/cilium/pkg/logging"
 	"github.com/cilium/cilium/pkg/logging/logfields"
 )
@@ -27,16 +32,34 @@ type AllocatorAzure struct{}
 // Init in Azure implementation doesn't need to do anything
 func (*AllocatorAzure) Init(ctx context.Context) error { return nil }
 
-// Start kicks of the Azure IP allocation
-func (*AllocatorAzure) Start(ctx context.Context, getterUpdater ipam.CiliumNodeGetterUpdater) (allocator.NodeEventHandler, error) {
-
-	var (
-		azMetrics azureAPI.MetricsAPI
-		iMetrics  ipam.MetricsAPI
-	)
-
-	log.Info("Starting Azure IP allocator...")
+// newSimulationAPI returns a fake ARM backend serving all API requests from
+// memory. The simulated topology is large enough to never constrain the
+// allocator, actual instance capacity is modeled via the CiliumNode
+// resources fed into the node manager.
+func newSimulationAPI() *apimock.API {
+	subnets := []*ipamTypes.Subnet{
+		{
+			ID:               "subnet-sim-1",
+			VirtualNetworkID: "vnet-sim",
+			CIDR:             cidr.MustParseCIDR("10.10.0.0/17"),
+		},
+		{
+			ID:               "subnet-sim-2",
+			VirtualNetworkID: "vnet-sim",
+			CIDR:             cidr.MustParseCIDR("10.10.128.0/17"),
+		},
+	}
+	vnets := []*ipamTypes.VirtualNetwork{
+		{
+			ID: "vnet-sim",
+		},
+	}
+	return apimock.NewAPI(subnets, vnets)
+}
 
+// newAzureClient discovers the Azure environment via the Azure IMS and
+// returns a client ready to serve the instances manager.
+func newAzureClient(ctx context.Context, azMetrics azureAPI.MetricsAPI) (*azureAPI.Client, error) {
 	log.Debug("Retrieving Azure cloud name via Azure IMS")
 	azureCloudName, err := azureAPI.GetAzureCloudName(ctx)
 	if err != nil {
@@ -65,6 +88,43 @@ func (*AllocatorAzure) Start(ctx context.Context, getterUpdater ipam.CiliumNodeG
 		log.WithField("resourceGroupName", resourceGroupName).Debug("Detected resource group name via Azure IMS")
 	}
 
+	var budget *helpers.APIBudget
+	if operatorOption.Config.IPAMAPIBudget > 0 {
+		var bMetrics helpers.BudgetMetricsAPI = &apiMetrics.NoOpMetrics{}
+		if operatorOption.Config.EnableMetrics {
+			bMetrics = apiMetrics.NewBudgetMetrics(operatorMetrics.Namespace, operatorMetrics.Registry)
+		}
+		budget = helpers.NewAPIBudget(bMetrics, operatorOption.Config.IPAMAPIBudget, operatorOption.Config.IPAMAPIBurst)
+	}
+
+	azureClient, err := azureAPI.NewClient(azureCloudName, subscriptionID, resourceGroupName, operatorOption.Config.AzureUserAssignedIdentityID, azMetrics, operatorOption.Config.IPAMAPIQPSLimit, operatorOption.Config.IPAMAPIBurst, budget, operatorOption.Config.AzureUsePrimaryAddress, operatorOption.Config.AzureDryRun)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create Azure client: %w", err)
+	}
+
+	if operatorOption.Config.AzureDryRun {
+		log.Info("Running in dry-run mode, Azure mutations will be logged but not executed")
+	}
+
+	// Refresh the static VM size limits from the ResourceSkus API. The
+	// static table is kept as fallback in case the API is unavailable.
+	if err := azureLimits.UpdateFromAPI(ctx, azureClient); err != nil {
+		log.WithError(err).Warning("Unable to update instance limits from the Azure ResourceSkus API")
+	}
+
+	return azureClient, nil
+}
+
+// Start kicks of the Azure IP allocation
+func (*AllocatorAzure) Start(ctx context.Context, getterUpdater ipam.CiliumNodeGetterUpdater) (allocator.NodeEventHandler, error) {
+
+	var (
+		azMetrics azureAPI.MetricsAPI
+		iMetrics  ipam.MetricsAPI
+	)
+
+	log.Info("Starting Azure IP allocator...")
+
 	if operatorOption.Config.EnableMetrics {
 		azMetrics = apiMetrics.NewPrometheusMetrics(operatorMetrics.Namespace, "azure", operatorMetrics.Registry)
 		iMetrics = ipamMetrics.NewPrometheusMetrics(operatorMetrics.Namespace, operatorMetrics.Registry)
@@ -73,11 +133,19 @@ func (*AllocatorAzure) Start(ctx context.Context, getterUpdater ipam.CiliumNodeG
 		iMetrics = &ipamMetrics.NoOpMetrics{}
 	}
 
-	azureClient, err := azureAPI.NewClient(azureCloudName, subscriptionID, resourceGroupName, operatorOption.Config.AzureUserAssignedIdentityID, azMetrics, operatorOption.Config.IPAMAPIQPSLimit, operatorOption.Config.IPAMAPIBurst, operatorOption.Config.AzureUsePrimaryAddress)
-	if err != nil {
-		return nil, fmt.Errorf("unable to create Azure client: %w", err)
+	var client azureIPAM.AzureAPI
+	if operatorOption.Config.IPAMSimulation {
+		log.Info("Running in IPAM simulation mode, serving Azure API requests from an in-memory fake backend")
+		client = newSimulationAPI()
+	} else {
+		azureClient, err := newAzureClient(ctx, azMetrics)
+		if err != nil {
+			return nil, err
+		}
+		client = azureClient
 	}
-	instances := azureIPAM.NewInstancesManager(azureClient)
+
+	instances := azureIPAM.NewInstancesManager(client)
 	nodeManager, err := ipam.NewNodeManager(instances, getterUpdater, iMetrics, operatorOption.Config.ParallelAllocWorkers, false, false)
 	if err != nil {
 		return nil, fmt.Errorf("unable to initialize Azure node manager: %w", err)
@@ -18,6 +18,7 @@ import (
 	"github.com/cilium/cilium/pkg/ipam/allocator"
 	"github.com/cilium/cilium/pkg/ipam/allocator/podcidr"
 	ipamMetrics "github.com/cilium/cilium/pkg/ipam/metrics"
+	k8sClient "github.com/cilium/cilium/pkg/k8s/client"
 	"github.com/cilium/cilium/pkg/logging"
 	"github.com/cilium/cilium/pkg/logging/logfields"
 	"github.com/cilium/cilium/pkg/option"
@@ -47,6 +48,13 @@ func (e *ErrCIDRColision) Is(target error) bool {
 // IPAM.
 type AllocatorOperator struct {
 	v4CIDRSet, v6CIDRSet []podcidr.CIDRAllocator
+
+	clientset k8sClient.Clientset
+}
+
+// SetClientset sets the Kubernetes client used for pod CIDR defragmentation.
+func (a *AllocatorOperator) SetClientset(clientset k8sClient.Clientset) {
+	a.clientset = clientset
 }
 
 // Init sets up Cilium allocator based on given options
@@ -101,6 +109,14 @@ func (a *AllocatorOperator) Start(ctx context.Context, updater ipam.CiliumNodeGe
 
 	nodeManager := podcidr.NewNodesPodCIDRManager(a.v4CIDRSet, a.v6CIDRSet, updater, iMetrics)
 
+	if interval := operatorOption.Config.ClusterPoolDefragInterval; interval > 0 {
+		if a.clientset == nil {
+			log.Warningf("%s requires a Kubernetes client, pod CIDR defragmentation disabled", operatorOption.ClusterPoolDefragInterval)
+		} else {
+			go a.defragLoop(ctx, nodeManager, interval)
+		}
+	}
+
 	return nodeManager, nil
 }
 
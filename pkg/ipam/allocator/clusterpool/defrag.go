@@ -0,0 +1,54 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package clusterpool
+
+import (
+	"context"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/cilium/cilium/pkg/ipam/allocator/podcidr"
+	v2 "github.com/cilium/cilium/pkg/k8s/apis/cilium.io/v2"
+)
+
+// defragLoop periodically returns pod CIDRs which the agents have marked as
+// released back into the cluster pool to combat long-term fragmentation.
+func (a *AllocatorOperator) defragLoop(ctx context.Context, nodeManager *podcidr.NodesPodCIDRManager, interval time.Duration) {
+	log.WithField("interval", interval).Info("Starting periodic pod CIDR defragmentation")
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		if released, err := a.defragment(ctx, nodeManager); err != nil {
+			log.WithError(err).Warning("Unable to defragment pod CIDRs")
+		} else if released > 0 {
+			log.WithField("released", released).Info("Released unused pod CIDRs back into the cluster pool")
+		}
+	}
+}
+
+// defragment removes the pod CIDRs which the agents have marked as released
+// in their CiliumNode status from the CiliumNode spec and returns them into
+// the cluster pool.
+func (a *AllocatorOperator) defragment(ctx context.Context, nodeManager *podcidr.NodesPodCIDRManager) (int, error) {
+	nodes, err := a.clientset.CiliumV2().CiliumNodes().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return 0, err
+	}
+
+	ciliumNodes := make([]*v2.CiliumNode, 0, len(nodes.Items))
+	for i := range nodes.Items {
+		ciliumNodes = append(ciliumNodes, &nodes.Items[i])
+	}
+
+	return nodeManager.Defragment(ciliumNodes), nil
+}
@@ -0,0 +1,310 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package multipool
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/cilium/ipam/cidrset"
+	"github.com/sirupsen/logrus"
+	"k8s.io/client-go/tools/cache"
+
+	operatorMetrics "github.com/cilium/cilium/operator/metrics"
+	operatorOption "github.com/cilium/cilium/operator/option"
+	ipPkg "github.com/cilium/cilium/pkg/ip"
+	"github.com/cilium/cilium/pkg/ipam"
+	"github.com/cilium/cilium/pkg/ipam/allocator"
+	"github.com/cilium/cilium/pkg/ipam/allocator/podcidr"
+	ipamMetrics "github.com/cilium/cilium/pkg/ipam/metrics"
+	v2 "github.com/cilium/cilium/pkg/k8s/apis/cilium.io/v2"
+	v2alpha1 "github.com/cilium/cilium/pkg/k8s/apis/cilium.io/v2alpha1"
+	k8sClient "github.com/cilium/cilium/pkg/k8s/client"
+	"github.com/cilium/cilium/pkg/k8s/informer"
+	"github.com/cilium/cilium/pkg/k8s/utils"
+	"github.com/cilium/cilium/pkg/lock"
+	"github.com/cilium/cilium/pkg/logging"
+	"github.com/cilium/cilium/pkg/logging/logfields"
+	"github.com/cilium/cilium/pkg/trigger"
+)
+
+var log = logging.DefaultLogger.WithField(logfields.LogSubsys, "ipam-allocator-multi-pool")
+
+const (
+	// DefaultPoolName is the name of the pool used when a node does not
+	// select a pool explicitly.
+	DefaultPoolName = "default"
+
+	// PoolAnnotation is the annotation used on CiliumNode resources to
+	// select the CiliumPodIPPool node CIDRs are allocated from.
+	PoolAnnotation = "ipam.cilium.io/pod-ip-pool"
+)
+
+// AllocatorOperator is an implementation of the IPAM allocator interface
+// which hands out per-node CIDRs from CiliumPodIPPool resources.
+type AllocatorOperator struct {
+	mutex     lock.Mutex
+	clientset k8sClient.Clientset
+	updater   ipam.CiliumNodeGetterUpdater
+	iMetrics  trigger.MetricsObserver
+
+	// pools maps a pool name to the pod CIDR manager allocating from it.
+	pools map[string]*podcidr.NodesPodCIDRManager
+	// nodePool remembers the pool each known node allocates from.
+	nodePool map[string]string
+	// pendingNodes holds nodes waiting for their pool to be created,
+	// indexed by pool name.
+	pendingNodes map[string][]*v2.CiliumNode
+	// synced is true once the initial node resync has been performed.
+	synced bool
+}
+
+// SetClientset sets the Kubernetes clientset used to watch CiliumPodIPPool
+// resources.
+func (a *AllocatorOperator) SetClientset(clientset k8sClient.Clientset) {
+	a.clientset = clientset
+}
+
+// Init validates that the allocator has access to the Kubernetes apiserver.
+// The pools themselves are read from CiliumPodIPPool resources once Start is
+// called.
+func (a *AllocatorOperator) Init(ctx context.Context) error {
+	if a.clientset == nil || !a.clientset.IsEnabled() {
+		return fmt.Errorf("multi-pool IPAM requires access to the Kubernetes apiserver")
+	}
+	return nil
+}
+
+// Start kicks off the multi-pool allocation. CiliumPodIPPool resources are
+// watched and a pod CIDR manager is maintained per pool, node events are
+// dispatched to the manager of the pool the node selects.
+func (a *AllocatorOperator) Start(ctx context.Context, updater ipam.CiliumNodeGetterUpdater) (allocator.NodeEventHandler, error) {
+	log.Info("Starting multi-pool IP allocator...")
+
+	if operatorOption.Config.EnableMetrics {
+		a.iMetrics = ipamMetrics.NewTriggerMetrics(operatorMetrics.Namespace, "k8s_sync")
+	} else {
+		a.iMetrics = &ipamMetrics.NoOpMetricsObserver{}
+	}
+
+	a.updater = updater
+	a.pools = map[string]*podcidr.NodesPodCIDRManager{}
+	a.nodePool = map[string]string{}
+	a.pendingNodes = map[string][]*v2.CiliumNode{}
+
+	_, poolController := informer.NewInformer(
+		utils.ListerWatcherFromTyped[*v2alpha1.CiliumPodIPPoolList](a.clientset.CiliumV2alpha1().CiliumPodIPPools()),
+		&v2alpha1.CiliumPodIPPool{},
+		0,
+		cache.ResourceEventHandlerFuncs{
+			AddFunc: func(obj interface{}) {
+				if pool, ok := obj.(*v2alpha1.CiliumPodIPPool); ok {
+					a.upsertPool(pool)
+				}
+			},
+			UpdateFunc: func(oldObj, newObj interface{}) {
+				oldPool, okOld := oldObj.(*v2alpha1.CiliumPodIPPool)
+				newPool, okNew := newObj.(*v2alpha1.CiliumPodIPPool)
+				if okOld && okNew && !oldPool.Spec.DeepEqual(&newPool.Spec) {
+					log.WithField("pool", newPool.Name).
+						Warning("Updating the spec of a pod IP pool in use is not supported, delete and re-create the pool instead")
+				}
+			},
+			DeleteFunc: func(obj interface{}) {
+				if pool, ok := obj.(*v2alpha1.CiliumPodIPPool); ok {
+					log.WithField("pool", pool.Name).
+						Warning("Pod IP pool deleted, CIDRs already allocated from it remain in place until the nodes are deleted")
+				}
+			},
+		},
+		nil,
+	)
+	go poolController.Run(ctx.Done())
+
+	return a, nil
+}
+
+// upsertPool registers a new pod CIDR manager for the given pool and replays
+// the node events which were waiting for the pool to be created.
+func (a *AllocatorOperator) upsertPool(pool *v2alpha1.CiliumPodIPPool) {
+	scopedLog := log.WithField("pool", pool.Name)
+
+	v4CIDRSets, v6CIDRSets, err := newPoolCIDRSets(&pool.Spec)
+	if err != nil {
+		scopedLog.WithError(err).Error("Invalid pod IP pool spec, pool is ignored")
+		return
+	}
+
+	a.mutex.Lock()
+	if _, ok := a.pools[pool.Name]; ok {
+		a.mutex.Unlock()
+		return
+	}
+	mgr := podcidr.NewNodesPodCIDRManager(v4CIDRSets, v6CIDRSets, a.updater, a.iMetrics)
+	a.pools[pool.Name] = mgr
+	pending := a.pendingNodes[pool.Name]
+	delete(a.pendingNodes, pool.Name)
+	for _, node := range pending {
+		a.nodePool[node.Name] = pool.Name
+	}
+	synced := a.synced
+	a.mutex.Unlock()
+
+	scopedLog.Info("Registered pod IP pool")
+
+	for _, node := range pending {
+		mgr.Update(node)
+	}
+	if synced {
+		// The initial resync already happened, allow the new manager to
+		// allocate CIDRs for nodes without any podCIDR right away.
+		mgr.Resync(context.TODO(), time.Time{})
+	}
+}
+
+// Create is called on CiliumNode creation, it dispatches the node to the pod
+// CIDR manager of the pool the node selects.
+func (a *AllocatorOperator) Create(node *v2.CiliumNode) bool {
+	return a.upsertNode(node)
+}
+
+// Update is called on CiliumNode updates, it dispatches the node to the pod
+// CIDR manager of the pool the node selects.
+func (a *AllocatorOperator) Update(node *v2.CiliumNode) bool {
+	return a.upsertNode(node)
+}
+
+func (a *AllocatorOperator) upsertNode(node *v2.CiliumNode) bool {
+	a.mutex.Lock()
+	poolName := nodePoolName(node)
+	if assigned, ok := a.nodePool[node.Name]; ok && assigned != poolName {
+		log.WithFields(logrus.Fields{
+			logfields.NodeName: node.Name,
+			"pool":             assigned,
+		}).Warning("Changing the pool of a node is not supported, the node keeps allocating from its original pool")
+		poolName = assigned
+	}
+	mgr, ok := a.pools[poolName]
+	if !ok {
+		pending := a.pendingNodes[poolName]
+		replaced := false
+		for i, pendingNode := range pending {
+			if pendingNode.Name == node.Name {
+				pending[i] = node
+				replaced = true
+				break
+			}
+		}
+		if !replaced {
+			a.pendingNodes[poolName] = append(pending, node)
+		}
+		a.mutex.Unlock()
+		log.WithFields(logrus.Fields{
+			logfields.NodeName: node.Name,
+			"pool":             poolName,
+		}).Info("Waiting for pod IP pool to be created before allocating CIDRs for node")
+		return false
+	}
+	a.nodePool[node.Name] = poolName
+	a.mutex.Unlock()
+
+	return mgr.Update(node)
+}
+
+// Delete releases the CIDRs of the node back to the pool it allocated from.
+func (a *AllocatorOperator) Delete(node *v2.CiliumNode) {
+	a.mutex.Lock()
+	poolName, ok := a.nodePool[node.Name]
+	if !ok {
+		poolName = nodePoolName(node)
+	}
+	delete(a.nodePool, node.Name)
+	pending := a.pendingNodes[poolName]
+	for i, pendingNode := range pending {
+		if pendingNode.Name == node.Name {
+			a.pendingNodes[poolName] = append(pending[:i], pending[i+1:]...)
+			break
+		}
+	}
+	mgr := a.pools[poolName]
+	a.mutex.Unlock()
+
+	if mgr != nil {
+		mgr.Delete(node)
+	}
+}
+
+// Resync resyncs all pod CIDR managers with k8s.
+func (a *AllocatorOperator) Resync(ctx context.Context, t time.Time) {
+	a.mutex.Lock()
+	a.synced = true
+	managers := make([]*podcidr.NodesPodCIDRManager, 0, len(a.pools))
+	for _, mgr := range a.pools {
+		managers = append(managers, mgr)
+	}
+	a.mutex.Unlock()
+
+	for _, mgr := range managers {
+		mgr.Resync(ctx, t)
+	}
+}
+
+// nodePoolName returns the name of the pool the given node allocates from.
+func nodePoolName(node *v2.CiliumNode) string {
+	if pool, ok := node.Annotations[PoolAnnotation]; ok && pool != "" {
+		return pool
+	}
+	return DefaultPoolName
+}
+
+// newPoolCIDRSets creates the per-family CIDR allocators for the given pool
+// spec.
+func newPoolCIDRSets(spec *v2alpha1.IPPoolSpec) (v4CIDRSets, v6CIDRSets []podcidr.CIDRAllocator, err error) {
+	if spec.IPv4 == nil && spec.IPv6 == nil {
+		return nil, nil, fmt.Errorf("pool must define at least one of ipv4 or ipv6")
+	}
+	if spec.IPv4 != nil {
+		v4CIDRSets, err = newCIDRSets(false, spec.IPv4.CIDRs, int(spec.IPv4.MaskSize))
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid ipv4 pool: %w", err)
+		}
+	}
+	if spec.IPv6 != nil {
+		v6CIDRSets, err = newCIDRSets(true, spec.IPv6.CIDRs, int(spec.IPv6.MaskSize))
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid ipv6 pool: %w", err)
+		}
+	}
+	return v4CIDRSets, v6CIDRSets, nil
+}
+
+func newCIDRSets(isV6 bool, strCIDRs []v2alpha1.PoolCIDR, maskSize int) ([]podcidr.CIDRAllocator, error) {
+	cidrAllocators := make([]podcidr.CIDRAllocator, 0, len(strCIDRs))
+	for _, strCIDR := range strCIDRs {
+		addr, cidr, err := net.ParseCIDR(string(strCIDR))
+		if err != nil {
+			return nil, err
+		}
+		// Check if CIDRs collide with each other.
+		for _, cidrAllocator := range cidrAllocators {
+			if cidrAllocator.InRange(cidr) {
+				return nil, fmt.Errorf("CIDR %s colides with %s", strCIDR, cidrAllocator)
+			}
+		}
+		switch {
+		case isV6 && ipPkg.IsIPv4(addr):
+			return nil, fmt.Errorf("CIDR is not v6 family: %s", cidr)
+		case !isV6 && !ipPkg.IsIPv4(addr):
+			return nil, fmt.Errorf("CIDR is not v4 family: %s", cidr)
+		}
+		cidrSet, err := cidrset.NewCIDRSet(cidr, maskSize)
+		if err != nil {
+			return nil, err
+		}
+		cidrAllocators = append(cidrAllocators, cidrSet)
+	}
+	return cidrAllocators, nil
+}
@@ -0,0 +1,155 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package multipool
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v2 "github.com/cilium/cilium/pkg/k8s/apis/cilium.io/v2"
+	v2alpha1 "github.com/cilium/cilium/pkg/k8s/apis/cilium.io/v2alpha1"
+)
+
+func Test_newPoolCIDRSets(t *testing.T) {
+	tests := []struct {
+		name    string
+		spec    v2alpha1.IPPoolSpec
+		wantV4  int
+		wantV6  int
+		wantErr bool
+	}{
+		{
+			name: "ipv4 only",
+			spec: v2alpha1.IPPoolSpec{
+				IPv4: &v2alpha1.IPv4PoolSpec{
+					CIDRs:    []v2alpha1.PoolCIDR{"10.0.0.0/16"},
+					MaskSize: 24,
+				},
+			},
+			wantV4: 1,
+		},
+		{
+			name: "dual stack",
+			spec: v2alpha1.IPPoolSpec{
+				IPv4: &v2alpha1.IPv4PoolSpec{
+					CIDRs:    []v2alpha1.PoolCIDR{"10.0.0.0/16", "10.1.0.0/16"},
+					MaskSize: 24,
+				},
+				IPv6: &v2alpha1.IPv6PoolSpec{
+					CIDRs:    []v2alpha1.PoolCIDR{"fd00::/104"},
+					MaskSize: 120,
+				},
+			},
+			wantV4: 2,
+			wantV6: 1,
+		},
+		{
+			name:    "no families",
+			spec:    v2alpha1.IPPoolSpec{},
+			wantErr: true,
+		},
+		{
+			name: "colliding CIDRs",
+			spec: v2alpha1.IPPoolSpec{
+				IPv4: &v2alpha1.IPv4PoolSpec{
+					CIDRs:    []v2alpha1.PoolCIDR{"10.0.0.0/16", "10.0.0.0/8"},
+					MaskSize: 24,
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "wrong family",
+			spec: v2alpha1.IPPoolSpec{
+				IPv6: &v2alpha1.IPv6PoolSpec{
+					CIDRs:    []v2alpha1.PoolCIDR{"10.0.0.0/16"},
+					MaskSize: 120,
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid CIDR",
+			spec: v2alpha1.IPPoolSpec{
+				IPv4: &v2alpha1.IPv4PoolSpec{
+					CIDRs:    []v2alpha1.PoolCIDR{"not-a-cidr"},
+					MaskSize: 24,
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "node CIDR size too big",
+			spec: v2alpha1.IPPoolSpec{
+				IPv6: &v2alpha1.IPv6PoolSpec{
+					CIDRs:    []v2alpha1.PoolCIDR{"fd00::/64"},
+					MaskSize: 96,
+				},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v4CIDRSets, v6CIDRSets, err := newPoolCIDRSets(&tt.spec)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("newPoolCIDRSets() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err != nil {
+				return
+			}
+			if len(v4CIDRSets) != tt.wantV4 {
+				t.Errorf("newPoolCIDRSets() len(v4CIDRSets) = %d, want %d", len(v4CIDRSets), tt.wantV4)
+			}
+			if len(v6CIDRSets) != tt.wantV6 {
+				t.Errorf("newPoolCIDRSets() len(v6CIDRSets) = %d, want %d", len(v6CIDRSets), tt.wantV6)
+			}
+		})
+	}
+}
+
+func Test_nodePoolName(t *testing.T) {
+	tests := []struct {
+		name string
+		node *v2.CiliumNode
+		want string
+	}{
+		{
+			name: "no annotation",
+			node: &v2.CiliumNode{
+				ObjectMeta: metav1.ObjectMeta{Name: "node-1"},
+			},
+			want: DefaultPoolName,
+		},
+		{
+			name: "empty annotation",
+			node: &v2.CiliumNode{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "node-1",
+					Annotations: map[string]string{PoolAnnotation: ""},
+				},
+			},
+			want: DefaultPoolName,
+		},
+		{
+			name: "pool annotation",
+			node: &v2.CiliumNode{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "node-1",
+					Annotations: map[string]string{PoolAnnotation: "jumbo"},
+				},
+			},
+			want: "jumbo",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := nodePoolName(tt.node); got != tt.want {
+				t.Errorf("nodePoolName() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,85 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package openstack
+
+import (
+	"context"
+	"fmt"
+
+	operatorMetrics "github.com/cilium/cilium/operator/metrics"
+	operatorOption "github.com/cilium/cilium/operator/option"
+	apiMetrics "github.com/cilium/cilium/pkg/api/metrics"
+	"github.com/cilium/cilium/pkg/ipam"
+	"github.com/cilium/cilium/pkg/ipam/allocator"
+	ipamMetrics "github.com/cilium/cilium/pkg/ipam/metrics"
+	"github.com/cilium/cilium/pkg/logging"
+	"github.com/cilium/cilium/pkg/logging/logfields"
+	openapi "github.com/cilium/cilium/pkg/openstack/api"
+	"github.com/cilium/cilium/pkg/openstack/eni"
+	"github.com/cilium/cilium/pkg/openstack/eni/limits"
+)
+
+var log = logging.DefaultLogger.WithField(logfields.LogSubsys, "ipam-allocator-openstack")
+
+// AllocatorOpenStack is an implementation of IPAM allocator interface for
+// OpenStack Neutron ports
+type AllocatorOpenStack struct {
+	client *openapi.Client
+}
+
+// Init sets up the OpenStack API client and the per-flavor ENI limits based
+// on given options. Credentials are taken from the standard OS_* environment
+// variables.
+func (a *AllocatorOpenStack) Init(ctx context.Context) error {
+	var aMetrics openapi.MetricsAPI
+
+	if operatorOption.Config.EnableMetrics {
+		aMetrics = apiMetrics.NewPrometheusMetrics(operatorMetrics.Namespace, "openstack", operatorMetrics.Registry)
+	} else {
+		aMetrics = &apiMetrics.NoOpMetrics{}
+	}
+
+	creds, err := openapi.NewCredentialsFromEnv()
+	if err != nil {
+		return err
+	}
+
+	a.client = openapi.NewClient(creds, aMetrics,
+		operatorOption.Config.IPAMAPIQPSLimit, operatorOption.Config.IPAMAPIBurst)
+
+	// There is no universal flavor catalog to discover limits from, the
+	// per-flavor limits must be provided via configuration.
+	if err := limits.UpdateFromUserDefinedMappings(operatorOption.Config.OpenStackFlavorLimitMapping); err != nil {
+		return fmt.Errorf("failed to parse openstack-flavor-limit-mapping: %w", err)
+	}
+
+	return nil
+}
+
+// Start kicks off ENI allocation, the initial connection to the OpenStack
+// APIs is done in a blocking manner. Provided this is successful, a controller
+// is started to manage allocation based on CiliumNode custom resources
+func (a *AllocatorOpenStack) Start(ctx context.Context, getterUpdater ipam.CiliumNodeGetterUpdater) (allocator.NodeEventHandler, error) {
+	var iMetrics ipam.MetricsAPI
+
+	log.Info("Starting OpenStack ENI allocator...")
+
+	if operatorOption.Config.EnableMetrics {
+		iMetrics = ipamMetrics.NewPrometheusMetrics(operatorMetrics.Namespace, operatorMetrics.Registry)
+	} else {
+		iMetrics = &ipamMetrics.NoOpMetrics{}
+	}
+	instances := eni.NewInstancesManager(a.client)
+	nodeManager, err := ipam.NewNodeManager(instances, getterUpdater, iMetrics,
+		operatorOption.Config.ParallelAllocWorkers, operatorOption.Config.OpenStackReleaseExcessIPs, false)
+	if err != nil {
+		return nil, fmt.Errorf("unable to initialize OpenStack node manager: %w", err)
+	}
+
+	if err := nodeManager.Start(ctx); err != nil {
+		return nil, err
+	}
+
+	return nodeManager, nil
+}
@@ -0,0 +1,75 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package podcidr
+
+import (
+	"net"
+
+	"github.com/sirupsen/logrus"
+
+	ipamTypes "github.com/cilium/cilium/pkg/ipam/types"
+	v2 "github.com/cilium/cilium/pkg/k8s/apis/cilium.io/v2"
+)
+
+// Defragment returns pod CIDRs which the agents have marked as released in
+// their CiliumNode status back into the cluster pool and removes them from
+// the CiliumNode spec. The release decision is made by the agent: a pod CIDR
+// is only marked as released once no IP is allocated from it and the agent
+// stopped allocating from it, see the status handling in
+// pkg/ipam/clusterpool.go. Returns the number of released CIDRs.
+func (n *NodesPodCIDRManager) Defragment(ciliumNodes []*v2.CiliumNode) (released int) {
+	n.Mutex.Lock()
+	defer n.Mutex.Unlock()
+
+	if !n.canAllocatePodCIDRs {
+		// Not all nodes have been synced yet, the allocator state is not
+		// authoritative.
+		return 0
+	}
+
+	for _, node := range ciliumNodes {
+		cidrs, ok := n.nodes[node.Name]
+		if !ok {
+			continue
+		}
+
+		keptV4, freedV4 := splitReleasedCIDRs(cidrs.v4PodCIDRs, node.Status.IPAM.PodCIDRs)
+		keptV6, freedV6 := splitReleasedCIDRs(cidrs.v6PodCIDRs, node.Status.IPAM.PodCIDRs)
+		if len(freedV4) == 0 && len(freedV6) == 0 {
+			continue
+		}
+
+		releaseCIDRs(n.v4CIDRAllocators, freedV4)
+		releaseCIDRs(n.v6CIDRAllocators, freedV6)
+		cidrs.v4PodCIDRs = keptV4
+		cidrs.v6PodCIDRs = keptV6
+		released += len(freedV4) + len(freedV6)
+
+		cn := node.DeepCopy()
+		cn.Spec.IPAM.PodCIDRs = append(ipNetString(keptV4), ipNetString(keptV6)...)
+		n.syncNode(k8sOpUpdate, cn)
+
+		log.WithFields(logrus.Fields{
+			"node-name": node.Name,
+			"released":  ipNetString(append(freedV4, freedV6...)),
+		}).Info("Released unused pod CIDRs of node")
+	}
+
+	return released
+}
+
+// splitReleasedCIDRs partitions the given CIDRs into the ones the agent has
+// marked as released in the CiliumNode status and the ones it is still using.
+// CIDRs without a status entry are conservatively treated as in use.
+func splitReleasedCIDRs(cidrs []*net.IPNet, status ipamTypes.PodCIDRMap) (kept, freed []*net.IPNet) {
+	for _, ipNet := range cidrs {
+		if entry, ok := status[ipNet.String()]; ok && entry.Status == ipamTypes.PodCIDRStatusReleased {
+			freed = append(freed, ipNet)
+		} else {
+			kept = append(kept, ipNet)
+		}
+	}
+
+	return kept, freed
+}
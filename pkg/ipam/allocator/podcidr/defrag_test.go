@@ -0,0 +1,126 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package podcidr
+
+import (
+	"net"
+	"sync/atomic"
+	"time"
+
+	. "gopkg.in/check.v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/cilium/cilium/pkg/checker"
+	ipamTypes "github.com/cilium/cilium/pkg/ipam/types"
+	v2 "github.com/cilium/cilium/pkg/k8s/apis/cilium.io/v2"
+)
+
+func (s *PodCIDRSuite) TestSplitReleasedCIDRs(c *C) {
+	tests := []struct {
+		name      string
+		cidrs     []*net.IPNet
+		status    ipamTypes.PodCIDRMap
+		wantKept  []*net.IPNet
+		wantFreed []*net.IPNet
+	}{
+		{
+			name:      "no status entries keeps all CIDRs",
+			cidrs:     mustNewCIDRs("10.10.0.0/24", "10.10.1.0/24"),
+			status:    nil,
+			wantKept:  mustNewCIDRs("10.10.0.0/24", "10.10.1.0/24"),
+			wantFreed: nil,
+		},
+		{
+			name:  "released CIDR is freed",
+			cidrs: mustNewCIDRs("10.10.0.0/24", "10.10.1.0/24"),
+			status: ipamTypes.PodCIDRMap{
+				"10.10.0.0/24": {Status: ipamTypes.PodCIDRStatusInUse},
+				"10.10.1.0/24": {Status: ipamTypes.PodCIDRStatusReleased},
+			},
+			wantKept:  mustNewCIDRs("10.10.0.0/24"),
+			wantFreed: mustNewCIDRs("10.10.1.0/24"),
+		},
+		{
+			name:  "depleted CIDR is kept",
+			cidrs: mustNewCIDRs("10.10.0.0/24", "10.10.1.0/24"),
+			status: ipamTypes.PodCIDRMap{
+				"10.10.0.0/24": {Status: ipamTypes.PodCIDRStatusInUse},
+				"10.10.1.0/24": {Status: ipamTypes.PodCIDRStatusDepleted},
+			},
+			wantKept:  mustNewCIDRs("10.10.0.0/24", "10.10.1.0/24"),
+			wantFreed: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		kept, freed := splitReleasedCIDRs(tt.cidrs, tt.status)
+		c.Assert(kept, checker.DeepEquals, tt.wantKept, Commentf("Test Name: %s", tt.name))
+		c.Assert(freed, checker.DeepEquals, tt.wantFreed, Commentf("Test Name: %s", tt.name))
+	}
+}
+
+func (s *PodCIDRSuite) TestNodesPodCIDRManager_Defragment(c *C) {
+	var (
+		reSyncCalls  int32
+		releasedCIDR *net.IPNet
+	)
+
+	node := &v2.CiliumNode{
+		ObjectMeta: v1.ObjectMeta{
+			Name: "node-1",
+		},
+		Status: v2.NodeStatus{
+			IPAM: ipamTypes.IPAMStatus{
+				PodCIDRs: ipamTypes.PodCIDRMap{
+					"10.10.0.0/24": {Status: ipamTypes.PodCIDRStatusInUse},
+					"10.10.1.0/24": {Status: ipamTypes.PodCIDRStatusReleased},
+				},
+			},
+		},
+	}
+
+	n := &NodesPodCIDRManager{
+		canAllocatePodCIDRs: true,
+		v4CIDRAllocators: []CIDRAllocator{
+			&mockCIDRAllocator{
+				OnRelease: func(cidr *net.IPNet) error {
+					releasedCIDR = cidr
+					return nil
+				},
+				OnInRange: func(cidr *net.IPNet) bool {
+					return true
+				},
+			},
+		},
+		nodes: map[string]*nodeCIDRs{
+			"node-1": {
+				v4PodCIDRs: mustNewCIDRs("10.10.0.0/24", "10.10.1.0/24"),
+			},
+		},
+		ciliumNodesToK8s: map[string]*ciliumNodeK8sOp{},
+		k8sReSync: mustNewTrigger(func() {
+			atomic.AddInt32(&reSyncCalls, 1)
+		}, time.Millisecond),
+	}
+
+	// The second CIDR has been marked as released by the agent and must be
+	// removed from the spec
+	released := n.Defragment([]*v2.CiliumNode{node})
+	c.Assert(released, checker.Equals, 1)
+	c.Assert(releasedCIDR, checker.DeepEquals, mustNewCIDRs("10.10.1.0/24")[0])
+	c.Assert(n.nodes["node-1"].v4PodCIDRs, checker.DeepEquals, mustNewCIDRs("10.10.0.0/24"))
+
+	// The CiliumNode spec update must have been queued
+	op, ok := n.ciliumNodesToK8s["node-1"]
+	c.Assert(ok, checker.Equals, true)
+	c.Assert(op.op, checker.Equals, k8sOpUpdate)
+	c.Assert(op.ciliumNode.Spec.IPAM.PodCIDRs, checker.DeepEquals, []string{"10.10.0.0/24"})
+	time.Sleep(2 * time.Millisecond)
+	c.Assert(atomic.LoadInt32(&reSyncCalls), checker.Equals, int32(1))
+
+	// A second run must be a no-op as the released CIDR is gone from the
+	// manager state
+	released = n.Defragment([]*v2.CiliumNode{node})
+	c.Assert(released, checker.Equals, 0)
+}
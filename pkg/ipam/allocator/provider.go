@@ -9,6 +9,7 @@ import (
 
 	"github.com/cilium/cilium/pkg/ipam"
 	v2 "github.com/cilium/cilium/pkg/k8s/apis/cilium.io/v2"
+	k8sClient "github.com/cilium/cilium/pkg/k8s/client"
 )
 
 // AllocatorProvider defines the functions of IPAM provider front-end
@@ -18,6 +19,32 @@ type AllocatorProvider interface {
 	Start(ctx context.Context, getterUpdater ipam.CiliumNodeGetterUpdater) (NodeEventHandler, error)
 }
 
+// AllocatorProviderWithClientset is an AllocatorProvider which requires
+// access to the Kubernetes apiserver, e.g. to watch pool custom resources.
+type AllocatorProviderWithClientset interface {
+	AllocatorProvider
+	SetClientset(clientset k8sClient.Clientset)
+}
+
+// PreflightCheck is the result of a single migration preflight check.
+type PreflightCheck struct {
+	// Name identifies the check
+	Name string
+
+	// Error is non-nil if the check failed
+	Error error
+
+	// Details is a human readable description of the check result
+	Details string
+}
+
+// MigrationPreflighter is an AllocatorProvider which can validate a planned
+// migration to its IPAM mode without mutating any cloud resources.
+type MigrationPreflighter interface {
+	AllocatorProvider
+	Preflight(ctx context.Context) []PreflightCheck
+}
+
 // NodeEventHandler should implement the behavior to handle CiliumNode
 type NodeEventHandler interface {
 	Create(resource *v2.CiliumNode) bool
@@ -5,6 +5,9 @@ package ipam
 
 import (
 	"net"
+	"os"
+	"path/filepath"
+	"strings"
 	"time"
 
 	. "gopkg.in/check.v1"
@@ -13,6 +16,7 @@ import (
 	"github.com/cilium/cilium/pkg/datapath/fake"
 	"github.com/cilium/cilium/pkg/k8s/watchers/subscriber"
 	"github.com/cilium/cilium/pkg/mtu"
+	"github.com/cilium/cilium/pkg/option"
 )
 
 type ownerMock struct{}
@@ -160,3 +164,105 @@ func (s *IPAMSuite) TestAllocateNextWithExpiration(c *C) {
 	err = ipam.ReleaseIP(ipv4.IP)
 	c.Assert(err, IsNil)
 }
+
+func (s *IPAMSuite) TestStickyOwner(c *C) {
+	c.Assert(isStickyOwner("default/db-0"), Equals, true)
+	c.Assert(isStickyOwner("kube-system/etcd-12"), Equals, true)
+	c.Assert(isStickyOwner("default/web-7f9c5b4d8-xvlbz"), Equals, false)
+	c.Assert(isStickyOwner("default/standalone"), Equals, false)
+	c.Assert(isStickyOwner("health"), Equals, false)
+}
+
+func (s *IPAMSuite) TestStickyIPReassignment(c *C) {
+	fakeAddressing := fake.NewNodeAddressing()
+	ipam := NewIPAM(fakeAddressing, &testConfiguration{}, &ownerMock{}, &ownerMock{}, &mtuMock, nil)
+	ipam.initStickyIPs()
+
+	owner := "default/db-0"
+	ipv4, ipv6, err := ipam.AllocateNext("", owner)
+	c.Assert(err, IsNil)
+
+	err = ipam.ReleaseIP(ipv4.IP)
+	c.Assert(err, IsNil)
+	err = ipam.ReleaseIP(ipv6.IP)
+	c.Assert(err, IsNil)
+
+	// The owner must be handed back the released IPs on re-allocation
+	newIPv4, newIPv6, err := ipam.AllocateNext("", owner)
+	c.Assert(err, IsNil)
+	c.Assert(newIPv4.IP.Equal(ipv4.IP), Equals, true)
+	c.Assert(newIPv6.IP.Equal(ipv6.IP), Equals, true)
+
+	// Pods without a stable identity must not have a sticky IP recorded
+	otherIPv4, _, err := ipam.AllocateNext("ipv4", "default/web-7f9c5b4d8-xvlbz")
+	c.Assert(err, IsNil)
+	_, ok := ipam.stickyIPs[otherIPv4.IP.String()]
+	c.Assert(ok, Equals, false)
+
+	// If the sticky IP has been re-used by another pod, a new IP must be
+	// allocated
+	err = ipam.ReleaseIP(newIPv4.IP)
+	c.Assert(err, IsNil)
+	err = ipam.AllocateIP(newIPv4.IP, "default/other-1")
+	c.Assert(err, IsNil)
+	replacementIPv4, _, err := ipam.AllocateNext("ipv4", owner)
+	c.Assert(err, IsNil)
+	c.Assert(replacementIPv4.IP.Equal(newIPv4.IP), Equals, false)
+	c.Assert(ipam.stickyIPs[replacementIPv4.IP.String()], Equals, owner)
+}
+
+func (s *IPAMSuite) TestReleaseGracePeriod(c *C) {
+	fakeAddressing := fake.NewNodeAddressing()
+	ipam := NewIPAM(fakeAddressing, &testConfiguration{}, &ownerMock{}, &ownerMock{}, &mtuMock, nil)
+
+	option.Config.IPAMReleaseGracePeriod = 100 * time.Millisecond
+	defer func() { option.Config.IPAMReleaseGracePeriod = 0 }()
+
+	ipv4, _, err := ipam.AllocateNext("ipv4", "default/gone-pod")
+	c.Assert(err, IsNil)
+	err = ipam.ReleaseIP(ipv4.IP)
+	c.Assert(err, IsNil)
+
+	// The released IP must be quarantined and withheld from re-allocation
+	c.Assert(len(ipam.QuarantinedIPs()), Equals, 1)
+	c.Assert(ipam.QuarantinedIPs()[0], Equals, ipv4.IP.String())
+	next, _, err := ipam.AllocateNext("ipv4", "default/other-pod")
+	c.Assert(err, IsNil)
+	c.Assert(next.IP.Equal(ipv4.IP), Equals, false)
+
+	// An explicit request for the quarantined IP returns it to the pool
+	// immediately
+	err = ipam.AllocateIP(ipv4.IP, "default/new-pod")
+	c.Assert(err, IsNil)
+	c.Assert(len(ipam.QuarantinedIPs()), Equals, 0)
+
+	// After the grace period has passed, the IP is returned to the pool
+	err = ipam.ReleaseIP(ipv4.IP)
+	c.Assert(err, IsNil)
+	c.Assert(len(ipam.QuarantinedIPs()), Equals, 1)
+	time.Sleep(2 * option.Config.IPAMReleaseGracePeriod)
+	c.Assert(len(ipam.QuarantinedIPs()), Equals, 0)
+	err = ipam.AllocateIP(ipv4.IP, "default/another-pod")
+	c.Assert(err, IsNil)
+}
+
+func (s *IPAMSuite) TestIPAMEventLog(c *C) {
+	logFile := filepath.Join(c.MkDir(), "ipam-events.log")
+	option.Config.IPAMEventLogFile = logFile
+	defer func() { option.Config.IPAMEventLogFile = "" }()
+
+	fakeAddressing := fake.NewNodeAddressing()
+	ipam := NewIPAM(fakeAddressing, &testConfiguration{}, &ownerMock{}, &ownerMock{}, &mtuMock, nil)
+
+	ipv4, _, err := ipam.AllocateNext("ipv4", "default/audited-pod")
+	c.Assert(err, IsNil)
+	err = ipam.ReleaseIP(ipv4.IP)
+	c.Assert(err, IsNil)
+
+	content, err := os.ReadFile(logFile)
+	c.Assert(err, IsNil)
+	c.Assert(strings.Contains(string(content), ipv4.IP.String()), Equals, true)
+	c.Assert(strings.Contains(string(content), `"action":"allocate"`), Equals, true)
+	c.Assert(strings.Contains(string(content), `"action":"release"`), Equals, true)
+	c.Assert(strings.Contains(string(content), `"owner":"default/audited-pod"`), Equals, true)
+}
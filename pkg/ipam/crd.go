@@ -240,6 +240,14 @@ func deriveVpcCIDRs(node *ciliumv2.CiliumNode) (primaryCIDR *cidr.CIDR, secondar
 			return
 		}
 	}
+	// return OpenStack network CIDR
+	if len(node.Status.OpenStack.ENIs) > 0 {
+		c, err := cidr.ParseCIDR(node.Spec.OpenStack.CIDRBlock)
+		if err == nil {
+			primaryCIDR = c
+			return
+		}
+	}
 	return
 }
 
@@ -311,7 +319,11 @@ func (n *nodeStore) hasMinimumIPsInPool() (minimumReached bool, required, numAva
 			minimumReached = true
 		}
 
-		if n.conf.IPAMMode() == ipamOption.IPAMENI || n.conf.IPAMMode() == ipamOption.IPAMAzure || n.conf.IPAMMode() == ipamOption.IPAMAlibabaCloud {
+		// The IPv4 native routing CIDR can only be derived from the VPC
+		// when the pool contains IPv4 addresses, skip it in IPv6-only
+		// clusters.
+		if n.conf.IPv4Enabled() &&
+			(n.conf.IPAMMode() == ipamOption.IPAMENI || n.conf.IPAMMode() == ipamOption.IPAMAzure || n.conf.IPAMMode() == ipamOption.IPAMAlibabaCloud || n.conf.IPAMMode() == ipamOption.IPAMOpenStack) {
 			if !n.autoDetectIPv4NativeRoutingCIDR() {
 				minimumReached = false
 			}
@@ -687,7 +699,14 @@ func (a *crdAllocator) buildAllocationResult(ip net.IP, ipInfo *ipamTypes.Alloca
 				return
 			}
 		}
-		return nil, fmt.Errorf("unable to find ENI %s", ipInfo.Resource)
+		// Fall back to the instance metadata service for ENIs which are
+		// attached to the instance but missing from the CiliumNode
+		// status, e.g. when the EC2 API is unreachable and the operator
+		// could not repopulate the status after a restart.
+		if err := a.buildAllocationResultFromMetadata(result, ipInfo.Resource); err != nil {
+			return nil, fmt.Errorf("unable to find ENI %s: %w", ipInfo.Resource, err)
+		}
+		return
 
 	// In Azure mode, the Resource points to the azure interface so we can
 	// derive the master interface
@@ -730,6 +749,28 @@ func (a *crdAllocator) buildAllocationResult(ip net.IP, ipInfo *ipamTypes.Alloca
 			return
 		}
 		return nil, fmt.Errorf("unable to find ENI %s", ipInfo.Resource)
+
+	// In OpenStack mode, the Resource points to the Neutron port so we can
+	// derive the master interface
+	case ipamOption.IPAMOpenStack:
+		for _, eni := range a.store.ownNode.Status.OpenStack.ENIs {
+			if eni.NetworkInterfaceID != ipInfo.Resource {
+				continue
+			}
+			result.PrimaryMAC = eni.MACAddress
+			result.CIDRs = []string{eni.Subnet.CIDRBlock}
+
+			// The subnet gateway defaults to the first usable address of
+			// the subnet CIDR unless overridden in Neutron.
+			result.GatewayIP = deriveGatewayIP(eni.Subnet.CIDRBlock, 1)
+			// The interface number is not tracked for Neutron ports, it
+			// is only used by the CNI plugin when
+			// EgressMultiHomeIPRuleCompat is disabled. See the Azure case
+			// above for the details.
+			result.InterfaceNumber = "0"
+			return
+		}
+		return nil, fmt.Errorf("unable to find ENI %s", ipInfo.Resource)
 	}
 
 	return
@@ -870,6 +911,15 @@ func (a *crdAllocator) totalPoolSize() int {
 	return 0
 }
 
+// Free returns the number of addresses which remain available for allocation
+// in the pool.
+func (a *crdAllocator) Free() int {
+	a.mutex.RLock()
+	defer a.mutex.RUnlock()
+
+	return a.totalPoolSize() - len(a.allocated)
+}
+
 // Dump provides a status report and lists all allocated IP addresses
 func (a *crdAllocator) Dump() (map[string]string, string) {
 	a.mutex.RLock()
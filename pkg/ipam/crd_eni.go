@@ -7,9 +7,11 @@ import (
 	"errors"
 	"fmt"
 	"net"
+	"strconv"
 	"time"
 
 	eniTypes "github.com/cilium/cilium/pkg/aws/eni/types"
+	"github.com/cilium/cilium/pkg/aws/metadata"
 	"github.com/cilium/cilium/pkg/backoff"
 	"github.com/cilium/cilium/pkg/defaults"
 	ciliumv2 "github.com/cilium/cilium/pkg/k8s/apis/cilium.io/v2"
@@ -20,6 +22,45 @@ import (
 	"golang.org/x/sys/unix"
 )
 
+// buildAllocationResultFromMetadata completes the allocation result for an
+// ENI which is attached to the instance but missing from the CiliumNode
+// status by falling back to the instance metadata service. This keeps the
+// agent serving existing pool data when the EC2 control plane is unreachable
+// and the operator could not repopulate the status, e.g. after a restart.
+func (a *crdAllocator) buildAllocationResultFromMetadata(result *AllocationResult, resource string) error {
+	enis, err := metadata.GetAttachedENIs()
+	if err != nil {
+		return fmt.Errorf("unable to retrieve ENIs from instance metadata: %w", err)
+	}
+
+	for _, eni := range enis {
+		if eni.ID != resource {
+			continue
+		}
+
+		log.WithField(logfields.Resource, resource).
+			Warning("ENI not found in CiliumNode status, falling back to instance metadata")
+
+		result.PrimaryMAC = eni.MAC
+		result.CIDRs = []string{eni.VPC.PrimaryCIDR}
+		result.CIDRs = append(result.CIDRs, eni.VPC.CIDRs...)
+		// Add manually configured Native Routing CIDR
+		if a.conf.GetIPv4NativeRoutingCIDR() != nil {
+			result.CIDRs = append(result.CIDRs, a.conf.GetIPv4NativeRoutingCIDR().String())
+		}
+		if eni.Subnet.CIDR != "" {
+			// The gateway for a subnet and VPC is always x.x.x.1
+			// Ref: https://docs.aws.amazon.com/vpc/latest/userguide/VPC_Route_Tables.html
+			result.GatewayIP = deriveGatewayIP(eni.Subnet.CIDR, 1)
+		}
+		result.InterfaceNumber = strconv.Itoa(eni.Number)
+
+		return nil
+	}
+
+	return fmt.Errorf("ENI %s not attached to the instance", resource)
+}
+
 type eniDeviceConfig struct {
 	name         string
 	ip           net.IP
@@ -0,0 +1,80 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package ipam
+
+import (
+	"fmt"
+	"net"
+
+	"github.com/cilium/cilium/pkg/option"
+)
+
+// initEgressIPPools sets up one allocator per namespace listed in the
+// egress-ip-pools option. The pools are node-local: each node masquerades a
+// namespace's traffic with the IP it has allocated for itself out of the
+// namespace's pool.
+func (ipam *IPAM) initEgressIPPools() {
+	ipam.egressPools = map[string]Allocator{}
+	ipam.egressIPs = map[string]net.IP{}
+
+	for namespace, pool := range option.Config.EgressIPPools {
+		// The option has been validated at startup
+		_, cidr, err := net.ParseCIDR(pool)
+		if err != nil {
+			log.WithError(err).Fatalf("Invalid egress IP pool '%s' for namespace %s", pool, namespace)
+		}
+		ipam.egressPools[namespace] = newHostScopeAllocator(cidr)
+	}
+}
+
+// EgressIPPoolExists returns true if an egress IP pool has been configured
+// for the given namespace.
+func (ipam *IPAM) EgressIPPoolExists(namespace string) bool {
+	ipam.allocatorMutex.RLock()
+	defer ipam.allocatorMutex.RUnlock()
+
+	_, ok := ipam.egressPools[namespace]
+	return ok
+}
+
+// AllocateNextEgressIP returns the egress IP the local node masquerades the
+// given namespace's traffic with, allocating one out of the namespace's pool
+// on first use.
+func (ipam *IPAM) AllocateNextEgressIP(namespace string) (net.IP, error) {
+	ipam.allocatorMutex.Lock()
+	defer ipam.allocatorMutex.Unlock()
+
+	if ip, ok := ipam.egressIPs[namespace]; ok {
+		return ip, nil
+	}
+
+	pool, ok := ipam.egressPools[namespace]
+	if !ok {
+		return nil, fmt.Errorf("no egress IP pool configured for namespace %s", namespace)
+	}
+
+	result, err := pool.AllocateNext(fmt.Sprintf("egress-ip-pool/%s", namespace))
+	if err != nil {
+		return nil, fmt.Errorf("unable to allocate egress IP for namespace %s: %w", namespace, err)
+	}
+
+	ipam.egressIPs[namespace] = result.IP
+	return result.IP, nil
+}
+
+// ReleaseEgressIP returns the egress IP allocated for the given namespace
+// back into the namespace's pool. It is a no-op if no egress IP has been
+// allocated for the namespace.
+func (ipam *IPAM) ReleaseEgressIP(namespace string) error {
+	ipam.allocatorMutex.Lock()
+	defer ipam.allocatorMutex.Unlock()
+
+	ip, ok := ipam.egressIPs[namespace]
+	if !ok {
+		return nil
+	}
+
+	delete(ipam.egressIPs, namespace)
+	return ipam.egressPools[namespace].Release(ip)
+}
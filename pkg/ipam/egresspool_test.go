@@ -0,0 +1,49 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package ipam
+
+import (
+	. "gopkg.in/check.v1"
+
+	"github.com/cilium/cilium/pkg/datapath/fake"
+	"github.com/cilium/cilium/pkg/option"
+)
+
+func (s *IPAMSuite) TestEgressIPPools(c *C) {
+	option.Config.EgressIPPools = map[string]string{
+		"tenant-a": "10.100.1.0/30",
+	}
+	defer func() {
+		option.Config.EgressIPPools = nil
+	}()
+
+	fakeAddressing := fake.NewNodeAddressing()
+	ipam := NewIPAM(fakeAddressing, &testConfiguration{}, &ownerMock{}, &ownerMock{}, &mtuMock, nil)
+
+	c.Assert(ipam.EgressIPPoolExists("tenant-a"), Equals, true)
+	c.Assert(ipam.EgressIPPoolExists("tenant-b"), Equals, false)
+
+	// No pool configured for the namespace
+	_, err := ipam.AllocateNextEgressIP("tenant-b")
+	c.Assert(err, Not(IsNil))
+
+	ip, err := ipam.AllocateNextEgressIP("tenant-a")
+	c.Assert(err, IsNil)
+	c.Assert(ip, Not(IsNil))
+
+	// Subsequent allocations return the same IP
+	ip2, err := ipam.AllocateNextEgressIP("tenant-a")
+	c.Assert(err, IsNil)
+	c.Assert(ip2.Equal(ip), Equals, true)
+
+	// After a release, the IP can be allocated again
+	err = ipam.ReleaseEgressIP("tenant-a")
+	c.Assert(err, IsNil)
+	ip2, err = ipam.AllocateNextEgressIP("tenant-a")
+	c.Assert(err, IsNil)
+	c.Assert(ip2, Not(IsNil))
+
+	// Releasing a namespace without an allocated egress IP is a no-op
+	c.Assert(ipam.ReleaseEgressIP("tenant-b"), IsNil)
+}
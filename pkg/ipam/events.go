@@ -0,0 +1,113 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package ipam
+
+import (
+	"net"
+	"os"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	typedcorev1 "k8s.io/client-go/kubernetes/typed/core/v1"
+	"k8s.io/client-go/tools/record"
+
+	nodeTypes "github.com/cilium/cilium/pkg/node/types"
+	"github.com/cilium/cilium/pkg/option"
+)
+
+const (
+	// ipamEventSourceComponent is the component name used as the source of
+	// Kubernetes events emitted for IP allocations and releases
+	ipamEventSourceComponent = "cilium-ipam"
+
+	// reasonIPAllocated is the Kubernetes event reason used when an IP has
+	// been allocated to a pod
+	reasonIPAllocated = "IPAllocated"
+
+	// reasonIPReleased is the Kubernetes event reason used when the IP of
+	// a pod has been released
+	reasonIPReleased = "IPReleased"
+)
+
+// initEvents enables emission of structured allocation and release events to
+// the Kubernetes event API and, if configured, to a dedicated audit log file.
+// The audit trail allows answering which pod owned an IP at a given point in
+// time without requiring flow visibility.
+func (ipam *IPAM) initEvents() {
+	if option.Config.EnableIPAMEvents && ipam.clientset != nil {
+		broadcaster := record.NewBroadcaster()
+		broadcaster.StartRecordingToSink(&typedcorev1.EventSinkImpl{
+			Interface: ipam.clientset.CoreV1().Events(""),
+		})
+		ipam.eventRecorder = broadcaster.NewRecorder(scheme.Scheme, corev1.EventSource{
+			Component: ipamEventSourceComponent,
+			Host:      nodeTypes.GetName(),
+		})
+	}
+
+	if path := option.Config.IPAMEventLogFile; path != "" {
+		f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+		if err != nil {
+			log.WithError(err).WithField("path", path).Fatal("Unable to open IPAM event log file")
+		}
+
+		auditLogger := logrus.New()
+		auditLogger.SetFormatter(&logrus.JSONFormatter{})
+		auditLogger.SetOutput(f)
+		ipam.auditLogger = auditLogger
+	}
+}
+
+// recordIPAMEvent emits a structured event for an IP allocation or release.
+// Kubernetes events are only emitted for owners referring to a pod, the audit
+// log covers all owners. The emission of Kubernetes events is asynchronous,
+// the function is safe to call while holding ipam.allocatorMutex.
+func (ipam *IPAM) recordIPAMEvent(action string, ip net.IP, owner string, result *AllocationResult) {
+	if ipam.eventRecorder == nil && ipam.auditLogger == nil {
+		return
+	}
+
+	if ipam.auditLogger != nil {
+		fields := logrus.Fields{
+			"action": action,
+			"ip":     ip.String(),
+			"owner":  owner,
+			"family": string(DeriveFamily(ip)),
+			"pool":   option.Config.IPAM,
+		}
+		if result != nil && result.InterfaceNumber != "" {
+			fields["interface"] = result.InterfaceNumber
+		}
+		ipam.auditLogger.WithFields(fields).Info("IPAM event")
+	}
+
+	if ipam.eventRecorder == nil {
+		return
+	}
+
+	// Only owners in the namespace/name form refer to a pod for which an
+	// event can be emitted
+	parts := strings.SplitN(owner, "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" || strings.Contains(parts[1], " ") {
+		return
+	}
+
+	podRef := &corev1.ObjectReference{
+		Kind:       "Pod",
+		APIVersion: "v1",
+		Namespace:  parts[0],
+		Name:       parts[1],
+	}
+
+	switch action {
+	case metricAllocate:
+		ipam.eventRecorder.Eventf(podRef, corev1.EventTypeNormal, reasonIPAllocated,
+			"IP %s allocated by %s on node %s", ip.String(), ipamEventSourceComponent, nodeTypes.GetName())
+	case metricRelease:
+		ipam.eventRecorder.Eventf(podRef, corev1.EventTypeNormal, reasonIPReleased,
+			"IP %s released by %s on node %s", ip.String(), ipamEventSourceComponent, nodeTypes.GetName())
+	}
+}
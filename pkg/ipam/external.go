@@ -0,0 +1,228 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package ipam
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"net"
+	"os"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"github.com/cilium/cilium/pkg/option"
+)
+
+const (
+	// externalIPAMService is the gRPC service implemented by external IPAM
+	// plugins
+	externalIPAMService = "/cilium.ipam.v1.ExternalIPAM"
+
+	// externalRequestTimeout bounds a single request to the external IPAM
+	// plugin
+	externalRequestTimeout = 10 * time.Second
+)
+
+// externalJSONCodec is the gRPC codec used to talk to external IPAM plugins.
+// Messages are encoded as JSON so that plugins can implement the protocol
+// without depending on Cilium's generated protobuf stubs.
+type externalJSONCodec struct{}
+
+func (externalJSONCodec) Marshal(v interface{}) ([]byte, error) {
+	return json.Marshal(v)
+}
+
+func (externalJSONCodec) Unmarshal(data []byte, v interface{}) error {
+	return json.Unmarshal(data, v)
+}
+
+func (externalJSONCodec) Name() string {
+	return "json"
+}
+
+// externalAllocateRequest is the request of the Allocate method of the
+// external IPAM plugin. IP is empty when the plugin may pick any available
+// address of the requested family.
+type externalAllocateRequest struct {
+	Family string `json:"family"`
+	IP     string `json:"ip,omitempty"`
+	Owner  string `json:"owner"`
+}
+
+// externalAllocateResponse is the response of the Allocate method of the
+// external IPAM plugin.
+type externalAllocateResponse struct {
+	IP        string   `json:"ip"`
+	CIDRs     []string `json:"cidrs,omitempty"`
+	GatewayIP string   `json:"gateway-ip,omitempty"`
+}
+
+// externalReleaseRequest is the request of the Release method of the external
+// IPAM plugin.
+type externalReleaseRequest struct {
+	Family string `json:"family"`
+	IP     string `json:"ip"`
+}
+
+// externalDumpRequest is the request of the Dump method of the external IPAM
+// plugin.
+type externalDumpRequest struct {
+	Family string `json:"family"`
+}
+
+// externalDumpResponse is the response of the Dump method of the external
+// IPAM plugin.
+type externalDumpResponse struct {
+	Allocations map[string]string `json:"allocations"`
+	Status      string            `json:"status"`
+}
+
+// externalAllocator delegates all allocations and releases of one address
+// family to an authoritative external IPAM, e.g. Infoblox or Netbox, fronted
+// by a plugin implementing the ExternalIPAM gRPC service.
+type externalAllocator struct {
+	family Family
+	conn   *grpc.ClientConn
+}
+
+// externalTransportCredentials returns the transport credentials used to
+// connect to the external IPAM plugin. When a CA file is configured via
+// --external-ipam-ca-file, the plugin's certificate is verified against it
+// and an optional client certificate pair is presented for mutual TLS.
+// Plaintext connections are only permitted over unix domain sockets.
+func externalTransportCredentials(endpoint string) (credentials.TransportCredentials, error) {
+	if option.Config.ExternalIPAMCAFile == "" {
+		if !strings.HasPrefix(endpoint, "unix:") {
+			return nil, fmt.Errorf("--%s is required to connect to non-unix endpoint %q",
+				option.ExternalIPAMCAFile, endpoint)
+		}
+		return insecure.NewCredentials(), nil
+	}
+
+	ca, err := os.ReadFile(option.Config.ExternalIPAMCAFile)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read CA file: %w", err)
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(ca) {
+		return nil, fmt.Errorf("unable to parse CA file %s", option.Config.ExternalIPAMCAFile)
+	}
+
+	tlsConfig := &tls.Config{RootCAs: pool}
+	if option.Config.ExternalIPAMCertFile != "" {
+		cert, err := tls.LoadX509KeyPair(option.Config.ExternalIPAMCertFile, option.Config.ExternalIPAMKeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("unable to load client certificate: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	return credentials.NewTLS(tlsConfig), nil
+}
+
+func newExternalAllocator(family Family) Allocator {
+	allocator, err := newExternalAllocatorForEndpoint(family, option.Config.ExternalIPAMEndpoint)
+	if err != nil {
+		log.WithError(err).Fatalf("Unable to connect to external IPAM plugin %s", option.Config.ExternalIPAMEndpoint)
+	}
+	return allocator
+}
+
+func newExternalAllocatorForEndpoint(family Family, endpoint string) (Allocator, error) {
+	creds, err := externalTransportCredentials(endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	conn, err := grpc.Dial(endpoint,
+		grpc.WithTransportCredentials(creds),
+		grpc.WithDefaultCallOptions(grpc.ForceCodec(externalJSONCodec{})))
+	if err != nil {
+		return nil, err
+	}
+
+	return &externalAllocator{
+		family: family,
+		conn:   conn,
+	}, nil
+}
+
+// invoke calls the given method of the external IPAM plugin.
+func (e *externalAllocator) invoke(method string, req, resp interface{}) error {
+	ctx, cancel := context.WithTimeout(context.Background(), externalRequestTimeout)
+	defer cancel()
+
+	if err := e.conn.Invoke(ctx, externalIPAMService+"/"+method, req, resp); err != nil {
+		return fmt.Errorf("external IPAM %s request failed: %w", method, err)
+	}
+	return nil
+}
+
+func (e *externalAllocator) allocate(ip net.IP, owner string) (*AllocationResult, error) {
+	req := &externalAllocateRequest{
+		Family: string(e.family),
+		Owner:  owner,
+	}
+	if ip != nil {
+		req.IP = ip.String()
+	}
+
+	resp := &externalAllocateResponse{}
+	if err := e.invoke("Allocate", req, resp); err != nil {
+		return nil, err
+	}
+
+	allocatedIP := net.ParseIP(resp.IP)
+	if allocatedIP == nil {
+		return nil, fmt.Errorf("external IPAM plugin returned invalid IP %q", resp.IP)
+	}
+
+	return &AllocationResult{
+		IP:        allocatedIP,
+		CIDRs:     resp.CIDRs,
+		GatewayIP: resp.GatewayIP,
+	}, nil
+}
+
+func (e *externalAllocator) Allocate(ip net.IP, owner string) (*AllocationResult, error) {
+	return e.allocate(ip, owner)
+}
+
+func (e *externalAllocator) AllocateWithoutSyncUpstream(ip net.IP, owner string) (*AllocationResult, error) {
+	return e.allocate(ip, owner)
+}
+
+func (e *externalAllocator) AllocateNext(owner string) (*AllocationResult, error) {
+	return e.allocate(nil, owner)
+}
+
+func (e *externalAllocator) AllocateNextWithoutSyncUpstream(owner string) (*AllocationResult, error) {
+	return e.allocate(nil, owner)
+}
+
+func (e *externalAllocator) Release(ip net.IP) error {
+	req := &externalReleaseRequest{
+		Family: string(e.family),
+		IP:     ip.String(),
+	}
+	return e.invoke("Release", req, &struct{}{})
+}
+
+func (e *externalAllocator) Dump() (map[string]string, string) {
+	resp := &externalDumpResponse{}
+	if err := e.invoke("Dump", &externalDumpRequest{Family: string(e.family)}, resp); err != nil {
+		return nil, fmt.Sprintf("Unable to dump external IPAM state: %s", err)
+	}
+	return resp.Allocations, resp.Status
+}
+
+func (e *externalAllocator) RestoreFinished() {
+}
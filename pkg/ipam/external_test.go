@@ -0,0 +1,131 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package ipam
+
+import (
+	"context"
+	"net"
+	"path/filepath"
+
+	"google.golang.org/grpc"
+	. "gopkg.in/check.v1"
+
+	"github.com/cilium/cilium/pkg/checker"
+)
+
+// externalIPAMStub is a minimal in-process implementation of the ExternalIPAM
+// gRPC service used to exercise the external allocator.
+type externalIPAMStub struct {
+	allocations map[string]string
+	released    []string
+}
+
+func externalAllocateHandler(srv interface{}, ctx context.Context, dec func(interface{}) error, _ grpc.UnaryServerInterceptor) (interface{}, error) {
+	req := &externalAllocateRequest{}
+	if err := dec(req); err != nil {
+		return nil, err
+	}
+	stub := srv.(*externalIPAMStub)
+	ip := req.IP
+	if ip == "" {
+		ip = "10.20.0.1"
+	}
+	stub.allocations[ip] = req.Owner
+	return &externalAllocateResponse{
+		IP:        ip,
+		CIDRs:     []string{"10.20.0.0/24"},
+		GatewayIP: "10.20.0.254",
+	}, nil
+}
+
+func externalReleaseHandler(srv interface{}, ctx context.Context, dec func(interface{}) error, _ grpc.UnaryServerInterceptor) (interface{}, error) {
+	req := &externalReleaseRequest{}
+	if err := dec(req); err != nil {
+		return nil, err
+	}
+	stub := srv.(*externalIPAMStub)
+	delete(stub.allocations, req.IP)
+	stub.released = append(stub.released, req.IP)
+	return &struct{}{}, nil
+}
+
+func externalDumpHandler(srv interface{}, ctx context.Context, dec func(interface{}) error, _ grpc.UnaryServerInterceptor) (interface{}, error) {
+	if err := dec(&externalDumpRequest{}); err != nil {
+		return nil, err
+	}
+	stub := srv.(*externalIPAMStub)
+	return &externalDumpResponse{
+		Allocations: stub.allocations,
+		Status:      "3 IPs available",
+	}, nil
+}
+
+var externalIPAMServiceDesc = grpc.ServiceDesc{
+	ServiceName: "cilium.ipam.v1.ExternalIPAM",
+	HandlerType: (*interface{})(nil),
+	Methods: []grpc.MethodDesc{
+		{MethodName: "Allocate", Handler: externalAllocateHandler},
+		{MethodName: "Release", Handler: externalReleaseHandler},
+		{MethodName: "Dump", Handler: externalDumpHandler},
+	},
+}
+
+// startExternalIPAMStub serves the stub on a unix socket and returns the
+// endpoint to dial.
+func startExternalIPAMStub(c *C, stub *externalIPAMStub) string {
+	socketPath := filepath.Join(c.MkDir(), "external-ipam.sock")
+	listener, err := net.Listen("unix", socketPath)
+	c.Assert(err, IsNil)
+
+	server := grpc.NewServer(grpc.ForceServerCodec(externalJSONCodec{}))
+	server.RegisterService(&externalIPAMServiceDesc, stub)
+	go server.Serve(listener)
+
+	return "unix://" + socketPath
+}
+
+func (s *IPAMSuite) TestExternalAllocator(c *C) {
+	stub := &externalIPAMStub{allocations: map[string]string{}}
+	endpoint := startExternalIPAMStub(c, stub)
+
+	allocator, err := newExternalAllocatorForEndpoint(IPv4, endpoint)
+	c.Assert(err, IsNil)
+
+	// Allocation of a specific IP
+	result, err := allocator.Allocate(net.ParseIP("10.20.0.5"), "default/pod-1")
+	c.Assert(err, IsNil)
+	c.Assert(result.IP, checker.DeepEquals, net.ParseIP("10.20.0.5"))
+	c.Assert(result.CIDRs, checker.DeepEquals, []string{"10.20.0.0/24"})
+	c.Assert(result.GatewayIP, Equals, "10.20.0.254")
+	c.Assert(stub.allocations["10.20.0.5"], Equals, "default/pod-1")
+
+	// Allocation of the next available IP
+	result, err = allocator.AllocateNext("default/pod-2")
+	c.Assert(err, IsNil)
+	c.Assert(result.IP, checker.DeepEquals, net.ParseIP("10.20.0.1"))
+
+	// Dump reflects the plugin state
+	allocations, status := allocator.Dump()
+	c.Assert(allocations, checker.DeepEquals, map[string]string{
+		"10.20.0.5": "default/pod-1",
+		"10.20.0.1": "default/pod-2",
+	})
+	c.Assert(status, Equals, "3 IPs available")
+
+	// Release removes the allocation
+	err = allocator.Release(net.ParseIP("10.20.0.5"))
+	c.Assert(err, IsNil)
+	c.Assert(stub.released, checker.DeepEquals, []string{"10.20.0.5"})
+}
+
+func (s *IPAMSuite) TestExternalTransportCredentials(c *C) {
+	// Plaintext is allowed over unix domain sockets
+	creds, err := externalTransportCredentials("unix:///var/run/cilium/external-ipam.sock")
+	c.Assert(err, IsNil)
+	c.Assert(creds.Info().SecurityProtocol, Equals, "insecure")
+
+	// Plaintext TCP connections are rejected without a CA file
+	_, err = externalTransportCredentials("ipam.example.com:4321")
+	c.Assert(err, NotNil)
+}
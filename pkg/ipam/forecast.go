@@ -0,0 +1,135 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package ipam
+
+import (
+	"sort"
+	"time"
+
+	ipamTypes "github.com/cilium/cilium/pkg/ipam/types"
+	"github.com/cilium/cilium/pkg/lock"
+)
+
+// poolForecastWindow is the duration over which pool usage samples are
+// retained to compute the allocation rate.
+const poolForecastWindow = time.Hour
+
+// PoolForecast projects the time-to-exhaustion of an IPAM pool based on the
+// allocation rate observed over the recent past.
+type PoolForecast struct {
+	// Pool is the identifier of the IPAM pool, e.g. the subnet ID
+	Pool string `json:"pool"`
+
+	// AvailabilityZone is the availability zone the pool resides in, if
+	// known
+	AvailabilityZone string `json:"availability-zone,omitempty"`
+
+	// AvailableIPs is the number of IPs still available in the pool
+	AvailableIPs int `json:"available-ips"`
+
+	// AllocationRatePerHour is the number of IPs allocated out of the pool
+	// per hour, observed over the forecast window. It is negative when IPs
+	// have been released back into the pool.
+	AllocationRatePerHour float64 `json:"allocation-rate-per-hour"`
+
+	// ExhaustedIn is the projected duration until the pool runs out of IPs
+	// at the current allocation rate. It is empty if the pool is not
+	// shrinking.
+	ExhaustedIn string `json:"exhausted-in,omitempty"`
+}
+
+// poolUsageSample is a point-in-time measurement of the available IPs of a
+// pool.
+type poolUsageSample struct {
+	time         time.Time
+	availableIPs int
+}
+
+// poolUsageHistory keeps a sliding window of pool usage samples to derive
+// allocation rates from.
+type poolUsageHistory struct {
+	mutex lock.Mutex
+
+	// samples maps a pool to its usage samples, ordered oldest first
+	samples map[ipamTypes.PoolID][]poolUsageSample
+
+	// availabilityZones maps a pool to the availability zone it resides in
+	availabilityZones map[ipamTypes.PoolID]string
+}
+
+func newPoolUsageHistory() *poolUsageHistory {
+	return &poolUsageHistory{
+		samples:           map[ipamTypes.PoolID][]poolUsageSample{},
+		availabilityZones: map[ipamTypes.PoolID]string{},
+	}
+}
+
+// record appends a usage sample for all pools of the given quota map and
+// drops samples which have aged out of the forecast window. Pools which are
+// no longer reported are forgotten.
+func (h *poolUsageHistory) record(now time.Time, quotas ipamTypes.PoolQuotaMap) {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	for poolID := range h.samples {
+		if _, ok := quotas[poolID]; !ok {
+			delete(h.samples, poolID)
+			delete(h.availabilityZones, poolID)
+		}
+	}
+
+	for poolID, quota := range quotas {
+		samples := append(h.samples[poolID], poolUsageSample{
+			time:         now,
+			availableIPs: quota.AvailableIPs,
+		})
+		for len(samples) > 0 && now.Sub(samples[0].time) > poolForecastWindow {
+			samples = samples[1:]
+		}
+		h.samples[poolID] = samples
+		h.availabilityZones[poolID] = quota.AvailabilityZone
+	}
+}
+
+// forecasts computes the per-pool allocation rates and projected exhaustion
+// times, sorted by pool identifier.
+func (h *poolUsageHistory) forecasts() []PoolForecast {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	forecasts := make([]PoolForecast, 0, len(h.samples))
+	for poolID, samples := range h.samples {
+		newest := samples[len(samples)-1]
+		forecast := PoolForecast{
+			Pool:             string(poolID),
+			AvailabilityZone: h.availabilityZones[poolID],
+			AvailableIPs:     newest.availableIPs,
+		}
+
+		oldest := samples[0]
+		if elapsed := newest.time.Sub(oldest.time); elapsed > 0 {
+			allocated := float64(oldest.availableIPs - newest.availableIPs)
+			forecast.AllocationRatePerHour = allocated / elapsed.Hours()
+			if forecast.AllocationRatePerHour > 0 && newest.availableIPs > 0 {
+				exhaustedIn := time.Duration(float64(newest.availableIPs) / forecast.AllocationRatePerHour * float64(time.Hour))
+				forecast.ExhaustedIn = exhaustedIn.Round(time.Minute).String()
+			}
+		}
+
+		forecasts = append(forecasts, forecast)
+	}
+
+	sort.Slice(forecasts, func(i, j int) bool {
+		return forecasts[i].Pool < forecasts[j].Pool
+	})
+
+	return forecasts
+}
+
+// PoolForecasts returns the projected time-to-exhaustion of all IPAM pools
+// known to the node manager based on the allocation rates observed over the
+// last hour.
+func (n *NodeManager) PoolForecasts() []PoolForecast {
+	return n.poolUsage.forecasts()
+}
@@ -0,0 +1,54 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package ipam
+
+import (
+	"time"
+
+	"gopkg.in/check.v1"
+
+	ipamTypes "github.com/cilium/cilium/pkg/ipam/types"
+)
+
+func (e *IPAMSuite) TestPoolForecasts(c *check.C) {
+	h := newPoolUsageHistory()
+	now := time.Now()
+
+	quotas := func(available int) ipamTypes.PoolQuotaMap {
+		return ipamTypes.PoolQuotaMap{
+			testPoolID: ipamTypes.PoolQuota{
+				AvailabilityZone: "us-west-1a",
+				AvailableIPs:     available,
+			},
+		}
+	}
+
+	// A single sample yields no allocation rate
+	h.record(now.Add(-30*time.Minute), quotas(100))
+	forecasts := h.forecasts()
+	c.Assert(forecasts, check.HasLen, 1)
+	c.Assert(forecasts[0].Pool, check.Equals, string(testPoolID))
+	c.Assert(forecasts[0].AvailabilityZone, check.Equals, "us-west-1a")
+	c.Assert(forecasts[0].AvailableIPs, check.Equals, 100)
+	c.Assert(forecasts[0].AllocationRatePerHour, check.Equals, 0.0)
+	c.Assert(forecasts[0].ExhaustedIn, check.Equals, "")
+
+	// 50 IPs allocated over 30 minutes projects exhaustion in 30 minutes
+	h.record(now, quotas(50))
+	forecasts = h.forecasts()
+	c.Assert(forecasts, check.HasLen, 1)
+	c.Assert(forecasts[0].AvailableIPs, check.Equals, 50)
+	c.Assert(forecasts[0].AllocationRatePerHour, check.Equals, 100.0)
+	c.Assert(forecasts[0].ExhaustedIn, check.Equals, "30m0s")
+
+	// A growing pool has a negative rate and no exhaustion projection
+	h.record(now.Add(time.Minute), quotas(200))
+	forecasts = h.forecasts()
+	c.Assert(forecasts[0].AllocationRatePerHour < 0, check.Equals, true)
+	c.Assert(forecasts[0].ExhaustedIn, check.Equals, "")
+
+	// Pools which are no longer reported are forgotten
+	h.record(now.Add(2*time.Minute), ipamTypes.PoolQuotaMap{})
+	c.Assert(h.forecasts(), check.HasLen, 0)
+}
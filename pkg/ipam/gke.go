@@ -0,0 +1,56 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package ipam
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cilium/cilium/pkg/cidr"
+	gkeMetadata "github.com/cilium/cilium/pkg/gke/metadata"
+	"github.com/cilium/cilium/pkg/node"
+)
+
+// configureGKEAliasRanges discovers the alias IP ranges assigned to the
+// instance via the GCE metadata service and configures them as the node's
+// allocation CIDRs. Alias IP ranges are carved out of the secondary ranges of
+// the subnetwork and are natively routed to the instance by the VPC, so no
+// manually synchronized cluster-pool CIDRs are required.
+func configureGKEAliasRanges(c Configuration) error {
+	ranges, err := gkeMetadata.GetAliasIPRanges(context.TODO())
+	if err != nil {
+		return fmt.Errorf("unable to retrieve alias IP ranges from the GCE metadata service: %w", err)
+	}
+
+	var v4AliasRange, v6AliasRange *cidr.CIDR
+	for _, aliasRange := range ranges {
+		prefix, err := cidr.ParseCIDR(aliasRange)
+		if err != nil {
+			return fmt.Errorf("invalid alias IP range %q: %w", aliasRange, err)
+		}
+		if prefix.IP.To4() != nil {
+			if v4AliasRange == nil {
+				v4AliasRange = prefix
+			}
+		} else if v6AliasRange == nil {
+			v6AliasRange = prefix
+		}
+	}
+
+	if c.IPv4Enabled() {
+		if v4AliasRange == nil {
+			return fmt.Errorf("no IPv4 alias IP range is assigned to the instance")
+		}
+		node.SetIPv4AllocRange(v4AliasRange)
+	}
+
+	if c.IPv6Enabled() {
+		if v6AliasRange == nil {
+			return fmt.Errorf("no IPv6 alias IP range is assigned to the instance")
+		}
+		node.SetIPv6NodeRange(v6AliasRange)
+	}
+
+	return nil
+}
@@ -69,6 +69,12 @@ func (h *hostScopeAllocator) AllocateNextWithoutSyncUpstream(owner string) (*All
 	return &AllocationResult{IP: ip}, nil
 }
 
+// Free returns the number of addresses which remain available for allocation
+// in the pool.
+func (h *hostScopeAllocator) Free() int {
+	return h.allocator.Free()
+}
+
 func (h *hostScopeAllocator) Dump() (map[string]string, string) {
 	var origIP *big.Int
 	alloc := map[string]string{}
@@ -15,6 +15,7 @@ import (
 	"github.com/cilium/cilium/pkg/k8s/watchers/subscriber"
 	"github.com/cilium/cilium/pkg/logging"
 	"github.com/cilium/cilium/pkg/logging/logfields"
+	"github.com/cilium/cilium/pkg/option"
 )
 
 var (
@@ -109,13 +110,32 @@ func NewIPAM(nodeAddressing types.NodeAddressing, c Configuration, owner Owner,
 		config:           c,
 		owner:            map[string]string{},
 		expirationTimers: map[string]string{},
+		quarantine:       map[string]*quarantinedIP{},
+		reservations:     map[string][]*net.IPNet{},
 		blacklist: IPBlacklist{
 			ips: map[string]string{},
 		},
+		clientset: clientset,
+	}
+
+	if option.Config.EnableStickyIPs {
+		ipam.initStickyIPs()
+	}
+
+	if option.Config.EnableIPAMEvents || option.Config.IPAMEventLogFile != "" {
+		ipam.initEvents()
+	}
+
+	if len(option.Config.EgressIPPools) > 0 {
+		ipam.initEgressIPPools()
+	}
+
+	if clientset != nil && clientset.IsEnabled() {
+		ipam.watchIPReservations(k8sEventReg)
 	}
 
 	switch c.IPAMMode() {
-	case ipamOption.IPAMKubernetes, ipamOption.IPAMClusterPool:
+	case ipamOption.IPAMKubernetes, ipamOption.IPAMClusterPool, ipamOption.IPAMMultiPool:
 		log.WithFields(logrus.Fields{
 			logfields.V4Prefix: nodeAddressing.IPv4().AllocationCIDR(),
 			logfields.V6Prefix: nodeAddressing.IPv6().AllocationCIDR(),
@@ -125,6 +145,23 @@ func NewIPAM(nodeAddressing types.NodeAddressing, c Configuration, owner Owner,
 			ipam.IPv6Allocator = newHostScopeAllocator(nodeAddressing.IPv6().AllocationCIDR().IPNet)
 		}
 
+		if c.IPv4Enabled() {
+			ipam.IPv4Allocator = newHostScopeAllocator(nodeAddressing.IPv4().AllocationCIDR().IPNet)
+		}
+	case ipamOption.IPAMGKE:
+		if err := configureGKEAliasRanges(c); err != nil {
+			log.WithError(err).Fatal("Unable to initialize GKE IPAM")
+		}
+
+		log.WithFields(logrus.Fields{
+			logfields.V4Prefix: nodeAddressing.IPv4().AllocationCIDR(),
+			logfields.V6Prefix: nodeAddressing.IPv6().AllocationCIDR(),
+		}).Info("Initializing GKE alias IP range based IPAM")
+
+		if c.IPv6Enabled() {
+			ipam.IPv6Allocator = newHostScopeAllocator(nodeAddressing.IPv6().AllocationCIDR().IPNet)
+		}
+
 		if c.IPv4Enabled() {
 			ipam.IPv4Allocator = newHostScopeAllocator(nodeAddressing.IPv4().AllocationCIDR().IPNet)
 		}
@@ -137,7 +174,7 @@ func NewIPAM(nodeAddressing types.NodeAddressing, c Configuration, owner Owner,
 		if c.IPv4Enabled() {
 			ipam.IPv4Allocator = newClusterPoolAllocator(IPv4, c, owner, k8sEventReg, clientset)
 		}
-	case ipamOption.IPAMCRD, ipamOption.IPAMENI, ipamOption.IPAMAzure, ipamOption.IPAMAlibabaCloud:
+	case ipamOption.IPAMCRD, ipamOption.IPAMENI, ipamOption.IPAMAzure, ipamOption.IPAMAlibabaCloud, ipamOption.IPAMOpenStack:
 		log.Info("Initializing CRD-based IPAM")
 		if c.IPv6Enabled() {
 			ipam.IPv6Allocator = newCRDAllocator(IPv6, c, owner, clientset, k8sEventReg, mtuConfig)
@@ -146,6 +183,15 @@ func NewIPAM(nodeAddressing types.NodeAddressing, c Configuration, owner Owner,
 		if c.IPv4Enabled() {
 			ipam.IPv4Allocator = newCRDAllocator(IPv4, c, owner, clientset, k8sEventReg, mtuConfig)
 		}
+	case ipamOption.IPAMExternal:
+		log.WithField("endpoint", option.Config.ExternalIPAMEndpoint).
+			Info("Initializing external IPAM")
+		if c.IPv6Enabled() {
+			ipam.IPv6Allocator = newExternalAllocator(IPv6)
+		}
+		if c.IPv4Enabled() {
+			ipam.IPv4Allocator = newExternalAllocator(IPv4)
+		}
 	case ipamOption.IPAMDelegatedPlugin:
 		log.Info("Initializing no-op IPAM since we're using a CNI delegated plugin")
 		if c.IPv6Enabled() {
@@ -27,6 +27,8 @@ type prometheusMetrics struct {
 	InterfaceCandidates   prometheus.Gauge
 	EmptyInterfaceSlots   prometheus.Gauge
 	AvailableIPsPerSubnet *prometheus.GaugeVec
+	NodeIPUsage           *prometheus.GaugeVec
+	NodeInterfaceUsage    *prometheus.GaugeVec
 	Nodes                 *prometheus.GaugeVec
 	Resync                prometheus.Counter
 	poolMaintainer        *triggerMetrics
@@ -97,6 +99,20 @@ func NewPrometheusMetrics(namespace string, registry *prometheus.Registry) *prom
 		Help:      "Number of available IPs per subnet ID",
 	}, []string{"subnet_id", "availability_zone"})
 
+	m.NodeIPUsage = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: ipamSubsystem,
+		Name:      "node_ips",
+		Help:      "Number of IPs per node by state { capacity | used | available | needed }",
+	}, []string{"target_node", "type"})
+
+	m.NodeInterfaceUsage = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: ipamSubsystem,
+		Name:      "node_interfaces",
+		Help:      "Number of interfaces per node by state { candidates | empty-slots }",
+	}, []string{"target_node", "type"})
+
 	m.Nodes = prometheus.NewGaugeVec(prometheus.GaugeOpts{
 		Namespace: namespace,
 		Subsystem: ipamSubsystem,
@@ -147,6 +163,8 @@ func NewPrometheusMetrics(namespace string, registry *prometheus.Registry) *prom
 	registry.MustRegister(m.InterfaceCandidates)
 	registry.MustRegister(m.EmptyInterfaceSlots)
 	registry.MustRegister(m.AvailableIPsPerSubnet)
+	registry.MustRegister(m.NodeIPUsage)
+	registry.MustRegister(m.NodeInterfaceUsage)
 	registry.MustRegister(m.Nodes)
 	registry.MustRegister(m.Resync)
 	registry.MustRegister(m.Allocation)
@@ -206,6 +224,25 @@ func (p *prometheusMetrics) SetNodes(label string, nodes int) {
 	p.Nodes.WithLabelValues(label).Set(float64(nodes))
 }
 
+func (p *prometheusMetrics) SetNodeIPUsage(node string, capacity, used, available, needed int) {
+	p.NodeIPUsage.WithLabelValues(node, "capacity").Set(float64(capacity))
+	p.NodeIPUsage.WithLabelValues(node, "used").Set(float64(used))
+	p.NodeIPUsage.WithLabelValues(node, "available").Set(float64(available))
+	p.NodeIPUsage.WithLabelValues(node, "needed").Set(float64(needed))
+}
+
+func (p *prometheusMetrics) SetNodeInterfaceUsage(node string, interfaceCandidates, emptyInterfaceSlots int) {
+	p.NodeInterfaceUsage.WithLabelValues(node, "candidates").Set(float64(interfaceCandidates))
+	p.NodeInterfaceUsage.WithLabelValues(node, "empty-slots").Set(float64(emptyInterfaceSlots))
+}
+
+// DeleteNode removes all per-node metrics of a node that has been deleted to
+// avoid leaking label values of nodes that no longer exist.
+func (p *prometheusMetrics) DeleteNode(node string) {
+	p.NodeIPUsage.DeletePartialMatch(prometheus.Labels{"target_node": node})
+	p.NodeInterfaceUsage.DeletePartialMatch(prometheus.Labels{"target_node": node})
+}
+
 func (p *prometheusMetrics) IncResyncCount() {
 	p.Resync.Inc()
 }
@@ -292,6 +329,9 @@ func (m *NoOpMetrics) SetInterfaceCandidates(interfaceCandidates int)
 func (m *NoOpMetrics) SetEmptyInterfaceSlots(emptyInterfaceSlots int)                            {}
 func (m *NoOpMetrics) SetAvailableIPsPerSubnet(subnetID, availabilityZone string, available int) {}
 func (m *NoOpMetrics) SetNodes(category string, nodes int)                                       {}
+func (m *NoOpMetrics) SetNodeIPUsage(node string, capacity, used, available, needed int)         {}
+func (m *NoOpMetrics) SetNodeInterfaceUsage(node string, interfaceCandidates, emptySlots int)    {}
+func (m *NoOpMetrics) DeleteNode(node string)                                                    {}
 func (m *NoOpMetrics) IncResyncCount()                                                           {}
 func (m *NoOpMetrics) PoolMaintainerTrigger() trigger.MetricsObserver                            { return &NoOpMetricsObserver{} }
 func (m *NoOpMetrics) K8sSyncTrigger() trigger.MetricsObserver                                   { return &NoOpMetricsObserver{} }
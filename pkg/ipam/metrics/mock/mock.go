@@ -23,6 +23,8 @@ type mockMetrics struct {
 	emptyInterfaceSlots   int
 	availableIPsPerSubnet map[string]int
 	nodes                 map[string]int
+	nodeIPUsage           map[string]int
+	nodeInterfaceUsage    map[string]int
 	resyncCount           int64
 }
 
@@ -42,6 +44,8 @@ func NewMockMetrics() *mockMetrics {
 		allocatedIPs:          map[string]int{},
 		nodes:                 map[string]int{},
 		availableIPsPerSubnet: map[string]int{},
+		nodeIPUsage:           map[string]int{},
+		nodeInterfaceUsage:    map[string]int{},
 	}
 }
 
@@ -155,6 +159,39 @@ func (m *mockMetrics) SetNodes(category string, nodes int) {
 	m.mutex.Unlock()
 }
 
+func (m *mockMetrics) NodeIPUsage(node, typ string) int {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+	return m.nodeIPUsage[fmt.Sprintf("node=%s, type=%s", node, typ)]
+}
+
+func (m *mockMetrics) SetNodeIPUsage(node string, capacity, used, available, needed int) {
+	m.mutex.Lock()
+	m.nodeIPUsage[fmt.Sprintf("node=%s, type=capacity", node)] = capacity
+	m.nodeIPUsage[fmt.Sprintf("node=%s, type=used", node)] = used
+	m.nodeIPUsage[fmt.Sprintf("node=%s, type=available", node)] = available
+	m.nodeIPUsage[fmt.Sprintf("node=%s, type=needed", node)] = needed
+	m.mutex.Unlock()
+}
+
+func (m *mockMetrics) SetNodeInterfaceUsage(node string, interfaceCandidates, emptyInterfaceSlots int) {
+	m.mutex.Lock()
+	m.nodeInterfaceUsage[fmt.Sprintf("node=%s, type=candidates", node)] = interfaceCandidates
+	m.nodeInterfaceUsage[fmt.Sprintf("node=%s, type=empty-slots", node)] = emptyInterfaceSlots
+	m.mutex.Unlock()
+}
+
+func (m *mockMetrics) DeleteNode(node string) {
+	m.mutex.Lock()
+	for _, typ := range []string{"capacity", "used", "available", "needed"} {
+		delete(m.nodeIPUsage, fmt.Sprintf("node=%s, type=%s", node, typ))
+	}
+	for _, typ := range []string{"candidates", "empty-slots"} {
+		delete(m.nodeInterfaceUsage, fmt.Sprintf("node=%s, type=%s", node, typ))
+	}
+	m.mutex.Unlock()
+}
+
 func (m *mockMetrics) SetAvailableIPsPerSubnet(subnetID, availabilityZone string, available int) {
 	m.mutex.Lock()
 	m.availableIPsPerSubnet[fmt.Sprintf("subnetId=%s, availabilityZone=%s", subnetID, availabilityZone)] = available
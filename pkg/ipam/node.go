@@ -8,9 +8,11 @@ package ipam
 import (
 	"context"
 	"fmt"
+	"sort"
 	"time"
 
 	"github.com/sirupsen/logrus"
+	"golang.org/x/time/rate"
 	"k8s.io/client-go/tools/cache"
 
 	operatorOption "github.com/cilium/cilium/operator/option"
@@ -33,6 +35,23 @@ const (
 	// once and then repeated if the warning persists.
 	warningInterval = time.Hour
 
+	// backgroundRefillInterval is the interval at which a node earns one
+	// additional watermark buffer refill
+	backgroundRefillInterval = time.Minute
+
+	// backgroundRefillBurst is the maximum number of watermark buffer
+	// refills a node may perform in a burst
+	backgroundRefillBurst = 4
+
+	// circuitBreakerThreshold is the number of consecutive pool
+	// maintenance failures after which the maintenance circuit breaker of
+	// a node opens
+	circuitBreakerThreshold = 10
+
+	// circuitBreakerCooldown is how long the maintenance circuit breaker
+	// stays open before pool maintenance is attempted again
+	circuitBreakerCooldown = 10 * time.Minute
+
 	// allocation type
 	createInterfaceAndAllocateIP = "createInterfaceAndAllocateIP"
 	allocateIP                   = "allocateIP"
@@ -107,6 +126,12 @@ type Node struct {
 	// instances API is unstable
 	retry *trigger.Trigger
 
+	// backgroundRefill rate limits pool maintenance runs which only
+	// refill the watermark buffer. This stops the churn of a single node
+	// from starving the cloud API budget of the operator while urgent
+	// deficits are still resolved immediately.
+	backgroundRefill *rate.Limiter
+
 	// Excess IPs from a cilium node would be marked for release only after a delay configured by excess-ip-release-delay
 	// flag. ipsMarkedForRelease tracks the IP and the timestamp at which it was marked for release.
 	ipsMarkedForRelease map[string]time.Time
@@ -118,6 +143,28 @@ type Node struct {
 	// IPAMReleased        : IP released by the operator
 	ipReleaseStatus map[string]string
 
+	// cordoned is true while the node is excluded from new IP allocations
+	// via the operator admin API. Existing allocations are unaffected.
+	cordoned bool
+
+	// draining is true while the node's pools are drained via the operator
+	// admin API. A draining node is cordoned and all of its unused IPs are
+	// treated as excess and released through the release handshake.
+	draining bool
+
+	// forcedReleaseIPs is the set of IPs whose release was requested via
+	// the operator admin API. Forced IPs skip the excess-ip-release-delay
+	// but still go through the release handshake with the agent.
+	forcedReleaseIPs map[string]struct{}
+
+	// maintenanceFailures counts consecutive failed pool maintenance runs,
+	// it drives the maintenance circuit breaker
+	maintenanceFailures int
+
+	// circuitOpenedAt is the time the maintenance circuit breaker opened,
+	// zero while the circuit is closed
+	circuitOpenedAt time.Time
+
 	// logLimiter rate limits potentially repeating warning logs
 	logLimiter logging.Limiter
 }
@@ -150,6 +197,10 @@ type Statistics struct {
 	// EmptyInterfaceSlots is the number of empty interface slots available
 	// for interfaces to be attached
 	EmptyInterfaceSlots int
+
+	// Capacity is the maximum number of IPs that can be allocated to the
+	// node based on the instance type and the first interface index
+	Capacity int
 }
 
 // IsRunning returns true if the node is considered to be running
@@ -170,6 +221,71 @@ func (n *Node) SetRunning(running bool) {
 	}
 }
 
+// SetCordoned excludes the node from new IP allocations, or includes it
+// again if cordoned is false. Uncordoning a node also stops an ongoing
+// drain.
+func (n *Node) SetCordoned(cordoned bool) {
+	n.mutex.Lock()
+	n.loggerLocked().Infof("Set cordoned %t", cordoned)
+	n.cordoned = cordoned
+	if !cordoned {
+		n.draining = false
+	}
+	n.mutex.Unlock()
+
+	n.requirePoolMaintenance()
+	n.poolMaintainer.Trigger()
+}
+
+// IsCordoned returns true if the node is excluded from new IP allocations
+func (n *Node) IsCordoned() bool {
+	n.mutex.RLock()
+	defer n.mutex.RUnlock()
+	return n.cordoned
+}
+
+// SetDraining cordons the node and releases all of its unused IPs through
+// the release handshake. The drain is stopped by uncordoning the node.
+func (n *Node) SetDraining() {
+	n.mutex.Lock()
+	n.loggerLocked().Info("Draining IP pools")
+	n.cordoned = true
+	n.draining = true
+	n.mutex.Unlock()
+
+	n.requirePoolMaintenance()
+	n.poolMaintainer.Trigger()
+}
+
+// ForceReleaseIPs requests the release of the given IPs regardless of
+// whether they are in excess. The IPs skip the excess-ip-release-delay but
+// still go through the release handshake with the agent, which may deny the
+// release of IPs that are in use.
+func (n *Node) ForceReleaseIPs(ips []string) error {
+	var unknown []string
+
+	n.mutex.Lock()
+	for _, ip := range ips {
+		if _, ok := n.available[ip]; !ok {
+			unknown = append(unknown, ip)
+			continue
+		}
+		n.forcedReleaseIPs[ip] = struct{}{}
+		// Backdate the release timestamp so the forced IPs skip the
+		// excess-ip-release-delay on the next maintenance iteration.
+		n.ipsMarkedForRelease[ip] = time.Now().Add(-time.Duration(operatorOption.Config.ExcessIPReleaseDelay) * time.Second)
+	}
+	n.mutex.Unlock()
+
+	n.requirePoolMaintenance()
+	n.poolMaintainer.Trigger()
+
+	if len(unknown) > 0 {
+		return fmt.Errorf("IPs %v are not in the allocation pool of node %s", unknown, n.name)
+	}
+	return nil
+}
+
 // Stats returns a copy of the node statistics
 func (n *Node) Stats() Statistics {
 	n.mutex.RLock()
@@ -429,6 +545,18 @@ func (n *Node) recalculate() {
 	n.stats.NeededIPs = calculateNeededIPs(n.stats.AvailableIPs, n.stats.UsedIPs, n.getPreAllocate(), n.getMinAllocate(), n.getMaxAllocate())
 	n.stats.ExcessIPs = calculateExcessIPs(n.stats.AvailableIPs, usedIPForExcessCalc, n.getPreAllocate(), n.getMinAllocate(), n.getMaxAboveWatermark())
 	n.stats.RemainingInterfaces = remainingAvailableInterfaceCount
+	n.stats.Capacity = n.ops.GetMaximumAllocatableIPv4()
+
+	// A cordoned node must not receive new IPs, a draining node releases
+	// all of its unused IPs.
+	if n.cordoned {
+		n.stats.NeededIPs = 0
+	}
+	if n.draining {
+		if excess := n.stats.AvailableIPs - n.stats.UsedIPs; excess > n.stats.ExcessIPs {
+			n.stats.ExcessIPs = excess
+		}
+	}
 
 	scopedLog.WithFields(logrus.Fields{
 		"available":                 n.stats.AvailableIPs,
@@ -452,7 +580,8 @@ func (n *Node) allocationNeeded() (needed bool) {
 // releaseNeeded returns true if this node requires IPs to be released
 func (n *Node) releaseNeeded() (needed bool) {
 	n.mutex.RLock()
-	needed = n.manager.releaseExcessIPs && !n.waitingForPoolMaintenance && n.resyncNeeded.IsZero() && n.stats.ExcessIPs > 0
+	needed = (n.manager.releaseExcessIPs || n.draining) && !n.waitingForPoolMaintenance && n.resyncNeeded.IsZero() && n.stats.ExcessIPs > 0
+	needed = needed || len(n.forcedReleaseIPs) > 0
 	if n.resource != nil {
 		releaseInProgress := len(n.resource.Status.IPAM.ReleaseIPs) > 0
 		needed = needed || releaseInProgress
@@ -591,9 +720,20 @@ func (n *Node) determineMaintenanceAction() (*maintenanceAction, error) {
 	scopedLog := n.logger()
 	stats := n.Stats()
 
+	// IPs whose release was forced via the operator admin API take
+	// precedence over excess IP release.
+	if release := n.forcedReleaseAction(); release != nil {
+		a.release = release
+		return a, nil
+	}
+
+	n.mutex.RLock()
+	draining := n.draining
+	n.mutex.RUnlock()
+
 	// Validate that the node still requires addresses to be released, the
 	// request may have been resolved in the meantime.
-	if n.manager.releaseExcessIPs && stats.ExcessIPs > 0 {
+	if (n.manager.releaseExcessIPs || draining) && stats.ExcessIPs > 0 {
 		a.release = n.ops.PrepareIPRelease(stats.ExcessIPs, scopedLog)
 		return a, nil
 	}
@@ -649,6 +789,48 @@ func (n *Node) determineMaintenanceAction() (*maintenanceAction, error) {
 	return a, nil
 }
 
+// forcedReleaseAction builds the release action for IPs whose release was
+// forced via the operator admin API. As a release action operates on a
+// single interface, the interface with the most forced IPs is selected,
+// remaining IPs are handled on subsequent maintenance iterations. Returns
+// nil if no forced IPs are pending.
+func (n *Node) forcedReleaseAction() *ReleaseAction {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+
+	if len(n.forcedReleaseIPs) == 0 {
+		return nil
+	}
+
+	byInterface := map[string][]string{}
+	for ip := range n.forcedReleaseIPs {
+		allocationIP, ok := n.available[ip]
+		if !ok {
+			// The IP is no longer part of the node's pool, either it
+			// has been released or it was removed by a resync.
+			delete(n.forcedReleaseIPs, ip)
+			continue
+		}
+		byInterface[allocationIP.Resource] = append(byInterface[allocationIP.Resource], ip)
+	}
+
+	selected := ""
+	for interfaceID, ips := range byInterface {
+		if selected == "" || len(ips) > len(byInterface[selected]) {
+			selected = interfaceID
+		}
+	}
+	if selected == "" {
+		return nil
+	}
+
+	sort.Strings(byInterface[selected])
+	return &ReleaseAction{
+		InterfaceID:  selected,
+		IPsToRelease: byInterface[selected],
+	}
+}
+
 // removeStaleReleaseIPs Removes stale entries in local n.ipReleaseStatus. Once the handshake is complete agent would
 // remove entries from IP release status map in ciliumnode CRD's status. These IPs need to be purged from
 // n.ipReleaseStatus
@@ -701,6 +883,7 @@ func (n *Node) handleIPReleaseResponse(markedIP string, ipsToRelease *[]string)
 			case ipamOption.IPAMDoNotRelease:
 				delete(n.ipsMarkedForRelease, markedIP)
 				delete(n.ipReleaseStatus, markedIP)
+				delete(n.forcedReleaseIPs, markedIP)
 			}
 			// 'released' state is already handled in removeStaleReleaseIPs()
 			// Other states don't need additional handling.
@@ -749,6 +932,13 @@ func (n *Node) handleIPRelease(ctx context.Context, a *maintenanceAction) (insta
 		n.ipsMarkedForRelease = make(map[string]time.Time)
 	}
 
+	n.mutex.RLock()
+	forcedIPs := make(map[string]struct{}, len(n.forcedReleaseIPs))
+	for ip := range n.forcedReleaseIPs {
+		forcedIPs[ip] = struct{}{}
+	}
+	n.mutex.RUnlock()
+
 	for markedIP, ts := range n.ipsMarkedForRelease {
 		// Determine which IPs are still marked for release.
 		stillMarkedForRelease := false
@@ -759,6 +949,13 @@ func (n *Node) handleIPRelease(ctx context.Context, a *maintenanceAction) (insta
 			}
 		}
 		if !stillMarkedForRelease {
+			if _, forced := forcedIPs[markedIP]; forced {
+				// Forced IPs remain marked until they are released
+				// or denied by the agent, even if the maintenance
+				// action of this iteration operates on another
+				// interface.
+				continue
+			}
 			// n.determineMaintenanceAction() only returns the IPs on the interface with maximum number of IPs that
 			// can be freed up. If the selected interface changes or if this IP is not excess anymore, remove entry
 			// from local maps.
@@ -863,6 +1060,49 @@ func (n *Node) handleIPAllocation(ctx context.Context, a *maintenanceAction) (in
 	return n.createInterface(ctx, a.allocation)
 }
 
+// maintenanceCircuitOpen returns true if the maintenance circuit breaker of
+// the node is open. An open circuit transitions to half-open once the
+// cooldown elapsed, allowing a single maintenance attempt to probe whether
+// the node has recovered.
+func (n *Node) maintenanceCircuitOpen() bool {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+
+	if n.circuitOpenedAt.IsZero() {
+		return false
+	}
+
+	if time.Since(n.circuitOpenedAt) < circuitBreakerCooldown {
+		return true
+	}
+
+	// Half-open: allow one attempt, a single additional failure re-opens
+	// the circuit.
+	n.circuitOpenedAt = time.Time{}
+	n.maintenanceFailures = circuitBreakerThreshold - 1
+	return false
+}
+
+// recordMaintenanceResult tracks the outcome of a pool maintenance run and
+// opens the maintenance circuit breaker after repeated failures.
+func (n *Node) recordMaintenanceResult(err error) {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+
+	if err == nil {
+		n.maintenanceFailures = 0
+		n.circuitOpenedAt = time.Time{}
+		return
+	}
+
+	n.maintenanceFailures++
+	if n.maintenanceFailures >= circuitBreakerThreshold && n.circuitOpenedAt.IsZero() {
+		n.circuitOpenedAt = time.Now()
+		n.loggerLocked().Warningf("Pool maintenance failed %d times in a row, pausing maintenance of this node for %s",
+			n.maintenanceFailures, circuitBreakerCooldown)
+	}
+}
+
 // maintainIPPool attempts to allocate or release all required IPs to fulfill the needed gap.
 // returns instanceMutated which tracks if state changed with the cloud provider and is used
 // to determine if IPAM pool maintainer trigger func needs to be invoked.
@@ -912,6 +1152,15 @@ func (n *Node) updateLastResync(syncTime time.Time) {
 	n.mutex.Unlock()
 }
 
+// backgroundRefillOnly returns true if pool maintenance is only required to
+// refill the watermark buffer, i.e. pods can still be served from the
+// available IPs and no excess IPs have to be released.
+func (n *Node) backgroundRefillOnly() bool {
+	n.mutex.RLock()
+	defer n.mutex.RUnlock()
+	return n.stats.AvailableIPs > n.stats.UsedIPs && n.stats.ExcessIPs == 0
+}
+
 // MaintainIPPool attempts to allocate or release all required IPs to fulfill
 // the needed gap. If required, interfaces are created.
 func (n *Node) MaintainIPPool(ctx context.Context) error {
@@ -924,6 +1173,17 @@ func (n *Node) MaintainIPPool(ctx context.Context) error {
 		return fmt.Errorf("instances API is unstable. Blocking mutating operations. See logs for details.")
 	}
 
+	// Refilling the watermark buffer is not urgent as pods are still being
+	// served from the available IPs. Rate limit the refill so the churn of
+	// a single node cannot starve the cloud API budget of the operator.
+	if n.backgroundRefill != nil && n.backgroundRefillOnly() && !n.backgroundRefill.Allow() {
+		n.logger().Debug("Deferring watermark buffer refill due to rate limiting")
+		if n.retry != nil {
+			n.retry.Trigger()
+		}
+		return nil
+	}
+
 	// If the instance has stopped running for less than a minute, don't attempt any deficit
 	// resolution and wait for the custom resource to be updated as a sign
 	// of life.
@@ -931,7 +1191,20 @@ func (n *Node) MaintainIPPool(ctx context.Context) error {
 		return nil
 	}
 
+	// If the circuit breaker is open after repeated maintenance failures,
+	// skip the attempt entirely so a poisoned node does not consume the
+	// cloud API budget of the operator. The retry trigger re-attempts the
+	// maintenance once the cooldown elapsed.
+	if n.maintenanceCircuitOpen() {
+		n.logger().Debug("Skipping pool maintenance, circuit breaker is open")
+		if n.retry != nil {
+			n.retry.Trigger()
+		}
+		return nil
+	}
+
 	instanceMutated, err := n.maintainIPPool(ctx)
+	n.recordMaintenanceResult(err)
 	if err == nil {
 		n.logger().Debug("Setting resync needed")
 		n.requireResync()
@@ -9,10 +9,12 @@ import (
 	"context"
 	"fmt"
 	"sort"
+	"sync"
 	"time"
 
 	"github.com/sirupsen/logrus"
 	"golang.org/x/sync/semaphore"
+	"golang.org/x/time/rate"
 
 	"github.com/cilium/cilium/pkg/backoff"
 	"github.com/cilium/cilium/pkg/controller"
@@ -23,6 +25,26 @@ import (
 	"github.com/cilium/cilium/pkg/trigger"
 )
 
+// instancesAPIFullResyncInterval is the interval at which the full instance
+// state is synchronized with the cloud provider APIs. The full resync is a
+// slow safety net behind the event driven targeted queries and picks up
+// changes made out of band.
+const instancesAPIFullResyncInterval = 5 * time.Minute
+
+// instanceSyncer is implemented by AllocationImplementations which support
+// synchronizing the state of a single instance with the cloud provider API
+// instead of performing a full resync.
+type instanceSyncer interface {
+	InstanceSync(ctx context.Context, instanceID string) time.Time
+}
+
+// instanceAccount is implemented by NodeOperations of IPAM implementations
+// which can attribute a node to a cloud account, such as an AWS account or
+// an Azure subscription.
+type instanceAccount interface {
+	AccountID() string
+}
+
 // CiliumNodeGetterUpdater defines the interface used to interact with the k8s
 // apiserver to retrieve and update the CiliumNode custom resource
 type CiliumNodeGetterUpdater interface {
@@ -138,6 +160,9 @@ type MetricsAPI interface {
 	SetEmptyInterfaceSlots(emptyInterfaceSlots int)
 	SetAvailableIPsPerSubnet(subnetID string, availabilityZone string, available int)
 	SetNodes(category string, nodes int)
+	SetNodeIPUsage(node string, capacity, used, available, needed int)
+	SetNodeInterfaceUsage(node string, interfaceCandidates, emptyInterfaceSlots int)
+	DeleteNode(node string)
 	IncResyncCount()
 	PoolMaintainerTrigger() trigger.MetricsObserver
 	K8sSyncTrigger() trigger.MetricsObserver
@@ -159,6 +184,13 @@ type NodeManager struct {
 	releaseExcessIPs   bool
 	stableInstancesAPI bool
 	prefixDelegation   bool
+
+	// lastInstancesResync is the time of the last successful full resync
+	// with the instances API
+	lastInstancesResync time.Time
+
+	// poolUsage tracks the recent pool usage to project pool exhaustion
+	poolUsage *poolUsageHistory
 }
 
 func (n *NodeManager) ClusterSizeDependantInterval(baseInterval time.Duration) time.Duration {
@@ -184,6 +216,7 @@ func NewNodeManager(instancesAPI AllocationImplementation, k8sAPI CiliumNodeGett
 		parallelWorkers:  parallelWorkers,
 		releaseExcessIPs: releaseExcessIPs,
 		prefixDelegation: prefixDelegation,
+		poolUsage:        newPoolUsageHistory(),
 	}
 
 	resyncTrigger, err := trigger.NewTrigger(trigger.Parameters{
@@ -211,10 +244,23 @@ func NewNodeManager(instancesAPI AllocationImplementation, k8sAPI CiliumNodeGett
 func (n *NodeManager) instancesAPIResync(ctx context.Context) (time.Time, bool) {
 	syncTime := n.instancesAPI.Resync(ctx)
 	success := !syncTime.IsZero()
-	n.SetInstancesAPIReadiness(success)
+	n.mutex.Lock()
+	n.stableInstancesAPI = success
+	if success {
+		n.lastInstancesResync = syncTime
+	}
+	n.mutex.Unlock()
 	return syncTime, success
 }
 
+// lastInstancesAPIResync returns the time of the last successful full resync
+// with the instances API
+func (n *NodeManager) lastInstancesAPIResync() time.Time {
+	n.mutex.RLock()
+	defer n.mutex.RUnlock()
+	return n.lastInstancesResync
+}
+
 // Start kicks of the NodeManager by performing the initial state
 // synchronization and starting the background sync go routine
 func (n *NodeManager) Start(ctx context.Context) error {
@@ -223,15 +269,26 @@ func (n *NodeManager) Start(ctx context.Context) error {
 		return fmt.Errorf("Initial synchronization with instances API failed")
 	}
 
-	// Start an interval based  background resync for safety, it will
-	// synchronize the state regularly and resolve eventual deficit if the
-	// event driven trigger fails, and also release excess IP addresses
-	// if release-excess-ips is enabled
+	// Start an interval based background refresh and resync for safety.
+	// The refresh recalculates the deficit of all nodes against the cached
+	// instance state and resolves it if the event driven trigger fails, it
+	// performs no cloud API calls. The full resync with the instances API
+	// additionally picks up changes made out of band and releases excess IP
+	// addresses if release-excess-ips is enabled, it runs rarely as events
+	// and targeted queries keep the cache up to date.
 	go func() {
 		mngr := controller.NewManager()
 		mngr.UpdateController("ipam-node-interval-refresh",
 			controller.ControllerParams{
 				RunInterval: time.Minute,
+				DoFunc: func(ctx context.Context) error {
+					n.Resync(ctx, n.lastInstancesAPIResync())
+					return nil
+				},
+			})
+		mngr.UpdateController("ipam-instances-api-full-resync",
+			controller.ControllerParams{
+				RunInterval: instancesAPIFullResyncInterval,
 				DoFunc: func(ctx context.Context) error {
 					if syncTime, ok := n.instancesAPIResync(ctx); ok {
 						n.Resync(ctx, syncTime)
@@ -244,6 +301,69 @@ func (n *NodeManager) Start(ctx context.Context) error {
 	return nil
 }
 
+// ResyncNode performs an immediate resync of the instances API and of the
+// given node instead of waiting for the regular resync interval. If name is
+// empty, all nodes are resynced. It is intended to be driven by the operator
+// API when debugging stuck allocations.
+func (n *NodeManager) ResyncNode(ctx context.Context, name string) error {
+	syncTime, ok := n.instancesAPIResync(ctx)
+	if !ok {
+		return fmt.Errorf("unable to synchronize with instances API")
+	}
+
+	if name == "" {
+		n.Resync(ctx, syncTime)
+		return nil
+	}
+
+	node := n.Get(name)
+	if node == nil {
+		return fmt.Errorf("node %q is not known to the IPAM node manager", name)
+	}
+
+	stats := resyncStats{}
+	n.resyncNode(ctx, node, &stats, syncTime)
+	return nil
+}
+
+// CordonNode excludes the given node from new IP allocations, or includes
+// it again if cordon is false. It is intended to be driven by the operator
+// admin API.
+func (n *NodeManager) CordonNode(name string, cordon bool) error {
+	node := n.Get(name)
+	if node == nil {
+		return fmt.Errorf("node %q is not known to the IPAM node manager", name)
+	}
+
+	node.SetCordoned(cordon)
+	return nil
+}
+
+// DrainNode cordons the given node and releases all of its unused IPs
+// through the release handshake. It is intended to be driven by the
+// operator admin API.
+func (n *NodeManager) DrainNode(name string) error {
+	node := n.Get(name)
+	if node == nil {
+		return fmt.Errorf("node %q is not known to the IPAM node manager", name)
+	}
+
+	node.SetDraining()
+	return nil
+}
+
+// ReleaseNodeIPs forces the release of the given IPs of the given node
+// through the release handshake. It is intended to be driven by the
+// operator admin API.
+func (n *NodeManager) ReleaseNodeIPs(name string, ips []string) error {
+	node := n.Get(name)
+	if node == nil {
+		return fmt.Errorf("node %q is not known to the IPAM node manager", name)
+	}
+
+	return node.ForceReleaseIPs(ips)
+}
+
 // SetInstancesAPIReadiness sets the readiness state of the instances API
 func (n *NodeManager) SetInstancesAPIReadiness(ready bool) {
 	n.mutex.Lock()
@@ -294,13 +414,22 @@ func (n *NodeManager) Update(resource *v2.CiliumNode) (nodeSynced bool) {
 			manager:             n,
 			ipsMarkedForRelease: make(map[string]time.Time),
 			ipReleaseStatus:     make(map[string]string),
+			forcedReleaseIPs:    make(map[string]struct{}),
 			logLimiter:          logging.NewLimiter(10*time.Second, 3), // 1 log / 10 secs, burst of 3
 		}
 
 		ctx, cancel := context.WithCancel(context.Background())
 		// InstanceAPI is stale and the instances API is stable then do resync instancesAPI to sync instances
 		if !n.instancesAPI.HasInstance(resource.InstanceID()) && n.stableInstancesAPI {
-			if syncTime := n.instancesAPI.Resync(ctx); syncTime.IsZero() {
+			// Prefer a targeted query for just the new instance over a
+			// full resync when the implementation supports it
+			var syncTime time.Time
+			if syncer, ok := n.instancesAPI.(instanceSyncer); ok {
+				syncTime = syncer.InstanceSync(ctx, resource.InstanceID())
+			} else {
+				syncTime = n.instancesAPI.Resync(ctx)
+			}
+			if syncTime.IsZero() {
 				node.logger().Warning("Failed to resync the instances from the API after new node was found")
 				n.stableInstancesAPI = false
 			} else {
@@ -344,6 +473,7 @@ func (n *NodeManager) Update(resource *v2.CiliumNode) (nodeSynced bool) {
 			return false
 		}
 		node.retry = retry
+		node.backgroundRefill = rate.NewLimiter(rate.Every(backgroundRefillInterval), backgroundRefillBurst)
 
 		k8sSync, err := trigger.NewTrigger(trigger.Parameters{
 			Name:            fmt.Sprintf("ipam-node-k8s-sync-%s", resource.Name),
@@ -394,6 +524,7 @@ func (n *NodeManager) Delete(resource *v2.CiliumNode) {
 	}
 
 	delete(n.nodes, resource.Name)
+	n.metricsAPI.DeleteNode(resource.Name)
 	n.mutex.Unlock()
 }
 
@@ -444,6 +575,7 @@ type resyncStats struct {
 	nodes               int
 	nodesAtCapacity     int
 	nodesInDeficit      int
+	nodesCircuitOpen    int
 }
 
 func (n *NodeManager) resyncNode(ctx context.Context, node *Node, stats *resyncStats, syncTime time.Time) {
@@ -476,8 +608,15 @@ func (n *NodeManager) resyncNode(ctx context.Context, node *Node, stats *resyncS
 		stats.nodesAtCapacity++
 	}
 
+	if node.maintenanceCircuitOpen() {
+		stats.nodesCircuitOpen++
+	}
+
 	stats.mutex.Unlock()
 
+	n.metricsAPI.SetNodeIPUsage(node.name, nodeStats.Capacity, nodeStats.UsedIPs, availableOnNode, nodeStats.NeededIPs)
+	n.metricsAPI.SetNodeInterfaceUsage(node.name, nodeStats.InterfaceCandidates, nodeStats.EmptyInterfaceSlots)
+
 	node.k8sSync.Trigger()
 }
 
@@ -489,22 +628,44 @@ func (n *NodeManager) Resync(ctx context.Context, syncTime time.Time) {
 	n.metricsAPI.IncResyncCount()
 
 	stats := resyncStats{}
-	sem := semaphore.NewWeighted(n.parallelWorkers)
 
+	// Partition the nodes by cloud account. Each account is synced with
+	// its own worker budget so that a throttled account does not stall
+	// the reconciliation of nodes in other accounts.
+	accounts := map[string][]*Node{}
 	for _, node := range n.GetNodesByIPWatermark() {
-		err := sem.Acquire(ctx, 1)
-		if err != nil {
-			continue
+		account := ""
+		if ops, ok := node.Ops().(instanceAccount); ok {
+			account = ops.AccountID()
 		}
-		go func(node *Node, stats *resyncStats) {
-			n.resyncNode(ctx, node, stats, syncTime)
-			sem.Release(1)
-		}(node, &stats)
+		accounts[account] = append(accounts[account], node)
 	}
 
-	// Acquire the full semaphore, this requires all go routines to
-	// complete and thus blocks until all nodes are synced
-	sem.Acquire(ctx, n.parallelWorkers)
+	var wg sync.WaitGroup
+	for _, nodes := range accounts {
+		wg.Add(1)
+		go func(nodes []*Node) {
+			defer wg.Done()
+
+			sem := semaphore.NewWeighted(n.parallelWorkers)
+			for _, node := range nodes {
+				err := sem.Acquire(ctx, 1)
+				if err != nil {
+					continue
+				}
+				go func(node *Node, stats *resyncStats) {
+					n.resyncNode(ctx, node, stats, syncTime)
+					sem.Release(1)
+				}(node, &stats)
+			}
+
+			// Acquire the full semaphore, this requires all go routines to
+			// complete and thus blocks until all nodes of the account are
+			// synced
+			sem.Acquire(ctx, n.parallelWorkers)
+		}(nodes)
+	}
+	wg.Wait()
 
 	n.metricsAPI.SetAllocatedIPs("used", stats.totalUsed)
 	n.metricsAPI.SetAllocatedIPs("available", stats.totalAvailable)
@@ -515,8 +676,11 @@ func (n *NodeManager) Resync(ctx context.Context, syncTime time.Time) {
 	n.metricsAPI.SetNodes("total", stats.nodes)
 	n.metricsAPI.SetNodes("in-deficit", stats.nodesInDeficit)
 	n.metricsAPI.SetNodes("at-capacity", stats.nodesAtCapacity)
+	n.metricsAPI.SetNodes("circuit-breaker-open", stats.nodesCircuitOpen)
 
-	for poolID, quota := range n.instancesAPI.GetPoolQuota() {
+	poolQuota := n.instancesAPI.GetPoolQuota()
+	for poolID, quota := range poolQuota {
 		n.metricsAPI.SetAvailableIPsPerSubnet(string(poolID), quota.AvailabilityZone, quota.AvailableIPs)
 	}
+	n.poolUsage.record(time.Now(), poolQuota)
 }
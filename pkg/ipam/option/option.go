@@ -26,13 +26,29 @@ const (
 	// IPAMClusterPoolV2 is the value to select cluster pool version 2
 	IPAMClusterPoolV2 = "cluster-pool-v2beta"
 
+	// IPAMMultiPool is the value to select the multi-pool mode for
+	// option.IPAM, pod CIDRs are allocated from CiliumPodIPPool resources
+	IPAMMultiPool = "multi-pool"
+
 	// IPAMAlibabaCloud is the value to select the AlibabaCloud ENI IPAM plugin for option.IPAM
 	IPAMAlibabaCloud = "alibabacloud"
 
+	// IPAMGKE is the value to select the GKE alias IP range based hostscope
+	// IPAM mode for option.IPAM
+	IPAMGKE = "gke"
+
+	// IPAMOpenStack is the value to select the OpenStack Neutron port IPAM
+	// plugin for option.IPAM
+	IPAMOpenStack = "openstack"
+
 	// IPAMDelegatedPlugin is the value to select CNI delegated IPAM plugin mode.
 	// In this mode, Cilium CNI invokes another CNI binary (the delegated plugin) for IPAM.
 	// See https://www.cni.dev/docs/spec/#section-4-plugin-delegation
 	IPAMDelegatedPlugin = "delegated-plugin"
+
+	// IPAMExternal is the value to select delegation to an external IPAM
+	// plugin over gRPC, e.g. an agent fronting Infoblox or Netbox
+	IPAMExternal = "external"
 )
 
 const (
@@ -0,0 +1,102 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package ipam
+
+import (
+	"fmt"
+	"net"
+	"sort"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/cilium/cilium/pkg/option"
+)
+
+// quarantinedIP tracks an IP which has been released but is withheld from
+// re-allocation until the configured grace period has passed. This avoids
+// conntrack and policy confusion caused by immediate recycling of IPs under
+// heavy pod churn.
+type quarantinedIP struct {
+	// owner is the owner the IP was allocated to before it was released
+	owner string
+
+	// timer returns the IP to the pool once the grace period has passed
+	timer *time.Timer
+}
+
+// quarantineIPLocked withholds a released IP from re-allocation for the
+// configured grace period. The IP remains allocated in the underlying
+// allocator until the grace period has passed. The caller must hold
+// ipam.allocatorMutex for writing.
+func (ipam *IPAM) quarantineIPLocked(ip net.IP) error {
+	ipString := ip.String()
+
+	if _, ok := ipam.quarantine[ipString]; ok {
+		return nil
+	}
+
+	q := &quarantinedIP{owner: ipam.owner[ipString]}
+	q.timer = time.AfterFunc(option.Config.IPAMReleaseGracePeriod, func() {
+		ipam.allocatorMutex.Lock()
+		defer ipam.allocatorMutex.Unlock()
+
+		if _, ok := ipam.quarantine[ipString]; !ok {
+			// The IP was already returned to the pool
+			return
+		}
+
+		delete(ipam.quarantine, ipString)
+		if err := ipam.releaseIPLocked(ip); err != nil {
+			log.WithError(err).WithField("ip", ipString).Warning("Unable to release quarantined IP")
+		}
+	})
+
+	ipam.quarantine[ipString] = q
+	ipam.owner[ipString] = fmt.Sprintf("%s (quarantined)", q.owner)
+	delete(ipam.expirationTimers, ipString)
+
+	log.WithFields(logrus.Fields{
+		"ip":    ipString,
+		"owner": q.owner,
+	}).Debug("Quarantined released IP")
+
+	return nil
+}
+
+// dequarantineIPLocked removes an IP from quarantine and returns it to the
+// pool immediately. It returns false if the IP is not quarantined. The caller
+// must hold ipam.allocatorMutex for writing.
+func (ipam *IPAM) dequarantineIPLocked(ip net.IP) bool {
+	ipString := ip.String()
+
+	q, ok := ipam.quarantine[ipString]
+	if !ok {
+		return false
+	}
+
+	q.timer.Stop()
+	delete(ipam.quarantine, ipString)
+	if err := ipam.releaseIPLocked(ip); err != nil {
+		log.WithError(err).WithField("ip", ipString).Warning("Unable to release quarantined IP")
+	}
+
+	return true
+}
+
+// QuarantinedIPs returns the list of IPs which have been released but are
+// waiting out the configured grace period before they are returned to the
+// pool.
+func (ipam *IPAM) QuarantinedIPs() []string {
+	ipam.allocatorMutex.RLock()
+	defer ipam.allocatorMutex.RUnlock()
+
+	ips := make([]string, 0, len(ipam.quarantine))
+	for ip := range ipam.quarantine {
+		ips = append(ips, ip)
+	}
+	sort.Strings(ips)
+
+	return ips
+}
@@ -0,0 +1,133 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package ipam
+
+import (
+	"net"
+	"reflect"
+
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/cilium/cilium/pkg/k8s/apis/cilium.io/v2alpha1"
+	"github.com/cilium/cilium/pkg/k8s/informer"
+	"github.com/cilium/cilium/pkg/k8s/utils"
+)
+
+// parseReservedAddresses converts the addresses of a CiliumIPReservation into
+// networks. Plain addresses are treated as host routes, invalid entries are
+// logged and skipped so that a single typo does not void the remaining
+// reservations.
+func parseReservedAddresses(reservation *v2alpha1.CiliumIPReservation) []*net.IPNet {
+	networks := make([]*net.IPNet, 0, len(reservation.Spec.Addresses))
+	for _, addr := range reservation.Spec.Addresses {
+		if _, network, err := net.ParseCIDR(string(addr)); err == nil {
+			networks = append(networks, network)
+			continue
+		}
+
+		ip := net.ParseIP(string(addr))
+		if ip == nil {
+			log.WithField("reservation", reservation.Name).
+				Warningf("Ignoring invalid reserved address %q", addr)
+			continue
+		}
+
+		bits := net.IPv6len * 8
+		if ip.To4() != nil {
+			bits = net.IPv4len * 8
+		}
+		networks = append(networks, &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)})
+	}
+
+	return networks
+}
+
+func (ipam *IPAM) upsertReservation(reservation *v2alpha1.CiliumIPReservation) {
+	ipam.allocatorMutex.Lock()
+	ipam.reservations[reservation.Name] = parseReservedAddresses(reservation)
+	ipam.allocatorMutex.Unlock()
+}
+
+func (ipam *IPAM) deleteReservation(name string) {
+	ipam.allocatorMutex.Lock()
+	delete(ipam.reservations, name)
+	ipam.allocatorMutex.Unlock()
+}
+
+// reservedByLocked returns the name of the CiliumIPReservation covering the
+// given IP, or an empty string if the IP is not reserved. The allocatorMutex
+// must be held.
+func (ipam *IPAM) reservedByLocked(ip net.IP) string {
+	for name, networks := range ipam.reservations {
+		for _, network := range networks {
+			if network.Contains(ip) {
+				return name
+			}
+		}
+	}
+
+	return ""
+}
+
+// watchIPReservations starts an informer on the cluster-wide
+// CiliumIPReservation resources and mirrors them into the reservations map
+// consulted at allocation time.
+func (ipam *IPAM) watchIPReservations(k8sEventReg K8sEventRegister) {
+	apiGroup := "cilium/v2alpha1::CiliumIPReservation"
+	_, reservationInformer := informer.NewInformer(
+		utils.ListerWatcherFromTyped[*v2alpha1.CiliumIPReservationList](ipam.clientset.CiliumV2alpha1().CiliumIPReservations()),
+		&v2alpha1.CiliumIPReservation{},
+		0,
+		cache.ResourceEventHandlerFuncs{
+			AddFunc: func(obj interface{}) {
+				var valid bool
+				defer func() { k8sEventReg.K8sEventReceived(apiGroup, "CiliumIPReservation", "create", valid, false) }()
+				if reservation, ok := obj.(*v2alpha1.CiliumIPReservation); ok {
+					valid = true
+					ipam.upsertReservation(reservation)
+					k8sEventReg.K8sEventProcessed("CiliumIPReservation", "create", true)
+				} else {
+					log.Warningf("Unknown CiliumIPReservation object type %s received: %+v", reflect.TypeOf(obj), obj)
+				}
+			},
+			UpdateFunc: func(oldObj, newObj interface{}) {
+				var valid, equal bool
+				defer func() { k8sEventReg.K8sEventReceived(apiGroup, "CiliumIPReservation", "update", valid, equal) }()
+				if oldReservation, ok := oldObj.(*v2alpha1.CiliumIPReservation); ok {
+					if newReservation, ok := newObj.(*v2alpha1.CiliumIPReservation); ok {
+						valid = true
+						if oldReservation.Spec.DeepEqual(&newReservation.Spec) {
+							equal = true
+							return
+						}
+						ipam.upsertReservation(newReservation)
+						k8sEventReg.K8sEventProcessed("CiliumIPReservation", "update", true)
+					}
+				}
+			},
+			DeleteFunc: func(obj interface{}) {
+				var valid bool
+				defer func() { k8sEventReg.K8sEventReceived(apiGroup, "CiliumIPReservation", "delete", valid, false) }()
+				reservation, ok := obj.(*v2alpha1.CiliumIPReservation)
+				if !ok {
+					deletedObj, ok := obj.(cache.DeletedFinalStateUnknown)
+					if !ok {
+						return
+					}
+					reservation, ok = deletedObj.Obj.(*v2alpha1.CiliumIPReservation)
+					if !ok {
+						return
+					}
+				}
+				valid = true
+				ipam.deleteReservation(reservation.Name)
+				k8sEventReg.K8sEventProcessed("CiliumIPReservation", "delete", true)
+			},
+		},
+		nil,
+	)
+
+	go reservationInformer.Run(wait.NeverStop)
+}
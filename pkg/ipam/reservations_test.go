@@ -0,0 +1,54 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package ipam
+
+import (
+	"net"
+
+	. "gopkg.in/check.v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/cilium/cilium/pkg/datapath/fake"
+	"github.com/cilium/cilium/pkg/k8s/apis/cilium.io/v2alpha1"
+)
+
+func (s *IPAMSuite) TestIPReservations(c *C) {
+	fakeAddressing := fake.NewNodeAddressing()
+	ipam := NewIPAM(fakeAddressing, &testConfiguration{}, &ownerMock{}, &ownerMock{}, &mtuMock, nil)
+
+	ipv4 := fakeIPv4AllocCIDRIP(fakeAddressing)
+	reservedIP := ipv4.Next()
+
+	ipam.upsertReservation(&v2alpha1.CiliumIPReservation{
+		ObjectMeta: metav1.ObjectMeta{Name: "gateways"},
+		Spec: v2alpha1.IPReservationSpec{
+			Addresses: []v2alpha1.ReservedAddress{
+				v2alpha1.ReservedAddress(reservedIP.String()),
+				"192.0.2.0/24",
+				"not-an-address",
+			},
+		},
+	})
+
+	// Explicit allocation of a reserved address must fail
+	err := ipam.AllocateIP(reservedIP.AsSlice(), "foo")
+	c.Assert(err, Not(IsNil))
+
+	// Both plain addresses and CIDRs are matched, invalid entries are
+	// ignored
+	c.Assert(ipam.reservedByLocked(reservedIP.AsSlice()), Equals, "gateways")
+	c.Assert(ipam.reservedByLocked(net.ParseIP("192.0.2.10")), Equals, "gateways")
+	c.Assert(ipam.reservedByLocked(net.ParseIP("192.0.3.1")), Equals, "")
+
+	// Random allocation must skip the reserved address
+	for i := 0; i < 10; i++ {
+		result, err := ipam.AllocateNextFamily(IPv4, "bar")
+		c.Assert(err, IsNil)
+		c.Assert(result.IP.Equal(reservedIP.AsSlice()), Equals, false)
+	}
+
+	// Removing the reservation makes the address allocatable again
+	ipam.deleteReservation("gateways")
+	c.Assert(ipam.reservedByLocked(reservedIP.AsSlice()), Equals, "")
+}
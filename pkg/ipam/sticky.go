@@ -0,0 +1,168 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package ipam
+
+import (
+	"context"
+	"net"
+	"regexp"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	nodeTypes "github.com/cilium/cilium/pkg/node/types"
+	"github.com/cilium/cilium/pkg/trigger"
+)
+
+const (
+	// stickyIPUpdateRate is the maximum rate in which the sticky IPs of
+	// the CiliumNode custom resource are updated
+	stickyIPUpdateRate = 15 * time.Second
+)
+
+// stickyOwnerRegexp matches owners which refer to pods with a stable
+// identity, i.e. StatefulSet pods whose name is made up of the StatefulSet
+// name followed by the replica ordinal. Pods managed by other controllers
+// carry a random alphanumeric suffix and will not match.
+var stickyOwnerRegexp = regexp.MustCompile(`^[^/]+/.+-[0-9]+$`)
+
+// isStickyOwner returns true if the owner refers to a pod with a stable
+// identity which should be re-assigned the same IP across pod restarts.
+func isStickyOwner(owner string) bool {
+	return stickyOwnerRegexp.MatchString(owner)
+}
+
+// initStickyIPs enables tracking of sticky IPs. Previously recorded sticky
+// IPs are restored from the CiliumNode custom resource and all subsequent
+// changes are synchronized back to it.
+func (ipam *IPAM) initStickyIPs() {
+	ipam.stickyIPs = map[string]string{}
+
+	if ipam.clientset == nil {
+		return
+	}
+
+	t, err := trigger.NewTrigger(trigger.Parameters{
+		Name:        "ipam-sticky-ips",
+		MinInterval: stickyIPUpdateRate,
+		TriggerFunc: ipam.syncStickyIPsTrigger,
+	})
+	if err != nil {
+		log.WithError(err).Fatal("Unable to initialize sticky IP synchronization trigger")
+	}
+	ipam.stickySyncTrigger = t
+
+	node, err := ipam.clientset.CiliumV2().CiliumNodes().Get(context.TODO(), nodeTypes.GetName(), metav1.GetOptions{})
+	if err != nil {
+		log.WithError(err).Info("Unable to retrieve CiliumNode custom resource, no sticky IPs restored")
+		return
+	}
+
+	for ip, owner := range node.Spec.IPAM.StickyIPs {
+		ipam.stickyIPs[ip] = owner
+	}
+
+	if len(ipam.stickyIPs) > 0 {
+		log.WithField("numIPs", len(ipam.stickyIPs)).Info("Restored sticky IPs from CiliumNode custom resource")
+	}
+}
+
+// stickyIPLocked returns the IP of the requested family the owner was last
+// allocated or nil if no sticky IP has been recorded for the owner. The
+// caller must hold ipam.allocatorMutex.
+func (ipam *IPAM) stickyIPLocked(owner string, family Family) net.IP {
+	for ip, o := range ipam.stickyIPs {
+		if o != owner {
+			continue
+		}
+
+		if parsedIP := net.ParseIP(ip); parsedIP != nil && DeriveFamily(parsedIP) == family {
+			return parsedIP
+		}
+	}
+
+	return nil
+}
+
+// updateStickyIPLocked records the IP allocated to an owner with a stable
+// identity, replacing any previously recorded IP of the same address family.
+// The caller must hold ipam.allocatorMutex.
+func (ipam *IPAM) updateStickyIPLocked(owner string, ip net.IP) {
+	if ipam.stickyIPs == nil || !isStickyOwner(owner) {
+		return
+	}
+
+	family := DeriveFamily(ip)
+	for oldIP, o := range ipam.stickyIPs {
+		if o == owner && DeriveFamily(net.ParseIP(oldIP)) == family {
+			if oldIP == ip.String() {
+				return
+			}
+			delete(ipam.stickyIPs, oldIP)
+		}
+	}
+
+	ipam.stickyIPs[ip.String()] = owner
+
+	if ipam.stickySyncTrigger != nil {
+		ipam.stickySyncTrigger.TriggerWithReason("sticky IP updated")
+	}
+}
+
+// syncStickyIPsTrigger is called to synchronize the sticky IPs to the custom
+// resource after taking the configured rate limiting into account
+//
+// Note: The function signature includes the reasons argument in order to
+// implement the trigger.TriggerFunc interface despite the argument being
+// unused.
+func (ipam *IPAM) syncStickyIPsTrigger(reasons []string) {
+	if err := ipam.syncStickyIPs(context.TODO()); err != nil {
+		log.WithError(err).Warning("Unable to update sticky IPs in CiliumNode custom resource")
+		ipam.stickySyncTrigger.TriggerWithReason("retry after error")
+	}
+}
+
+// syncStickyIPs updates the sticky IPs in the CiliumNode custom resource to
+// match the local state
+func (ipam *IPAM) syncStickyIPs(ctx context.Context) error {
+	node, err := ipam.clientset.CiliumV2().CiliumNodes().Get(ctx, nodeTypes.GetName(), metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+
+	ipam.allocatorMutex.RLock()
+	stickyIPs := make(map[string]string, len(ipam.stickyIPs))
+	for ip, owner := range ipam.stickyIPs {
+		stickyIPs[ip] = owner
+	}
+	ipam.allocatorMutex.RUnlock()
+
+	if stickyIPsEqual(node.Spec.IPAM.StickyIPs, stickyIPs) {
+		return nil
+	}
+
+	node.Spec.IPAM.StickyIPs = stickyIPs
+	_, err = ipam.clientset.CiliumV2().CiliumNodes().Update(ctx, node, metav1.UpdateOptions{})
+	if err == nil {
+		log.WithFields(logrus.Fields{
+			"numIPs": len(stickyIPs),
+		}).Debug("Updated sticky IPs in CiliumNode custom resource")
+	}
+	return err
+}
+
+func stickyIPsEqual(a, b map[string]string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for ip, owner := range a {
+		if b[ip] != owner {
+			return false
+		}
+	}
+
+	return true
+}
@@ -7,10 +7,14 @@ import (
 	"net"
 
 	"github.com/davecgh/go-spew/spew"
+	"github.com/sirupsen/logrus"
+	"k8s.io/client-go/tools/record"
 
 	"github.com/cilium/cilium/pkg/cidr"
 	"github.com/cilium/cilium/pkg/datapath/types"
+	"github.com/cilium/cilium/pkg/k8s/client"
 	"github.com/cilium/cilium/pkg/lock"
+	"github.com/cilium/cilium/pkg/trigger"
 )
 
 // AllocationResult is the result of an allocation
@@ -97,10 +101,47 @@ type IPAM struct {
 	// timer.
 	expirationTimers map[string]string
 
+	// quarantine is the set of released IPs which are withheld from
+	// re-allocation until the configured grace period has passed
+	quarantine map[string]*quarantinedIP
+
+	// stickyIPs maps an IP to the owner of a pod with a stable identity
+	// which should be re-assigned the IP on subsequent allocations. It
+	// mirrors CiliumNode.Spec.IPAM.StickyIPs and is nil unless sticky IPs
+	// are enabled.
+	stickyIPs map[string]string
+
+	// stickySyncTrigger is the configured trigger to synchronize updates
+	// of stickyIPs to the CiliumNode custom resource with rate limiting
+	stickySyncTrigger *trigger.Trigger
+
+	// egressPools maps a namespace to the allocator backing its egress IP
+	// pool, it is nil unless egress IP pools are configured
+	egressPools map[string]Allocator
+
+	// egressIPs maps a namespace to the egress IP the local node has
+	// allocated for it out of the namespace's pool
+	egressIPs map[string]net.IP
+
+	// reservations maps the name of a CiliumIPReservation to the networks
+	// it reserves. Reserved addresses are never handed out, regardless of
+	// the IPAM mode.
+	reservations map[string][]*net.IPNet
+
 	// mutex covers access to all members of this struct
 	allocatorMutex lock.RWMutex
 
 	blacklist IPBlacklist
+
+	// eventRecorder emits Kubernetes events for IP allocations and
+	// releases, it is nil unless IPAM events are enabled
+	eventRecorder record.EventRecorder
+
+	// auditLogger writes structured allocation and release events to the
+	// configured event log file, it is nil unless a file is configured
+	auditLogger *logrus.Logger
+
+	clientset client.Clientset
 }
 
 // DebugStatus implements debug.StatusObject to provide debug status collection
@@ -121,6 +121,14 @@ type IPAMSpec struct {
 	//
 	// +kubebuilder:validation:Minimum=0
 	PodCIDRReleaseThreshold int `json:"pod-cidr-release-threshold,omitempty"`
+
+	// StickyIPs maps an IP to the owner of a pod with a stable identity,
+	// e.g. a StatefulSet pod, which is preferably re-assigned the same IP
+	// when the pod is restarted or rescheduled. This map is maintained by
+	// cilium-agent when sticky IPs are enabled.
+	//
+	// +optional
+	StickyIPs map[string]string `json:"sticky-ips,omitempty"`
 }
 
 // IPReleaseStatus  defines the valid states in IP release handshake
@@ -366,6 +374,17 @@ func (m *InstanceMap) updateLocked(instanceID string, iface InterfaceRevision) {
 	i.Interfaces[iface.Resource.InterfaceID()] = iface
 }
 
+// UpdateInstance updates the definition of an instance. If the instance is
+// already known, the definition is updated, otherwise the instance is added
+// to the map.
+func (m *InstanceMap) UpdateInstance(instanceID string, instance *Instance) {
+	m.mutex.Lock()
+	for _, iface := range instance.Interfaces {
+		m.updateLocked(instanceID, iface)
+	}
+	m.mutex.Unlock()
+}
+
 type Address interface{}
 
 // AddressIterator is the function called by the ForeachAddress iterator
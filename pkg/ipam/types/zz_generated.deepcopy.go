@@ -61,6 +61,13 @@ func (in *IPAMSpec) DeepCopyInto(out *IPAMSpec) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.StickyIPs != nil {
+		in, out := &in.StickyIPs, &out.StickyIPs
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 	return
 }
 
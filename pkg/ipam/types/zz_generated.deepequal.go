@@ -99,6 +99,27 @@ func (in *IPAMSpec) DeepEqual(other *IPAMSpec) bool {
 		return false
 	}
 
+	if ((in.StickyIPs != nil) && (other.StickyIPs != nil)) || ((in.StickyIPs == nil) != (other.StickyIPs == nil)) {
+		in, other := &in.StickyIPs, &other.StickyIPs
+		if other == nil {
+			return false
+		}
+
+		if len(*in) != len(*other) {
+			return false
+		} else {
+			for key, inValue := range *in {
+				if otherValue, present := (*other)[key]; !present {
+					return false
+				} else {
+					if inValue != otherValue {
+						return false
+					}
+				}
+			}
+		}
+	}
+
 	return true
 }
 
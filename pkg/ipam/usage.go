@@ -0,0 +1,87 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package ipam
+
+// PoolUsage summarizes the occupancy of an allocation pool.
+type PoolUsage struct {
+	// Allocated is the number of addresses currently handed out of the
+	// pool, including addresses withheld by the allocator itself, e.g.
+	// blacklisted or reserved addresses which were encountered during
+	// allocation.
+	Allocated int
+
+	// Available is the number of addresses which can still be allocated
+	// out of the pool. It is negative if the backing allocator does not
+	// track the pool capacity, e.g. when allocation is delegated to an
+	// external plugin.
+	Available int
+
+	// Reserved is the number of addresses withheld from allocation, i.e.
+	// blacklisted or quarantined addresses.
+	Reserved int
+}
+
+// poolCapacity is implemented by allocators which know how many addresses
+// remain available in their backing pool. For the CRD-based cloud allocators
+// this includes delegated ENI prefixes which are accounted in the pool size
+// published in the CiliumNode resource.
+type poolCapacity interface {
+	Free() int
+}
+
+func familyOf(ip string) Family {
+	for i := 0; i < len(ip); i++ {
+		switch ip[i] {
+		case '.':
+			return IPv4
+		case ':':
+			return IPv6
+		}
+	}
+	return IPv4
+}
+
+// PoolUsage returns the per-family usage of the allocation pools.
+func (ipam *IPAM) PoolUsage() map[Family]PoolUsage {
+	ipam.allocatorMutex.RLock()
+	defer ipam.allocatorMutex.RUnlock()
+
+	usage := map[Family]PoolUsage{}
+
+	for family, allocator := range map[Family]Allocator{
+		IPv4: ipam.IPv4Allocator,
+		IPv6: ipam.IPv6Allocator,
+	} {
+		if allocator == nil {
+			continue
+		}
+
+		allocs, _ := allocator.Dump()
+
+		available := -1
+		if c, ok := allocator.(poolCapacity); ok {
+			available = c.Free()
+		}
+
+		reserved := 0
+		for ip := range ipam.blacklist.ips {
+			if familyOf(ip) == family {
+				reserved++
+			}
+		}
+		for ip := range ipam.quarantine {
+			if familyOf(ip) == family {
+				reserved++
+			}
+		}
+
+		usage[family] = PoolUsage{
+			Allocated: len(allocs),
+			Available: available,
+			Reserved:  reserved,
+		}
+	}
+
+	return usage
+}
@@ -75,6 +75,24 @@ const (
 
 	// CNCCRDName is the full name of the CiliumNodeConfig CRD.
 	CNCCRDName = k8sconstv2alpha1.CNCKindDefinition + "/" + k8sconstv2alpha1.CustomResourceDefinitionVersion
+
+	// CPIPCRDName is the full name of the CiliumPodIPPool CRD.
+	CPIPCRDName = k8sconstv2alpha1.CPIPKindDefinition + "/" + k8sconstv2alpha1.CustomResourceDefinitionVersion
+
+	// CIRCRDName is the full name of the CiliumIPReservation CRD.
+	CIRCRDName = k8sconstv2alpha1.CIRKindDefinition + "/" + k8sconstv2alpha1.CustomResourceDefinitionVersion
+
+	// CMGCRDName is the full name of the CiliumMulticastGroup CRD.
+	CMGCRDName = k8sconstv2alpha1.CMGKindDefinition + "/" + k8sconstv2alpha1.CustomResourceDefinitionVersion
+
+	// CXFCRDName is the full name of the CiliumXDPFilter CRD.
+	CXFCRDName = k8sconstv2alpha1.CXFKindDefinition + "/" + k8sconstv2alpha1.CustomResourceDefinitionVersion
+
+	// CCGCRDName is the full name of the CiliumCIDRGroup CRD.
+	CCGCRDName = k8sconstv2alpha1.CCGKindDefinition + "/" + k8sconstv2alpha1.CustomResourceDefinitionVersion
+
+	// CPDCRDName is the full name of the CiliumPolicyDefaults CRD.
+	CPDCRDName = k8sconstv2alpha1.CPDKindDefinition + "/" + k8sconstv2alpha1.CustomResourceDefinitionVersion
 )
 
 var (
@@ -106,6 +124,12 @@ func CreateCustomResourceDefinitions(clientset apiextensionsclient.Interface) er
 		synced.CRDResourceName(k8sconstv2alpha1.BGPPName):     createBGPPCRD,
 		synced.CRDResourceName(k8sconstv2alpha1.LBIPPoolName): createLBIPPoolCRD,
 		synced.CRDResourceName(k8sconstv2alpha1.CNCName):      createCNCCRD,
+		synced.CRDResourceName(k8sconstv2alpha1.CPIPName):     createCPIPCRD,
+		synced.CRDResourceName(k8sconstv2alpha1.CIRName):      createCIRCRD,
+		synced.CRDResourceName(k8sconstv2alpha1.CMGName):      createCMGCRD,
+		synced.CRDResourceName(k8sconstv2alpha1.CXFName):      createCXFCRD,
+		synced.CRDResourceName(k8sconstv2alpha1.CCGName):      createCCGCRD,
+		synced.CRDResourceName(k8sconstv2alpha1.CPDName):      createCPDCRD,
 	}
 	for _, r := range synced.AllCRDResourceNames() {
 		fn, ok := resourceToCreateFnMapping[r]
@@ -162,6 +186,24 @@ var (
 
 	//go:embed crds/v2alpha1/ciliumnodeconfigs.yaml
 	crdsv2Alpha1CiliumNodeConfigs []byte
+
+	//go:embed crds/v2alpha1/ciliumpodippools.yaml
+	crdsv2Alpha1Ciliumpodippools []byte
+
+	//go:embed crds/v2alpha1/ciliumipreservations.yaml
+	crdsv2Alpha1Ciliumipreservations []byte
+
+	//go:embed crds/v2alpha1/ciliummulticastgroups.yaml
+	crdsv2Alpha1Ciliummulticastgroups []byte
+
+	//go:embed crds/v2alpha1/ciliumxdpfilters.yaml
+	crdsv2Alpha1Ciliumxdpfilters []byte
+
+	//go:embed crds/v2alpha1/ciliumcidrgroups.yaml
+	crdsv2Alpha1Ciliumcidrgroups []byte
+
+	//go:embed crds/v2alpha1/ciliumpolicydefaults.yaml
+	crdsv2Alpha1Ciliumpolicydefaults []byte
 )
 
 // GetPregeneratedCRD returns the pregenerated CRD based on the requested CRD
@@ -205,6 +247,18 @@ func GetPregeneratedCRD(crdName string) apiextensionsv1.CustomResourceDefinition
 		crdBytes = crdsv2Alpha1Ciliumloadbalancerippools
 	case CNCCRDName:
 		crdBytes = crdsv2Alpha1CiliumNodeConfigs
+	case CPIPCRDName:
+		crdBytes = crdsv2Alpha1Ciliumpodippools
+	case CIRCRDName:
+		crdBytes = crdsv2Alpha1Ciliumipreservations
+	case CMGCRDName:
+		crdBytes = crdsv2Alpha1Ciliummulticastgroups
+	case CXFCRDName:
+		crdBytes = crdsv2Alpha1Ciliumxdpfilters
+	case CCGCRDName:
+		crdBytes = crdsv2Alpha1Ciliumcidrgroups
+	case CPDCRDName:
+		crdBytes = crdsv2Alpha1Ciliumpolicydefaults
 	default:
 		scopedLog.Fatal("Pregenerated CRD does not exist")
 	}
@@ -391,6 +445,78 @@ func createCNCCRD(clientset apiextensionsclient.Interface) error {
 	)
 }
 
+// createCPIPCRD creates and updates the CiliumPodIPPool CRD.
+func createCPIPCRD(clientset apiextensionsclient.Interface) error {
+	ciliumCRD := GetPregeneratedCRD(CPIPCRDName)
+
+	return createUpdateCRD(
+		clientset,
+		CPIPCRDName,
+		constructV1CRD(k8sconstv2alpha1.CPIPName, ciliumCRD),
+		newDefaultPoller(),
+	)
+}
+
+// createCIRCRD creates and updates the CiliumIPReservation CRD.
+func createCIRCRD(clientset apiextensionsclient.Interface) error {
+	ciliumCRD := GetPregeneratedCRD(CIRCRDName)
+
+	return createUpdateCRD(
+		clientset,
+		CIRCRDName,
+		constructV1CRD(k8sconstv2alpha1.CIRName, ciliumCRD),
+		newDefaultPoller(),
+	)
+}
+
+// createCMGCRD creates and updates the CiliumMulticastGroup CRD.
+func createCMGCRD(clientset apiextensionsclient.Interface) error {
+	ciliumCRD := GetPregeneratedCRD(CMGCRDName)
+
+	return createUpdateCRD(
+		clientset,
+		CMGCRDName,
+		constructV1CRD(k8sconstv2alpha1.CMGName, ciliumCRD),
+		newDefaultPoller(),
+	)
+}
+
+// createCXFCRD creates and updates the CiliumXDPFilter CRD.
+func createCXFCRD(clientset apiextensionsclient.Interface) error {
+	ciliumCRD := GetPregeneratedCRD(CXFCRDName)
+
+	return createUpdateCRD(
+		clientset,
+		CXFCRDName,
+		constructV1CRD(k8sconstv2alpha1.CXFName, ciliumCRD),
+		newDefaultPoller(),
+	)
+}
+
+// createCCGCRD creates and updates the CiliumCIDRGroup CRD.
+func createCCGCRD(clientset apiextensionsclient.Interface) error {
+	ciliumCRD := GetPregeneratedCRD(CCGCRDName)
+
+	return createUpdateCRD(
+		clientset,
+		CCGCRDName,
+		constructV1CRD(k8sconstv2alpha1.CCGName, ciliumCRD),
+		newDefaultPoller(),
+	)
+}
+
+// createCPDCRD creates and updates the CiliumPolicyDefaults CRD.
+func createCPDCRD(clientset apiextensionsclient.Interface) error {
+	ciliumCRD := GetPregeneratedCRD(CPDCRDName)
+
+	return createUpdateCRD(
+		clientset,
+		CPDCRDName,
+		constructV1CRD(k8sconstv2alpha1.CPDName, ciliumCRD),
+		newDefaultPoller(),
+	)
+}
+
 // createUpdateCRD ensures the CRD object is installed into the K8s cluster. It
 // will create or update the CRD and its validation schema as necessary. This
 // function only accepts v1 CRD objects, and defers to its v1beta1 variant if
@@ -98,6 +98,21 @@ func getEndpointSelector(namespace string, labelSelector *slim_metav1.LabelSelec
 	return es
 }
 
+// getServiceAccounts copies the given service account selectors, scoping
+// entries which do not specify a namespace to the namespace the policy lives
+// in. For clusterwide policies the namespace is left empty so that the
+// selector matches the ServiceAccount name in any namespace.
+func getServiceAccounts(namespace string, serviceAccounts api.ServiceAccountSlice) api.ServiceAccountSlice {
+	ret := make(api.ServiceAccountSlice, len(serviceAccounts))
+	copy(ret, serviceAccounts)
+	for i := range ret {
+		if ret[i].Namespace == "" {
+			ret[i].Namespace = namespace
+		}
+	}
+	return ret
+}
+
 func parseToCiliumIngressCommonRule(namespace string, es api.EndpointSelector, ing api.IngressCommonRule) api.IngressCommonRule {
 	matchesInit := matchesPodInit(es)
 	var retRule api.IngressCommonRule
@@ -131,6 +146,16 @@ func parseToCiliumIngressCommonRule(namespace string, es api.EndpointSelector, i
 		copy(retRule.FromEntities, ing.FromEntities)
 	}
 
+	if ing.FromNodes != nil {
+		// Node selectors are cluster-scoped and are not namespaced.
+		retRule.FromNodes = make(api.NodeSelectorSlice, len(ing.FromNodes))
+		copy(retRule.FromNodes, ing.FromNodes)
+	}
+
+	if ing.FromServiceAccounts != nil {
+		retRule.FromServiceAccounts = getServiceAccounts(namespace, ing.FromServiceAccounts)
+	}
+
 	return retRule
 }
 
@@ -213,6 +238,16 @@ func parseToCiliumEgressCommonRule(namespace string, es api.EndpointSelector, eg
 		copy(retRule.ToEntities, egr.ToEntities)
 	}
 
+	if egr.ToNodes != nil {
+		// Node selectors are cluster-scoped and are not namespaced.
+		retRule.ToNodes = make(api.NodeSelectorSlice, len(egr.ToNodes))
+		copy(retRule.ToNodes, egr.ToNodes)
+	}
+
+	if egr.ToServiceAccounts != nil {
+		retRule.ToServiceAccounts = getServiceAccounts(namespace, egr.ToServiceAccounts)
+	}
+
 	if egr.ToGroups != nil {
 		retRule.ToGroups = make([]api.ToGroups, len(egr.ToGroups))
 		copy(retRule.ToGroups, egr.ToGroups)
@@ -1221,8 +1221,9 @@ func (in *NodeSpec) DeepCopyInto(out *NodeSpec) {
 	out.IngressAddressing = in.IngressAddressing
 	out.Encryption = in.Encryption
 	in.ENI.DeepCopyInto(&out.ENI)
-	out.Azure = in.Azure
+	in.Azure.DeepCopyInto(&out.Azure)
 	in.AlibabaCloud.DeepCopyInto(&out.AlibabaCloud)
+	in.OpenStack.DeepCopyInto(&out.OpenStack)
 	in.IPAM.DeepCopyInto(&out.IPAM)
 	return
 }
@@ -1244,6 +1245,7 @@ func (in *NodeStatus) DeepCopyInto(out *NodeStatus) {
 	in.Azure.DeepCopyInto(&out.Azure)
 	in.IPAM.DeepCopyInto(&out.IPAM)
 	in.AlibabaCloud.DeepCopyInto(&out.AlibabaCloud)
+	in.OpenStack.DeepCopyInto(&out.OpenStack)
 	return
 }
 
@@ -1096,7 +1096,7 @@ func (in *NodeSpec) DeepEqual(other *NodeSpec) bool {
 		return false
 	}
 
-	if in.Azure != other.Azure {
+	if !in.Azure.DeepEqual(&other.Azure) {
 		return false
 	}
 
@@ -1104,6 +1104,10 @@ func (in *NodeSpec) DeepEqual(other *NodeSpec) bool {
 		return false
 	}
 
+	if !in.OpenStack.DeepEqual(&other.OpenStack) {
+		return false
+	}
+
 	if !in.IPAM.DeepEqual(&other.IPAM) {
 		return false
 	}
@@ -1138,6 +1142,10 @@ func (in *NodeStatus) DeepEqual(other *NodeStatus) bool {
 		return false
 	}
 
+	if !in.OpenStack.DeepEqual(&other.OpenStack) {
+		return false
+	}
+
 	return true
 }
 
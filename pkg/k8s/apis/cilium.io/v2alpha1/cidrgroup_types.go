@@ -0,0 +1,58 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package v2alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	api "github.com/cilium/cilium/pkg/policy/api"
+)
+
+// +genclient
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:resource:categories={cilium},singular="ciliumcidrgroup",path="ciliumcidrgroups",scope="Cluster",shortName={ccg}
+// +kubebuilder:object:root=true
+// +kubebuilder:storageversion
+
+// CiliumCIDRGroup is a list of external CIDRs (i.e: CIDRs selecting peers
+// outside the cluster) that can be referenced as a single entity from
+// CiliumNetworkPolicies via a fromCIDRSet cidrGroupRef. Updating the group
+// propagates to all referencing policies without having to duplicate and
+// re-import the CIDR list per policy.
+type CiliumCIDRGroup struct {
+	// +deepequal-gen=false
+	metav1.TypeMeta `json:",inline"`
+	// +deepequal-gen=false
+	metav1.ObjectMeta `json:"metadata"`
+
+	// Spec describes the CIDR group.
+	//
+	// +kubebuilder:validation:Required
+	Spec CiliumCIDRGroupSpec `json:"spec"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +k8s:openapi-gen=false
+// +deepequal-gen=false
+
+// CiliumCIDRGroupList is a list of CiliumCIDRGroup objects.
+type CiliumCIDRGroupList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	// Items is a list of CiliumCIDRGroups.
+	Items []CiliumCIDRGroup `json:"items"`
+}
+
+// +deepequal-gen=true
+
+// CiliumCIDRGroupSpec describes a group of CIDRs.
+type CiliumCIDRGroupSpec struct {
+	// ExternalCIDRs is a list of CIDRs selecting peers outside the cluster.
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinItems=0
+	ExternalCIDRs []api.CIDR `json:"externalCIDRs"`
+}
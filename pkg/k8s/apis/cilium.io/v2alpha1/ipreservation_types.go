@@ -0,0 +1,65 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package v2alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +genclient
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:resource:categories={cilium},singular="ciliumipreservation",path="ciliumipreservations",scope="Cluster",shortName={cir}
+// +kubebuilder:object:root=true
+// +kubebuilder:storageversion
+
+// CiliumIPReservation marks infrastructure addresses, e.g. gateways, VIPs or
+// scanners, as unallocatable. Reserved addresses are rejected at allocation
+// time in all IPAM modes.
+type CiliumIPReservation struct {
+	// +deepequal-gen=false
+	metav1.TypeMeta `json:",inline"`
+	// +deepequal-gen=false
+	metav1.ObjectMeta `json:"metadata"`
+
+	// Spec describes the reserved addresses.
+	//
+	// +kubebuilder:validation:Required
+	Spec IPReservationSpec `json:"spec"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +k8s:openapi-gen=false
+// +deepequal-gen=false
+
+// CiliumIPReservationList is a list of CiliumIPReservation objects.
+type CiliumIPReservationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	// Items is a list of CiliumIPReservations.
+	Items []CiliumIPReservation `json:"items"`
+}
+
+// +deepequal-gen=true
+
+// IPReservationSpec describes a set of reserved addresses.
+type IPReservationSpec struct {
+	// Addresses is the list of reserved addresses. Each entry is a single
+	// IP address or a CIDR.
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinItems=1
+	Addresses []ReservedAddress `json:"addresses"`
+
+	// Description is a human readable description of what the addresses
+	// are reserved for.
+	//
+	// +kubebuilder:validation:Optional
+	Description string `json:"description,omitempty"`
+}
+
+// ReservedAddress is a single IP address or a CIDR which must never be
+// allocated.
+type ReservedAddress string
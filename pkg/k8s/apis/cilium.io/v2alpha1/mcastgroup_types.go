@@ -0,0 +1,61 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package v2alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +genclient
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:resource:categories={cilium},singular="ciliummulticastgroup",path="ciliummulticastgroups",scope="Cluster",shortName={cmg}
+// +kubebuilder:object:root=true
+// +kubebuilder:storageversion
+
+// CiliumMulticastGroup opts a multicast group address into the datapath.
+// Pods may only send to and receive from group addresses listed in a
+// CiliumMulticastGroup, all other multicast traffic is dropped when the
+// multicast datapath is enabled.
+type CiliumMulticastGroup struct {
+	// +deepequal-gen=false
+	metav1.TypeMeta `json:",inline"`
+	// +deepequal-gen=false
+	metav1.ObjectMeta `json:"metadata"`
+
+	// Spec describes the multicast group.
+	//
+	// +kubebuilder:validation:Required
+	Spec MulticastGroupSpec `json:"spec"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +k8s:openapi-gen=false
+// +deepequal-gen=false
+
+// CiliumMulticastGroupList is a list of CiliumMulticastGroup objects.
+type CiliumMulticastGroupList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	// Items is a list of CiliumMulticastGroups.
+	Items []CiliumMulticastGroup `json:"items"`
+}
+
+// +deepequal-gen=true
+
+// MulticastGroupSpec describes a single multicast group.
+type MulticastGroupSpec struct {
+	// GroupIP is the multicast group address, e.g. 239.255.0.1.
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern=`^(2(2[4-9]|3[0-9])\.)([0-9]{1,3}\.){2}[0-9]{1,3}$`
+	GroupIP string `json:"groupIP"`
+
+	// Description is a human readable description of what the group is
+	// used for.
+	//
+	// +kubebuilder:validation:Optional
+	Description string `json:"description,omitempty"`
+}
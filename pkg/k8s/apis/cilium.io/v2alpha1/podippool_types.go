@@ -0,0 +1,123 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package v2alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	slimv1 "github.com/cilium/cilium/pkg/k8s/slim/k8s/apis/meta/v1"
+)
+
+// +genclient
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:resource:categories={cilium},singular="ciliumpodippool",path="ciliumpodippools",scope="Cluster",shortName={cpip}
+// +kubebuilder:object:root=true
+// +kubebuilder:storageversion
+
+// CiliumPodIPPool defines an IP pool from which pod IPs can be allocated. Pods
+// select the pool they draw their IPs from via the `ipam.cilium.io/ip-pool`
+// annotation or via the pool's pod selector.
+type CiliumPodIPPool struct {
+	// +deepequal-gen=false
+	metav1.TypeMeta `json:",inline"`
+	// +deepequal-gen=false
+	metav1.ObjectMeta `json:"metadata"`
+
+	// Spec describes the CIDRs, per-node allocation mask sizes and routing
+	// configuration of this pool.
+	//
+	// +kubebuilder:validation:Required
+	Spec IPPoolSpec `json:"spec"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +k8s:openapi-gen=false
+// +deepequal-gen=false
+
+// CiliumPodIPPoolList is a list of CiliumPodIPPool objects.
+type CiliumPodIPPoolList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	// Items is a list of CiliumPodIPPools.
+	Items []CiliumPodIPPool `json:"items"`
+}
+
+// +deepequal-gen=true
+
+// IPPoolSpec describes a pod IP pool.
+type IPPoolSpec struct {
+	// IPv4 specifies the IPv4 CIDRs and mask sizes of the pool.
+	//
+	// +kubebuilder:validation:Optional
+	IPv4 *IPv4PoolSpec `json:"ipv4"`
+
+	// IPv6 specifies the IPv6 CIDRs and mask sizes of the pool.
+	//
+	// +kubebuilder:validation:Optional
+	IPv6 *IPv6PoolSpec `json:"ipv6"`
+
+	// PodSelector selects the pods eligible to receive IPs from this pool.
+	// Pods may also select a pool explicitly via the
+	// `ipam.cilium.io/ip-pool` annotation, which takes precedence over the
+	// selector.
+	//
+	// +kubebuilder:validation:Optional
+	PodSelector *slimv1.LabelSelector `json:"podSelector"`
+
+	// Routing configures how IPs allocated from this pool are routed.
+	//
+	// +kubebuilder:validation:Optional
+	Routing *PoolRouting `json:"routing"`
+}
+
+// IPv4PoolSpec defines the IPv4 CIDRs and mask sizes of an IP pool.
+type IPv4PoolSpec struct {
+	// CIDRs is a list of IPv4 CIDRs that are part of the pool.
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinItems=1
+	CIDRs []PoolCIDR `json:"cidrs"`
+
+	// MaskSize is the mask size of the IPv4 CIDRs allocated to each node
+	// from this pool.
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=32
+	MaskSize uint8 `json:"maskSize"`
+}
+
+// IPv6PoolSpec defines the IPv6 CIDRs and mask sizes of an IP pool.
+type IPv6PoolSpec struct {
+	// CIDRs is a list of IPv6 CIDRs that are part of the pool.
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinItems=1
+	CIDRs []PoolCIDR `json:"cidrs"`
+
+	// MaskSize is the mask size of the IPv6 CIDRs allocated to each node
+	// from this pool.
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=128
+	MaskSize uint8 `json:"maskSize"`
+}
+
+// PoolCIDR is an IP pool CIDR.
+//
+// +kubebuilder:validation:Format=cidr
+type PoolCIDR string
+
+// PoolRouting configures how IPs allocated from a pool are routed.
+type PoolRouting struct {
+	// Mode is the datapath routing mode used for CIDRs of this pool. If
+	// unset, the routing mode of the agent applies.
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=native;tunnel
+	Mode string `json:"mode,omitempty"`
+}
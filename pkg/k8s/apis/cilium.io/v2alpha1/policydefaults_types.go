@@ -0,0 +1,107 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package v2alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:resource:categories={cilium,ciliumpolicy},singular="ciliumpolicydefaults",path="ciliumpolicydefaults",scope="Namespaced",shortName={cpd}
+// +kubebuilder:object:root=true
+// +kubebuilder:storageversion
+
+// CiliumPolicyDefaults sets the default policy posture for all pods in the
+// namespace it is created in. It provides a per-namespace baseline which
+// applies without any pod having to be selected by an individual policy.
+type CiliumPolicyDefaults struct {
+	// +deepequal-gen=false
+	metav1.TypeMeta `json:",inline"`
+	// +deepequal-gen=false
+	metav1.ObjectMeta `json:"metadata"`
+
+	// Spec is the desired policy defaults for the namespace.
+	//
+	// +kubebuilder:validation:Required
+	Spec PolicyDefaultsSpec `json:"spec"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +k8s:openapi-gen=false
+// +deepequal-gen=false
+
+// CiliumPolicyDefaultsList is a list of CiliumPolicyDefaults objects.
+type CiliumPolicyDefaultsList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	// Items is a list of CiliumPolicyDefaults.
+	Items []CiliumPolicyDefaults `json:"items"`
+}
+
+// PolicyPosture is the default policy enforcement posture for a direction of
+// traffic.
+//
+// +kubebuilder:validation:Enum=allow;deny
+type PolicyPosture string
+
+const (
+	// PolicyPostureAllow leaves traffic in the direction allowed unless an
+	// individual policy selects the pod.
+	PolicyPostureAllow PolicyPosture = "allow"
+
+	// PolicyPostureDeny denies traffic in the direction unless it is
+	// allowed by an individual policy or listed in AllowSystemFlows.
+	PolicyPostureDeny PolicyPosture = "deny"
+)
+
+// SystemFlow is a well-known class of traffic required for pods to function
+// in most clusters.
+//
+// +kubebuilder:validation:Enum=dns;kube-apiserver
+type SystemFlow string
+
+const (
+	// SystemFlowDNS allows egress DNS traffic on port 53.
+	SystemFlowDNS SystemFlow = "dns"
+
+	// SystemFlowKubeAPIServer allows egress traffic to the kube-apiserver.
+	SystemFlowKubeAPIServer SystemFlow = "kube-apiserver"
+)
+
+// +deepequal-gen=true
+
+// PolicyDefaultsSpec is the default policy posture for the pods of a
+// namespace.
+type PolicyDefaultsSpec struct {
+	// Ingress is the default ingress posture for pods in the namespace.
+	// When set to deny, ingress traffic to all pods in the namespace is
+	// denied unless allowed by an individual policy.
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=allow
+	Ingress PolicyPosture `json:"ingress,omitempty"`
+
+	// Egress is the default egress posture for pods in the namespace.
+	// When set to deny, egress traffic of all pods in the namespace is
+	// denied unless allowed by an individual policy or listed in
+	// AllowSystemFlows.
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=allow
+	Egress PolicyPosture `json:"egress,omitempty"`
+
+	// AllowSystemFlows lists well-known system flows which remain allowed
+	// for all pods in the namespace regardless of the posture.
+	//
+	// +kubebuilder:validation:Optional
+	AllowSystemFlows []SystemFlow `json:"allowSystemFlows,omitempty"`
+
+	// AuditMode reports traffic that would be denied by the posture
+	// instead of dropping it.
+	//
+	// +kubebuilder:validation:Optional
+	AuditMode bool `json:"auditMode,omitempty"`
+}
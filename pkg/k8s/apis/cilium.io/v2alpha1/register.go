@@ -23,7 +23,7 @@ const (
 	//
 	// Maintainers: Run ./Documentation/check-crd-compat-table.sh for each release
 	// Developers: Bump patch for each change in the CRD schema.
-	CustomResourceDefinitionSchemaVersion = "1.27.0"
+	CustomResourceDefinitionSchemaVersion = "1.27.1"
 
 	// CustomResourceDefinitionSchemaVersionKey is key to label which holds the CRD schema version
 	CustomResourceDefinitionSchemaVersionKey = "io.cilium.k8s.crd.schema.version"
@@ -74,6 +74,90 @@ const (
 	CNCPluralName     = "ciliumnodeconfigs"
 	CNCKindDefinition = "CiliumNodeConfig"
 	CNCName           = CNCPluralName + "." + CustomResourceDefinitionGroup
+
+	// Cilium Pod IP Pool (CPIP)
+
+	// CPIPSingularName is the singular name of Cilium Pod IP Pool
+	CPIPSingularName = "ciliumpodippool"
+
+	// CPIPPluralName is the plural name of Cilium Pod IP Pool
+	CPIPPluralName = "ciliumpodippools"
+
+	// CPIPKindDefinition is the kind name of Cilium Pod IP Pool
+	CPIPKindDefinition = "CiliumPodIPPool"
+
+	// CPIPName is the full name of Cilium Pod IP Pool
+	CPIPName = CPIPPluralName + "." + CustomResourceDefinitionGroup
+
+	// Cilium IP Reservation (CIR)
+
+	// CIRSingularName is the singular name of Cilium IP Reservation
+	CIRSingularName = "ciliumipreservation"
+
+	// CIRPluralName is the plural name of Cilium IP Reservation
+	CIRPluralName = "ciliumipreservations"
+
+	// CIRKindDefinition is the kind name of Cilium IP Reservation
+	CIRKindDefinition = "CiliumIPReservation"
+
+	// CIRName is the full name of Cilium IP Reservation
+	CIRName = CIRPluralName + "." + CustomResourceDefinitionGroup
+
+	// Cilium Multicast Group (CMG)
+
+	// CMGSingularName is the singular name of Cilium Multicast Group
+	CMGSingularName = "ciliummulticastgroup"
+
+	// CMGPluralName is the plural name of Cilium Multicast Group
+	CMGPluralName = "ciliummulticastgroups"
+
+	// CMGKindDefinition is the kind name of Cilium Multicast Group
+	CMGKindDefinition = "CiliumMulticastGroup"
+
+	// CMGName is the full name of Cilium Multicast Group
+	CMGName = CMGPluralName + "." + CustomResourceDefinitionGroup
+
+	// Cilium XDP Filter (CXF)
+
+	// CXFSingularName is the singular name of Cilium XDP Filter
+	CXFSingularName = "ciliumxdpfilter"
+
+	// CXFPluralName is the plural name of Cilium XDP Filter
+	CXFPluralName = "ciliumxdpfilters"
+
+	// CXFKindDefinition is the kind name of Cilium XDP Filter
+	CXFKindDefinition = "CiliumXDPFilter"
+
+	// CXFName is the full name of Cilium XDP Filter
+	CXFName = CXFPluralName + "." + CustomResourceDefinitionGroup
+
+	// Cilium Policy Defaults (CPD)
+
+	// CPDSingularName is the singular name of Cilium Policy Defaults
+	CPDSingularName = "ciliumpolicydefaults"
+
+	// CPDPluralName is the plural name of Cilium Policy Defaults
+	CPDPluralName = "ciliumpolicydefaults"
+
+	// CPDKindDefinition is the kind name of Cilium Policy Defaults
+	CPDKindDefinition = "CiliumPolicyDefaults"
+
+	// CPDName is the full name of Cilium Policy Defaults
+	CPDName = CPDPluralName + "." + CustomResourceDefinitionGroup
+
+	// Cilium CIDR Group (CCG)
+
+	// CCGSingularName is the singular name of Cilium CIDR Group
+	CCGSingularName = "ciliumcidrgroup"
+
+	// CCGPluralName is the plural name of Cilium CIDR Group
+	CCGPluralName = "ciliumcidrgroups"
+
+	// CCGKindDefinition is the kind name of Cilium CIDR Group
+	CCGKindDefinition = "CiliumCIDRGroup"
+
+	// CCGName is the full name of Cilium CIDR Group
+	CCGName = CCGPluralName + "." + CustomResourceDefinitionGroup
 )
 
 // SchemeGroupVersion is group version used to register these objects
@@ -125,6 +209,18 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&CiliumLoadBalancerIPPoolList{},
 		&CiliumNodeConfig{},
 		&CiliumNodeConfigList{},
+		&CiliumPodIPPool{},
+		&CiliumPodIPPoolList{},
+		&CiliumIPReservation{},
+		&CiliumIPReservationList{},
+		&CiliumMulticastGroup{},
+		&CiliumMulticastGroupList{},
+		&CiliumXDPFilter{},
+		&CiliumXDPFilterList{},
+		&CiliumCIDRGroup{},
+		&CiliumCIDRGroupList{},
+		&CiliumPolicyDefaults{},
+		&CiliumPolicyDefaultsList{},
 	)
 
 	metav1.AddToGroupVersion(scheme, SchemeGroupVersion)
@@ -0,0 +1,89 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package v2alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +genclient
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:resource:categories={cilium},singular="ciliumxdpfilter",path="ciliumxdpfilters",scope="Cluster",shortName={cxf}
+// +kubebuilder:object:root=true
+// +kubebuilder:storageversion
+
+// CiliumXDPFilter programs drop rules into the XDP prefilter, ahead of the
+// main datapath. It is intended for absorbing volumetric attacks against
+// node IPs and NodePorts on kernels with native or offloaded XDP support.
+type CiliumXDPFilter struct {
+	// +deepequal-gen=false
+	metav1.TypeMeta `json:",inline"`
+	// +deepequal-gen=false
+	metav1.ObjectMeta `json:"metadata"`
+
+	// Spec describes the traffic to drop at XDP.
+	//
+	// +kubebuilder:validation:Required
+	Spec XDPFilterSpec `json:"spec"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +k8s:openapi-gen=false
+// +deepequal-gen=false
+
+// CiliumXDPFilterList is a list of CiliumXDPFilter objects.
+type CiliumXDPFilterList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	// Items is a list of CiliumXDPFilters.
+	Items []CiliumXDPFilter `json:"items"`
+}
+
+// +deepequal-gen=true
+
+// XDPFilterSpec describes traffic which is dropped at XDP before it reaches
+// the main datapath.
+type XDPFilterSpec struct {
+	// SourceCIDRs is a list of IPv4 or IPv6 CIDRs. Traffic originating
+	// from these prefixes is dropped.
+	//
+	// +kubebuilder:validation:Optional
+	SourceCIDRs []string `json:"sourceCIDRs,omitempty"`
+
+	// Ports is a list of destination ports for which traffic is dropped.
+	//
+	// +kubebuilder:validation:Optional
+	Ports []XDPPortFilter `json:"ports,omitempty"`
+}
+
+// XDPPortFilter selects traffic towards a single destination port.
+type XDPPortFilter struct {
+	// Port is the destination port to drop traffic for.
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	Port uint16 `json:"port"`
+
+	// Protocol is the L4 protocol of the traffic to drop.
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Enum=TCP;UDP
+	Protocol string `json:"protocol"`
+
+	// TCPFlags restricts the drop to TCP segments which have at least one
+	// of the given flags set, e.g. SYN to absorb SYN floods while keeping
+	// established connections alive. An empty list drops all segments.
+	// Only valid with protocol TCP.
+	//
+	// +kubebuilder:validation:Optional
+	TCPFlags []TCPFlag `json:"tcpFlags,omitempty"`
+}
+
+// TCPFlag is a single TCP flag name.
+//
+// +kubebuilder:validation:Enum=SYN;ACK;FIN;RST;PSH;URG
+type TCPFlag string
@@ -115,6 +115,47 @@ func (in *CiliumBGPVirtualRouter) DeepEqual(other *CiliumBGPVirtualRouter) bool
 	return true
 }
 
+// DeepEqual is an autogenerated deepequal function, deeply comparing the
+// receiver with other. in must be non-nil.
+func (in *CiliumCIDRGroup) DeepEqual(other *CiliumCIDRGroup) bool {
+	if other == nil {
+		return false
+	}
+
+	if !in.Spec.DeepEqual(&other.Spec) {
+		return false
+	}
+
+	return true
+}
+
+// DeepEqual is an autogenerated deepequal function, deeply comparing the
+// receiver with other. in must be non-nil.
+func (in *CiliumCIDRGroupSpec) DeepEqual(other *CiliumCIDRGroupSpec) bool {
+	if other == nil {
+		return false
+	}
+
+	if ((in.ExternalCIDRs != nil) && (other.ExternalCIDRs != nil)) || ((in.ExternalCIDRs == nil) != (other.ExternalCIDRs == nil)) {
+		in, other := &in.ExternalCIDRs, &other.ExternalCIDRs
+		if other == nil {
+			return false
+		}
+
+		if len(*in) != len(*other) {
+			return false
+		} else {
+			for i, inElement := range *in {
+				if inElement != (*other)[i] {
+					return false
+				}
+			}
+		}
+	}
+
+	return true
+}
+
 // DeepEqual is an autogenerated deepequal function, deeply comparing the
 // receiver with other. in must be non-nil.
 func (in *CiliumEndpointSlice) DeepEqual(other *CiliumEndpointSlice) bool {
@@ -212,6 +253,34 @@ func (in *CiliumLoadBalancerIPPoolSpec) DeepEqual(other *CiliumLoadBalancerIPPoo
 	return true
 }
 
+// DeepEqual is an autogenerated deepequal function, deeply comparing the
+// receiver with other. in must be non-nil.
+func (in *CiliumPodIPPool) DeepEqual(other *CiliumPodIPPool) bool {
+	if other == nil {
+		return false
+	}
+
+	if !in.Spec.DeepEqual(&other.Spec) {
+		return false
+	}
+
+	return true
+}
+
+// DeepEqual is an autogenerated deepequal function, deeply comparing the
+// receiver with other. in must be non-nil.
+func (in *CiliumPolicyDefaults) DeepEqual(other *CiliumPolicyDefaults) bool {
+	if other == nil {
+		return false
+	}
+
+	if !in.Spec.DeepEqual(&other.Spec) {
+		return false
+	}
+
+	return true
+}
+
 // DeepEqual is an autogenerated deepequal function, deeply comparing the
 // receiver with other. in must be non-nil.
 func (in *CoreCiliumEndpoint) DeepEqual(other *CoreCiliumEndpoint) bool {
@@ -282,3 +351,283 @@ func (in *EgressRule) DeepEqual(other *EgressRule) bool {
 
 	return true
 }
+
+// DeepEqual is an autogenerated deepequal function, deeply comparing the
+// receiver with other. in must be non-nil.
+func (in *IPReservationSpec) DeepEqual(other *IPReservationSpec) bool {
+	if other == nil {
+		return false
+	}
+
+	if ((in.Addresses != nil) && (other.Addresses != nil)) || ((in.Addresses == nil) != (other.Addresses == nil)) {
+		in, other := &in.Addresses, &other.Addresses
+		if other == nil {
+			return false
+		}
+
+		if len(*in) != len(*other) {
+			return false
+		} else {
+			for i, inElement := range *in {
+				if inElement != (*other)[i] {
+					return false
+				}
+			}
+		}
+	}
+
+	if in.Description != other.Description {
+		return false
+	}
+
+	return true
+}
+
+// DeepEqual is an autogenerated deepequal function, deeply comparing the
+// receiver with other. in must be non-nil.
+func (in *IPPoolSpec) DeepEqual(other *IPPoolSpec) bool {
+	if other == nil {
+		return false
+	}
+
+	if (in.IPv4 == nil) != (other.IPv4 == nil) {
+		return false
+	} else if in.IPv4 != nil {
+		if !in.IPv4.DeepEqual(other.IPv4) {
+			return false
+		}
+	}
+
+	if (in.IPv6 == nil) != (other.IPv6 == nil) {
+		return false
+	} else if in.IPv6 != nil {
+		if !in.IPv6.DeepEqual(other.IPv6) {
+			return false
+		}
+	}
+
+	if (in.PodSelector == nil) != (other.PodSelector == nil) {
+		return false
+	} else if in.PodSelector != nil {
+		if !in.PodSelector.DeepEqual(other.PodSelector) {
+			return false
+		}
+	}
+
+	if (in.Routing == nil) != (other.Routing == nil) {
+		return false
+	} else if in.Routing != nil {
+		if !in.Routing.DeepEqual(other.Routing) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// DeepEqual is an autogenerated deepequal function, deeply comparing the
+// receiver with other. in must be non-nil.
+func (in *IPv4PoolSpec) DeepEqual(other *IPv4PoolSpec) bool {
+	if other == nil {
+		return false
+	}
+
+	if ((in.CIDRs != nil) && (other.CIDRs != nil)) || ((in.CIDRs == nil) != (other.CIDRs == nil)) {
+		in, other := &in.CIDRs, &other.CIDRs
+		if other == nil {
+			return false
+		}
+
+		if len(*in) != len(*other) {
+			return false
+		} else {
+			for i, inElement := range *in {
+				if inElement != (*other)[i] {
+					return false
+				}
+			}
+		}
+	}
+
+	if in.MaskSize != other.MaskSize {
+		return false
+	}
+
+	return true
+}
+
+// DeepEqual is an autogenerated deepequal function, deeply comparing the
+// receiver with other. in must be non-nil.
+func (in *IPv6PoolSpec) DeepEqual(other *IPv6PoolSpec) bool {
+	if other == nil {
+		return false
+	}
+
+	if ((in.CIDRs != nil) && (other.CIDRs != nil)) || ((in.CIDRs == nil) != (other.CIDRs == nil)) {
+		in, other := &in.CIDRs, &other.CIDRs
+		if other == nil {
+			return false
+		}
+
+		if len(*in) != len(*other) {
+			return false
+		} else {
+			for i, inElement := range *in {
+				if inElement != (*other)[i] {
+					return false
+				}
+			}
+		}
+	}
+
+	if in.MaskSize != other.MaskSize {
+		return false
+	}
+
+	return true
+}
+
+// DeepEqual is an autogenerated deepequal function, deeply comparing the
+// receiver with other. in must be non-nil.
+func (in *MulticastGroupSpec) DeepEqual(other *MulticastGroupSpec) bool {
+	if other == nil {
+		return false
+	}
+
+	if in.GroupIP != other.GroupIP {
+		return false
+	}
+	if in.Description != other.Description {
+		return false
+	}
+
+	return true
+}
+
+// DeepEqual is an autogenerated deepequal function, deeply comparing the
+// receiver with other. in must be non-nil.
+func (in *PolicyDefaultsSpec) DeepEqual(other *PolicyDefaultsSpec) bool {
+	if other == nil {
+		return false
+	}
+
+	if in.Ingress != other.Ingress {
+		return false
+	}
+	if in.Egress != other.Egress {
+		return false
+	}
+	if ((in.AllowSystemFlows != nil) && (other.AllowSystemFlows != nil)) || ((in.AllowSystemFlows == nil) != (other.AllowSystemFlows == nil)) {
+		in, other := &in.AllowSystemFlows, &other.AllowSystemFlows
+		if other == nil {
+			return false
+		}
+
+		if len(*in) != len(*other) {
+			return false
+		} else {
+			for i, inElement := range *in {
+				if inElement != (*other)[i] {
+					return false
+				}
+			}
+		}
+	}
+
+	if in.AuditMode != other.AuditMode {
+		return false
+	}
+
+	return true
+}
+
+// DeepEqual is an autogenerated deepequal function, deeply comparing the
+// receiver with other. in must be non-nil.
+func (in *PoolRouting) DeepEqual(other *PoolRouting) bool {
+	if other == nil {
+		return false
+	}
+
+	if in.Mode != other.Mode {
+		return false
+	}
+
+	return true
+}
+
+// DeepEqual is an autogenerated deepequal function, deeply comparing the
+// receiver with other. in must be non-nil.
+func (in *XDPFilterSpec) DeepEqual(other *XDPFilterSpec) bool {
+	if other == nil {
+		return false
+	}
+
+	if ((in.SourceCIDRs != nil) && (other.SourceCIDRs != nil)) || ((in.SourceCIDRs == nil) != (other.SourceCIDRs == nil)) {
+		in, other := &in.SourceCIDRs, &other.SourceCIDRs
+		if other == nil {
+			return false
+		}
+
+		if len(*in) != len(*other) {
+			return false
+		} else {
+			for i, inElement := range *in {
+				if inElement != (*other)[i] {
+					return false
+				}
+			}
+		}
+	}
+
+	if ((in.Ports != nil) && (other.Ports != nil)) || ((in.Ports == nil) != (other.Ports == nil)) {
+		in, other := &in.Ports, &other.Ports
+		if other == nil {
+			return false
+		}
+
+		if len(*in) != len(*other) {
+			return false
+		} else {
+			for i, inElement := range *in {
+				if !inElement.DeepEqual(&(*other)[i]) {
+					return false
+				}
+			}
+		}
+	}
+
+	return true
+}
+
+// DeepEqual is an autogenerated deepequal function, deeply comparing the
+// receiver with other. in must be non-nil.
+func (in *XDPPortFilter) DeepEqual(other *XDPPortFilter) bool {
+	if other == nil {
+		return false
+	}
+
+	if in.Port != other.Port {
+		return false
+	}
+	if in.Protocol != other.Protocol {
+		return false
+	}
+	if ((in.TCPFlags != nil) && (other.TCPFlags != nil)) || ((in.TCPFlags == nil) != (other.TCPFlags == nil)) {
+		in, other := &in.TCPFlags, &other.TCPFlags
+		if other == nil {
+			return false
+		}
+
+		if len(*in) != len(*other) {
+			return false
+		} else {
+			for i, inElement := range *in {
+				if inElement != (*other)[i] {
+					return false
+				}
+			}
+		}
+	}
+
+	return true
+}
@@ -19,6 +19,12 @@ type CiliumV2alpha1Interface interface {
 	CiliumEndpointSlicesGetter
 	CiliumLoadBalancerIPPoolsGetter
 	CiliumNodeConfigsGetter
+	CiliumIPReservationsGetter
+	CiliumMulticastGroupsGetter
+	CiliumXDPFiltersGetter
+	CiliumCIDRGroupsGetter
+	CiliumPodIPPoolsGetter
+	CiliumPolicyDefaultsGetter
 }
 
 // CiliumV2alpha1Client is used to interact with features provided by the cilium.io group.
@@ -42,6 +48,30 @@ func (c *CiliumV2alpha1Client) CiliumNodeConfigs(namespace string) CiliumNodeCon
 	return newCiliumNodeConfigs(c, namespace)
 }
 
+func (c *CiliumV2alpha1Client) CiliumIPReservations() CiliumIPReservationInterface {
+	return newCiliumIPReservations(c)
+}
+
+func (c *CiliumV2alpha1Client) CiliumMulticastGroups() CiliumMulticastGroupInterface {
+	return newCiliumMulticastGroups(c)
+}
+
+func (c *CiliumV2alpha1Client) CiliumXDPFilters() CiliumXDPFilterInterface {
+	return newCiliumXDPFilters(c)
+}
+
+func (c *CiliumV2alpha1Client) CiliumCIDRGroups() CiliumCIDRGroupInterface {
+	return newCiliumCIDRGroups(c)
+}
+
+func (c *CiliumV2alpha1Client) CiliumPodIPPools() CiliumPodIPPoolInterface {
+	return newCiliumPodIPPools(c)
+}
+
+func (c *CiliumV2alpha1Client) CiliumPolicyDefaults(namespace string) CiliumPolicyDefaultsInterface {
+	return newCiliumPolicyDefaults(c, namespace)
+}
+
 // NewForConfig creates a new CiliumV2alpha1Client for the given config.
 // NewForConfig is equivalent to NewForConfigAndClient(c, httpClient),
 // where httpClient was generated with rest.HTTPClientFor(c).
@@ -31,6 +31,30 @@ func (c *FakeCiliumV2alpha1) CiliumNodeConfigs(namespace string) v2alpha1.Cilium
 	return &FakeCiliumNodeConfigs{c, namespace}
 }
 
+func (c *FakeCiliumV2alpha1) CiliumIPReservations() v2alpha1.CiliumIPReservationInterface {
+	return &FakeCiliumIPReservations{c}
+}
+
+func (c *FakeCiliumV2alpha1) CiliumMulticastGroups() v2alpha1.CiliumMulticastGroupInterface {
+	return &FakeCiliumMulticastGroups{c}
+}
+
+func (c *FakeCiliumV2alpha1) CiliumXDPFilters() v2alpha1.CiliumXDPFilterInterface {
+	return &FakeCiliumXDPFilters{c}
+}
+
+func (c *FakeCiliumV2alpha1) CiliumCIDRGroups() v2alpha1.CiliumCIDRGroupInterface {
+	return &FakeCiliumCIDRGroups{c}
+}
+
+func (c *FakeCiliumV2alpha1) CiliumPodIPPools() v2alpha1.CiliumPodIPPoolInterface {
+	return &FakeCiliumPodIPPools{c}
+}
+
+func (c *FakeCiliumV2alpha1) CiliumPolicyDefaults(namespace string) v2alpha1.CiliumPolicyDefaultsInterface {
+	return &FakeCiliumPolicyDefaults{c, namespace}
+}
+
 // RESTClient returns a RESTClient that is used to communicate
 // with API server by this client implementation.
 func (c *FakeCiliumV2alpha1) RESTClient() rest.Interface {
@@ -12,3 +12,15 @@ type CiliumEndpointSliceExpansion interface{}
 type CiliumLoadBalancerIPPoolExpansion interface{}
 
 type CiliumNodeConfigExpansion interface{}
+
+type CiliumIPReservationExpansion interface{}
+
+type CiliumMulticastGroupExpansion interface{}
+
+type CiliumXDPFilterExpansion interface{}
+
+type CiliumCIDRGroupExpansion interface{}
+
+type CiliumPodIPPoolExpansion interface{}
+
+type CiliumPolicyDefaultsExpansion interface{}
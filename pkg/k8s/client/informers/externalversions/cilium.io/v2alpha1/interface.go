@@ -19,6 +19,18 @@ type Interface interface {
 	CiliumLoadBalancerIPPools() CiliumLoadBalancerIPPoolInformer
 	// CiliumNodeConfigs returns a CiliumNodeConfigInformer.
 	CiliumNodeConfigs() CiliumNodeConfigInformer
+	// CiliumIPReservations returns a CiliumIPReservationInformer.
+	CiliumIPReservations() CiliumIPReservationInformer
+	// CiliumMulticastGroups returns a CiliumMulticastGroupInformer.
+	CiliumMulticastGroups() CiliumMulticastGroupInformer
+	// CiliumXDPFilters returns a CiliumXDPFilterInformer.
+	CiliumXDPFilters() CiliumXDPFilterInformer
+	// CiliumCIDRGroups returns a CiliumCIDRGroupInformer.
+	CiliumCIDRGroups() CiliumCIDRGroupInformer
+	// CiliumPodIPPools returns a CiliumPodIPPoolInformer.
+	CiliumPodIPPools() CiliumPodIPPoolInformer
+	// CiliumPolicyDefaults returns a CiliumPolicyDefaultsInformer.
+	CiliumPolicyDefaults() CiliumPolicyDefaultsInformer
 }
 
 type version struct {
@@ -51,3 +63,33 @@ func (v *version) CiliumLoadBalancerIPPools() CiliumLoadBalancerIPPoolInformer {
 func (v *version) CiliumNodeConfigs() CiliumNodeConfigInformer {
 	return &ciliumNodeConfigInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
+
+// CiliumIPReservations returns a CiliumIPReservationInformer.
+func (v *version) CiliumIPReservations() CiliumIPReservationInformer {
+	return &ciliumIPReservationInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
+// CiliumMulticastGroups returns a CiliumMulticastGroupInformer.
+func (v *version) CiliumMulticastGroups() CiliumMulticastGroupInformer {
+	return &ciliumMulticastGroupInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
+// CiliumXDPFilters returns a CiliumXDPFilterInformer.
+func (v *version) CiliumXDPFilters() CiliumXDPFilterInformer {
+	return &ciliumXDPFilterInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
+// CiliumCIDRGroups returns a CiliumCIDRGroupInformer.
+func (v *version) CiliumCIDRGroups() CiliumCIDRGroupInformer {
+	return &ciliumCIDRGroupInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
+// CiliumPodIPPools returns a CiliumPodIPPoolInformer.
+func (v *version) CiliumPodIPPools() CiliumPodIPPoolInformer {
+	return &ciliumPodIPPoolInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
+// CiliumPolicyDefaults returns a CiliumPolicyDefaultsInformer.
+func (v *version) CiliumPolicyDefaults() CiliumPolicyDefaultsInformer {
+	return &ciliumPolicyDefaultsInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
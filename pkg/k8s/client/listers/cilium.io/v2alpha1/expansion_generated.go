@@ -24,3 +24,31 @@ type CiliumNodeConfigListerExpansion interface{}
 // CiliumNodeConfigNamespaceListerExpansion allows custom methods to be added to
 // CiliumNodeConfigNamespaceLister.
 type CiliumNodeConfigNamespaceListerExpansion interface{}
+
+// CiliumIPReservationListerExpansion allows custom methods to be added to
+// CiliumIPReservationLister.
+type CiliumIPReservationListerExpansion interface{}
+
+// CiliumMulticastGroupListerExpansion allows custom methods to be added to
+// CiliumMulticastGroupLister.
+type CiliumMulticastGroupListerExpansion interface{}
+
+// CiliumXDPFilterListerExpansion allows custom methods to be added to
+// CiliumXDPFilterLister.
+type CiliumXDPFilterListerExpansion interface{}
+
+// CiliumCIDRGroupListerExpansion allows custom methods to be added to
+// CiliumCIDRGroupLister.
+type CiliumCIDRGroupListerExpansion interface{}
+
+// CiliumPodIPPoolListerExpansion allows custom methods to be added to
+// CiliumPodIPPoolLister.
+type CiliumPodIPPoolListerExpansion interface{}
+
+// CiliumPolicyDefaultsListerExpansion allows custom methods to be added to
+// CiliumPolicyDefaultsLister.
+type CiliumPolicyDefaultsListerExpansion interface{}
+
+// CiliumPolicyDefaultsNamespaceListerExpansion allows custom methods to be added to
+// CiliumPolicyDefaultsNamespaceLister.
+type CiliumPolicyDefaultsNamespaceListerExpansion interface{}
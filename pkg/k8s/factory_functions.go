@@ -834,6 +834,54 @@ func ConvertToCiliumEgressGatewayPolicy(obj interface{}) interface{} {
 	}
 }
 
+// ConvertToCiliumCIDRGroup converts a *cilium_v2alpha1.CiliumCIDRGroup into a
+// *cilium_v2alpha1.CiliumCIDRGroup or a cache.DeletedFinalStateUnknown into
+// a cache.DeletedFinalStateUnknown with a *cilium_v2alpha1.CiliumCIDRGroup in
+// its Obj. If the given obj can't be cast into either
+// *cilium_v2alpha1.CiliumCIDRGroup nor cache.DeletedFinalStateUnknown, the
+// original obj is returned.
+func ConvertToCiliumCIDRGroup(obj interface{}) interface{} {
+	switch concreteObj := obj.(type) {
+	case *cilium_v2alpha1.CiliumCIDRGroup:
+		return concreteObj
+	case cache.DeletedFinalStateUnknown:
+		ciliumCIDRGroup, ok := concreteObj.Obj.(*cilium_v2alpha1.CiliumCIDRGroup)
+		if !ok {
+			return obj
+		}
+		return cache.DeletedFinalStateUnknown{
+			Key: concreteObj.Key,
+			Obj: ciliumCIDRGroup,
+		}
+	default:
+		return obj
+	}
+}
+
+// ConvertToCiliumPolicyDefaults converts a *cilium_v2alpha1.CiliumPolicyDefaults
+// into a *cilium_v2alpha1.CiliumPolicyDefaults or a cache.DeletedFinalStateUnknown
+// into a cache.DeletedFinalStateUnknown with a *cilium_v2alpha1.CiliumPolicyDefaults
+// in its Obj. If the given obj can't be cast into either
+// *cilium_v2alpha1.CiliumPolicyDefaults nor cache.DeletedFinalStateUnknown, the
+// original obj is returned.
+func ConvertToCiliumPolicyDefaults(obj interface{}) interface{} {
+	switch concreteObj := obj.(type) {
+	case *cilium_v2alpha1.CiliumPolicyDefaults:
+		return concreteObj
+	case cache.DeletedFinalStateUnknown:
+		ciliumPolicyDefaults, ok := concreteObj.Obj.(*cilium_v2alpha1.CiliumPolicyDefaults)
+		if !ok {
+			return obj
+		}
+		return cache.DeletedFinalStateUnknown{
+			Key: concreteObj.Key,
+			Obj: ciliumPolicyDefaults,
+		}
+	default:
+		return obj
+	}
+}
+
 // ConvertToCiliumClusterwideEnvoyConfig converts a *cilium_v2.CiliumClusterwideEnvoyConfig into a
 // *cilium_v2.CiliumClusterwideEnvoyConfig or a cache.DeletedFinalStateUnknown into
 // a cache.DeletedFinalStateUnknown with a *cilium_v2.CiliumClusterwideEnvoyConfig in its Obj.
@@ -1039,6 +1087,50 @@ func ObjToCEGP(obj interface{}) *cilium_v2.CiliumEgressGatewayPolicy {
 	return nil
 }
 
+// ObjToCCG attempts to cast object to a CiliumCIDRGroup object
+// and returns the object if the cast succeeds. Otherwise, nil is returned.
+func ObjToCCG(obj interface{}) *cilium_v2alpha1.CiliumCIDRGroup {
+	ccg, ok := obj.(*cilium_v2alpha1.CiliumCIDRGroup)
+	if ok {
+		return ccg
+	}
+	deletedObj, ok := obj.(cache.DeletedFinalStateUnknown)
+	if ok {
+		// Delete was not observed by the watcher but is
+		// removed from kube-apiserver. This is the last
+		// known state and the object no longer exists.
+		ccg, ok := deletedObj.Obj.(*cilium_v2alpha1.CiliumCIDRGroup)
+		if ok {
+			return ccg
+		}
+	}
+	log.WithField(logfields.Object, logfields.Repr(obj)).
+		Warn("Ignoring invalid v2alpha1 Cilium CIDR Group")
+	return nil
+}
+
+// ObjToCPD attempts to cast object to a CiliumPolicyDefaults object
+// and returns the object if the cast succeeds. Otherwise, nil is returned.
+func ObjToCPD(obj interface{}) *cilium_v2alpha1.CiliumPolicyDefaults {
+	cpd, ok := obj.(*cilium_v2alpha1.CiliumPolicyDefaults)
+	if ok {
+		return cpd
+	}
+	deletedObj, ok := obj.(cache.DeletedFinalStateUnknown)
+	if ok {
+		// Delete was not observed by the watcher but is
+		// removed from kube-apiserver. This is the last
+		// known state and the object no longer exists.
+		cpd, ok := deletedObj.Obj.(*cilium_v2alpha1.CiliumPolicyDefaults)
+		if ok {
+			return cpd
+		}
+	}
+	log.WithField(logfields.Object, logfields.Repr(obj)).
+		Warn("Ignoring invalid v2alpha1 Cilium Policy Defaults")
+	return nil
+}
+
 // ObjToCiliumEndpointSlice attempts to cast object to a CiliumEndpointSlice object
 // and returns a deep copy if the castin succeeds. Otherwise, nil is returned.
 func ObjToCiliumEndpointSlice(obj interface{}) *cilium_v2alpha1.CiliumEndpointSlice {
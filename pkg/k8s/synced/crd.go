@@ -18,6 +18,7 @@ import (
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/cache"
 
+	ipamOption "github.com/cilium/cilium/pkg/ipam/option"
 	"github.com/cilium/cilium/pkg/k8s"
 	v2 "github.com/cilium/cilium/pkg/k8s/apis/cilium.io/v2"
 	v2alpha1 "github.com/cilium/cilium/pkg/k8s/apis/cilium.io/v2alpha1"
@@ -41,9 +42,11 @@ func agentCRDResourceNames() []string {
 	result := []string{
 		CRDResourceName(v2.CNPName),
 		CRDResourceName(v2.CCNPName),
+		CRDResourceName(v2alpha1.CCGName),
 		CRDResourceName(v2.CNName),
 		CRDResourceName(v2.CIDName),
 		CRDResourceName(v2alpha1.CNCName),
+		CRDResourceName(v2alpha1.CPDName),
 	}
 
 	if !option.Config.DisableCiliumEndpointCRD {
@@ -66,8 +69,18 @@ func agentCRDResourceNames() []string {
 	if option.Config.EnableBGPControlPlane {
 		result = append(result, CRDResourceName(v2alpha1.BGPPName))
 	}
+	if option.Config.IPAM == ipamOption.IPAMMultiPool {
+		result = append(result, CRDResourceName(v2alpha1.CPIPName))
+	}
+	if option.Config.EnableMulticast {
+		result = append(result, CRDResourceName(v2alpha1.CMGName))
+	}
+	if option.Config.EnableXDPPrefilter {
+		result = append(result, CRDResourceName(v2alpha1.CXFName))
+	}
 
 	result = append(result, CRDResourceName(v2alpha1.LBIPPoolName))
+	result = append(result, CRDResourceName(v2alpha1.CIRName))
 
 	return result
 }
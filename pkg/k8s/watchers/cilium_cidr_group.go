@@ -0,0 +1,273 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package watchers
+
+import (
+	"github.com/sirupsen/logrus"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/cilium/cilium/pkg/k8s"
+	cilium_v2alpha1 "github.com/cilium/cilium/pkg/k8s/apis/cilium.io/v2alpha1"
+	"github.com/cilium/cilium/pkg/k8s/client"
+	"github.com/cilium/cilium/pkg/k8s/informer"
+	"github.com/cilium/cilium/pkg/k8s/types"
+	"github.com/cilium/cilium/pkg/k8s/utils"
+	k8sUtils "github.com/cilium/cilium/pkg/k8s/utils"
+	"github.com/cilium/cilium/pkg/k8s/watchers/resources"
+	"github.com/cilium/cilium/pkg/lock"
+	"github.com/cilium/cilium/pkg/logging/logfields"
+	"github.com/cilium/cilium/pkg/policy/api"
+)
+
+// cidrGroupCache tracks the CIDRs of each CiliumCIDRGroup in the cluster,
+// together with the original, untranslated form of every imported policy
+// which references a CIDR group. The untranslated policies are re-translated
+// and re-imported whenever a referenced group changes, so that an update to a
+// group propagates to all referencing policies at once.
+type cidrGroupCache struct {
+	mutex lock.RWMutex
+
+	// groups maps the name of a CIDR group to its external CIDRs.
+	groups map[string][]api.CIDR
+
+	// cnps maps the namespace and name of an imported policy which
+	// references at least one CIDR group to its untranslated form.
+	cnps map[string]*types.SlimCNP
+}
+
+var cidrGroups = &cidrGroupCache{
+	groups: map[string][]api.CIDR{},
+	cnps:   map[string]*types.SlimCNP{},
+}
+
+// upsertCNP tracks the untranslated form of the given policy if it references
+// any CIDR group and reports whether it does. A policy which no longer
+// contains any reference stops being tracked.
+func (c *cidrGroupCache) upsertCNP(cnp *types.SlimCNP) bool {
+	key := k8sUtils.GetObjNamespaceName(&cnp.ObjectMeta)
+	hasRefs := len(cnpCIDRGroupRefs(cnp)) > 0
+
+	c.mutex.Lock()
+	if hasRefs {
+		c.cnps[key] = cnp.DeepCopy()
+	} else {
+		delete(c.cnps, key)
+	}
+	c.mutex.Unlock()
+
+	return hasRefs
+}
+
+// deleteCNP stops tracking the given policy.
+func (c *cidrGroupCache) deleteCNP(cnp *types.SlimCNP) {
+	key := k8sUtils.GetObjNamespaceName(&cnp.ObjectMeta)
+
+	c.mutex.Lock()
+	delete(c.cnps, key)
+	c.mutex.Unlock()
+}
+
+// upsertGroup records the external CIDRs of the given group and returns the
+// untranslated form of all policies referencing it.
+func (c *cidrGroupCache) upsertGroup(ccg *cilium_v2alpha1.CiliumCIDRGroup) []*types.SlimCNP {
+	cidrs := make([]api.CIDR, len(ccg.Spec.ExternalCIDRs))
+	copy(cidrs, ccg.Spec.ExternalCIDRs)
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.groups[ccg.ObjectMeta.Name] = cidrs
+	return c.referencingCNPsLocked(ccg.ObjectMeta.Name)
+}
+
+// deleteGroup forgets the CIDRs of the given group and returns the
+// untranslated form of all policies referencing it.
+func (c *cidrGroupCache) deleteGroup(name string) []*types.SlimCNP {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	delete(c.groups, name)
+	return c.referencingCNPsLocked(name)
+}
+
+func (c *cidrGroupCache) referencingCNPsLocked(name string) []*types.SlimCNP {
+	var cnps []*types.SlimCNP
+	for _, cnp := range c.cnps {
+		for _, ref := range cnpCIDRGroupRefs(cnp) {
+			if ref == name {
+				cnps = append(cnps, cnp.DeepCopy())
+				break
+			}
+		}
+	}
+	return cnps
+}
+
+// resolve returns a copy of the given policy with all CIDR group references
+// replaced by the external CIDRs of the referenced groups. References to
+// groups which do not exist are left in place, they match nothing until the
+// group appears.
+func (c *cidrGroupCache) resolve(cnp *types.SlimCNP) *types.SlimCNP {
+	resolved := cnp.DeepCopy()
+
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+	if resolved.Spec != nil {
+		c.resolveRuleLocked(resolved.Spec)
+	}
+	for _, rule := range resolved.Specs {
+		c.resolveRuleLocked(rule)
+	}
+	return resolved
+}
+
+func (c *cidrGroupCache) resolveRuleLocked(rule *api.Rule) {
+	for i := range rule.Ingress {
+		rule.Ingress[i].FromCIDRSet = c.resolveCIDRSetLocked(rule.Ingress[i].FromCIDRSet)
+	}
+	for i := range rule.IngressDeny {
+		rule.IngressDeny[i].FromCIDRSet = c.resolveCIDRSetLocked(rule.IngressDeny[i].FromCIDRSet)
+	}
+	for i := range rule.Egress {
+		rule.Egress[i].ToCIDRSet = c.resolveCIDRSetLocked(rule.Egress[i].ToCIDRSet)
+	}
+	for i := range rule.EgressDeny {
+		rule.EgressDeny[i].ToCIDRSet = c.resolveCIDRSetLocked(rule.EgressDeny[i].ToCIDRSet)
+	}
+}
+
+func (c *cidrGroupCache) resolveCIDRSetLocked(cidrSet []api.CIDRRule) []api.CIDRRule {
+	if len(cidrSet) == 0 {
+		return cidrSet
+	}
+
+	out := make([]api.CIDRRule, 0, len(cidrSet))
+	for _, cidrRule := range cidrSet {
+		if cidrRule.CIDRGroupRef == "" {
+			out = append(out, cidrRule)
+			continue
+		}
+		cidrs, ok := c.groups[string(cidrRule.CIDRGroupRef)]
+		if !ok {
+			// Keep the unresolvable reference in place, it matches
+			// nothing until the group appears.
+			out = append(out, cidrRule)
+			continue
+		}
+		for _, cidr := range cidrs {
+			out = append(out, api.CIDRRule{Cidr: cidr, Generated: true})
+		}
+	}
+	return out
+}
+
+// cnpCIDRGroupRefs returns the names of all CIDR groups referenced by the
+// given policy.
+func cnpCIDRGroupRefs(cnp *types.SlimCNP) []string {
+	rules := make(api.Rules, 0, len(cnp.Specs)+1)
+	if cnp.Spec != nil {
+		rules = append(rules, cnp.Spec)
+	}
+	rules = append(rules, cnp.Specs...)
+
+	var refs []string
+	appendRefs := func(cidrSet []api.CIDRRule) {
+		for _, cidrRule := range cidrSet {
+			if cidrRule.CIDRGroupRef != "" {
+				refs = append(refs, string(cidrRule.CIDRGroupRef))
+			}
+		}
+	}
+	for _, rule := range rules {
+		for i := range rule.Ingress {
+			appendRefs(rule.Ingress[i].FromCIDRSet)
+		}
+		for i := range rule.IngressDeny {
+			appendRefs(rule.IngressDeny[i].FromCIDRSet)
+		}
+		for i := range rule.Egress {
+			appendRefs(rule.Egress[i].ToCIDRSet)
+		}
+		for i := range rule.EgressDeny {
+			appendRefs(rule.EgressDeny[i].ToCIDRSet)
+		}
+	}
+	return refs
+}
+
+func (k *K8sWatcher) ciliumCIDRGroupInit(cs client.Clientset) {
+	apiGroup := k8sAPIGroupCiliumCIDRGroupV2Alpha1
+	_, ccgController := informer.NewInformer(
+		utils.ListerWatcherFromTyped[*cilium_v2alpha1.CiliumCIDRGroupList](
+			cs.CiliumV2alpha1().CiliumCIDRGroups()),
+		&cilium_v2alpha1.CiliumCIDRGroup{},
+		0,
+		cache.ResourceEventHandlerFuncs{
+			AddFunc: func(obj interface{}) {
+				var valid, equal bool
+				defer func() { k.K8sEventReceived(apiGroup, metricCCG, resources.MetricCreate, valid, equal) }()
+				if ccg := k8s.ObjToCCG(obj); ccg != nil {
+					valid = true
+					k.updateCiliumCIDRGroup(cs, ccg)
+					k.K8sEventProcessed(metricCCG, resources.MetricCreate, true)
+				}
+			},
+			UpdateFunc: func(oldObj, newObj interface{}) {
+				var valid, equal bool
+				defer func() { k.K8sEventReceived(apiGroup, metricCCG, resources.MetricUpdate, valid, equal) }()
+				if oldCCG := k8s.ObjToCCG(oldObj); oldCCG != nil {
+					if newCCG := k8s.ObjToCCG(newObj); newCCG != nil {
+						valid = true
+						if oldCCG.DeepEqual(newCCG) {
+							equal = true
+							return
+						}
+						k.updateCiliumCIDRGroup(cs, newCCG)
+						k.K8sEventProcessed(metricCCG, resources.MetricUpdate, true)
+					}
+				}
+			},
+			DeleteFunc: func(obj interface{}) {
+				var valid, equal bool
+				defer func() { k.K8sEventReceived(apiGroup, metricCCG, resources.MetricDelete, valid, equal) }()
+				ccg := k8s.ObjToCCG(obj)
+				if ccg == nil {
+					return
+				}
+				valid = true
+				k.deleteCiliumCIDRGroup(cs, ccg)
+				k.K8sEventProcessed(metricCCG, resources.MetricDelete, true)
+			},
+		},
+		k8s.ConvertToCiliumCIDRGroup,
+	)
+
+	k.blockWaitGroupToSyncResources(k.stop, nil, ccgController.HasSynced, apiGroup)
+	go ccgController.Run(k.stop)
+	k.k8sAPIGroups.AddAPI(apiGroup)
+}
+
+// updateCiliumCIDRGroup caches the CIDRs of the given group and re-imports
+// all policies referencing it.
+func (k *K8sWatcher) updateCiliumCIDRGroup(cs client.Clientset, ccg *cilium_v2alpha1.CiliumCIDRGroup) {
+	affected := cidrGroups.upsertGroup(ccg)
+	k.reimportCIDRGroupPolicies(cs, ccg.ObjectMeta.Name, affected)
+}
+
+// deleteCiliumCIDRGroup forgets the CIDRs of the given group and re-imports
+// all policies referencing it.
+func (k *K8sWatcher) deleteCiliumCIDRGroup(cs client.Clientset, ccg *cilium_v2alpha1.CiliumCIDRGroup) {
+	affected := cidrGroups.deleteGroup(ccg.ObjectMeta.Name)
+	k.reimportCIDRGroupPolicies(cs, ccg.ObjectMeta.Name, affected)
+}
+
+func (k *K8sWatcher) reimportCIDRGroupPolicies(cs client.Clientset, name string, cnps []*types.SlimCNP) {
+	for _, cnp := range cnps {
+		if err := k.addCiliumNetworkPolicyV2(cs, cnp); err != nil {
+			log.WithError(err).WithFields(logrus.Fields{
+				logfields.CiliumCIDRGroupName:     name,
+				logfields.CiliumNetworkPolicyName: cnp.ObjectMeta.Name,
+				logfields.K8sNamespace:            cnp.ObjectMeta.Namespace,
+			}).Warn("Unable to re-import policy referencing CIDR group")
+		}
+	}
+}
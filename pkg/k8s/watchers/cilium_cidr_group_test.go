@@ -0,0 +1,92 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package watchers
+
+import (
+	. "gopkg.in/check.v1"
+
+	"github.com/cilium/cilium/pkg/checker"
+	cilium_v2 "github.com/cilium/cilium/pkg/k8s/apis/cilium.io/v2"
+	cilium_v2alpha1 "github.com/cilium/cilium/pkg/k8s/apis/cilium.io/v2alpha1"
+	"github.com/cilium/cilium/pkg/k8s/types"
+	"github.com/cilium/cilium/pkg/policy/api"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func cidrGroupRefCNP(name, namespace string, refs ...api.CIDRGroupRef) *types.SlimCNP {
+	cidrSet := make(api.CIDRRuleSlice, 0, len(refs))
+	for _, ref := range refs {
+		cidrSet = append(cidrSet, api.CIDRRule{CIDRGroupRef: ref})
+	}
+	return &types.SlimCNP{
+		CiliumNetworkPolicy: &cilium_v2.CiliumNetworkPolicy{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: namespace,
+			},
+			Spec: &api.Rule{
+				EndpointSelector: api.NewESFromLabels(),
+				Ingress: []api.IngressRule{
+					{
+						IngressCommonRule: api.IngressCommonRule{
+							FromCIDRSet: cidrSet,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (s *K8sWatcherSuite) TestCIDRGroupRefResolution(c *C) {
+	cache := &cidrGroupCache{
+		groups: map[string][]api.CIDR{},
+		cnps:   map[string]*types.SlimCNP{},
+	}
+
+	cnp := cidrGroupRefCNP("allow-peers", "default", "peers")
+	c.Assert(cache.upsertCNP(cnp), Equals, true)
+
+	// The group does not exist yet, the reference is kept in place and
+	// matches nothing.
+	resolved := cache.resolve(cnp)
+	c.Assert(resolved.Spec.Ingress[0].FromCIDRSet, checker.DeepEquals,
+		api.CIDRRuleSlice{{CIDRGroupRef: "peers"}})
+	c.Assert(api.ComputeResultantCIDRSet(resolved.Spec.Ingress[0].FromCIDRSet), HasLen, 0)
+
+	affected := cache.upsertGroup(&cilium_v2alpha1.CiliumCIDRGroup{
+		ObjectMeta: metav1.ObjectMeta{Name: "peers"},
+		Spec: cilium_v2alpha1.CiliumCIDRGroupSpec{
+			ExternalCIDRs: []api.CIDR{"10.0.0.0/8", "192.0.2.0/24"},
+		},
+	})
+	c.Assert(affected, HasLen, 1)
+	c.Assert(affected[0].ObjectMeta.Name, Equals, "allow-peers")
+
+	resolved = cache.resolve(cnp)
+	c.Assert(resolved.Spec.Ingress[0].FromCIDRSet, checker.DeepEquals,
+		api.CIDRRuleSlice{
+			{Cidr: "10.0.0.0/8", Generated: true},
+			{Cidr: "192.0.2.0/24", Generated: true},
+		})
+
+	// The original policy is left untouched by resolution.
+	c.Assert(cnp.Spec.Ingress[0].FromCIDRSet, checker.DeepEquals,
+		api.CIDRRuleSlice{{CIDRGroupRef: "peers"}})
+
+	// Deleting the group reverts the referencing policies to matching
+	// nothing.
+	affected = cache.deleteGroup("peers")
+	c.Assert(affected, HasLen, 1)
+	resolved = cache.resolve(cnp)
+	c.Assert(resolved.Spec.Ingress[0].FromCIDRSet, checker.DeepEquals,
+		api.CIDRRuleSlice{{CIDRGroupRef: "peers"}})
+
+	// Policies without references and deleted policies are not tracked.
+	c.Assert(cache.upsertCNP(cidrGroupRefCNP("no-refs", "default")), Equals, false)
+	cache.deleteCNP(cnp)
+	c.Assert(cache.upsertGroup(&cilium_v2alpha1.CiliumCIDRGroup{
+		ObjectMeta: metav1.ObjectMeta{Name: "peers"},
+	}), HasLen, 0)
+}
@@ -162,6 +162,13 @@ func (k *K8sWatcher) addCiliumNetworkPolicyV2(ciliumNPClient clientset.Interface
 
 	scopedLog.Debug("Adding CiliumNetworkPolicy")
 
+	// Resolve CIDR group references before importing the policy, and track
+	// its original form so that it can be re-imported whenever one of the
+	// referenced groups changes.
+	if cidrGroups.upsertCNP(cnp) {
+		cnp = cidrGroups.resolve(cnp)
+	}
+
 	var rev uint64
 
 	rules, policyImportErr := cnp.Parse()
@@ -221,6 +228,7 @@ func (k *K8sWatcher) deleteCiliumNetworkPolicyV2(cnp *types.SlimCNP) error {
 	scopedLog.Debug("Deleting CiliumNetworkPolicy")
 
 	importMetadataCache.delete(cnp)
+	cidrGroups.deleteCNP(cnp)
 	ctrlName := cnp.GetControllerName()
 	err := k8sCM.RemoveControllerAndWait(ctrlName)
 	if err != nil {
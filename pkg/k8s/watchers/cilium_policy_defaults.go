@@ -0,0 +1,203 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package watchers
+
+import (
+	"github.com/sirupsen/logrus"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/cilium/cilium/pkg/k8s"
+	k8sConst "github.com/cilium/cilium/pkg/k8s/apis/cilium.io"
+	k8sCiliumUtils "github.com/cilium/cilium/pkg/k8s/apis/cilium.io/utils"
+	cilium_v2alpha1 "github.com/cilium/cilium/pkg/k8s/apis/cilium.io/v2alpha1"
+	"github.com/cilium/cilium/pkg/k8s/client"
+	"github.com/cilium/cilium/pkg/k8s/informer"
+	"github.com/cilium/cilium/pkg/k8s/utils"
+	"github.com/cilium/cilium/pkg/k8s/watchers/resources"
+	"github.com/cilium/cilium/pkg/labels"
+	"github.com/cilium/cilium/pkg/logging/logfields"
+	"github.com/cilium/cilium/pkg/metrics"
+	"github.com/cilium/cilium/pkg/policy"
+	"github.com/cilium/cilium/pkg/policy/api"
+)
+
+func (k *K8sWatcher) ciliumPolicyDefaultsInit(cs client.Clientset) {
+	apiGroup := k8sAPIGroupCiliumPolicyDefaultsV2Alpha1
+	_, cpdController := informer.NewInformer(
+		utils.ListerWatcherFromTyped[*cilium_v2alpha1.CiliumPolicyDefaultsList](
+			cs.CiliumV2alpha1().CiliumPolicyDefaults("")),
+		&cilium_v2alpha1.CiliumPolicyDefaults{},
+		0,
+		cache.ResourceEventHandlerFuncs{
+			AddFunc: func(obj interface{}) {
+				var valid, equal bool
+				defer func() { k.K8sEventReceived(apiGroup, metricCPD, resources.MetricCreate, valid, equal) }()
+				if cpd := k8s.ObjToCPD(obj); cpd != nil {
+					valid = true
+					err := k.addCiliumPolicyDefaults(cpd)
+					k.K8sEventProcessed(metricCPD, resources.MetricCreate, err == nil)
+				}
+			},
+			UpdateFunc: func(oldObj, newObj interface{}) {
+				var valid, equal bool
+				defer func() { k.K8sEventReceived(apiGroup, metricCPD, resources.MetricUpdate, valid, equal) }()
+				if oldCPD := k8s.ObjToCPD(oldObj); oldCPD != nil {
+					if newCPD := k8s.ObjToCPD(newObj); newCPD != nil {
+						valid = true
+						if oldCPD.DeepEqual(newCPD) {
+							equal = true
+							return
+						}
+						err := k.addCiliumPolicyDefaults(newCPD)
+						k.K8sEventProcessed(metricCPD, resources.MetricUpdate, err == nil)
+					}
+				}
+			},
+			DeleteFunc: func(obj interface{}) {
+				var valid, equal bool
+				defer func() { k.K8sEventReceived(apiGroup, metricCPD, resources.MetricDelete, valid, equal) }()
+				cpd := k8s.ObjToCPD(obj)
+				if cpd == nil {
+					return
+				}
+				valid = true
+				err := k.deleteCiliumPolicyDefaults(cpd)
+				k.K8sEventProcessed(metricCPD, resources.MetricDelete, err == nil)
+			},
+		},
+		k8s.ConvertToCiliumPolicyDefaults,
+	)
+
+	k.blockWaitGroupToSyncResources(k.stop, nil, cpdController.HasSynced, apiGroup)
+	go cpdController.Run(k.stop)
+	k.k8sAPIGroups.AddAPI(apiGroup)
+}
+
+// addCiliumPolicyDefaults imports the policy derived from the given policy
+// defaults object into the policy repository and records the audit mode
+// override of the namespace.
+func (k *K8sWatcher) addCiliumPolicyDefaults(cpd *cilium_v2alpha1.CiliumPolicyDefaults) error {
+	scopedLog := log.WithFields(logrus.Fields{
+		logfields.CiliumPolicyDefaultsName: cpd.ObjectMeta.Name,
+		logfields.K8sNamespace:             cpd.ObjectMeta.Namespace,
+		logfields.K8sAPIVersion:            cpd.TypeMeta.APIVersion,
+	})
+
+	policy.SetNamespaceAuditMode(cpd.ObjectMeta.Namespace, cpd.Spec.AuditMode)
+
+	rules, err := policyDefaultsRules(cpd)
+	if err != nil {
+		metrics.PolicyImportErrorsTotal.Inc()
+		scopedLog.WithError(err).Error("Error while translating CiliumPolicyDefaults to policy rules")
+		return err
+	}
+
+	if len(rules) == 0 {
+		// Both postures are set to allow, there is nothing to enforce.
+		// Delete any rule imported for a previous posture of the object.
+		if _, err := k.policyManager.PolicyDelete(policyDefaultsLabels(cpd)); err != nil {
+			scopedLog.WithError(err).Error("Error while deleting CiliumPolicyDefaults rules from policy repository")
+			return err
+		}
+		return nil
+	}
+
+	opts := policy.AddOptions{Replace: true, Source: metrics.LabelEventSourceK8s}
+	if _, err := k.policyManager.PolicyAdd(rules, &opts); err != nil {
+		metrics.PolicyImportErrorsTotal.Inc()
+		scopedLog.WithError(err).Error("Unable to add CiliumPolicyDefaults rules to policy repository")
+		return err
+	}
+
+	scopedLog.Info("CiliumPolicyDefaults successfully added")
+	return nil
+}
+
+// deleteCiliumPolicyDefaults removes the policy derived from the given policy
+// defaults object and clears the audit mode override of the namespace.
+func (k *K8sWatcher) deleteCiliumPolicyDefaults(cpd *cilium_v2alpha1.CiliumPolicyDefaults) error {
+	scopedLog := log.WithFields(logrus.Fields{
+		logfields.CiliumPolicyDefaultsName: cpd.ObjectMeta.Name,
+		logfields.K8sNamespace:             cpd.ObjectMeta.Namespace,
+		logfields.K8sAPIVersion:            cpd.TypeMeta.APIVersion,
+	})
+
+	policy.ClearNamespaceAuditMode(cpd.ObjectMeta.Namespace)
+
+	if _, err := k.policyManager.PolicyDelete(policyDefaultsLabels(cpd)); err != nil {
+		scopedLog.WithError(err).Error("Error while deleting CiliumPolicyDefaults")
+		return err
+	}
+
+	scopedLog.Info("CiliumPolicyDefaults successfully removed")
+	return nil
+}
+
+// policyDefaultsLabels returns the labels of the rule derived from the given
+// policy defaults object.
+func policyDefaultsLabels(cpd *cilium_v2alpha1.CiliumPolicyDefaults) labels.LabelArray {
+	return k8sCiliumUtils.GetPolicyLabels(cpd.ObjectMeta.Namespace, cpd.ObjectMeta.Name,
+		cpd.ObjectMeta.UID, resourceTypeCiliumPolicyDefaults)
+}
+
+// resourceTypeCiliumPolicyDefaults is the derived-from label value of rules
+// imported from a CiliumPolicyDefaults object.
+const resourceTypeCiliumPolicyDefaults = "CiliumPolicyDefaults"
+
+// policyDefaultsRules translates the given policy defaults object into policy
+// rules selecting all pods of its namespace. A posture set to deny becomes an
+// empty rule for the direction, enabling default-deny enforcement without
+// allowing any traffic, with the listed system flows as the only allowed
+// egress peers. If both postures are set to allow, no rules are returned.
+func policyDefaultsRules(cpd *cilium_v2alpha1.CiliumPolicyDefaults) (api.Rules, error) {
+	if cpd.Spec.Ingress != cilium_v2alpha1.PolicyPostureDeny &&
+		cpd.Spec.Egress != cilium_v2alpha1.PolicyPostureDeny {
+		return nil, nil
+	}
+
+	rule := api.NewRule().
+		WithEndpointSelector(api.NewESFromMatchRequirements(map[string]string{
+			labels.LabelSourceK8sKeyPrefix + k8sConst.PodNamespaceLabel: cpd.ObjectMeta.Namespace,
+		}, nil)).
+		WithLabels(policyDefaultsLabels(cpd))
+
+	if cpd.Spec.Ingress == cilium_v2alpha1.PolicyPostureDeny {
+		rule.Ingress = []api.IngressRule{{}}
+	}
+
+	if cpd.Spec.Egress == cilium_v2alpha1.PolicyPostureDeny {
+		egresses := []api.EgressRule{}
+		for _, flow := range cpd.Spec.AllowSystemFlows {
+			switch flow {
+			case cilium_v2alpha1.SystemFlowDNS:
+				egresses = append(egresses, api.EgressRule{
+					ToPorts: []api.PortRule{{
+						Ports: []api.PortProtocol{
+							{Port: "53", Protocol: api.ProtoUDP},
+							{Port: "53", Protocol: api.ProtoTCP},
+						},
+					}},
+				})
+			case cilium_v2alpha1.SystemFlowKubeAPIServer:
+				egresses = append(egresses, api.EgressRule{
+					EgressCommonRule: api.EgressCommonRule{
+						ToEntities: api.EntitySlice{api.EntityKubeAPIServer},
+					},
+				})
+			}
+		}
+		if len(egresses) == 0 {
+			// No system flows to allow, an empty rule enables
+			// default-deny enforcement for the direction.
+			egresses = []api.EgressRule{{}}
+		}
+		rule.Egress = egresses
+	}
+
+	if err := rule.Sanitize(); err != nil {
+		return nil, err
+	}
+
+	return api.Rules{rule}, nil
+}
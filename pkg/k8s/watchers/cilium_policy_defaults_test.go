@@ -0,0 +1,70 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package watchers
+
+import (
+	. "gopkg.in/check.v1"
+
+	cilium_v2alpha1 "github.com/cilium/cilium/pkg/k8s/apis/cilium.io/v2alpha1"
+	"github.com/cilium/cilium/pkg/policy/api"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func policyDefaults(namespace string, spec cilium_v2alpha1.PolicyDefaultsSpec) *cilium_v2alpha1.CiliumPolicyDefaults {
+	return &cilium_v2alpha1.CiliumPolicyDefaults{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "defaults",
+			Namespace: namespace,
+		},
+		Spec: spec,
+	}
+}
+
+func (s *K8sWatcherSuite) TestPolicyDefaultsRules(c *C) {
+	// Both postures set to allow, there is nothing to enforce.
+	rules, err := policyDefaultsRules(policyDefaults("default", cilium_v2alpha1.PolicyDefaultsSpec{
+		Ingress: cilium_v2alpha1.PolicyPostureAllow,
+		Egress:  cilium_v2alpha1.PolicyPostureAllow,
+	}))
+	c.Assert(err, IsNil)
+	c.Assert(rules, HasLen, 0)
+
+	// Ingress deny becomes a single empty ingress rule which enables
+	// default-deny enforcement without allowing any traffic.
+	rules, err = policyDefaultsRules(policyDefaults("default", cilium_v2alpha1.PolicyDefaultsSpec{
+		Ingress: cilium_v2alpha1.PolicyPostureDeny,
+		Egress:  cilium_v2alpha1.PolicyPostureAllow,
+	}))
+	c.Assert(err, IsNil)
+	c.Assert(rules, HasLen, 1)
+	c.Assert(rules[0].Ingress, HasLen, 1)
+	c.Assert(rules[0].Egress, HasLen, 0)
+
+	// Egress deny without system flows becomes a single empty egress rule.
+	rules, err = policyDefaultsRules(policyDefaults("default", cilium_v2alpha1.PolicyDefaultsSpec{
+		Egress: cilium_v2alpha1.PolicyPostureDeny,
+	}))
+	c.Assert(err, IsNil)
+	c.Assert(rules, HasLen, 1)
+	c.Assert(rules[0].Ingress, HasLen, 0)
+	c.Assert(rules[0].Egress, HasLen, 1)
+
+	// Allowed system flows become the only allowed egress of the
+	// namespace.
+	rules, err = policyDefaultsRules(policyDefaults("default", cilium_v2alpha1.PolicyDefaultsSpec{
+		Egress: cilium_v2alpha1.PolicyPostureDeny,
+		AllowSystemFlows: []cilium_v2alpha1.SystemFlow{
+			cilium_v2alpha1.SystemFlowDNS,
+			cilium_v2alpha1.SystemFlowKubeAPIServer,
+		},
+	}))
+	c.Assert(err, IsNil)
+	c.Assert(rules, HasLen, 1)
+	c.Assert(rules[0].Egress, HasLen, 2)
+	c.Assert(rules[0].Egress[0].ToPorts, HasLen, 1)
+	c.Assert(rules[0].Egress[0].ToPorts[0].Ports, HasLen, 2)
+	c.Assert(rules[0].Egress[0].ToPorts[0].Ports[0].Port, Equals, "53")
+	c.Assert(rules[0].Egress[1].ToEntities, HasLen, 1)
+	c.Assert(rules[0].Egress[1].ToEntities[0], Equals, api.EntityKubeAPIServer)
+}
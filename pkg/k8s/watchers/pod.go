@@ -292,9 +292,10 @@ func (k *K8sWatcher) updateK8sPodV1(oldK8sPod, newK8sPod *slim_corev1.Pod) error
 	oldAnno := oldK8sPod.ObjectMeta.Annotations
 	newAnno := newK8sPod.ObjectMeta.Annotations
 	annoChangedProxy := !k8s.AnnotationsEqual([]string{annotation.ProxyVisibility}, oldAnno, newAnno)
-	annoChangedBandwidth := !k8s.AnnotationsEqual([]string{bandwidth.EgressBandwidth}, oldAnno, newAnno)
+	annoChangedBandwidth := !k8s.AnnotationsEqual([]string{bandwidth.EgressBandwidth, bandwidth.IngressBandwidth, bandwidth.BBRPacing}, oldAnno, newAnno)
 	annoChangedNoTrack := !k8s.AnnotationsEqual([]string{annotation.NoTrack}, oldAnno, newAnno)
-	annotationsChanged := annoChangedProxy || annoChangedBandwidth || annoChangedNoTrack
+	annoChangedDSCP := !k8s.AnnotationsEqual([]string{annotation.DSCP}, oldAnno, newAnno)
+	annotationsChanged := annoChangedProxy || annoChangedBandwidth || annoChangedNoTrack || annoChangedDSCP
 
 	// Check label updates too.
 	oldK8sPodLabels, _ := labelsfilter.Filter(labels.Map2Labels(oldK8sPod.ObjectMeta.Labels, labels.LabelSourceK8s))
@@ -361,12 +362,23 @@ func (k *K8sWatcher) updateK8sPodV1(oldK8sPod, newK8sPod *slim_corev1.Pod) error
 			})
 		}
 		if annoChangedBandwidth {
-			podEP.UpdateBandwidthPolicy(func(ns, podName string) (bandwidthEgress string, err error) {
+			podEP.UpdateBandwidthPolicy(func(ns, podName string) (bandwidthEgress, bandwidthIngress, bbrPacing string, err error) {
+				p, err := k.GetCachedPod(ns, podName)
+				if err != nil {
+					return "", "", "", nil
+				}
+				return p.ObjectMeta.Annotations[bandwidth.EgressBandwidth],
+					p.ObjectMeta.Annotations[bandwidth.IngressBandwidth],
+					p.ObjectMeta.Annotations[bandwidth.BBRPacing], nil
+			})
+		}
+		if annoChangedDSCP {
+			podEP.UpdateDSCP(func(ns, podName string) (dscp string, err error) {
 				p, err := k.GetCachedPod(ns, podName)
 				if err != nil {
 					return "", nil
 				}
-				return p.ObjectMeta.Annotations[bandwidth.EgressBandwidth], nil
+				return p.ObjectMeta.Annotations[annotation.DSCP], nil
 			})
 		}
 		if annoChangedNoTrack {
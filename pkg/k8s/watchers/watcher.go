@@ -72,6 +72,8 @@ const (
 	k8sAPIGroupCiliumLocalRedirectPolicyV2      = "cilium/v2::CiliumLocalRedirectPolicy"
 	k8sAPIGroupCiliumEgressGatewayPolicyV2      = "cilium/v2::CiliumEgressGatewayPolicy"
 	k8sAPIGroupCiliumEndpointSliceV2Alpha1      = "cilium/v2alpha1::CiliumEndpointSlice"
+	k8sAPIGroupCiliumCIDRGroupV2Alpha1          = "cilium/v2alpha1::CiliumCIDRGroup"
+	k8sAPIGroupCiliumPolicyDefaultsV2Alpha1     = "cilium/v2alpha1::CiliumPolicyDefaults"
 	k8sAPIGroupCiliumClusterwideEnvoyConfigV2   = "cilium/v2::CiliumClusterwideEnvoyConfig"
 	k8sAPIGroupCiliumEnvoyConfigV2              = "cilium/v2::CiliumEnvoyConfig"
 
@@ -82,6 +84,8 @@ const (
 	metricCiliumEndpoint = "CiliumEndpoint"
 	metricCLRP           = "CiliumLocalRedirectPolicy"
 	metricCEGP           = "CiliumEgressGatewayPolicy"
+	metricCCG            = "CiliumCIDRGroup"
+	metricCPD            = "CiliumPolicyDefaults"
 	metricCCEC           = "CiliumClusterwideEnvoyConfig"
 	metricCEC            = "CiliumEnvoyConfig"
 	metricPod            = "Pod"
@@ -415,6 +419,8 @@ var ciliumResourceToGroupMapping = map[string]watcherInfo{
 	synced.CRDResourceName(v2.CEWName):            {skip, ""}, // Handled in clustermesh-apiserver/
 	synced.CRDResourceName(v2.CEGPName):           {start, k8sAPIGroupCiliumEgressGatewayPolicyV2},
 	synced.CRDResourceName(v2alpha1.CESName):      {start, k8sAPIGroupCiliumEndpointSliceV2Alpha1},
+	synced.CRDResourceName(v2alpha1.CCGName):      {start, k8sAPIGroupCiliumCIDRGroupV2Alpha1},
+	synced.CRDResourceName(v2alpha1.CPDName):      {start, k8sAPIGroupCiliumPolicyDefaultsV2Alpha1},
 	synced.CRDResourceName(v2.CCECName):           {afterNodeInit, k8sAPIGroupCiliumClusterwideEnvoyConfigV2},
 	synced.CRDResourceName(v2.CECName):            {afterNodeInit, k8sAPIGroupCiliumEnvoyConfigV2},
 	synced.CRDResourceName(v2alpha1.BGPPName):     {skip, ""}, // Handled in BGP control plane
@@ -574,6 +580,10 @@ func (k *K8sWatcher) enableK8sWatchers(ctx context.Context, resourceNames []stri
 			k.initCiliumEndpointOrSlices(k.clientset, asyncControllers)
 		case k8sAPIGroupCiliumEndpointSliceV2Alpha1:
 			// no-op; handled in k8sAPIGroupCiliumEndpointV2
+		case k8sAPIGroupCiliumCIDRGroupV2Alpha1:
+			k.ciliumCIDRGroupInit(k.clientset)
+		case k8sAPIGroupCiliumPolicyDefaultsV2Alpha1:
+			k.ciliumPolicyDefaultsInit(k.clientset)
 		case k8sAPIGroupCiliumLocalRedirectPolicyV2:
 			k.ciliumLocalRedirectPolicyInit(k.clientset)
 		case k8sAPIGroupCiliumEgressGatewayPolicyV2:
@@ -764,13 +774,14 @@ func genCartesianProduct(
 
 	for fePortName, fePort := range ports {
 		var besValues []*loadbalancer.Backend
+		var besValuesOtherFamily []*loadbalancer.Backend
 		for addrCluster, backend := range bes.Backends {
-			if backendPort := backend.Ports[string(fePortName)]; backendPort != nil && feFamilyIPv6 == addrCluster.Is6() {
+			if backendPort := backend.Ports[string(fePortName)]; backendPort != nil {
 				backendState := loadbalancer.BackendStateActive
 				if backend.Terminating {
 					backendState = loadbalancer.BackendStateTerminating
 				}
-				besValues = append(besValues, &loadbalancer.Backend{
+				be := &loadbalancer.Backend{
 					FEPortName: string(fePortName),
 					NodeName:   backend.NodeName,
 					L3n4Addr: loadbalancer.L3n4Addr{
@@ -780,10 +791,25 @@ func genCartesianProduct(
 					State:     backendState,
 					Preferred: loadbalancer.Preferred(backend.Preferred),
 					Weight:    loadbalancer.DefaultBackendWeight,
-				})
+				}
+				if feFamilyIPv6 == addrCluster.Is6() {
+					besValues = append(besValues, be)
+				} else {
+					besValuesOtherFamily = append(besValuesOtherFamily, be)
+				}
 			}
 		}
 
+		// If the service has no backend of the frontend's address family,
+		// but the datapath can do NAT46/64, fall back to the backends of
+		// the other family. This allows e.g. exposing IPv4-only pods via
+		// an IPv6 VIP during dual-stack migration. Mixing both families
+		// behind a single frontend is not supported by the datapath, so
+		// the fallback only kicks in when no same-family backend exists.
+		if len(besValues) == 0 && option.Config.NodePortNat46X64 {
+			besValues = besValuesOtherFamily
+		}
+
 		addrCluster := cmtypes.MustAddrClusterFromIP(fe)
 
 		// External scoped entry.
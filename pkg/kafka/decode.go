@@ -0,0 +1,354 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package kafka
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	api "github.com/cilium/cilium/pkg/policy/api/kafka"
+)
+
+// decoder is a cursor over the raw bytes of a Kafka request. It understands
+// both the classic encoding and the compact ("flexible") encoding introduced
+// with KIP-482, which modern clients use for current API versions.
+type decoder struct {
+	data     []byte
+	off      int
+	flexible bool
+	err      error
+}
+
+func (d *decoder) fail(format string, args ...interface{}) {
+	if d.err == nil {
+		d.err = fmt.Errorf(format, args...)
+	}
+}
+
+func (d *decoder) skip(n int) {
+	if d.err != nil {
+		return
+	}
+	if n < 0 || d.off+n > len(d.data) {
+		d.fail("unexpected end of request")
+		return
+	}
+	d.off += n
+}
+
+func (d *decoder) int16() int16 {
+	if d.err != nil {
+		return 0
+	}
+	if d.off+2 > len(d.data) {
+		d.fail("unexpected end of request")
+		return 0
+	}
+	v := int16(binary.BigEndian.Uint16(d.data[d.off:]))
+	d.off += 2
+	return v
+}
+
+func (d *decoder) int32() int32 {
+	if d.err != nil {
+		return 0
+	}
+	if d.off+4 > len(d.data) {
+		d.fail("unexpected end of request")
+		return 0
+	}
+	v := int32(binary.BigEndian.Uint32(d.data[d.off:]))
+	d.off += 4
+	return v
+}
+
+func (d *decoder) uvarint() uint64 {
+	if d.err != nil {
+		return 0
+	}
+	v, n := binary.Uvarint(d.data[d.off:])
+	if n <= 0 {
+		d.fail("invalid varint in request")
+		return 0
+	}
+	d.off += n
+	return v
+}
+
+// string_ reads a (nullable) string in the encoding selected by d.flexible,
+// returning the empty string for null.
+func (d *decoder) string_() string {
+	if d.flexible {
+		n := d.uvarint()
+		if d.err != nil || n == 0 {
+			return ""
+		}
+		start := d.off
+		d.skip(int(n - 1))
+		if d.err != nil {
+			return ""
+		}
+		return string(d.data[start:d.off])
+	}
+	n := d.int16()
+	if d.err != nil || n <= 0 {
+		return ""
+	}
+	start := d.off
+	d.skip(int(n))
+	if d.err != nil {
+		return ""
+	}
+	return string(d.data[start:d.off])
+}
+
+// bytes skips over a (nullable) byte sequence in the encoding selected by
+// d.flexible.
+func (d *decoder) bytes() {
+	if d.flexible {
+		n := d.uvarint()
+		if d.err != nil || n == 0 {
+			return
+		}
+		d.skip(int(n - 1))
+		return
+	}
+	n := d.int32()
+	if d.err == nil && n > 0 {
+		d.skip(int(n))
+	}
+}
+
+// arrayLen reads a (nullable) array length in the encoding selected by
+// d.flexible. Null arrays are returned as empty.
+func (d *decoder) arrayLen() int {
+	if d.flexible {
+		n := d.uvarint()
+		if d.err != nil || n == 0 {
+			return 0
+		}
+		return int(n - 1)
+	}
+	n := d.int32()
+	if d.err != nil || n < 0 {
+		return 0
+	}
+	return int(n)
+}
+
+// taggedFields skips over a tagged field section. It is a no-op for the
+// classic encoding, which has no tagged fields.
+func (d *decoder) taggedFields() {
+	if !d.flexible {
+		return
+	}
+	n := d.uvarint()
+	for i := uint64(0); i < n && d.err == nil; i++ {
+		d.uvarint() // tag
+		size := d.uvarint()
+		d.skip(int(size))
+	}
+}
+
+// firstFlexibleVersion maps an API key to the lowest API version using the
+// compact request encoding. API keys missing from the map are decoded with
+// the classic encoding for all versions handled here.
+var firstFlexibleVersion = map[int16]int16{
+	api.ProduceKey:          9,
+	api.FetchKey:            12,
+	api.OffsetsKey:          6,
+	api.MetadataKey:         9,
+	api.OffsetCommitKey:     8,
+	api.OffsetFetchKey:      6,
+	api.APIVersionsKey:      3,
+	api.SaslAuthenticateKey: 2,
+}
+
+// requestBody returns a decoder positioned at the start of the request body,
+// right after the request header. 'rawMsg' starts with the 4 byte frame
+// length. The client ID remains a classic nullable string in all header
+// versions; flexible headers only append tagged fields after it.
+func requestBody(kind, version int16, rawMsg []byte) *decoder {
+	d := &decoder{data: rawMsg, off: 12}
+	if n := d.int16(); n > 0 {
+		d.skip(int(n))
+	}
+	if first, ok := firstFlexibleVersion[kind]; ok && version >= first {
+		d.flexible = true
+		d.taggedFields()
+	}
+	return d
+}
+
+// extractTopics returns the topic names carried in the given request and
+// whether the request's API version is understood. Requests with versions
+// newer than handled here, such as fetch requests addressing topics by ID
+// instead of by name, return false and are treated as carrying unknown
+// topics, so that rules with topic constraints fail closed. A non-nil error
+// means the request is malformed.
+func extractTopics(kind, version int16, rawMsg []byte) ([]string, bool, error) {
+	d := requestBody(kind, version, rawMsg)
+	var topics []string
+
+	switch kind {
+	case api.ProduceKey:
+		if version > 11 {
+			return nil, false, nil
+		}
+		if version >= 3 {
+			d.string_() // transactional_id
+		}
+		d.skip(6) // acks, timeout_ms
+		n := d.arrayLen()
+		for i := 0; i < n && d.err == nil; i++ {
+			topics = append(topics, d.string_())
+			pn := d.arrayLen()
+			for j := 0; j < pn && d.err == nil; j++ {
+				d.skip(4) // partition index
+				d.bytes() // records
+				d.taggedFields()
+			}
+			d.taggedFields()
+		}
+
+	case api.FetchKey:
+		if version > 12 {
+			// v13+ addresses topics by UUID instead of by name.
+			return nil, false, nil
+		}
+		d.skip(12) // replica_id, max_wait_ms, min_bytes
+		if version >= 3 {
+			d.skip(4) // max_bytes
+		}
+		if version >= 4 {
+			d.skip(1) // isolation_level
+		}
+		if version >= 7 {
+			d.skip(8) // session_id, session_epoch
+		}
+		n := d.arrayLen()
+		for i := 0; i < n && d.err == nil; i++ {
+			topics = append(topics, d.string_())
+			pn := d.arrayLen()
+			for j := 0; j < pn && d.err == nil; j++ {
+				d.skip(4) // partition
+				if version >= 9 {
+					d.skip(4) // current_leader_epoch
+				}
+				d.skip(8) // fetch_offset
+				if version >= 12 {
+					d.skip(4) // last_fetched_epoch
+				}
+				if version >= 5 {
+					d.skip(8) // log_start_offset
+				}
+				d.skip(4) // partition_max_bytes
+				d.taggedFields()
+			}
+			d.taggedFields()
+		}
+
+	case api.OffsetsKey:
+		if version > 7 {
+			return nil, false, nil
+		}
+		d.skip(4) // replica_id
+		if version >= 2 {
+			d.skip(1) // isolation_level
+		}
+		n := d.arrayLen()
+		for i := 0; i < n && d.err == nil; i++ {
+			topics = append(topics, d.string_())
+			pn := d.arrayLen()
+			for j := 0; j < pn && d.err == nil; j++ {
+				d.skip(4) // partition
+				if version >= 4 {
+					d.skip(4) // current_leader_epoch
+				}
+				d.skip(8) // timestamp
+				if version == 0 {
+					d.skip(4) // max_num_offsets
+				}
+				d.taggedFields()
+			}
+			d.taggedFields()
+		}
+
+	case api.MetadataKey:
+		if version > 12 {
+			return nil, false, nil
+		}
+		n := d.arrayLen() // null means all topics
+		for i := 0; i < n && d.err == nil; i++ {
+			if version >= 10 {
+				d.skip(16) // topic_id
+			}
+			topics = append(topics, d.string_())
+			d.taggedFields()
+		}
+
+	case api.OffsetCommitKey:
+		if version > 8 {
+			return nil, false, nil
+		}
+		d.string_() // group_id
+		if version >= 1 {
+			d.skip(4)   // generation_id
+			d.string_() // member_id
+		}
+		if version >= 7 {
+			d.string_() // group_instance_id
+		}
+		if version >= 2 && version <= 4 {
+			d.skip(8) // retention_time_ms
+		}
+		n := d.arrayLen()
+		for i := 0; i < n && d.err == nil; i++ {
+			topics = append(topics, d.string_())
+			pn := d.arrayLen()
+			for j := 0; j < pn && d.err == nil; j++ {
+				d.skip(12) // partition, committed_offset
+				if version == 1 {
+					d.skip(8) // commit_timestamp
+				}
+				if version >= 6 {
+					d.skip(4) // committed_leader_epoch
+				}
+				d.string_() // metadata
+				d.taggedFields()
+			}
+			d.taggedFields()
+		}
+
+	case api.OffsetFetchKey:
+		if version > 7 {
+			// v8+ fetches offsets for multiple groups in one request.
+			return nil, false, nil
+		}
+		d.string_()       // group_id
+		n := d.arrayLen() // null means all topics
+		for i := 0; i < n && d.err == nil; i++ {
+			topics = append(topics, d.string_())
+			pn := d.arrayLen()
+			d.skip(4 * pn) // partition indexes
+			d.taggedFields()
+		}
+
+	default:
+		return nil, false, nil
+	}
+
+	if d.err != nil {
+		return nil, false, d.err
+	}
+	return topics, true, nil
+}
+
+// extractSaslMechanism returns the mechanism name from a SaslHandshake
+// request. Both handshake versions encode the body as a single classic
+// string.
+func extractSaslMechanism(version int16, rawMsg []byte) string {
+	d := requestBody(api.SaslHandshakeKey, version, rawMsg)
+	return d.string_()
+}
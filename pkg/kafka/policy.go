@@ -4,6 +4,9 @@
 package kafka
 
 import (
+	"regexp"
+	"strings"
+
 	"github.com/sirupsen/logrus"
 
 	"github.com/cilium/cilium/pkg/flowdebug"
@@ -45,8 +48,13 @@ type Rule struct {
 	// version the length was changed from 255 to 249. For compatibility
 	// reasons we are allowing 255.
 	//
-	// If empty, all topics are allowed.
+	// If empty, all topics are allowed. The topic may contain '*'
+	// wildcards, each matching any sequence of topic characters.
 	Topic string
+
+	// topicRegex is the compiled form of a Topic containing wildcards,
+	// nil if Topic is a literal name.
+	topicRegex *regexp.Regexp
 }
 
 // NewRule creates a new rule from already sanitized inputs
@@ -60,6 +68,9 @@ func NewRule(apiVersion int32, apiKeys []int32, clientID, topic string) Rule {
 	for _, key := range apiKeys {
 		r.APIKeys[int16(key)] = struct{}{}
 	}
+	if strings.ContainsRune(topic, '*') {
+		r.topicRegex = regexp.MustCompile("^" + strings.ReplaceAll(regexp.QuoteMeta(topic), `\*`, "[a-zA-Z0-9._-]*") + "$")
+	}
 	return r
 }
 
@@ -86,6 +97,15 @@ func (r *Rule) CheckClientID(clientID string) bool {
 	return r.ClientID == "" || clientID == r.ClientID
 }
 
+// CheckTopic returns true if 'topic' is allowed by the rule's topic
+// constraint
+func (r *Rule) CheckTopic(topic string) bool {
+	if r.topicRegex != nil {
+		return r.topicRegex.MatchString(topic)
+	}
+	return topic == r.Topic
+}
+
 // isTopicAPIKey returns true if kind is apiKey message type which contains a
 // topic in its request.
 func isTopicAPIKey(kind int16) bool {
@@ -145,18 +165,24 @@ func (r Rule) Matches(data interface{}) bool {
 	if r.Topic != "" && isTopicAPIKey(req.kind) {
 		// Rule has a topic constraint and the request type carries topics.
 		//
-		// Check it this rule's topic is in the request, but keep matching
-		// other rules (by returning false) even if this rule is satisfied
-		// if there are other topics in the request not matched yet.
+		// Check if this rule's topic matches topics in the request, but
+		// keep matching other rules (by returning false) even if this rule
+		// is satisfied if there are other topics in the request not matched
+		// yet. A rule with a wildcard topic may match multiple topics in a
+		// single request.
 		//
 		// (req.topics is initialized with all the topics in the request
 		// before any rules are matched.)
-		if _, exists := req.topics[r.Topic]; exists {
-			delete(req.topics, r.Topic)
-			if len(req.topics) == 0 {
-				return true // all topics have matched
+		matched := false
+		for topic := range req.topics {
+			if r.CheckTopic(topic) {
+				delete(req.topics, topic)
+				matched = true
 			}
 		}
+		if matched && len(req.topics) == 0 {
+			return true // all topics have matched
+		}
 		return false // more topic matches needed
 	}
 
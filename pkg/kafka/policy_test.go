@@ -4,6 +4,8 @@
 package kafka
 
 import (
+	"bytes"
+	"encoding/binary"
 	"testing"
 	"time"
 
@@ -122,6 +124,103 @@ func (k *kafkaTestSuite) TestProduceRequest(c *C) {
 
 }
 
+func (k *kafkaTestSuite) TestWildcardTopicRule(c *C) {
+	req := &proto.ProduceReq{
+		Topics: []proto.ProduceReqTopic{
+			{Name: "logs-foo"},
+			{Name: "logs-bar"},
+		},
+	}
+	reqMsg := RequestMessage{request: req}
+
+	// a single wildcard rule may match all topics in the request
+	reqMsg.setTopics()
+	c.Assert(reqMsg.MatchesRule([]Rule{
+		NewRule(-1, nil, "", "logs-*"),
+	}), Equals, true)
+
+	reqMsg.setTopics()
+	c.Assert(reqMsg.MatchesRule([]Rule{
+		NewRule(-1, nil, "", "logs-f*"), NewRule(-1, nil, "", "logs-bar"),
+	}), Equals, true)
+
+	reqMsg.setTopics()
+	c.Assert(reqMsg.MatchesRule([]Rule{
+		NewRule(-1, nil, "", "logs-f*"),
+	}), Equals, false)
+
+	reqMsg.setTopics()
+	c.Assert(reqMsg.MatchesRule([]Rule{
+		NewRule(-1, nil, "", "metrics-*"),
+	}), Equals, false)
+}
+
+// writeRequestHeader writes a request header with a classic client ID
+// string, followed by an empty tagged field section when 'flexible'.
+func writeRequestHeader(buf *bytes.Buffer, apiKey, version int16, flexible bool) {
+	binary.Write(buf, binary.BigEndian, apiKey)
+	binary.Write(buf, binary.BigEndian, version)
+	binary.Write(buf, binary.BigEndian, int32(42)) // correlation ID
+	binary.Write(buf, binary.BigEndian, int16(4))
+	buf.WriteString("test")
+	if flexible {
+		buf.WriteByte(0) // tagged fields
+	}
+}
+
+// frame prepends the 4 byte frame length
+func frame(body []byte) []byte {
+	framed := make([]byte, 0, 4+len(body))
+	framed = binary.BigEndian.AppendUint32(framed, uint32(len(body)))
+	return append(framed, body...)
+}
+
+func (k *kafkaTestSuite) TestModernProduceRequest(c *C) {
+	// Produce v9 request with the compact encoding and topics "foo" and
+	// "bar", each with an empty partition array.
+	var buf bytes.Buffer
+	writeRequestHeader(&buf, int16(kafka.ProduceKey), 9, true)
+	buf.WriteByte(0)                                  // transactional_id (null)
+	binary.Write(&buf, binary.BigEndian, int16(-1))   // acks
+	binary.Write(&buf, binary.BigEndian, int32(1000)) // timeout_ms
+	buf.WriteByte(3)                                  // topic array length 2
+	for _, topic := range []string{"foo", "bar"} {
+		buf.WriteByte(byte(len(topic) + 1))
+		buf.WriteString(topic)
+		buf.WriteByte(1) // empty partition array
+		buf.WriteByte(0) // tagged fields
+	}
+	buf.WriteByte(0) // tagged fields
+
+	req, err := ReadRequest(bytes.NewReader(frame(buf.Bytes())))
+	c.Assert(err, IsNil)
+	c.Assert(req.GetAPIKey(), Equals, int16(kafka.ProduceKey))
+	c.Assert(req.GetVersion(), Equals, int16(9))
+	c.Assert(req.clientID, Equals, "test")
+	c.Assert(req.GetTopics(), HasLen, 2)
+
+	c.Assert(req.MatchesRule([]Rule{NewRule(-1, nil, "", "foo")}), Equals, false)
+	req, err = ReadRequest(bytes.NewReader(frame(buf.Bytes())))
+	c.Assert(err, IsNil)
+	c.Assert(req.MatchesRule([]Rule{
+		NewRule(-1, nil, "", "foo"), NewRule(-1, nil, "", "bar"),
+	}), Equals, true)
+	req, err = ReadRequest(bytes.NewReader(frame(buf.Bytes())))
+	c.Assert(err, IsNil)
+	c.Assert(req.MatchesRule([]Rule{NewRule(-1, nil, "", "*")}), Equals, true)
+}
+
+func (k *kafkaTestSuite) TestSaslHandshakeRequest(c *C) {
+	var buf bytes.Buffer
+	writeRequestHeader(&buf, int16(kafka.SaslHandshakeKey), 0, false)
+	binary.Write(&buf, binary.BigEndian, int16(5))
+	buf.WriteString("PLAIN")
+
+	req, err := ReadRequest(bytes.NewReader(frame(buf.Bytes())))
+	c.Assert(err, IsNil)
+	c.Assert(req.GetSaslMechanism(), Equals, "PLAIN")
+}
+
 func (k *kafkaTestSuite) TestUnknownRequest(c *C) {
 	reqMsg := RequestMessage{kind: 18} // ApiVersions request
 
@@ -14,6 +14,7 @@ import (
 	"github.com/sirupsen/logrus"
 
 	"github.com/cilium/cilium/pkg/flowdebug"
+	api "github.com/cilium/cilium/pkg/policy/api/kafka"
 )
 
 // RequestMessage represents a Kafka request message
@@ -23,6 +24,9 @@ type RequestMessage struct {
 	clientID string
 	rawMsg   []byte
 	request  interface{}
+	// saslMechanism is the mechanism name carried in a SaslHandshake
+	// request, empty for all other request kinds.
+	saslMechanism string
 	// Maintain a map of all topics in the request.  We should
 	// allow the request only if all topics in the request are
 	// allowed by the rules.
@@ -48,6 +52,12 @@ func (req *RequestMessage) GetVersion() int16 {
 	return req.version
 }
 
+// GetSaslMechanism returns the mechanism name of a SaslHandshake request,
+// or the empty string for all other request kinds
+func (req *RequestMessage) GetSaslMechanism() string {
+	return req.saslMechanism
+}
+
 // GetCorrelationID returns the Kafka request correlationID
 func (req *RequestMessage) GetCorrelationID() CorrelationID {
 	if len(req.rawMsg) >= 12 {
@@ -93,9 +103,6 @@ func (req *RequestMessage) String() string {
 
 // GetTopics returns the Kafka request list of topics
 func (req *RequestMessage) GetTopics() []string {
-	if req.request == nil {
-		return nil
-	}
 	topics := make([]string, 0, len(req.topics))
 	for topic := range req.topics {
 		topics = append(topics, topic)
@@ -204,6 +211,30 @@ func (req *RequestMessage) CreateAuthErrorResponse() (*ResponseMessage, error) {
 	return req.CreateResponse(proto.ErrTopicAuthorizationFailed)
 }
 
+// legacyVersion returns the newest API version of the given request kind
+// for which the library decoders used in ReadRequest can build an error
+// response. Requests with newer versions are enforced based on the fields
+// decoded by extractTopics, but are denied without an injected response.
+func legacyVersion(kind int16) int16 {
+	switch kind {
+	case proto.ProduceReqKind:
+		return 4
+	case proto.FetchReqKind:
+		return 5
+	case proto.OffsetReqKind:
+		return 2
+	case proto.MetadataReqKind:
+		return 4
+	case proto.ConsumerMetadataReqKind:
+		return 1
+	case proto.OffsetCommitReqKind:
+		return 4
+	case proto.OffsetFetchReqKind:
+		return 1
+	}
+	return -1
+}
+
 // ReadRequest will read a Kafka request from an io.Reader and return the
 // message or an error.
 func ReadRequest(reader io.Reader) (*RequestMessage, error) {
@@ -221,6 +252,39 @@ func ReadRequest(reader io.Reader) (*RequestMessage, error) {
 	req.version = req.extractVersion()
 	req.clientID = req.extractClientID()
 
+	if req.kind == api.SaslHandshakeKey {
+		req.saslMechanism = extractSaslMechanism(req.version, req.rawMsg)
+	}
+
+	// Decode the fields the policy is enforced on with the version-aware
+	// decoder, which understands the current protocol versions including
+	// the compact encodings used by modern clients. Requests with versions
+	// that extractTopics does not understand are treated as carrying
+	// unknown topics, so that rules with topic constraints fail closed.
+	topics, ok, err := extractTopics(req.kind, req.version, req.rawMsg)
+	if err != nil {
+		if flowdebug.Enabled() {
+			logrus.WithError(err).Debugf("Ignoring Kafka message %s due to parse error", req.String())
+		}
+		return nil, err
+	}
+	if !ok && flowdebug.Enabled() {
+		logrus.Debugf("Unknown Kafka request API key or version in %s, topics are not decoded", req.String())
+	}
+	req.topics = make(map[string]struct{}, len(topics))
+	for _, topic := range topics {
+		req.topics[topic] = struct{}{}
+	}
+
+	if req.version > legacyVersion(req.kind) {
+		return req, nil
+	}
+
+	// Additionally decode the request with the library decoders, which are
+	// only needed to build an error response if the request is denied. The
+	// library predates the current protocol versions; failing to decode
+	// here is not fatal, the request is enforced based on the fields
+	// decoded above but denied without an injected response.
 	var nilSlice []byte
 	buf := bytes.NewBuffer(append(nilSlice, req.rawMsg...))
 
@@ -239,20 +303,14 @@ func ReadRequest(reader io.Reader) (*RequestMessage, error) {
 		req.request, err = proto.ReadOffsetCommitReq(buf)
 	case proto.OffsetFetchReqKind:
 		req.request, err = proto.ReadOffsetFetchReq(buf)
-	default:
-		if flowdebug.Enabled() {
-			logrus.Debugf("Unknown Kafka request API key: %d in %s", req.kind, req.String())
-		}
 	}
 
 	if err != nil {
 		if flowdebug.Enabled() {
-			logrus.WithError(err).Debugf("Ignoring Kafka message %s due to parse error", req.String())
+			logrus.WithError(err).Debugf("Unable to decode Kafka message %s for response creation", req.String())
 		}
-		return nil, err
+		req.request = nil
 	}
 
-	req.setTopics()
-
 	return req, nil
 }
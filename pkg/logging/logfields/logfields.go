@@ -207,6 +207,12 @@ const (
 	// Veth is a veth object or ID
 	Veth = "veth"
 
+	// Bridge is a bridge device name
+	Bridge = "bridge"
+
+	// Tap is a tap device name
+	Tap = "tap"
+
 	// VethPair is a tuple of Veth that are paired
 	VethPair = "vethPair"
 
@@ -298,6 +304,12 @@ const (
 	// CiliumClusterwideNetworkPolicyName is the name of the CiliumClusterWideNetworkPolicy
 	CiliumClusterwideNetworkPolicyName = "ciliumClusterwideNetworkPolicyName"
 
+	// CiliumCIDRGroupName is the name of a CiliumCIDRGroup
+	CiliumCIDRGroupName = "ciliumCIDRGroupName"
+
+	// CiliumPolicyDefaultsName is the name of a CiliumPolicyDefaults
+	CiliumPolicyDefaultsName = "ciliumPolicyDefaultsName"
+
 	// BPFClockSource denotes the internal clock source (ktime vs jiffies)
 	BPFClockSource = "bpfClockSource"
 
@@ -0,0 +1,123 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package authmap
+
+import (
+	"fmt"
+	"unsafe"
+
+	"github.com/cilium/cilium/pkg/bpf"
+	"github.com/cilium/cilium/pkg/identity"
+	"github.com/cilium/cilium/pkg/option"
+)
+
+const (
+	// MapName is the canonical name of the auth map on the filesystem.
+	MapName = "cilium_auth_map"
+
+	// MaxEntries is the maximum number of handshake results that can be
+	// cached in the auth map.
+	MaxEntries = 1 << 19
+)
+
+// AuthType is the type of the authentication performed for a peer.
+type AuthType uint8
+
+const (
+	// AuthTypeSpire is a mutual TLS handshake against the SPIFFE
+	// identities issued to the peers by SPIRE.
+	AuthTypeSpire AuthType = 1
+)
+
+func (a AuthType) String() string {
+	switch a {
+	case AuthTypeSpire:
+		return "spire"
+	}
+	return fmt.Sprintf("unknown(%d)", uint8(a))
+}
+
+// AuthKey implements the bpf.MapKey interface.
+//
+// Must be in sync with struct auth_key in <bpf/lib/common.h>
+// +k8s:deepcopy-gen=true
+// +k8s:deepcopy-gen:interfaces=github.com/cilium/cilium/pkg/bpf.MapKey
+type AuthKey struct {
+	LocalIdentity  uint32   `align:"local_sec_label"`
+	RemoteIdentity uint32   `align:"remote_sec_label"`
+	RemoteNodeID   uint16   `align:"remote_node_id"`
+	AuthType       AuthType `align:"auth_type"`
+	Pad            uint8    `align:"pad"`
+}
+
+// GetKeyPtr returns the unsafe pointer to the BPF key.
+func (k *AuthKey) GetKeyPtr() unsafe.Pointer { return unsafe.Pointer(k) }
+
+// NewValue returns a new empty instance of the structure representing the BPF
+// map value.
+func (k AuthKey) NewValue() bpf.MapValue { return &AuthInfo{} }
+
+func (k AuthKey) String() string {
+	return fmt.Sprintf("localidentity=%d remoteidentity=%d remotenodeid=%d authtype=%s",
+		k.LocalIdentity, k.RemoteIdentity, k.RemoteNodeID, k.AuthType)
+}
+
+// AuthInfo implements the bpf.MapValue interface. It contains the expiration
+// of the cached authentication of the peers in the key.
+// +k8s:deepcopy-gen=true
+// +k8s:deepcopy-gen:interfaces=github.com/cilium/cilium/pkg/bpf.MapValue
+type AuthInfo struct {
+	// Expiration is the monotonic time in seconds at which the cached
+	// authentication expires and the handshake has to be performed again.
+	Expiration uint64 `align:"expiration"`
+}
+
+func (v *AuthInfo) String() string {
+	return fmt.Sprintf("expiration=%d", v.Expiration)
+}
+
+// GetValuePtr returns the unsafe pointer to the BPF value.
+func (v *AuthInfo) GetValuePtr() unsafe.Pointer { return unsafe.Pointer(v) }
+
+// AuthMap caches the result of the mutual authentication handshakes
+// performed by the agent. The datapath allows traffic that requires
+// authentication only while a non-expired entry for the peers is present and
+// drops it with an auth required drop reason otherwise.
+var AuthMap = bpf.NewMap(
+	MapName,
+	bpf.MapTypeHash,
+	&AuthKey{},
+	int(unsafe.Sizeof(AuthKey{})),
+	&AuthInfo{},
+	int(unsafe.Sizeof(AuthInfo{})),
+	MaxEntries,
+	0, 0,
+	bpf.ConvertKeyValue,
+).WithCache().WithPressureMetric().
+	WithEvents(option.Config.GetEventBufferConfig(MapName))
+
+// Update records a successful handshake between the given identities,
+// expiring at the given monotonic time in seconds.
+func Update(local, remote identity.NumericIdentity, remoteNodeID uint16, authType AuthType, expiration uint64) error {
+	key := AuthKey{
+		LocalIdentity:  local.Uint32(),
+		RemoteIdentity: remote.Uint32(),
+		RemoteNodeID:   remoteNodeID,
+		AuthType:       authType,
+	}
+	value := AuthInfo{Expiration: expiration}
+	return AuthMap.Update(&key, &value)
+}
+
+// Delete removes the cached authentication of the given identities, forcing
+// a new handshake for any further traffic that requires authentication.
+func Delete(local, remote identity.NumericIdentity, remoteNodeID uint16, authType AuthType) error {
+	key := AuthKey{
+		LocalIdentity:  local.Uint32(),
+		RemoteIdentity: remote.Uint32(),
+		RemoteNodeID:   remoteNodeID,
+		AuthType:       authType,
+	}
+	return AuthMap.Delete(&key)
+}
@@ -14,14 +14,24 @@ import (
 
 const (
 	MapName = "cilium_throttle"
-	// Flow aggregate is per Pod, so same size as Endpoint map.
-	MapSize = lxcmap.MaxEntries
+	// Flow aggregates are per Pod and direction, so twice the size of
+	// the Endpoint map.
+	MapSize = 2 * lxcmap.MaxEntries
 
 	// DefaultDropHorizon represents maximum allowed departure
 	// time delta in future. Given applications can set SO_TXTIME
 	// from user space this is a limit to prevent buggy applications
 	// to fill the FQ qdisc.
 	DefaultDropHorizon = 2 * time.Second
+
+	// EdtFlagBBR marks the aggregate as paced by the TCP socket's BBR
+	// congestion control. Must match EDT_F_BBR in <bpf/lib/common.h>.
+	EdtFlagBBR uint64 = 1 << 0
+
+	// EdtIngressID is ORed into the endpoint ID to key ingress aggregates
+	// in the throttle map. Must match EDT_INGRESS_ID in
+	// <bpf/lib/common.h>.
+	EdtIngressID uint64 = 1 << 16
 )
 
 type EdtId struct {
@@ -37,13 +47,14 @@ type EdtInfo struct {
 	Bps             uint64    `align:"bps"`
 	TimeLast        uint64    `align:"t_last"`
 	TimeHorizonDrop uint64    `align:"t_horizon_drop"`
-	Pad             [4]uint64 `align:"pad"`
+	Flags           uint64    `align:"flags"`
+	Pad             [3]uint64 `align:"pad"`
 }
 
 func (v *EdtInfo) GetValuePtr() unsafe.Pointer { return unsafe.Pointer(v) }
 func (v *EdtInfo) String() string              { return fmt.Sprintf("%d", int(v.Bps)) }
 func (v *EdtInfo) DeepCopyMapValue() bpf.MapValue {
-	return &EdtInfo{v.Bps, v.TimeLast, v.TimeHorizonDrop, v.Pad}
+	return &EdtInfo{v.Bps, v.TimeLast, v.TimeHorizonDrop, v.Flags, v.Pad}
 }
 
 var ThrottleMap = bpf.NewMap(
@@ -56,10 +67,10 @@ var ThrottleMap = bpf.NewMap(
 	bpf.ConvertKeyValue,
 ).WithCache().WithPressureMetric()
 
-func Update(Id uint16, Bps uint64) error {
+func Update(Id uint16, Bps uint64, Flags uint64) error {
 	return ThrottleMap.Update(
 		&EdtId{Id: uint64(Id)},
-		&EdtInfo{Bps: Bps, TimeHorizonDrop: uint64(DefaultDropHorizon)})
+		&EdtInfo{Bps: Bps, Flags: Flags, TimeHorizonDrop: uint64(DefaultDropHorizon)})
 }
 
 func Delete(Id uint16) error {
@@ -73,3 +84,21 @@ func SilentDelete(Id uint16) error {
 
 	return err
 }
+
+func UpdateIngress(Id uint16, Bps uint64) error {
+	return ThrottleMap.Update(
+		&EdtId{Id: uint64(Id) | EdtIngressID},
+		&EdtInfo{Bps: Bps, TimeHorizonDrop: uint64(DefaultDropHorizon)})
+}
+
+func DeleteIngress(Id uint16) error {
+	return ThrottleMap.Delete(
+		&EdtId{Id: uint64(Id) | EdtIngressID})
+}
+
+func SilentDeleteIngress(Id uint16) error {
+	_, err := ThrottleMap.SilentDelete(
+		&EdtId{Id: uint64(Id) | EdtIngressID})
+
+	return err
+}
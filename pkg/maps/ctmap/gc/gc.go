@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"net/netip"
 	"os"
+	"strconv"
 	"time"
 
 	"github.com/sirupsen/logrus"
@@ -19,6 +20,7 @@ import (
 	"github.com/cilium/cilium/pkg/logging"
 	"github.com/cilium/cilium/pkg/logging/logfields"
 	"github.com/cilium/cilium/pkg/maps/ctmap"
+	"github.com/cilium/cilium/pkg/metrics"
 	"github.com/cilium/cilium/pkg/option"
 	"github.com/cilium/cilium/pkg/signal"
 )
@@ -75,7 +77,19 @@ func Enable(ipv4, ipv6 bool, restoredEndpoints []*endpoint.Endpoint, mgr Endpoin
 				// alive during idle periods of upto ToFQDNsIdleConnectionGracePeriod.
 				aliveTime = gcStart.Add(option.Config.ToFQDNsIdleConnectionGracePeriod)
 
+				// epEntries counts the alive CT entries per local endpoint for
+				// the conntrack_entries_per_endpoint metric.
+				countEntries = option.Config.MetricsConfig.ConntrackEndpointEntriesEnabled
+				epEntries    = make(map[uint16]int)
+
 				emitEntryCB = func(srcIP, dstIP netip.Addr, srcPort, dstPort uint16, nextHdr, flags uint8, entry *ctmap.CtEntry) {
+					if countEntries {
+						if ep, exists := epsMap[srcIP]; exists {
+							epEntries[ep.ID]++
+						} else if ep, exists := epsMap[dstIP]; exists {
+							epEntries[ep.ID]++
+						}
+					}
 					// FQDN related connections can only be outbound
 					if flags != ctmap.TUPLE_F_OUT {
 						return
@@ -90,6 +104,9 @@ func Enable(ipv4, ipv6 bool, restoredEndpoints []*endpoint.Endpoint, mgr Endpoin
 			for _, e := range eps {
 				epsMap[e.IPv4Address()] = e
 				epsMap[e.IPv6Address()] = e
+				if countEntries {
+					epEntries[e.ID] = 0
+				}
 			}
 
 			if len(eps) > 0 || initialScan {
@@ -104,6 +121,16 @@ func Enable(ipv4, ipv6 bool, restoredEndpoints []*endpoint.Endpoint, mgr Endpoin
 				runGC(e, ipv4, ipv6, triggeredBySignal, &ctmap.GCFilter{RemoveExpired: true, EmitCTEntryCB: emitEntryCB})
 			}
 
+			// Only publish the per-endpoint occupancy after full scans,
+			// signal-triggered partial runs would under-report it.
+			if countEntries && !triggeredBySignal {
+				metrics.ConntrackEndpointEntries.Reset()
+				for epID, entries := range epEntries {
+					metrics.ConntrackEndpointEntries.WithLabelValues(
+						strconv.FormatUint(uint64(epID), 10)).Set(float64(entries))
+				}
+			}
+
 			// Mark the CT GC as over in each EP DNSZombies instance
 			for _, e := range eps {
 				e.MarkCTGCTime(gcStart)
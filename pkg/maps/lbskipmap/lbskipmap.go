@@ -0,0 +1,63 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package lbskipmap
+
+import (
+	"fmt"
+	"unsafe"
+
+	"github.com/cilium/cilium/pkg/bpf"
+)
+
+const (
+	MapName = "cilium_lb_skip_netns"
+	// MapSize is the maximum number of netns excluded from socket LB.
+	MapSize = 16384
+)
+
+// SkipKey is the key of the socket LB skip map, the cookie of a network
+// namespace excluded from socket LB.
+type SkipKey struct {
+	NetnsCookie uint64 `align:"netns_cookie"`
+}
+
+func (k *SkipKey) GetKeyPtr() unsafe.Pointer { return unsafe.Pointer(k) }
+func (k *SkipKey) NewValue() bpf.MapValue    { return &SkipEntry{} }
+func (k *SkipKey) String() string            { return fmt.Sprintf("%d", k.NetnsCookie) }
+func (k *SkipKey) DeepCopyMapKey() bpf.MapKey {
+	return &SkipKey{NetnsCookie: k.NetnsCookie}
+}
+
+// SkipEntry is the value of the socket LB skip map. The presence of the key
+// is all that matters.
+type SkipEntry struct {
+	Pad uint8 `align:"pad"`
+}
+
+func (v *SkipEntry) GetValuePtr() unsafe.Pointer    { return unsafe.Pointer(v) }
+func (v *SkipEntry) String() string                 { return "" }
+func (v *SkipEntry) DeepCopyMapValue() bpf.MapValue { return &SkipEntry{} }
+
+var SkipMap = bpf.NewMap(
+	MapName,
+	bpf.MapTypeHash,
+	&SkipKey{}, int(unsafe.Sizeof(SkipKey{})),
+	&SkipEntry{}, int(unsafe.Sizeof(SkipEntry{})),
+	MapSize,
+	bpf.BPF_F_NO_PREALLOC, 0,
+	bpf.ConvertKeyValue,
+).WithCache().WithPressureMetric()
+
+// AddNetNSCookie excludes the netns with the given cookie from socket LB.
+func AddNetNSCookie(cookie uint64) error {
+	return SkipMap.Update(&SkipKey{NetnsCookie: cookie}, &SkipEntry{})
+}
+
+// DeleteNetNSCookie removes the socket LB exclusion of the netns with the
+// given cookie.
+func DeleteNetNSCookie(cookie uint64) error {
+	_, err := SkipMap.SilentDelete(&SkipKey{NetnsCookie: cookie})
+
+	return err
+}
@@ -0,0 +1,80 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package mcastmap
+
+import (
+	"fmt"
+	"net"
+	"unsafe"
+
+	"github.com/cilium/cilium/pkg/bpf"
+	"github.com/cilium/cilium/pkg/types"
+)
+
+const (
+	MapName = "cilium_mcast_group"
+	// MapSize is the maximum number of multicast groups which can be
+	// opted into the datapath.
+	MapSize = 1024
+)
+
+// McastGroupKey is the key of the multicast group map, the IPv4 group
+// address.
+//
+// Must be in sync with struct mcast_group_key in <bpf/lib/common.h>
+type McastGroupKey struct {
+	Group types.IPv4 `align:"group"`
+}
+
+func (k *McastGroupKey) GetKeyPtr() unsafe.Pointer  { return unsafe.Pointer(k) }
+func (k *McastGroupKey) NewValue() bpf.MapValue     { return &McastGroupEntry{} }
+func (k *McastGroupKey) String() string             { return k.Group.String() }
+func (k *McastGroupKey) DeepCopyMapKey() bpf.MapKey { return &McastGroupKey{k.Group} }
+
+// NewKey returns a McastGroupKey for the given IPv4 group address.
+func NewKey(group net.IP) McastGroupKey {
+	key := McastGroupKey{}
+	if ip4 := group.To4(); ip4 != nil {
+		copy(key.Group[:], ip4)
+	}
+
+	return key
+}
+
+// McastGroupEntry is the value of the multicast group map.
+//
+// Must be in sync with struct mcast_group_entry in <bpf/lib/common.h>
+type McastGroupEntry struct {
+	Flags uint32 `align:"flags"`
+}
+
+func (v *McastGroupEntry) GetValuePtr() unsafe.Pointer { return unsafe.Pointer(v) }
+func (v *McastGroupEntry) String() string              { return fmt.Sprintf("%d", int(v.Flags)) }
+func (v *McastGroupEntry) DeepCopyMapValue() bpf.MapValue {
+	return &McastGroupEntry{v.Flags}
+}
+
+var McastGroupMap = bpf.NewMap(
+	MapName,
+	bpf.MapTypeHash,
+	&McastGroupKey{}, int(unsafe.Sizeof(McastGroupKey{})),
+	&McastGroupEntry{}, int(unsafe.Sizeof(McastGroupEntry{})),
+	MapSize,
+	bpf.BPF_F_NO_PREALLOC, 0,
+	bpf.ConvertKeyValue,
+).WithCache().WithPressureMetric()
+
+// Update adds the given IPv4 group address to the map.
+func Update(group net.IP) error {
+	key := NewKey(group)
+	return McastGroupMap.Update(&key, &McastGroupEntry{})
+}
+
+// Delete removes the given IPv4 group address from the map.
+func Delete(group net.IP) error {
+	key := NewKey(group)
+	_, err := McastGroupMap.SilentDelete(&key)
+
+	return err
+}
@@ -0,0 +1,117 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package ratelimitmap
+
+import (
+	"fmt"
+	"unsafe"
+
+	"github.com/cilium/cilium/pkg/bpf"
+	"github.com/cilium/cilium/pkg/identity"
+	"github.com/cilium/cilium/pkg/option"
+	"github.com/cilium/cilium/pkg/policy/trafficdirection"
+	"github.com/cilium/cilium/pkg/u8proto"
+)
+
+const (
+	// MapName is the canonical name of the rate limit map on the
+	// filesystem.
+	MapName = "cilium_ratelimit"
+
+	// MaxEntries is the maximum number of rate limit configurations which
+	// can be installed in the map.
+	MaxEntries = 1 << 16
+)
+
+// RateLimitKey implements the bpf.MapKey interface.
+//
+// Must be in sync with struct ratelimit_key in <bpf/lib/common.h>
+// +k8s:deepcopy-gen=true
+// +k8s:deepcopy-gen:interfaces=github.com/cilium/cilium/pkg/bpf.MapKey
+type RateLimitKey struct {
+	Identity  uint32 `align:"sec_label"`
+	DestPort  uint16 `align:"dport"` // In network byte-order
+	Nexthdr   uint8  `align:"nexthdr"`
+	Direction uint8  `align:"direction"`
+}
+
+// GetKeyPtr returns the unsafe pointer to the BPF key.
+func (k *RateLimitKey) GetKeyPtr() unsafe.Pointer { return unsafe.Pointer(k) }
+
+// NewValue returns a new empty instance of the structure representing the BPF
+// map value.
+func (k RateLimitKey) NewValue() bpf.MapValue { return &RateLimitInfo{} }
+
+func (k RateLimitKey) String() string {
+	return fmt.Sprintf("identity=%d dport=%d nexthdr=%d direction=%d",
+		k.Identity, k.DestPort, k.Nexthdr, k.Direction)
+}
+
+// RateLimitInfo implements the bpf.MapValue interface. It contains the
+// configured limits for the traffic selected by the key. The token bucket
+// state tracking the consumed rate is kept by the datapath and is not
+// visible to the agent.
+// +k8s:deepcopy-gen=true
+// +k8s:deepcopy-gen:interfaces=github.com/cilium/cilium/pkg/bpf.MapValue
+type RateLimitInfo struct {
+	// PacketsPerSecond is the maximum number of packets per second allowed
+	// per source identity. Zero means no packet based limit.
+	PacketsPerSecond uint64 `align:"packets_per_second"`
+
+	// BytesPerSecond is the maximum number of bytes per second allowed per
+	// source identity. Zero means no byte based limit.
+	BytesPerSecond uint64 `align:"bytes_per_second"`
+}
+
+func (v *RateLimitInfo) String() string {
+	return fmt.Sprintf("packetspersecond=%d bytespersecond=%d",
+		v.PacketsPerSecond, v.BytesPerSecond)
+}
+
+// GetValuePtr returns the unsafe pointer to the BPF value.
+func (v *RateLimitInfo) GetValuePtr() unsafe.Pointer { return unsafe.Pointer(v) }
+
+// RateLimitMap contains the rate limits configured by policy rules with a
+// rate limit verdict. The datapath accounts the traffic matching the key
+// against the configured limits and drops the packets exceeding them.
+var RateLimitMap = bpf.NewMap(
+	MapName,
+	bpf.MapTypeHash,
+	&RateLimitKey{},
+	int(unsafe.Sizeof(RateLimitKey{})),
+	&RateLimitInfo{},
+	int(unsafe.Sizeof(RateLimitInfo{})),
+	MaxEntries,
+	0, 0,
+	bpf.ConvertKeyValue,
+).WithCache().WithPressureMetric().
+	WithEvents(option.Config.GetEventBufferConfig(MapName))
+
+// Update installs the given limits for traffic from the given identity to
+// the given port and protocol.
+func Update(id identity.NumericIdentity, dport uint16, proto u8proto.U8proto, dir trafficdirection.TrafficDirection, packetsPerSecond, bytesPerSecond uint64) error {
+	key := RateLimitKey{
+		Identity:  id.Uint32(),
+		DestPort:  dport,
+		Nexthdr:   uint8(proto),
+		Direction: dir.Uint8(),
+	}
+	value := RateLimitInfo{
+		PacketsPerSecond: packetsPerSecond,
+		BytesPerSecond:   bytesPerSecond,
+	}
+	return RateLimitMap.Update(&key, &value)
+}
+
+// Delete removes the rate limit for traffic from the given identity to the
+// given port and protocol.
+func Delete(id identity.NumericIdentity, dport uint16, proto u8proto.U8proto, dir trafficdirection.TrafficDirection) error {
+	key := RateLimitKey{
+		Identity:  id.Uint32(),
+		DestPort:  dport,
+		Nexthdr:   uint8(proto),
+		Direction: dir.Uint8(),
+	}
+	return RateLimitMap.Delete(&key)
+}
@@ -0,0 +1,97 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package xdpportmap
+
+import (
+	"fmt"
+	"unsafe"
+
+	"github.com/cilium/cilium/pkg/bpf"
+	"github.com/cilium/cilium/pkg/byteorder"
+)
+
+const (
+	MapName = "cilium_xdp_port_filter"
+	// MapSize is the maximum number of port filter rules.
+	MapSize = 16384
+)
+
+// TCP flag bits as found in the flags byte of the TCP header.
+const (
+	TCPFlagFIN uint8 = 1 << iota
+	TCPFlagSYN
+	TCPFlagRST
+	TCPFlagPSH
+	TCPFlagACK
+	TCPFlagURG
+)
+
+// PortFilterKey is the key of the XDP port filter map, the destination port
+// in network byte order plus the L4 protocol.
+//
+// Must be in sync with struct xdp_port_filter_key in <bpf/lib/common.h>
+type PortFilterKey struct {
+	DPort uint16 `align:"dport"`
+	Proto uint8  `align:"proto"`
+	Pad   uint8  `align:"pad"`
+}
+
+func (k *PortFilterKey) GetKeyPtr() unsafe.Pointer { return unsafe.Pointer(k) }
+func (k *PortFilterKey) NewValue() bpf.MapValue    { return &PortFilterEntry{} }
+func (k *PortFilterKey) String() string {
+	return fmt.Sprintf("%d/%d", byteorder.NetworkToHost16(k.DPort), k.Proto)
+}
+func (k *PortFilterKey) DeepCopyMapKey() bpf.MapKey {
+	return &PortFilterKey{DPort: k.DPort, Proto: k.Proto}
+}
+
+// NewKey returns a PortFilterKey for the given destination port in host byte
+// order and L4 protocol.
+func NewKey(port uint16, proto uint8) PortFilterKey {
+	return PortFilterKey{
+		DPort: byteorder.HostToNetwork16(port),
+		Proto: proto,
+	}
+}
+
+// PortFilterEntry is the value of the XDP port filter map.
+//
+// Must be in sync with struct xdp_port_filter_entry in <bpf/lib/common.h>
+type PortFilterEntry struct {
+	TCPFlags uint8  `align:"tcp_flags"`
+	Pad1     uint8  `align:"pad1"`
+	Pad2     uint16 `align:"pad2"`
+}
+
+func (v *PortFilterEntry) GetValuePtr() unsafe.Pointer { return unsafe.Pointer(v) }
+func (v *PortFilterEntry) String() string              { return fmt.Sprintf("flags=0x%02x", v.TCPFlags) }
+func (v *PortFilterEntry) DeepCopyMapValue() bpf.MapValue {
+	return &PortFilterEntry{TCPFlags: v.TCPFlags}
+}
+
+var PortFilterMap = bpf.NewMap(
+	MapName,
+	bpf.MapTypeHash,
+	&PortFilterKey{}, int(unsafe.Sizeof(PortFilterKey{})),
+	&PortFilterEntry{}, int(unsafe.Sizeof(PortFilterEntry{})),
+	MapSize,
+	bpf.BPF_F_NO_PREALLOC, 0,
+	bpf.ConvertKeyValue,
+).WithCache().WithPressureMetric()
+
+// Update adds a drop rule for the given port and protocol. tcpFlags narrows
+// the rule to TCP segments with any of the given flags set, zero matches all
+// segments.
+func Update(port uint16, proto uint8, tcpFlags uint8) error {
+	key := NewKey(port, proto)
+	return PortFilterMap.Update(&key, &PortFilterEntry{TCPFlags: tcpFlags})
+}
+
+// Delete removes the drop rule for the given port and protocol.
+func Delete(port uint16, proto uint8) error {
+	key := NewKey(port, proto)
+	_, err := PortFilterMap.SilentDelete(&key)
+
+	return err
+}
@@ -28,6 +28,7 @@ type CounterVec interface {
 
 type GaugeVec interface {
 	WithLabelValues(lvls ...string) prometheus.Gauge
+	Reset()
 	prometheus.Collector
 }
 
@@ -133,3 +134,5 @@ type gaugeVec struct {
 func (gv *gaugeVec) WithLabelValues(lvls ...string) prometheus.Gauge {
 	return NoOpGauge
 }
+
+func (gv *gaugeVec) Reset() {}
@@ -125,6 +125,10 @@ const (
 	// LabelStatus the label from completed task
 	LabelStatus = "status"
 
+	// LabelState is the label used to describe the state an object is in,
+	// e.g. the state of an IP in an IPAM pool
+	LabelState = "state"
+
 	// LabelPolicyEnforcement is the label used to see the enforcement status
 	LabelPolicyEnforcement = "enforcement"
 
@@ -205,6 +209,7 @@ const (
 	// LabelType is the label for type in general (e.g. endpoint, node)
 	LabelType         = "type"
 	LabelPeerEndpoint = "endpoint"
+	LabelEndpointID   = "endpoint_id"
 	LabelPeerNode     = "node"
 
 	LabelTrafficHTTP = "http"
@@ -258,6 +263,10 @@ var (
 	// Policy is the number of policies loaded into the agent
 	Policy = NoOpGauge
 
+	// PolicyRulesNeverMatched is the number of policy rules currently loaded
+	// into the agent which have never matched in the policy engine
+	PolicyRulesNeverMatched = NoOpGauge
+
 	// PolicyRegenerationCount is the total number of successful policy
 	// regenerations.
 	PolicyRegenerationCount = NoOpCounter
@@ -274,6 +283,10 @@ var (
 	// PolicyEndpointStatus is the number of endpoints with policy labeled by enforcement type
 	PolicyEndpointStatus = NoOpGaugeVec
 
+	// PolicyDefaultDenyRolloutPhase is the per-namespace phase of the staged
+	// default-deny rollout, 0 while auditing and 1 once enforcing
+	PolicyDefaultDenyRolloutPhase = NoOpGaugeVec
+
 	// PolicyImplementationDelay is a distribution of times taken from adding a
 	// policy (and incrementing the policy revision) to seeing it in the datapath
 	// per Endpoint. This reflects the actual delay perceived by traffic flowing
@@ -369,6 +382,10 @@ var (
 	// ConntrackDumpReset marks the count for conntrack dump resets
 	ConntrackDumpResets = NoOpCounterVec
 
+	// ConntrackEndpointEntries is the number of alive conntrack entries
+	// per local endpoint, computed during conntrack garbage collection.
+	ConntrackEndpointEntries = NoOpGaugeVec
+
 	// Signals
 
 	// SignalsHandled is the number of signals received.
@@ -426,6 +443,10 @@ var (
 	// datapath family type
 	IpamEvent = NoOpCounterVec
 
+	// IpamPoolIPs is the per-pool number of IPs labeled by datapath family
+	// and state (allocated, available, reserved)
+	IpamPoolIPs = NoOpGaugeVec
+
 	// KVstore events
 
 	// KVStoreOperationsDuration records the duration of kvstore operations
@@ -533,11 +554,13 @@ type Configuration struct {
 	EndpointRegenerationTimeStatsEnabled    bool
 	EndpointPropagationDelayEnabled         bool
 	PolicyCountEnabled                      bool
+	PolicyRulesNeverMatchedEnabled          bool
 	PolicyRegenerationCountEnabled          bool
 	PolicyRegenerationTimeStatsEnabled      bool
 	PolicyRevisionEnabled                   bool
 	PolicyImportErrorsEnabled               bool
 	PolicyEndpointStatusEnabled             bool
+	PolicyDefaultDenyRolloutPhaseEnabled    bool
 	PolicyImplementationDelayEnabled        bool
 	IdentityCountEnabled                    bool
 	EventTSEnabled                          bool
@@ -561,6 +584,7 @@ type Configuration struct {
 	ConntrackGCSizeEnabled                  bool
 	ConntrackGCDurationEnabled              bool
 	ConntrackDumpResetsEnabled              bool
+	ConntrackEndpointEntriesEnabled         bool
 	SignalsHandledEnabled                   bool
 	ServicesCountEnabled                    bool
 	ErrorsWarningsEnabled                   bool
@@ -575,6 +599,7 @@ type Configuration struct {
 	KubernetesCNPStatusCompletionEnabled    bool
 	KubernetesTerminatingEndpointsEnabled   bool
 	IpamEventEnabled                        bool
+	IpamPoolIPsEnabled                      bool
 	IPCacheErrorsTotalEnabled               bool
 	IPCacheEventsTotalEnabled               bool
 	KVStoreOperationsDurationEnabled        bool
@@ -609,6 +634,7 @@ func DefaultMetrics() map[string]struct{} {
 		Namespace + "_endpoint_state":                                                {},
 		Namespace + "_endpoint_regeneration_time_stats_seconds":                      {},
 		Namespace + "_policy":                                                        {},
+		Namespace + "_policy_rules_never_matched":                                    {},
 		Namespace + "_policy_regeneration_total":                                     {},
 		Namespace + "_policy_regeneration_time_stats_seconds":                        {},
 		Namespace + "_policy_max_revision":                                           {},
@@ -649,6 +675,7 @@ func DefaultMetrics() map[string]struct{} {
 		Namespace + "_" + SubsystemK8s + "_cnp_status_completion_seconds":            {},
 		Namespace + "_" + SubsystemK8s + "_terminating_endpoints_events_total":       {},
 		Namespace + "_ipam_events_total":                                             {},
+		Namespace + "_ipam_pool_ips":                                                 {},
 		Namespace + "_" + SubsystemKVStore + "_operations_duration_seconds":          {},
 		Namespace + "_" + SubsystemKVStore + "_events_queue_seconds":                 {},
 		Namespace + "_" + SubsystemKVStore + "_quorum_errors_total":                  {},
@@ -733,6 +760,16 @@ func CreateConfiguration(metricsEnabled []string) (Configuration, []prometheus.C
 			collectors = append(collectors, Policy)
 			c.PolicyCountEnabled = true
 
+		case Namespace + "_policy_rules_never_matched":
+			PolicyRulesNeverMatched = prometheus.NewGauge(prometheus.GaugeOpts{
+				Namespace: Namespace,
+				Name:      "policy_rules_never_matched",
+				Help:      "Number of policy rules currently loaded which have never matched",
+			})
+
+			collectors = append(collectors, PolicyRulesNeverMatched)
+			c.PolicyRulesNeverMatchedEnabled = true
+
 		case Namespace + "_policy_regeneration_total":
 			PolicyRegenerationCount = prometheus.NewCounter(prometheus.CounterOpts{
 				Namespace: Namespace,
@@ -783,6 +820,16 @@ func CreateConfiguration(metricsEnabled []string) (Configuration, []prometheus.C
 			collectors = append(collectors, PolicyEndpointStatus)
 			c.PolicyEndpointStatusEnabled = true
 
+		case Namespace + "_policy_default_deny_rollout_phase":
+			PolicyDefaultDenyRolloutPhase = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+				Namespace: Namespace,
+				Name:      "policy_default_deny_rollout_phase",
+				Help:      "Per-namespace phase of the staged default-deny rollout (0=audit, 1=enforcing)",
+			}, []string{"namespace"})
+
+			collectors = append(collectors, PolicyDefaultDenyRolloutPhase)
+			c.PolicyDefaultDenyRolloutPhaseEnabled = true
+
 		case Namespace + "_policy_implementation_delay":
 			PolicyImplementationDelay = prometheus.NewHistogramVec(prometheus.HistogramOpts{
 				Namespace: Namespace,
@@ -1015,6 +1062,19 @@ func CreateConfiguration(metricsEnabled []string) (Configuration, []prometheus.C
 			collectors = append(collectors, ConntrackDumpResets)
 			c.ConntrackDumpResetsEnabled = true
 
+		case Namespace + "_" + SubsystemDatapath + "_conntrack_entries_per_endpoint":
+			ConntrackEndpointEntries = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+				Namespace: Namespace,
+				Subsystem: SubsystemDatapath,
+				Name:      "conntrack_entries_per_endpoint",
+				Help: "The number of alive conntrack entries per local endpoint " +
+					"at the end of a garbage collector run. Disabled by default " +
+					"due to its per-endpoint cardinality",
+			}, []string{LabelEndpointID})
+
+			collectors = append(collectors, ConntrackEndpointEntries)
+			c.ConntrackEndpointEntriesEnabled = true
+
 		case Namespace + "_" + SubsystemDatapath + "_signals_handled_total":
 			SignalsHandled = prometheus.NewCounterVec(prometheus.CounterOpts{
 				Namespace: Namespace,
@@ -1151,6 +1211,16 @@ func CreateConfiguration(metricsEnabled []string) (Configuration, []prometheus.C
 			collectors = append(collectors, IpamEvent)
 			c.IpamEventEnabled = true
 
+		case Namespace + "_ipam_pool_ips":
+			IpamPoolIPs = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+				Namespace: Namespace,
+				Name:      "ipam_pool_ips",
+				Help:      "Number of IPs in the IPAM pool labeled by family and state",
+			}, []string{LabelDatapathFamily, LabelState})
+
+			collectors = append(collectors, IpamPoolIPs)
+			c.IpamPoolIPsEnabled = true
+
 		case Namespace + "_" + SubsystemKVStore + "_operations_duration_seconds":
 			KVStoreOperationsDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
 				Namespace: Namespace,
@@ -84,6 +84,10 @@ var errors = map[uint8]string{
 	186: "SRv6 state was removed during tail call",
 	187: "L3 translation from IPv4 to IPv6 failed (NAT46)",
 	188: "L3 translation from IPv6 to IPv4 failed (NAT64)",
+	189: "Multicast group not opted in via CiliumMulticastGroup",
+	190: "Policy denied (L3)",
+	191: "Policy denied (L4)",
+	192: "CT: Map full",
 }
 
 func extendedReason(reason uint8, extError int8) string {
@@ -184,6 +184,24 @@ func (c *Configuration) GetRouteMTU() int {
 	return c.tunnelMTU
 }
 
+// GetRouteMTUForPath returns the MTU to be used on a route towards a node
+// which is reached via a path with the given underlay MTU. Tunnel and
+// encryption overhead is accounted for in the same way as in GetRouteMTU.
+// A pathMTU of 0 or one not smaller than the standard MTU falls back to
+// GetRouteMTU.
+func (c *Configuration) GetRouteMTUForPath(pathMTU int) int {
+	if pathMTU == 0 || pathMTU >= c.GetDeviceMTU() {
+		return c.GetRouteMTU()
+	}
+
+	mtu := pathMTU - (c.GetDeviceMTU() - c.GetRouteMTU())
+	if mtu < 0 {
+		return 0
+	}
+
+	return mtu
+}
+
 // GetDeviceMTU returns the MTU to be used on workload facing devices.
 func (c *Configuration) GetDeviceMTU() int {
 	if c.standardMTU == 0 {
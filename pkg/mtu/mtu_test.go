@@ -87,3 +87,20 @@ func (m *MTUSuite) TestNewConfiguration(c *C) {
 	conf = NewConfiguration(0, true, true, false, 0, testIP2)
 	c.Assert(conf.GetDeviceMTU(), Equals, 65536)
 }
+
+func (m *MTUSuite) TestGetRouteMTUForPath(c *C) {
+	// Without tunneling the path MTU is used as is
+	conf := NewConfiguration(0, false, false, false, 1500, nil)
+	c.Assert(conf.GetRouteMTUForPath(0), Equals, conf.GetRouteMTU())
+	c.Assert(conf.GetRouteMTUForPath(1500), Equals, conf.GetRouteMTU())
+	c.Assert(conf.GetRouteMTUForPath(1400), Equals, 1400)
+
+	// With tunneling the tunnel overhead is subtracted from the path MTU
+	conf = NewConfiguration(0, false, true, false, 1500, nil)
+	c.Assert(conf.GetRouteMTUForPath(0), Equals, conf.GetRouteMTU())
+	c.Assert(conf.GetRouteMTUForPath(1500), Equals, conf.GetRouteMTU())
+	c.Assert(conf.GetRouteMTUForPath(1400), Equals, 1400-TunnelOverhead)
+
+	// A path MTU smaller than the overhead must not underflow
+	c.Assert(conf.GetRouteMTUForPath(TunnelOverhead-10), Equals, 0)
+}
@@ -0,0 +1,133 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package multicast
+
+import (
+	"net"
+	"reflect"
+
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/cilium/cilium/pkg/k8s/apis/cilium.io/v2alpha1"
+	"github.com/cilium/cilium/pkg/k8s/client"
+	"github.com/cilium/cilium/pkg/k8s/informer"
+	"github.com/cilium/cilium/pkg/k8s/utils"
+	"github.com/cilium/cilium/pkg/maps/mcastmap"
+)
+
+// K8sEventRegister is used to do metrics accounting for the group watcher's
+// Kubernetes events.
+type K8sEventRegister interface {
+	// K8sEventReceived is called to do metrics accounting for received
+	// Kubernetes events, as well as calculating timeouts for k8s watcher
+	// cache sync.
+	K8sEventReceived(apiGroupResourceName string, scope string, action string, valid, equal bool)
+
+	// K8sEventProcessed is called to do metrics accounting for each processed
+	// Kubernetes event.
+	K8sEventProcessed(scope string, action string, status bool)
+}
+
+// groupAddress validates the group address of a CiliumMulticastGroup. Invalid
+// addresses are logged and skipped so that a single typo does not stall the
+// remaining groups.
+func groupAddress(group *v2alpha1.CiliumMulticastGroup) net.IP {
+	ip := net.ParseIP(group.Spec.GroupIP)
+	if ip == nil || ip.To4() == nil || !ip.IsMulticast() {
+		log.WithField("group", group.Name).
+			Warningf("Ignoring invalid multicast group address %q", group.Spec.GroupIP)
+		return nil
+	}
+
+	return ip
+}
+
+func upsertGroup(group *v2alpha1.CiliumMulticastGroup) {
+	ip := groupAddress(group)
+	if ip == nil {
+		return
+	}
+
+	if err := mcastmap.Update(ip); err != nil {
+		log.WithError(err).WithField("group", group.Name).
+			Warning("Unable to add multicast group to BPF map")
+	}
+}
+
+func deleteGroup(group *v2alpha1.CiliumMulticastGroup) {
+	ip := groupAddress(group)
+	if ip == nil {
+		return
+	}
+
+	if err := mcastmap.Delete(ip); err != nil {
+		log.WithError(err).WithField("group", group.Name).
+			Warning("Unable to remove multicast group from BPF map")
+	}
+}
+
+// WatchGroups starts an informer on the cluster-wide CiliumMulticastGroup
+// resources and mirrors them into the multicast group BPF map consulted by
+// the datapath.
+func WatchGroups(clientset client.Clientset, k8sEventReg K8sEventRegister) {
+	apiGroup := "cilium/v2alpha1::CiliumMulticastGroup"
+	_, groupInformer := informer.NewInformer(
+		utils.ListerWatcherFromTyped[*v2alpha1.CiliumMulticastGroupList](clientset.CiliumV2alpha1().CiliumMulticastGroups()),
+		&v2alpha1.CiliumMulticastGroup{},
+		0,
+		cache.ResourceEventHandlerFuncs{
+			AddFunc: func(obj interface{}) {
+				var valid bool
+				defer func() { k8sEventReg.K8sEventReceived(apiGroup, "CiliumMulticastGroup", "create", valid, false) }()
+				if group, ok := obj.(*v2alpha1.CiliumMulticastGroup); ok {
+					valid = true
+					upsertGroup(group)
+					k8sEventReg.K8sEventProcessed("CiliumMulticastGroup", "create", true)
+				} else {
+					log.Warningf("Unknown CiliumMulticastGroup object type %s received: %+v", reflect.TypeOf(obj), obj)
+				}
+			},
+			UpdateFunc: func(oldObj, newObj interface{}) {
+				var valid, equal bool
+				defer func() { k8sEventReg.K8sEventReceived(apiGroup, "CiliumMulticastGroup", "update", valid, equal) }()
+				if oldGroup, ok := oldObj.(*v2alpha1.CiliumMulticastGroup); ok {
+					if newGroup, ok := newObj.(*v2alpha1.CiliumMulticastGroup); ok {
+						valid = true
+						if oldGroup.Spec.DeepEqual(&newGroup.Spec) {
+							equal = true
+							return
+						}
+						if oldGroup.Spec.GroupIP != newGroup.Spec.GroupIP {
+							deleteGroup(oldGroup)
+						}
+						upsertGroup(newGroup)
+						k8sEventReg.K8sEventProcessed("CiliumMulticastGroup", "update", true)
+					}
+				}
+			},
+			DeleteFunc: func(obj interface{}) {
+				var valid bool
+				defer func() { k8sEventReg.K8sEventReceived(apiGroup, "CiliumMulticastGroup", "delete", valid, false) }()
+				group, ok := obj.(*v2alpha1.CiliumMulticastGroup)
+				if !ok {
+					deletedObj, ok := obj.(cache.DeletedFinalStateUnknown)
+					if !ok {
+						return
+					}
+					group, ok = deletedObj.Obj.(*v2alpha1.CiliumMulticastGroup)
+					if !ok {
+						return
+					}
+				}
+				valid = true
+				deleteGroup(group)
+				k8sEventReg.K8sEventProcessed("CiliumMulticastGroup", "delete", true)
+			},
+		},
+		nil,
+	)
+
+	go groupInformer.Run(wait.NeverStop)
+}
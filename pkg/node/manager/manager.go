@@ -24,6 +24,7 @@ import (
 	"github.com/cilium/cilium/pkg/ipcache"
 	ipcacheTypes "github.com/cilium/cilium/pkg/ipcache/types"
 	"github.com/cilium/cilium/pkg/labels"
+	"github.com/cilium/cilium/pkg/labelsfilter"
 	"github.com/cilium/cilium/pkg/lock"
 	"github.com/cilium/cilium/pkg/metrics"
 	"github.com/cilium/cilium/pkg/node/addressing"
@@ -395,6 +396,13 @@ func (m *Manager) NodeUpdated(n nodeTypes.Node) {
 		}
 	}
 
+	var nodeLabels labels.Labels
+	if option.Config.EnableNodeSelectorLabels {
+		// Only the filtered labels are associated with the node identity, so
+		// that high-churn labels do not trigger identity re-allocations.
+		nodeLabels, _ = labelsfilter.Filter(labels.Map2Labels(n.Labels, labels.LabelSourceNode))
+	}
+
 	var ipsAdded, healthIPsAdded, ingressIPsAdded []string
 
 	// helper function with the required logic to skip IPCache interactions
@@ -445,7 +453,7 @@ func (m *Manager) NodeUpdated(n nodeTypes.Node) {
 			Source: n.Source,
 		})
 		resource := ipcacheTypes.NewResourceID(ipcacheTypes.ResourceKindNode, "", n.Name)
-		m.upsertIntoIDMD(prefix, remoteHostIdentity, resource)
+		m.upsertIntoIDMD(prefix, remoteHostIdentity, resource, nodeLabels)
 
 		// Upsert() will return true if the ipcache entry is owned by
 		// the source of the node update that triggered this node
@@ -576,12 +584,22 @@ func (m *Manager) NodeUpdated(n nodeTypes.Node) {
 
 // upsertIntoIDMD upserts the given CIDR into the ipcache.identityMetadata
 // (IDMD) map. The given node identity determines which labels are associated
-// with the CIDR.
-func (m *Manager) upsertIntoIDMD(prefix netip.Prefix, id identity.NumericIdentity, rid ipcacheTypes.ResourceID) {
+// with the CIDR. The node labels, if any, are merged on top of them so that
+// policies can select nodes by the labels of their Kubernetes node object.
+func (m *Manager) upsertIntoIDMD(prefix netip.Prefix, id identity.NumericIdentity, rid ipcacheTypes.ResourceID, nodeLabels labels.Labels) {
+	mergedLabels := func(base labels.Labels) labels.Labels {
+		if len(nodeLabels) == 0 {
+			return base
+		}
+		lbls := make(labels.Labels, len(base)+len(nodeLabels))
+		lbls.MergeLabels(nodeLabels)
+		lbls.MergeLabels(base)
+		return lbls
+	}
 	if id == identity.ReservedIdentityHost {
-		m.ipcache.UpsertLabels(prefix, labels.LabelHost, source.Local, rid)
+		m.ipcache.UpsertLabels(prefix, mergedLabels(labels.LabelHost), source.Local, rid)
 	} else {
-		m.ipcache.UpsertLabels(prefix, labels.LabelRemoteNode, source.CustomResource, rid)
+		m.ipcache.UpsertLabels(prefix, mergedLabels(labels.LabelRemoteNode), source.CustomResource, rid)
 	}
 }
 
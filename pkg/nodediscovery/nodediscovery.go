@@ -42,6 +42,8 @@ import (
 	nodestore "github.com/cilium/cilium/pkg/node/store"
 	"github.com/cilium/cilium/pkg/node/types"
 	nodeTypes "github.com/cilium/cilium/pkg/node/types"
+	openStackTypes "github.com/cilium/cilium/pkg/openstack/eni/types"
+	openStackMetadata "github.com/cilium/cilium/pkg/openstack/metadata"
 	"github.com/cilium/cilium/pkg/option"
 	"github.com/cilium/cilium/pkg/source"
 	cnitypes "github.com/cilium/cilium/plugins/cilium-cni/types"
@@ -80,7 +82,8 @@ func enableLocalNodeRoute() bool {
 	return option.Config.EnableLocalNodeRoute &&
 		option.Config.IPAM != ipamOption.IPAMENI &&
 		option.Config.IPAM != ipamOption.IPAMAzure &&
-		option.Config.IPAM != ipamOption.IPAMAlibabaCloud
+		option.Config.IPAM != ipamOption.IPAMAlibabaCloud &&
+		option.Config.IPAM != ipamOption.IPAMOpenStack
 }
 
 // NewNodeDiscovery returns a pointer to new node discovery object
@@ -114,6 +117,7 @@ func NewNodeDiscovery(manager *nodemanager.Manager, clientset client.Clientset,
 			EnableIPv6:              option.Config.EnableIPv6,
 			EnableEncapsulation:     option.Config.Tunnel != option.TunnelDisabled,
 			EnableAutoDirectRouting: option.Config.EnableAutoDirectRouting,
+			DirectRoutingNodeCIDRs:  option.Config.DirectRoutingNodeCIDRs,
 			EnableLocalNodeRoute:    enableLocalNodeRoute(),
 			AuxiliaryPrefixes:       auxPrefixes,
 			EnableIPSec:             option.Config.EnableIPSec,
@@ -493,7 +497,7 @@ func (n *NodeDiscovery) mutateNodeResource(nodeResource *ciliumv2.CiliumNode) er
 	}
 
 	switch option.Config.IPAM {
-	case ipamOption.IPAMClusterPool, ipamOption.IPAMClusterPoolV2:
+	case ipamOption.IPAMClusterPool, ipamOption.IPAMClusterPoolV2, ipamOption.IPAMMultiPool:
 		// We want to keep the podCIDRs untouched in these IPAM modes because
 		// the operator will verify if it can assign such podCIDRs.
 		// If the user was running in non-IPAM Operator mode and then switched
@@ -705,6 +709,51 @@ func (n *NodeDiscovery) mutateNodeResource(nodeResource *ciliumv2.CiliumNode) er
 				nodeResource.Spec.AlibabaCloud.SecurityGroupTags = c.AlibabaCloud.SecurityGroupTags
 			}
 
+			if c.IPAM.PreAllocate != 0 {
+				nodeResource.Spec.IPAM.PreAllocate = c.IPAM.PreAllocate
+			}
+		}
+
+	case ipamOption.IPAMOpenStack:
+		nodeResource.Spec.OpenStack = openStackTypes.Spec{}
+
+		instanceID, err := openStackMetadata.GetInstanceID(context.TODO())
+		if err != nil {
+			log.WithError(err).Fatal("Unable to retrieve InstanceID of own instance")
+		}
+
+		if instanceID == "" {
+			return errors.New("InstanceID of own instance is empty")
+		}
+
+		availabilityZone, err := openStackMetadata.GetAvailabilityZone(context.TODO())
+		if err != nil {
+			log.WithError(err).Fatal("Unable to retrieve availability zone of own instance")
+		}
+		nodeResource.Spec.InstanceID = instanceID
+		nodeResource.Spec.OpenStack.AvailabilityZone = availabilityZone
+
+		if c := n.NetConf; c != nil {
+			if c.OpenStack.Flavor != "" {
+				nodeResource.Spec.OpenStack.Flavor = c.OpenStack.Flavor
+			}
+
+			if c.OpenStack.NetworkID != "" {
+				nodeResource.Spec.OpenStack.NetworkID = c.OpenStack.NetworkID
+			}
+
+			if c.OpenStack.CIDRBlock != "" {
+				nodeResource.Spec.OpenStack.CIDRBlock = c.OpenStack.CIDRBlock
+			}
+
+			if len(c.OpenStack.SubnetIDs) > 0 {
+				nodeResource.Spec.OpenStack.SubnetIDs = c.OpenStack.SubnetIDs
+			}
+
+			if len(c.OpenStack.SecurityGroupIDs) > 0 {
+				nodeResource.Spec.OpenStack.SecurityGroupIDs = c.OpenStack.SecurityGroupIDs
+			}
+
 			if c.IPAM.PreAllocate != 0 {
 				nodeResource.Spec.IPAM.PreAllocate = c.IPAM.PreAllocate
 			}
@@ -0,0 +1,650 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/cilium/cilium/pkg/api/helpers"
+	"github.com/cilium/cilium/pkg/cidr"
+	ipamTypes "github.com/cilium/cilium/pkg/ipam/types"
+	"github.com/cilium/cilium/pkg/lock"
+	eniTypes "github.com/cilium/cilium/pkg/openstack/eni/types"
+	"github.com/cilium/cilium/pkg/spanstat"
+)
+
+const (
+	// eniPortName is the name given to Neutron ports created by Cilium. It
+	// is used to tell ports managed by Cilium apart from the primary port
+	// of the instance during resync.
+	eniPortName = "cilium-eni"
+
+	// computeDeviceOwnerPrefix is the device_owner prefix Neutron sets on
+	// ports which are attached to a Nova instance
+	computeDeviceOwnerPrefix = "compute:"
+
+	serviceTypeNetwork = "network"
+	serviceTypeCompute = "compute"
+
+	endpointInterfacePublic = "public"
+
+	// tokenExpirationSlack is subtracted from the token lifetime so that a
+	// token is renewed before Keystone rejects it
+	tokenExpirationSlack = 5 * time.Minute
+)
+
+// MetricsAPI represents the metrics maintained by the OpenStack API client
+type MetricsAPI interface {
+	helpers.MetricsAPI
+	ObserveAPICall(call, status string, duration float64)
+}
+
+// Credentials are the Keystone credentials used to authenticate the client
+type Credentials struct {
+	AuthURL           string
+	Username          string
+	Password          string
+	ProjectName       string
+	UserDomainName    string
+	ProjectDomainName string
+	Region            string
+}
+
+// NewCredentialsFromEnv derives Keystone credentials from the standard OS_*
+// environment variables as set by an OpenStack RC file
+func NewCredentialsFromEnv() (Credentials, error) {
+	creds := Credentials{
+		AuthURL:           os.Getenv("OS_AUTH_URL"),
+		Username:          os.Getenv("OS_USERNAME"),
+		Password:          os.Getenv("OS_PASSWORD"),
+		ProjectName:       os.Getenv("OS_PROJECT_NAME"),
+		UserDomainName:    os.Getenv("OS_USER_DOMAIN_NAME"),
+		ProjectDomainName: os.Getenv("OS_PROJECT_DOMAIN_NAME"),
+		Region:            os.Getenv("OS_REGION_NAME"),
+	}
+
+	switch {
+	case creds.AuthURL == "":
+		return creds, fmt.Errorf("OS_AUTH_URL must be set")
+	case creds.Username == "":
+		return creds, fmt.Errorf("OS_USERNAME must be set")
+	case creds.Password == "":
+		return creds, fmt.Errorf("OS_PASSWORD must be set")
+	case creds.ProjectName == "":
+		return creds, fmt.Errorf("OS_PROJECT_NAME must be set")
+	}
+
+	if creds.UserDomainName == "" {
+		creds.UserDomainName = "Default"
+	}
+	if creds.ProjectDomainName == "" {
+		creds.ProjectDomainName = "Default"
+	}
+
+	return creds, nil
+}
+
+// Client is an OpenStack API client talking to Keystone, Neutron and Nova
+type Client struct {
+	httpClient *http.Client
+	creds      Credentials
+	limiter    *helpers.APILimiter
+	metricsAPI MetricsAPI
+
+	// mutex protects the fields below which are refreshed on
+	// re-authentication
+	mutex       lock.RWMutex
+	token       string
+	tokenExpiry time.Time
+	networkURL  string
+	computeURL  string
+}
+
+// NewClient returns a new OpenStack API client. No connection to the API is
+// made before the first call.
+func NewClient(creds Credentials, metrics MetricsAPI, rateLimit float64, burst int) *Client {
+	return &Client{
+		httpClient: &http.Client{Timeout: time.Minute},
+		creds:      creds,
+		limiter:    helpers.NewAPILimiter(metrics, rateLimit, burst),
+		metricsAPI: metrics,
+	}
+}
+
+// apiError is returned for any request answered with an unexpected HTTP
+// status code
+type apiError struct {
+	status int
+	body   string
+}
+
+func (e *apiError) Error() string {
+	return fmt.Sprintf("OpenStack API returned status code %d: %s", e.status, e.body)
+}
+
+// deriveStatus returns the status string to report to metrics for an API
+// call outcome
+func deriveStatus(err error) string {
+	var apiErr *apiError
+	if errors.As(err, &apiErr) {
+		return fmt.Sprintf("HTTP %d", apiErr.status)
+	}
+
+	if err != nil {
+		return "Failed"
+	}
+
+	return "OK"
+}
+
+// fixedIP is a fixed IP assigned to a Neutron port
+type fixedIP struct {
+	IPAddress string `json:"ip_address,omitempty"`
+	SubnetID  string `json:"subnet_id,omitempty"`
+}
+
+// port is a Neutron port
+type port struct {
+	ID             string    `json:"id,omitempty"`
+	Name           string    `json:"name,omitempty"`
+	NetworkID      string    `json:"network_id,omitempty"`
+	MACAddress     string    `json:"mac_address,omitempty"`
+	DeviceID       string    `json:"device_id,omitempty"`
+	DeviceOwner    string    `json:"device_owner,omitempty"`
+	FixedIPs       []fixedIP `json:"fixed_ips,omitempty"`
+	SecurityGroups []string  `json:"security_groups,omitempty"`
+}
+
+// subnet is a Neutron subnet
+type subnet struct {
+	ID        string `json:"id,omitempty"`
+	NetworkID string `json:"network_id,omitempty"`
+	CIDR      string `json:"cidr,omitempty"`
+	IPVersion int    `json:"ip_version,omitempty"`
+}
+
+// authenticate acquires a new Keystone token and resolves the Neutron and
+// Nova endpoints from the service catalog
+func (c *Client) authenticate(ctx context.Context) error {
+	type domain struct {
+		Name string `json:"name"`
+	}
+	authRequest := map[string]interface{}{
+		"auth": map[string]interface{}{
+			"identity": map[string]interface{}{
+				"methods": []string{"password"},
+				"password": map[string]interface{}{
+					"user": map[string]interface{}{
+						"name":     c.creds.Username,
+						"domain":   domain{Name: c.creds.UserDomainName},
+						"password": c.creds.Password,
+					},
+				},
+			},
+			"scope": map[string]interface{}{
+				"project": map[string]interface{}{
+					"name":   c.creds.ProjectName,
+					"domain": domain{Name: c.creds.ProjectDomainName},
+				},
+			},
+		},
+	}
+
+	body, err := json.Marshal(authRequest)
+	if err != nil {
+		return err
+	}
+
+	url := strings.TrimSuffix(c.creds.AuthURL, "/") + "/auth/tokens"
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	respBytes, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode != http.StatusCreated {
+		return &apiError{status: resp.StatusCode, body: string(respBytes)}
+	}
+
+	var authResponse struct {
+		Token struct {
+			ExpiresAt time.Time `json:"expires_at"`
+			Catalog   []struct {
+				Type      string `json:"type"`
+				Endpoints []struct {
+					Interface string `json:"interface"`
+					Region    string `json:"region"`
+					URL       string `json:"url"`
+				} `json:"endpoints"`
+			} `json:"catalog"`
+		} `json:"token"`
+	}
+	if err := json.Unmarshal(respBytes, &authResponse); err != nil {
+		return err
+	}
+
+	networkURL, computeURL := "", ""
+	for _, service := range authResponse.Token.Catalog {
+		for _, endpoint := range service.Endpoints {
+			if endpoint.Interface != endpointInterfacePublic {
+				continue
+			}
+			if c.creds.Region != "" && endpoint.Region != c.creds.Region {
+				continue
+			}
+			switch service.Type {
+			case serviceTypeNetwork:
+				networkURL = strings.TrimSuffix(endpoint.URL, "/")
+			case serviceTypeCompute:
+				computeURL = strings.TrimSuffix(endpoint.URL, "/")
+			}
+		}
+	}
+
+	if networkURL == "" {
+		return fmt.Errorf("no %q endpoint found in service catalog", serviceTypeNetwork)
+	}
+	if computeURL == "" {
+		return fmt.Errorf("no %q endpoint found in service catalog", serviceTypeCompute)
+	}
+
+	c.mutex.Lock()
+	c.token = resp.Header.Get("X-Subject-Token")
+	c.tokenExpiry = authResponse.Token.ExpiresAt
+	c.networkURL = networkURL
+	c.computeURL = computeURL
+	c.mutex.Unlock()
+
+	return nil
+}
+
+// ensureToken re-authenticates if no token is held or the held token is about
+// to expire
+func (c *Client) ensureToken(ctx context.Context) error {
+	c.mutex.RLock()
+	valid := c.token != "" && time.Now().Add(tokenExpirationSlack).Before(c.tokenExpiry)
+	c.mutex.RUnlock()
+
+	if valid {
+		return nil
+	}
+
+	return c.authenticate(ctx)
+}
+
+// do performs an authenticated request against the given service and decodes
+// the response into out if out is non-nil
+func (c *Client) do(ctx context.Context, method, service, path string, in, out interface{}) error {
+	if err := c.ensureToken(ctx); err != nil {
+		return err
+	}
+
+	c.mutex.RLock()
+	token := c.token
+	baseURL := c.networkURL
+	if service == serviceTypeCompute {
+		baseURL = c.computeURL
+	}
+	c.mutex.RUnlock()
+
+	var reqBody io.Reader
+	if in != nil {
+		body, err := json.Marshal(in)
+		if err != nil {
+			return err
+		}
+		reqBody = bytes.NewReader(body)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, baseURL+path, reqBody)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("X-Auth-Token", token)
+	if in != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	respBytes, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		return &apiError{status: resp.StatusCode, body: string(respBytes)}
+	}
+
+	if out != nil {
+		return json.Unmarshal(respBytes, out)
+	}
+
+	return nil
+}
+
+// GetInstances returns the list of all instances including their Neutron
+// ports as instanceMap
+func (c *Client) GetInstances(ctx context.Context, subnets ipamTypes.SubnetMap) (*ipamTypes.InstanceMap, error) {
+	instances := ipamTypes.NewInstanceMap()
+
+	c.limiter.Limit(ctx, "ListPorts")
+	sinceStart := spanstat.Start()
+	var response struct {
+		Ports []port `json:"ports"`
+	}
+	err := c.do(ctx, http.MethodGet, serviceTypeNetwork, "/v2.0/ports", nil, &response)
+	c.metricsAPI.ObserveAPICall("ListPorts", deriveStatus(err), sinceStart.Seconds())
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range response.Ports {
+		p := &response.Ports[i]
+		if !strings.HasPrefix(p.DeviceOwner, computeDeviceOwnerPrefix) || p.DeviceID == "" {
+			continue
+		}
+
+		instances.Update(p.DeviceID, ipamTypes.InterfaceRevision{
+			Resource: parsePort(p, subnets),
+		})
+	}
+
+	return instances, nil
+}
+
+// GetSubnets returns all IPv4 Neutron subnets as a subnetMap
+func (c *Client) GetSubnets(ctx context.Context) (ipamTypes.SubnetMap, error) {
+	c.limiter.Limit(ctx, "ListSubnets")
+	sinceStart := spanstat.Start()
+	var response struct {
+		Subnets []subnet `json:"subnets"`
+	}
+	err := c.do(ctx, http.MethodGet, serviceTypeNetwork, "/v2.0/subnets", nil, &response)
+	c.metricsAPI.ObserveAPICall("ListSubnets", deriveStatus(err), sinceStart.Seconds())
+	if err != nil {
+		return nil, err
+	}
+
+	result := ipamTypes.SubnetMap{}
+	for _, s := range response.Subnets {
+		if s.IPVersion != 4 {
+			continue
+		}
+		_, ipnet, err := net.ParseCIDR(s.CIDR)
+		if err != nil {
+			return nil, err
+		}
+		result[s.ID] = &ipamTypes.Subnet{
+			ID:               s.ID,
+			CIDR:             cidr.NewCIDR(ipnet),
+			VirtualNetworkID: s.NetworkID,
+		}
+	}
+
+	if err := c.updateSubnetAvailability(ctx, result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// updateSubnetAvailability fills in the available address count of each
+// subnet from the network-ip-availabilities extension. If the extension is
+// not enabled in the deployment, the address capacity of the subnet CIDR is
+// used instead.
+func (c *Client) updateSubnetAvailability(ctx context.Context, subnets ipamTypes.SubnetMap) error {
+	c.limiter.Limit(ctx, "ListNetworkIPAvailabilities")
+	sinceStart := spanstat.Start()
+	var response struct {
+		NetworkIPAvailabilities []struct {
+			SubnetIPAvailability []struct {
+				SubnetID string `json:"subnet_id"`
+				TotalIPs int    `json:"total_ips"`
+				UsedIPs  int    `json:"used_ips"`
+			} `json:"subnet_ip_availability"`
+		} `json:"network_ip_availabilities"`
+	}
+	err := c.do(ctx, http.MethodGet, serviceTypeNetwork, "/v2.0/network-ip-availabilities", nil, &response)
+	c.metricsAPI.ObserveAPICall("ListNetworkIPAvailabilities", deriveStatus(err), sinceStart.Seconds())
+	if err != nil {
+		var apiErr *apiError
+		if errors.As(err, &apiErr) && apiErr.status == http.StatusNotFound {
+			for _, s := range subnets {
+				s.AvailableAddresses = subnetCapacity(s.CIDR)
+			}
+			return nil
+		}
+		return err
+	}
+
+	for _, network := range response.NetworkIPAvailabilities {
+		for _, availability := range network.SubnetIPAvailability {
+			if s, ok := subnets[availability.SubnetID]; ok {
+				s.AvailableAddresses = availability.TotalIPs - availability.UsedIPs
+			}
+		}
+	}
+
+	return nil
+}
+
+// subnetCapacity returns the number of addresses covered by the subnet CIDR
+func subnetCapacity(c *cidr.CIDR) int {
+	ones, bits := c.Mask.Size()
+	return 1 << (bits - ones)
+}
+
+// CreateNetworkInterface creates a Neutron port with 1 + secondaryFixedIPCount
+// fixed IPs in the given subnet
+func (c *Client) CreateNetworkInterface(ctx context.Context, secondaryFixedIPCount int, subnetID, networkID string, groups []string) (string, *eniTypes.ENI, error) {
+	fixedIPs := make([]fixedIP, 0, secondaryFixedIPCount+1)
+	for i := 0; i < secondaryFixedIPCount+1; i++ {
+		fixedIPs = append(fixedIPs, fixedIP{SubnetID: subnetID})
+	}
+
+	request := map[string]port{
+		"port": {
+			Name:           eniPortName,
+			NetworkID:      networkID,
+			FixedIPs:       fixedIPs,
+			SecurityGroups: groups,
+		},
+	}
+
+	c.limiter.Limit(ctx, "CreatePort")
+	sinceStart := spanstat.Start()
+	var response struct {
+		Port port `json:"port"`
+	}
+	err := c.do(ctx, http.MethodPost, serviceTypeNetwork, "/v2.0/ports", request, &response)
+	c.metricsAPI.ObserveAPICall("CreatePort", deriveStatus(err), sinceStart.Seconds())
+	if err != nil {
+		return "", nil, err
+	}
+
+	return response.Port.ID, parsePort(&response.Port, nil), nil
+}
+
+// AttachNetworkInterface attaches a previously created Neutron port to an
+// instance
+func (c *Client) AttachNetworkInterface(ctx context.Context, instanceID, eniID string) error {
+	request := map[string]interface{}{
+		"interfaceAttachment": map[string]string{
+			"port_id": eniID,
+		},
+	}
+
+	c.limiter.Limit(ctx, "AttachInterface")
+	sinceStart := spanstat.Start()
+	err := c.do(ctx, http.MethodPost, serviceTypeCompute, "/servers/"+instanceID+"/os-interface", request, nil)
+	c.metricsAPI.ObserveAPICall("AttachInterface", deriveStatus(err), sinceStart.Seconds())
+	return err
+}
+
+// DeleteNetworkInterface deletes a Neutron port
+func (c *Client) DeleteNetworkInterface(ctx context.Context, eniID string) error {
+	c.limiter.Limit(ctx, "DeletePort")
+	sinceStart := spanstat.Start()
+	err := c.do(ctx, http.MethodDelete, serviceTypeNetwork, "/v2.0/ports/"+eniID, nil, nil)
+	c.metricsAPI.ObserveAPICall("DeletePort", deriveStatus(err), sinceStart.Seconds())
+	return err
+}
+
+// AssignPrivateIPAddresses assigns toAllocate additional fixed IPs to the
+// port, drawn from the subnet of the primary fixed IP
+func (c *Client) AssignPrivateIPAddresses(ctx context.Context, eniID string, toAllocate int) ([]string, error) {
+	p, err := c.getPort(ctx, eniID)
+	if err != nil {
+		return nil, err
+	}
+	if len(p.FixedIPs) == 0 {
+		return nil, fmt.Errorf("port %s has no fixed IPs", eniID)
+	}
+
+	assigned := make(map[string]struct{}, len(p.FixedIPs))
+	for _, ip := range p.FixedIPs {
+		assigned[ip.IPAddress] = struct{}{}
+	}
+
+	fixedIPs := p.FixedIPs
+	for i := 0; i < toAllocate; i++ {
+		fixedIPs = append(fixedIPs, fixedIP{SubnetID: p.FixedIPs[0].SubnetID})
+	}
+
+	updated, err := c.updatePortFixedIPs(ctx, eniID, fixedIPs)
+	if err != nil {
+		return nil, err
+	}
+
+	addresses := make([]string, 0, toAllocate)
+	for _, ip := range updated.FixedIPs {
+		if _, ok := assigned[ip.IPAddress]; !ok {
+			addresses = append(addresses, ip.IPAddress)
+		}
+	}
+	return addresses, nil
+}
+
+// UnassignPrivateIPAddresses removes the given fixed IPs from the port. The
+// primary fixed IP is never removed.
+func (c *Client) UnassignPrivateIPAddresses(ctx context.Context, eniID string, addresses []string) error {
+	p, err := c.getPort(ctx, eniID)
+	if err != nil {
+		return err
+	}
+
+	releaseMap := make(map[string]struct{}, len(addresses))
+	for _, address := range addresses {
+		releaseMap[address] = struct{}{}
+	}
+
+	fixedIPs := make([]fixedIP, 0, len(p.FixedIPs))
+	for i, ip := range p.FixedIPs {
+		if _, release := releaseMap[ip.IPAddress]; release && i != 0 {
+			continue
+		}
+		fixedIPs = append(fixedIPs, ip)
+	}
+
+	_, err = c.updatePortFixedIPs(ctx, eniID, fixedIPs)
+	return err
+}
+
+func (c *Client) getPort(ctx context.Context, portID string) (*port, error) {
+	c.limiter.Limit(ctx, "ShowPort")
+	sinceStart := spanstat.Start()
+	var response struct {
+		Port port `json:"port"`
+	}
+	err := c.do(ctx, http.MethodGet, serviceTypeNetwork, "/v2.0/ports/"+portID, nil, &response)
+	c.metricsAPI.ObserveAPICall("ShowPort", deriveStatus(err), sinceStart.Seconds())
+	if err != nil {
+		return nil, err
+	}
+	return &response.Port, nil
+}
+
+func (c *Client) updatePortFixedIPs(ctx context.Context, portID string, fixedIPs []fixedIP) (*port, error) {
+	request := map[string]interface{}{
+		"port": map[string]interface{}{
+			"fixed_ips": fixedIPs,
+		},
+	}
+
+	c.limiter.Limit(ctx, "UpdatePort")
+	sinceStart := spanstat.Start()
+	var response struct {
+		Port port `json:"port"`
+	}
+	err := c.do(ctx, http.MethodPut, serviceTypeNetwork, "/v2.0/ports/"+portID, request, &response)
+	c.metricsAPI.ObserveAPICall("UpdatePort", deriveStatus(err), sinceStart.Seconds())
+	if err != nil {
+		return nil, err
+	}
+	return &response.Port, nil
+}
+
+// parsePort converts a Neutron port to an ENI. Ports which were not created
+// by Cilium, typically the primary port of the instance, are marked as
+// primary ENI.
+func parsePort(p *port, subnets ipamTypes.SubnetMap) *eniTypes.ENI {
+	eni := eniTypes.ENI{
+		NetworkInterfaceID: p.ID,
+		MACAddress:         p.MACAddress,
+		Type:               eniTypes.ENITypePrimary,
+		InstanceID:         p.DeviceID,
+		SecurityGroupIDs:   p.SecurityGroups,
+		NetworkID:          p.NetworkID,
+	}
+
+	if p.Name == eniPortName {
+		eni.Type = eniTypes.ENITypeSecondary
+	}
+
+	for i, ip := range p.FixedIPs {
+		primary := i == 0
+		if primary {
+			eni.PrimaryIPAddress = ip.IPAddress
+			eni.Subnet.SubnetID = ip.SubnetID
+			if subnets != nil {
+				if subnet, ok := subnets[ip.SubnetID]; ok && subnet.CIDR != nil {
+					eni.Subnet.CIDRBlock = subnet.CIDR.String()
+				}
+			}
+		}
+		eni.FixedIPSets = append(eni.FixedIPSets, eniTypes.FixedIPSet{
+			IPAddress: ip.IPAddress,
+			SubnetID:  ip.SubnetID,
+			Primary:   primary,
+		})
+	}
+
+	return &eni
+}
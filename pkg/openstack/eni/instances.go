@@ -0,0 +1,173 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package eni
+
+import (
+	"context"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/cilium/cilium/pkg/ipam"
+	ipamTypes "github.com/cilium/cilium/pkg/ipam/types"
+	v2 "github.com/cilium/cilium/pkg/k8s/apis/cilium.io/v2"
+	"github.com/cilium/cilium/pkg/lock"
+	eniTypes "github.com/cilium/cilium/pkg/openstack/eni/types"
+)
+
+// OpenStackAPI is the API surface used of the Neutron and Nova APIs
+type OpenStackAPI interface {
+	GetInstances(ctx context.Context, subnets ipamTypes.SubnetMap) (*ipamTypes.InstanceMap, error)
+	GetSubnets(ctx context.Context) (ipamTypes.SubnetMap, error)
+	CreateNetworkInterface(ctx context.Context, secondaryFixedIPCount int, subnetID, networkID string, groups []string) (string, *eniTypes.ENI, error)
+	AttachNetworkInterface(ctx context.Context, instanceID, eniID string) error
+	DeleteNetworkInterface(ctx context.Context, eniID string) error
+	AssignPrivateIPAddresses(ctx context.Context, eniID string, toAllocate int) ([]string, error)
+	UnassignPrivateIPAddresses(ctx context.Context, eniID string, addresses []string) error
+}
+
+// InstancesManager maintains the list of instances. It must be kept up to date
+// by calling resync() regularly.
+type InstancesManager struct {
+	mutex     lock.RWMutex
+	instances *ipamTypes.InstanceMap
+	subnets   ipamTypes.SubnetMap
+	api       OpenStackAPI
+}
+
+// NewInstancesManager returns a new instances manager
+func NewInstancesManager(api OpenStackAPI) *InstancesManager {
+	return &InstancesManager{
+		instances: ipamTypes.NewInstanceMap(),
+		api:       api,
+	}
+}
+
+// CreateNode
+func (m *InstancesManager) CreateNode(obj *v2.CiliumNode, node *ipam.Node) ipam.NodeOperations {
+	return &Node{k8sObj: obj, manager: m, node: node, instanceID: node.InstanceID()}
+}
+
+// HasInstance returns whether the instance is in instances
+func (m *InstancesManager) HasInstance(instanceID string) bool {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+	return m.instances.Exists(instanceID)
+}
+
+// GetPoolQuota returns the number of available IPs in all IP pools
+func (m *InstancesManager) GetPoolQuota() ipamTypes.PoolQuotaMap {
+	pool := ipamTypes.PoolQuotaMap{}
+	for subnetID, subnet := range m.GetSubnets() {
+		pool[ipamTypes.PoolID(subnetID)] = ipamTypes.PoolQuota{
+			AvailableIPs: subnet.AvailableAddresses,
+		}
+	}
+	return pool
+}
+
+// Resync fetches the list of instances and subnets and updates the local
+// cache in the instanceManager. It returns the time when the resync has
+// started or time.Time{} if it did not complete.
+func (m *InstancesManager) Resync(ctx context.Context) time.Time {
+	resyncStart := time.Now()
+
+	subnets, err := m.api.GetSubnets(ctx)
+	if err != nil {
+		log.WithError(err).Warning("Unable to retrieve Neutron subnets list")
+		return time.Time{}
+	}
+
+	instances, err := m.api.GetInstances(ctx, subnets)
+	if err != nil {
+		log.WithError(err).Warning("Unable to synchronize Neutron port list")
+		return time.Time{}
+	}
+
+	log.WithFields(logrus.Fields{
+		"numInstances": instances.NumInstances(),
+		"numSubnets":   len(subnets),
+	}).Info("Synchronized ENI information")
+
+	m.mutex.Lock()
+	m.instances = instances
+	m.subnets = subnets
+	m.mutex.Unlock()
+
+	return resyncStart
+}
+
+// GetSubnets returns all the tracked subnets
+// The returned subnetMap is immutable so it can be safely accessed
+func (m *InstancesManager) GetSubnets() ipamTypes.SubnetMap {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	subnetsCopy := make(ipamTypes.SubnetMap)
+	for k, v := range m.subnets {
+		subnetsCopy[k] = v
+	}
+
+	return subnetsCopy
+}
+
+// GetSubnet return subnet by id
+func (m *InstancesManager) GetSubnet(id string) *ipamTypes.Subnet {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+	return m.subnets[id]
+}
+
+// ForeachInstance will iterate over each instance inside `instances`, and call
+// `fn`. This function is read-locked for the entire execution.
+func (m *InstancesManager) ForeachInstance(instanceID string, fn ipamTypes.InterfaceIterator) {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+	m.instances.ForeachInterface(instanceID, fn)
+}
+
+// UpdateENI updates the ENI definition of an ENI for a particular instance. If
+// the ENI is already known, the definition is updated, otherwise the ENI is
+// added to the instance.
+func (m *InstancesManager) UpdateENI(instanceID string, eni *eniTypes.ENI) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	eniRevision := ipamTypes.InterfaceRevision{Resource: eni}
+	m.instances.Update(instanceID, eniRevision)
+}
+
+// FindOneSubnet returns the subnet with the fewest available addresses
+// matching the network and the optional list of eligible subnet IDs
+func (m *InstancesManager) FindOneSubnet(networkID string, subnetIDs []string, toAllocate int) *ipamTypes.Subnet {
+	eligible := map[string]struct{}{}
+	for _, id := range subnetIDs {
+		eligible[id] = struct{}{}
+	}
+
+	var bestSubnet *ipamTypes.Subnet
+	for _, subnet := range m.GetSubnets() {
+		if subnet.VirtualNetworkID != networkID {
+			continue
+		}
+		if len(eligible) > 0 {
+			if _, ok := eligible[subnet.ID]; !ok {
+				continue
+			}
+		}
+		if subnet.AvailableAddresses < toAllocate {
+			continue
+		}
+		if bestSubnet == nil || bestSubnet.AvailableAddresses > subnet.AvailableAddresses {
+			bestSubnet = subnet
+		}
+	}
+	return bestSubnet
+}
+
+// DeleteInstance delete instance from m.instances
+func (m *InstancesManager) DeleteInstance(instanceID string) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	m.instances.Delete(instanceID)
+}
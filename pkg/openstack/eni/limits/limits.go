@@ -0,0 +1,77 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package limits
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	ipamTypes "github.com/cilium/cilium/pkg/ipam/types"
+	"github.com/cilium/cilium/pkg/lock"
+)
+
+// limits contains limits for adapter count and addresses per Nova flavor.
+// Unlike the public clouds, there is no universal flavor catalog: flavors and
+// the Neutron port quota they allow are specific to each deployment, so the
+// mappings are populated exclusively from agent configuration at bootstrap
+// time.
+var limits = struct {
+	lock.RWMutex
+
+	m map[string]ipamTypes.Limits
+}{
+	m: map[string]ipamTypes.Limits{},
+}
+
+// Update update the limit map
+func Update(limitMap map[string]ipamTypes.Limits) {
+	limits.Lock()
+	defer limits.Unlock()
+
+	for k, v := range limitMap {
+		limits.m[k] = v
+	}
+}
+
+// Get returns the instance limits of a particular flavor.
+func Get(flavor string) (limit ipamTypes.Limits, ok bool) {
+	limits.RLock()
+	limit, ok = limits.m[flavor]
+	limits.RUnlock()
+	return
+}
+
+// UpdateFromUserDefinedMappings updates limits from the given map.
+func UpdateFromUserDefinedMappings(m map[string]string) (err error) {
+	limits.Lock()
+	defer limits.Unlock()
+
+	for flavor, limitString := range m {
+		limit, err := parseLimitString(limitString)
+		if err != nil {
+			return err
+		}
+		// Add or overwrite limits
+		limits.m[flavor] = limit
+	}
+	return nil
+}
+
+// parseLimitString returns the Limits struct parsed from config string.
+func parseLimitString(limitString string) (limit ipamTypes.Limits, err error) {
+	intSlice := make([]int, 3)
+	stringSlice := strings.Split(strings.ReplaceAll(limitString, " ", ""), ",")
+	if len(stringSlice) != 3 {
+		return limit, fmt.Errorf("invalid limit value")
+	}
+	for i, s := range stringSlice {
+		intLimit, err := strconv.Atoi(s)
+		if err != nil {
+			return limit, err
+		}
+		intSlice[i] = intLimit
+	}
+	return ipamTypes.Limits{Adapters: intSlice[0], IPv4: intSlice[1], IPv6: intSlice[2]}, nil
+}
@@ -0,0 +1,51 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package limits
+
+import (
+	"testing"
+
+	"gopkg.in/check.v1"
+)
+
+func Test(t *testing.T) {
+	check.TestingT(t)
+}
+
+type ENILimitsSuite struct{}
+
+var _ = check.Suite(&ENILimitsSuite{})
+
+func (e *ENILimitsSuite) TestUpdateFromUserDefinedMappings(c *check.C) {
+	m1 := map[string]string{"m1.large": "3,10,0"}
+
+	err := UpdateFromUserDefinedMappings(m1)
+	c.Assert(err, check.Equals, nil)
+
+	limit, ok := Get("m1.large")
+	c.Assert(ok, check.Equals, true)
+	c.Assert(limit.Adapters, check.Equals, 3)
+	c.Assert(limit.IPv4, check.Equals, 10)
+	c.Assert(limit.IPv6, check.Equals, 0)
+
+	_, ok = Get("unknown")
+	c.Assert(ok, check.Equals, false)
+}
+
+func (e *ENILimitsSuite) TestParseLimitString(c *check.C) {
+	limit, err := parseLimitString("4,5 ,6")
+	c.Assert(err, check.Equals, nil)
+	c.Assert(limit.Adapters, check.Equals, 4)
+	c.Assert(limit.IPv4, check.Equals, 5)
+	c.Assert(limit.IPv6, check.Equals, 6)
+
+	_, err = parseLimitString("4,5,a")
+	c.Assert(err, check.Not(check.Equals), nil)
+
+	_, err = parseLimitString("4,5")
+	c.Assert(err.Error(), check.Equals, "invalid limit value")
+
+	_, err = parseLimitString("")
+	c.Assert(err.Error(), check.Equals, "invalid limit value")
+}
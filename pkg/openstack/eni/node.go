@@ -0,0 +1,439 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package eni
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/cilium/cilium/pkg/defaults"
+	"github.com/cilium/cilium/pkg/ipam"
+	ipamTypes "github.com/cilium/cilium/pkg/ipam/types"
+	v2 "github.com/cilium/cilium/pkg/k8s/apis/cilium.io/v2"
+	"github.com/cilium/cilium/pkg/lock"
+	"github.com/cilium/cilium/pkg/math"
+	"github.com/cilium/cilium/pkg/openstack/eni/limits"
+	eniTypes "github.com/cilium/cilium/pkg/openstack/eni/types"
+)
+
+// The following error constants represent the error conditions for
+// CreateInterface without additional context embedded in order to make them
+// usable for metrics accounting purposes.
+const (
+	errUnableToDetermineLimits   = "unable to determine limits"
+	unableToDetermineLimits      = "unableToDetermineLimits"
+	errUnableToGetSecurityGroups = "unable to get security groups"
+	unableToGetSecurityGroups    = "unableToGetSecurityGroups"
+	errUnableToCreateENI         = "unable to create ENI"
+	unableToCreateENI            = "unableToCreateENI"
+	errUnableToAttachENI         = "unable to attach ENI"
+	unableToAttachENI            = "unableToAttachENI"
+	unableToFindSubnet           = "unableToFindSubnet"
+	unableToFindNetwork          = "unableToFindNetwork"
+)
+
+const (
+	// maxENIIPCreate is the maximum number of fixed IPs assigned to a port
+	// at creation time
+	maxENIIPCreate = 10
+)
+
+type Node struct {
+	// node contains the general purpose fields of a node
+	node *ipam.Node
+
+	// mutex protects members below this field
+	mutex lock.RWMutex
+
+	// enis is the list of ENIs attached to the node indexed by ENI ID.
+	// Protected by Node.mutex.
+	enis map[string]eniTypes.ENI
+
+	// k8sObj is the CiliumNode custom resource representing the node
+	k8sObj *v2.CiliumNode
+
+	// manager is the OpenStack node manager responsible for this node
+	manager *InstancesManager
+
+	// instanceID of the node
+	instanceID string
+}
+
+// UpdatedNode is called when an update to the CiliumNode is received.
+func (n *Node) UpdatedNode(obj *v2.CiliumNode) {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+	n.k8sObj = obj
+}
+
+// PopulateStatusFields fills in the status field of the CiliumNode custom
+// resource with ENI specific information
+func (n *Node) PopulateStatusFields(resource *v2.CiliumNode) {
+	resource.Status.OpenStack.ENIs = map[string]eniTypes.ENI{}
+
+	n.manager.ForeachInstance(n.node.InstanceID(),
+		func(instanceID, interfaceID string, rev ipamTypes.InterfaceRevision) error {
+			e, ok := rev.Resource.(*eniTypes.ENI)
+			if ok {
+				resource.Status.OpenStack.ENIs[interfaceID] = *e.DeepCopy()
+			}
+			return nil
+		})
+
+	return
+}
+
+// CreateInterface creates an additional interface with the instance and
+// attaches it to the instance as specified by the CiliumNode. neededAddresses
+// of secondary IPs are assigned to the interface up to the maximum number of
+// addresses as allowed by the flavor.
+func (n *Node) CreateInterface(ctx context.Context, allocation *ipam.AllocationAction, scopedLog *logrus.Entry) (int, string, error) {
+	l, limitsAvailable := n.getLimits()
+	if !limitsAvailable {
+		return 0, unableToDetermineLimits, fmt.Errorf(errUnableToDetermineLimits)
+	}
+
+	n.mutex.RLock()
+	resource := *n.k8sObj
+	n.mutex.RUnlock()
+
+	// Must allocate secondary ENI IPs as needed, up to ENI instance limit
+	toAllocate := math.IntMin(allocation.MaxIPsToAllocate, l.IPv4)
+	toAllocate = math.IntMin(maxENIIPCreate, toAllocate) // in first alloc no more than 10
+	// Validate whether request has already been fulfilled in the meantime
+	if toAllocate == 0 {
+		return 0, "", nil
+	}
+
+	networkID := n.getNetworkID(resource.Spec.OpenStack)
+	if networkID == "" {
+		return 0, unableToFindNetwork, fmt.Errorf("unable to determine Neutron network for interface creation")
+	}
+
+	bestSubnet := n.manager.FindOneSubnet(networkID, resource.Spec.OpenStack.SubnetIDs, toAllocate)
+	if bestSubnet == nil {
+		return 0,
+			unableToFindSubnet,
+			fmt.Errorf(
+				"no matching subnet available for interface creation (NetworkID=%s SubnetIDs=%s)",
+				networkID,
+				resource.Spec.OpenStack.SubnetIDs,
+			)
+	}
+	allocation.PoolID = ipamTypes.PoolID(bestSubnet.ID)
+
+	securityGroupIDs, err := n.getSecurityGroupIDs(resource.Spec.OpenStack)
+	if err != nil {
+		return 0,
+			unableToGetSecurityGroups,
+			fmt.Errorf("%s %s", errUnableToGetSecurityGroups, err)
+	}
+
+	scopedLog = scopedLog.WithFields(logrus.Fields{
+		"securityGroupIDs": securityGroupIDs,
+		"subnetID":         bestSubnet.ID,
+		"toAllocate":       toAllocate,
+	})
+	scopedLog.Info("No more IPs available, creating new ENI")
+
+	instanceID := n.node.InstanceID()
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+	eniID, eni, err := n.manager.api.CreateNetworkInterface(ctx, toAllocate-1, bestSubnet.ID, networkID, securityGroupIDs)
+	if err != nil {
+		return 0, unableToCreateENI, fmt.Errorf("%s %s", errUnableToCreateENI, err)
+	}
+
+	scopedLog = scopedLog.WithField(fieldENIID, eniID)
+	scopedLog.Info("Created new ENI")
+
+	if bestSubnet.CIDR != nil {
+		eni.Subnet.CIDRBlock = bestSubnet.CIDR.String()
+	}
+
+	err = n.manager.api.AttachNetworkInterface(ctx, instanceID, eniID)
+	if err != nil {
+		err2 := n.manager.api.DeleteNetworkInterface(ctx, eniID)
+		if err2 != nil {
+			scopedLog.Errorf("Failed to release ENI after failure to attach, %s", err2.Error())
+		}
+		return 0, unableToAttachENI, fmt.Errorf("%s %s", errUnableToAttachENI, err)
+	}
+
+	eni.InstanceID = instanceID
+	n.enis[eniID] = *eni
+	scopedLog.Info("Attached ENI to instance")
+
+	// Add the information of the created ENI to the instances manager
+	n.manager.UpdateENI(instanceID, eni)
+	return toAllocate, "", nil
+}
+
+// ResyncInterfacesAndIPs is called to retrieve the ENIs and IPs as known to
+// the OpenStack API and return them
+func (n *Node) ResyncInterfacesAndIPs(ctx context.Context, scopedLog *logrus.Entry) (available ipamTypes.AllocationMap, remainAvailableENIsCount int, err error) {
+	limits, limitsAvailable := n.getLimits()
+	if !limitsAvailable {
+		return nil, -1, fmt.Errorf(errUnableToDetermineLimits)
+	}
+	instanceID := n.node.InstanceID()
+	available = ipamTypes.AllocationMap{}
+
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+	n.enis = map[string]eniTypes.ENI{}
+
+	n.manager.ForeachInstance(instanceID,
+		func(instanceID, interfaceID string, rev ipamTypes.InterfaceRevision) error {
+			e, ok := rev.Resource.(*eniTypes.ENI)
+			if !ok {
+				return nil
+			}
+
+			n.enis[e.NetworkInterfaceID] = *e
+			if e.Type == eniTypes.ENITypePrimary {
+				return nil
+			}
+
+			availableOnENI := math.IntMax(limits.IPv4-len(e.FixedIPSets), 0)
+			if availableOnENI > 0 {
+				remainAvailableENIsCount++
+			}
+
+			for _, ip := range e.FixedIPSets {
+				available[ip.IPAddress] = ipamTypes.AllocationIP{Resource: e.NetworkInterfaceID}
+			}
+			return nil
+		})
+	enis := len(n.enis)
+
+	// An instance has at least one port attached, no port found implies
+	// instance not found.
+	if enis == 0 {
+		scopedLog.Warning("Instance not found! Please delete corresponding ciliumnode if instance has already been deleted.")
+		return nil, -1, fmt.Errorf("unable to retrieve ENIs")
+	}
+
+	remainAvailableENIsCount += limits.Adapters - len(n.enis)
+	return available, remainAvailableENIsCount, nil
+}
+
+// PrepareIPAllocation returns the number of ENI IPs and interfaces that can be
+// allocated/created.
+func (n *Node) PrepareIPAllocation(scopedLog *logrus.Entry) (*ipam.AllocationAction, error) {
+	l, limitsAvailable := n.getLimits()
+	if !limitsAvailable {
+		return nil, fmt.Errorf(errUnableToDetermineLimits)
+	}
+	a := &ipam.AllocationAction{}
+
+	n.mutex.RLock()
+	defer n.mutex.RUnlock()
+
+	for key, e := range n.enis {
+		if e.Type != eniTypes.ENITypeSecondary {
+			continue
+		}
+		scopedLog.WithFields(logrus.Fields{
+			fieldENIID:  e.NetworkInterfaceID,
+			"ipv4Limit": l.IPv4,
+			"allocated": len(e.FixedIPSets),
+		}).Debug("Considering ENI for allocation")
+
+		availableOnENI := math.IntMax(l.IPv4-len(e.FixedIPSets), 0)
+		if availableOnENI <= 0 {
+			continue
+		} else {
+			a.InterfaceCandidates++
+		}
+
+		scopedLog.WithFields(logrus.Fields{
+			fieldENIID:       e.NetworkInterfaceID,
+			"availableOnENI": availableOnENI,
+		}).Debug("ENI has IPs available")
+
+		if subnet := n.manager.GetSubnet(e.Subnet.SubnetID); subnet != nil {
+			if subnet.AvailableAddresses > 0 && a.InterfaceID == "" {
+				scopedLog.WithFields(logrus.Fields{
+					"subnetID":           e.Subnet.SubnetID,
+					"availableAddresses": subnet.AvailableAddresses,
+				}).Debug("Subnet has IPs available")
+
+				a.InterfaceID = key
+				a.PoolID = ipamTypes.PoolID(subnet.ID)
+				a.AvailableForAllocation = math.IntMin(subnet.AvailableAddresses, availableOnENI)
+			}
+		}
+	}
+	a.EmptyInterfaceSlots = l.Adapters - len(n.enis)
+	return a, nil
+}
+
+// AllocateIPs performs the ENI allocation operation
+func (n *Node) AllocateIPs(ctx context.Context, a *ipam.AllocationAction) error {
+	_, err := n.manager.api.AssignPrivateIPAddresses(ctx, a.InterfaceID, a.AvailableForAllocation)
+	return err
+}
+
+// PrepareIPRelease prepares the release of ENI IPs.
+func (n *Node) PrepareIPRelease(excessIPs int, scopedLog *logrus.Entry) *ipam.ReleaseAction {
+	r := &ipam.ReleaseAction{}
+
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+
+	// Iterate over ENIs on this node, select the ENI with the most
+	// addresses available for release
+	for key, e := range n.enis {
+		if e.Type != eniTypes.ENITypeSecondary {
+			continue
+		}
+		scopedLog.WithFields(logrus.Fields{
+			fieldENIID:     e.NetworkInterfaceID,
+			"numAddresses": len(e.FixedIPSets),
+		}).Debug("Considering ENI for IP release")
+
+		// Count free IP addresses on this ENI
+		ipsOnENI := n.k8sObj.Status.OpenStack.ENIs[e.NetworkInterfaceID].FixedIPSets
+		freeIpsOnENI := []string{}
+		for _, ip := range ipsOnENI {
+			// exclude primary IPs
+			if ip.Primary {
+				continue
+			}
+			_, ipUsed := n.k8sObj.Status.IPAM.Used[ip.IPAddress]
+			if !ipUsed {
+				freeIpsOnENI = append(freeIpsOnENI, ip.IPAddress)
+			}
+		}
+		freeOnENICount := len(freeIpsOnENI)
+
+		if freeOnENICount <= 0 {
+			continue
+		}
+
+		scopedLog.WithFields(logrus.Fields{
+			fieldENIID:       e.NetworkInterfaceID,
+			"excessIPs":      excessIPs,
+			"freeOnENICount": freeOnENICount,
+		}).Debug("ENI has unused IPs that can be released")
+		maxReleaseOnENI := math.IntMin(freeOnENICount, excessIPs)
+
+		r.InterfaceID = key
+		r.PoolID = ipamTypes.PoolID(e.Subnet.SubnetID)
+		r.IPsToRelease = freeIpsOnENI[:maxReleaseOnENI]
+	}
+
+	return r
+}
+
+// ReleaseIPs performs the ENI IP release operation
+func (n *Node) ReleaseIPs(ctx context.Context, r *ipam.ReleaseAction) error {
+	return n.manager.api.UnassignPrivateIPAddresses(ctx, r.InterfaceID, r.IPsToRelease)
+}
+
+// GetMaximumAllocatableIPv4 returns the maximum amount of IPv4 addresses
+// that can be allocated to the instance
+func (n *Node) GetMaximumAllocatableIPv4() int {
+	n.mutex.RLock()
+	defer n.mutex.RUnlock()
+
+	// Retrieve l for the flavor
+	l, limitsAvailable := n.getLimitsLocked()
+	if !limitsAvailable {
+		return 0
+	}
+
+	// Return the maximum amount of IP addresses allocatable on the instance
+	// reserve the primary port
+	return (l.Adapters - 1) * l.IPv4
+}
+
+// GetMinimumAllocatableIPv4 returns the minimum amount of IPv4 addresses that
+// must be allocated to the instance.
+func (n *Node) GetMinimumAllocatableIPv4() int {
+	return defaults.IPAMPreAllocation
+}
+
+func (n *Node) loggerLocked() *logrus.Entry {
+	if n == nil || n.instanceID == "" {
+		return log
+	}
+
+	return log.WithField("instanceID", n.instanceID)
+}
+
+func (n *Node) IsPrefixDelegated() bool {
+	return false
+}
+
+func (n *Node) GetUsedIPWithPrefixes() int {
+	if n.k8sObj == nil {
+		return 0
+	}
+	return len(n.k8sObj.Status.IPAM.Used)
+}
+
+// getLimits returns the interface and IP limits of this node
+func (n *Node) getLimits() (ipamTypes.Limits, bool) {
+	n.mutex.RLock()
+	l, b := n.getLimitsLocked()
+	n.mutex.RUnlock()
+	return l, b
+}
+
+// getLimitsLocked is the same function as getLimits, but assumes the n.mutex
+// is read locked.
+func (n *Node) getLimitsLocked() (ipamTypes.Limits, bool) {
+	return limits.Get(n.k8sObj.Spec.OpenStack.Flavor)
+}
+
+// getNetworkID returns the Neutron network to create new ports in. If not
+// set explicitly in the spec, the network of the primary port is used.
+func (n *Node) getNetworkID(eniSpec eniTypes.Spec) string {
+	if eniSpec.NetworkID != "" {
+		return eniSpec.NetworkID
+	}
+
+	var networkID string
+	n.manager.ForeachInstance(n.node.InstanceID(),
+		func(instanceID, interfaceID string, rev ipamTypes.InterfaceRevision) error {
+			e, ok := rev.Resource.(*eniTypes.ENI)
+			if ok && e.Type == eniTypes.ENITypePrimary {
+				networkID = e.NetworkID
+			}
+			return nil
+		})
+
+	return networkID
+}
+
+func (n *Node) getSecurityGroupIDs(eniSpec eniTypes.Spec) ([]string, error) {
+	// ENI must have at least one security group
+	// 1. use security groups defined by user
+	// 2. use security groups used by the primary port
+
+	if len(eniSpec.SecurityGroupIDs) > 0 {
+		return eniSpec.SecurityGroupIDs, nil
+	}
+
+	var securityGroups []string
+
+	n.manager.ForeachInstance(n.node.InstanceID(),
+		func(instanceID, interfaceID string, rev ipamTypes.InterfaceRevision) error {
+			e, ok := rev.Resource.(*eniTypes.ENI)
+			if ok && e.Type == eniTypes.ENITypePrimary {
+				securityGroups = append(securityGroups, e.SecurityGroupIDs...)
+			}
+			return nil
+		})
+
+	if len(securityGroups) <= 0 {
+		return nil, fmt.Errorf("failed to get security group ids")
+	}
+
+	return securityGroups, nil
+}
@@ -0,0 +1,153 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package types
+
+import (
+	"github.com/cilium/cilium/pkg/ipam/types"
+)
+
+// Spec is the ENI specification of a node. This specification is considered
+// by the cilium-operator to act as an IPAM operator and makes Neutron port
+// IPs available via the IPAMSpec section.
+//
+// The ENI specification can either be provided explicitly by the user or the
+// cilium-agent running on the node can be instructed to create the CiliumNode
+// custom resource along with an ENI specification when the node registers
+// itself to the Kubernetes cluster.
+type Spec struct {
+	// Flavor is the Nova flavor name of the instance, e.g. "m1.large"
+	//
+	// +kubebuilder:validation:Optional
+	Flavor string `json:"flavor,omitempty"`
+
+	// AvailabilityZone is the availability zone to use when allocating
+	// ENIs.
+	//
+	// +kubebuilder:validation:Optional
+	AvailabilityZone string `json:"availability-zone,omitempty"`
+
+	// NetworkID is the Neutron network ID to use when allocating ENIs.
+	//
+	// +kubebuilder:validation:Optional
+	NetworkID string `json:"network-id,omitempty"`
+
+	// CIDRBlock is the network IPv4 CIDR
+	//
+	// +kubebuilder:validation:Optional
+	CIDRBlock string `json:"cidr-block,omitempty"`
+
+	// SubnetIDs is the list of Neutron subnet IDs available for ENI fixed
+	// IPs.
+	//
+	// +kubebuilder:validation:Optional
+	SubnetIDs []string `json:"subnet-ids,omitempty"`
+
+	// SecurityGroupIDs is the list of security group IDs to attach to any
+	// ENI that is created and attached to the instance.
+	//
+	// +kubebuilder:validation:Optional
+	SecurityGroupIDs []string `json:"security-group-ids,omitempty"`
+}
+
+const (
+	// ENITypePrimary is the type for ENI
+	ENITypePrimary string = "Primary"
+	// ENITypeSecondary is the type for ENI
+	ENITypeSecondary string = "Secondary"
+)
+
+// ENI represents a Neutron port attached to an instance
+type ENI struct {
+	// NetworkInterfaceID is the Neutron port id
+	//
+	// +optional
+	NetworkInterfaceID string `json:"network-interface-id,omitempty"`
+
+	// MACAddress is the mac address of the ENI
+	//
+	// +optional
+	MACAddress string `json:"mac-address,omitempty"`
+
+	// Type is the ENI type Primary or Secondary
+	//
+	// +optional
+	Type string `json:"type,omitempty"`
+
+	// InstanceID is the instance using this ENI
+	//
+	// +optional
+	InstanceID string `json:"instance-id,omitempty"`
+
+	// SecurityGroupIDs is the security group ids used by this ENI
+	//
+	// +optional
+	SecurityGroupIDs []string `json:"security-groupids,omitempty"`
+
+	// NetworkID is the Neutron network to which the ENI belongs
+	//
+	// +optional
+	NetworkID string `json:"network-id,omitempty"`
+
+	// Subnet is the subnet the primary fixed IP of the ENI is drawn from
+	//
+	// +optional
+	Subnet Subnet `json:"subnet,omitempty"`
+
+	// PrimaryIPAddress is the primary fixed IP on the ENI
+	//
+	// +optional
+	PrimaryIPAddress string `json:"primary-ip-address,omitempty"`
+
+	// FixedIPSets is the list of all fixed IPs on the ENI, including
+	// PrimaryIPAddress
+	//
+	// +optional
+	FixedIPSets []FixedIPSet `json:"fixed-ipsets,omitempty"`
+}
+
+// InterfaceID returns the identifier of the interface
+func (e *ENI) InterfaceID() string {
+	return e.NetworkInterfaceID
+}
+
+// ForeachAddress iterates over all addresses and calls fn
+func (e *ENI) ForeachAddress(id string, fn types.AddressIterator) error {
+	for _, address := range e.FixedIPSets {
+		if address.Primary {
+			continue
+		}
+		if err := fn(id, e.NetworkInterfaceID, address.IPAddress, "", address); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ENIStatus is the status of ENI addressing of the node
+type ENIStatus struct {
+	// ENIs is the list of ENIs on the node
+	//
+	// +optional
+	ENIs map[string]ENI `json:"enis,omitempty"`
+}
+
+// FixedIPSet is a fixed IP assigned to a Neutron port
+type FixedIPSet struct {
+	IPAddress string `json:"ip-address,omitempty"`
+	SubnetID  string `json:"subnet-id,omitempty"`
+	Primary   bool   `json:"primary,omitempty"`
+}
+
+type Subnet struct {
+	// SubnetID is the subnet to which the ENI belongs
+	//
+	// +optional
+	SubnetID string `json:"subnet-id,omitempty"`
+
+	// CIDRBlock is the subnet IPv4 CIDR
+	//
+	// +optional
+	CIDRBlock string `json:"cidr,omitempty"`
+}
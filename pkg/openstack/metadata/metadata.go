@@ -0,0 +1,76 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package metadata
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+const (
+	metadataURL = "http://169.254.169.254/openstack/latest/meta_data.json"
+)
+
+// metaData is the subset of the instance metadata served by the Nova
+// metadata service which is consumed by Cilium
+type metaData struct {
+	UUID             string `json:"uuid"`
+	AvailabilityZone string `json:"availability_zone"`
+}
+
+// GetInstanceID returns the instance UUID from metadata
+func GetInstanceID(ctx context.Context) (string, error) {
+	metadata, err := getMetadata(ctx)
+	if err != nil {
+		return "", err
+	}
+	return metadata.UUID, nil
+}
+
+// GetAvailabilityZone returns the availability zone from metadata
+func GetAvailabilityZone(ctx context.Context) (string, error) {
+	metadata, err := getMetadata(ctx)
+	if err != nil {
+		return "", err
+	}
+	return metadata.AvailabilityZone, nil
+}
+
+// getMetadata gets metadata
+// see https://docs.openstack.org/nova/latest/user/metadata.html
+func getMetadata(ctx context.Context) (*metaData, error) {
+	client := &http.Client{
+		Timeout: time.Second * 10,
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, metadataURL, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("metadata service returned status code %d", resp.StatusCode)
+	}
+
+	defer resp.Body.Close()
+	respBytes, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	metadata := &metaData{}
+	if err := json.Unmarshal(respBytes, metadata); err != nil {
+		return nil, err
+	}
+
+	return metadata, nil
+}
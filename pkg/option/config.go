@@ -25,6 +25,7 @@ import (
 	"github.com/spf13/cast"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
+	k8sLabels "k8s.io/apimachinery/pkg/labels"
 
 	"github.com/cilium/cilium/api/v1/models"
 	"github.com/cilium/cilium/pkg/cidr"
@@ -145,6 +146,10 @@ const (
 	// EnableL7Proxy is the name of the option to enable L7 proxy
 	EnableL7Proxy = "enable-l7-proxy"
 
+	// EnableStickyIPs enables re-assignment of the same IP to pods with a
+	// stable identity, e.g. StatefulSet pods
+	EnableStickyIPs = "enable-sticky-ips"
+
 	// EnableTracing enables tracing mode in the agent.
 	EnableTracing = "enable-tracing"
 
@@ -353,6 +358,9 @@ const (
 	// EnableIPv6BIGTCP enables IPv6 BIG TCP (larger GSO/GRO limits) for the node including pods.
 	EnableIPv6BIGTCP = "enable-ipv6-big-tcp"
 
+	// EnableIPv4BIGTCP enables IPv4 BIG TCP (larger GSO/GRO limits) for the node including pods.
+	EnableIPv4BIGTCP = "enable-ipv4-big-tcp"
+
 	// EnableBPFClockProbe selects a more efficient source clock (jiffies vs ktime)
 	EnableBPFClockProbe = "enable-bpf-clock-probe"
 
@@ -371,6 +379,10 @@ const (
 	// InstallEgressGatewayRoutes installs IP rules and routes required to steer traffic to the correct network interface
 	InstallEgressGatewayRoutes = "install-egress-gateway-routes"
 
+	// EgressIPPools maps a namespace to the pool of source IPs used for
+	// masquerading that namespace's traffic
+	EgressIPPools = "egress-ip-pools"
+
 	// EnableIngressController enables Ingress Controller
 	EnableIngressController = "enable-ingress-controller"
 
@@ -493,12 +505,19 @@ const (
 	// MTUName is the name of the MTU option
 	MTUName = "mtu"
 
+	// RouteMTUDiscoveryName is the name of the option to enable per-route
+	// MTU discovery
+	RouteMTUDiscoveryName = "enable-route-mtu-discovery"
+
 	// RouteMetric is the name of the route-metric option
 	RouteMetric = "route-metric"
 
 	// DatapathMode is the name of the DatapathMode option
 	DatapathMode = "datapath-mode"
 
+	// DatapathDrainTimeout is the name of the DatapathDrainTimeout option
+	DatapathDrainTimeout = "datapath-drain-timeout"
+
 	// EnableSocketLB is the name for the option to enable the socket LB
 	EnableSocketLB = "bpf-lb-sock"
 
@@ -508,6 +527,10 @@ const (
 	// BPFSocketLBHostnsOnly is the name of the BPFSocketLBHostnsOnly option
 	BPFSocketLBHostnsOnly = "bpf-lb-sock-hostns-only"
 
+	// BPFSocketLBSkipSelector is the name of the option to exclude
+	// workloads from socket LB by label selector
+	BPFSocketLBSkipSelector = "bpf-lb-sock-skip-selector"
+
 	// TunnelName is the name of the Tunnel option
 	TunnelName = "tunnel"
 	// TunnelPortName is the name of the TunnelPort option
@@ -580,6 +603,10 @@ const (
 	// that sock rev NAT is mostly used for UDP and getpeername only.
 	SockRevNATMapEntriesDefault = CTMapEntriesGlobalAnyDefault
 
+	// BPFMapSizingProfileName is the name of the option to select a BPF
+	// map sizing profile
+	BPFMapSizingProfileName = "bpf-map-sizing-profile"
+
 	// MapEntriesGlobalDynamicSizeRatioName is the name of the option to
 	// set the ratio of total system memory to use for dynamic sizing of the
 	// CT, NAT, Neighbor and SockRevNAT BPF maps.
@@ -613,6 +640,21 @@ const (
 	// PolicyMapMax defines the maximum policy map limit.
 	PolicyMapMax = 1 << 16
 
+	// MapSizingProfileCustom disables profile based map sizing, map sizes
+	// are taken from the individual options and dynamic sizing.
+	MapSizingProfileCustom = "custom"
+
+	// MapSizingProfileSmall sizes the BPF maps for small nodes with few
+	// endpoints and services.
+	MapSizingProfileSmall = "small"
+
+	// MapSizingProfileMedium sizes the BPF maps with the default sizes.
+	MapSizingProfileMedium = "medium"
+
+	// MapSizingProfileLarge sizes the BPF maps for nodes with a large
+	// number of endpoints and services.
+	MapSizingProfileLarge = "large"
+
 	// FragmentsMapMin defines the minimum fragments map limit.
 	FragmentsMapMin = 1 << 8
 
@@ -670,6 +712,10 @@ const (
 	// EnableIPv6NDPName is the name of the option to enable IPv6 NDP support
 	EnableIPv6NDPName = "enable-ipv6-ndp"
 
+	// EnableIPv6OnlyIPAM is the name of the option to allow single-stack IPv6
+	// allocation in IPAM modes which otherwise require an IPv4 pool
+	EnableIPv6OnlyIPAM = "enable-ipv6-only-ipam"
+
 	// EnableSRv6 is the name of the option to enable SRv6 encapsulation support
 	EnableSRv6 = "enable-srv6"
 
@@ -679,9 +725,17 @@ const (
 	// EnableSCTPName is the name of the option to enable SCTP support
 	EnableSCTPName = "enable-sctp"
 
+	// EnableMulticastName is the name of the option to enable multicast
+	// forwarding for opted-in group addresses
+	EnableMulticastName = "enable-multicast"
+
 	// EnableNat46X64Gateway enables L3 based NAT46 and NAT64 gateway
 	EnableNat46X64Gateway = "enable-nat46x64-gateway"
 
+	// EnableDNS64 enables DNS64 AAAA synthesis in the DNS proxy for the
+	// NAT46x64 gateway prefix
+	EnableDNS64 = "enable-dns64"
+
 	// IPv6MCastDevice is the name of the option to select IPv6 multicast device
 	IPv6MCastDevice = "ipv6-mcast-device"
 
@@ -723,6 +777,9 @@ const (
 	// EnableAutoDirectRoutingName is the name for the EnableAutoDirectRouting option
 	EnableAutoDirectRoutingName = "auto-direct-node-routes"
 
+	// DirectRoutingNodeCIDRsName is the name of the DirectRoutingNodeCIDRs option
+	DirectRoutingNodeCIDRsName = "direct-routing-node-cidrs"
+
 	// EnableIPSecName is the name of the option to enable IPSec
 	EnableIPSecName = "enable-ipsec"
 
@@ -824,6 +881,34 @@ const (
 	// IPAM is the IPAM method to use
 	IPAM = "ipam"
 
+	// IPAMReleaseGracePeriod is the duration a released pod IP is
+	// quarantined before it is returned to the pool for re-allocation
+	IPAMReleaseGracePeriod = "ipam-release-grace-period"
+
+	// ExternalIPAMEndpoint is the gRPC endpoint of the external IPAM
+	// plugin used in external IPAM mode
+	ExternalIPAMEndpoint = "external-ipam-endpoint"
+
+	// ExternalIPAMCAFile is the CA certificate bundle used to verify the
+	// external IPAM plugin. TLS is enabled when set.
+	ExternalIPAMCAFile = "external-ipam-ca-file"
+
+	// ExternalIPAMCertFile is the client certificate presented to the
+	// external IPAM plugin for mutual TLS
+	ExternalIPAMCertFile = "external-ipam-cert-file"
+
+	// ExternalIPAMKeyFile is the client key presented to the external IPAM
+	// plugin for mutual TLS
+	ExternalIPAMKeyFile = "external-ipam-key-file"
+
+	// EnableIPAMEvents enables emission of Kubernetes events for IP
+	// allocations and releases
+	EnableIPAMEvents = "enable-ipam-events"
+
+	// IPAMEventLogFile is the path to a file to which structured IPAM
+	// allocation and release events are appended
+	IPAMEventLogFile = "ipam-event-log-file"
+
 	// XDPModeNative for loading progs with XDPModeLinkDriver
 	XDPModeNative = "native"
 
@@ -897,6 +982,18 @@ const (
 	// PolicyAuditModeArg argument enables policy audit mode.
 	PolicyAuditModeArg = "policy-audit-mode"
 
+	// PolicyDefaultDenyNamespaces is the list of namespaces rolled out to
+	// default-deny policy enforcement, each starting with an audit phase.
+	PolicyDefaultDenyNamespaces = "policy-default-deny-namespaces"
+
+	// PolicyDefaultDenyAuditDuration is how long a namespace in the staged
+	// default-deny rollout stays in the audit phase before enforcement.
+	PolicyDefaultDenyAuditDuration = "policy-default-deny-audit-duration"
+
+	// EnableIdentityRelevance enables computing policy map entries only for
+	// identities relevant to the node.
+	EnableIdentityRelevance = "enable-identity-relevance"
+
 	// EnableHubble enables hubble in the agent.
 	EnableHubble = "enable-hubble"
 
@@ -939,6 +1036,22 @@ const (
 	// HubbleMetrics specifies enabled metrics and their configuration options.
 	HubbleMetrics = "hubble-metrics"
 
+	// HubbleFlowStorePath specifies the filepath of the on-disk store backing
+	// the Hubble ring buffer. e.g. "/var/run/cilium/hubble/flowstore"
+	HubbleFlowStorePath = "hubble-flow-store-path"
+
+	// HubbleFlowStoreMaxSizeMB specifies the size in MB at which the on-disk
+	// flow store is capped.
+	HubbleFlowStoreMaxSizeMB = "hubble-flow-store-max-size-mb"
+
+	// HubbleFlowSampling specifies sampling rates for decoded flows per
+	// verdict and namespace, e.g. "FORWARDED/kube-system:0.01".
+	HubbleFlowSampling = "hubble-flow-sampling"
+
+	// HubbleFlowAggregationWindow specifies the window within which
+	// forwarded flows of the same connection are deduplicated.
+	HubbleFlowAggregationWindow = "hubble-flow-aggregation-window"
+
 	// HubbleExportFilePath specifies the filepath to write Hubble events to.
 	// e.g. "/var/run/cilium/hubble/events.log"
 	HubbleExportFilePath = "hubble-export-file-path"
@@ -1067,6 +1180,17 @@ const (
 	// EnableICMPRules enables ICMP-based rule support for Cilium Network Policies.
 	EnableICMPRules = "enable-icmp-rules"
 
+	// EnableNodeSelectorLabels enables use of the node label based identity
+	EnableNodeSelectorLabels = "enable-node-selector-labels"
+
+	// MeshAuthEnabled enables the mutual authentication rule support for
+	// Cilium Network Policies.
+	MeshAuthEnabled = "mesh-auth-enabled"
+
+	// EnablePolicyRateLimit enables the rate limit rule support for Cilium
+	// Network Policies.
+	EnablePolicyRateLimit = "enable-policy-rate-limit"
+
 	// BypassIPAvailabilityUponRestore bypasses the IP availability error
 	// within IPAM upon endpoint restore and allows the use of the restored IP
 	// regardless of whether it's available in the pool.
@@ -1333,6 +1457,13 @@ type DaemonConfig struct {
 	Tunnel       string // Tunnel mode
 	TunnelPort   int    // Tunnel port
 
+	// DatapathDrainTimeout is the time for which datapath programs which
+	// are no longer needed, e.g. after a device was removed from the
+	// configuration during an agent upgrade, keep forwarding before they
+	// are detached. This allows established connections through the old
+	// programs to drain. Zero disables draining and detaches immediately.
+	DatapathDrainTimeout time.Duration
+
 	DryMode bool // Do not create BPF maps, devices, ..
 
 	// RestoreState enables restoring the state from previous running daemons.
@@ -1406,6 +1537,11 @@ type DaemonConfig struct {
 	// MTU is the maximum transmission unit of the underlying network
 	MTU int
 
+	// EnableRouteMTUDiscovery discovers the MTU of the underlay path
+	// towards each remote node from the kernel routing table and programs
+	// it on the per-node routes instead of assuming the cluster-wide MTU
+	EnableRouteMTUDiscovery bool
+
 	// RouteMetric is the metric used for the routes added to the cilium_host device
 	RouteMetric int
 
@@ -1447,6 +1583,9 @@ type DaemonConfig struct {
 	// is enabled. Network byte-order.
 	MonitorAggregationFlags uint16
 
+	// BPFMapSizingProfile is the selected BPF map sizing profile
+	BPFMapSizingProfile string
+
 	// BPFMapsDynamicSizeRatio is ratio of total system memory to use for
 	// dynamic sizing of the CT, NAT, Neighbor and SockRevNAT BPF maps.
 	BPFMapsDynamicSizeRatio float64
@@ -1571,12 +1710,24 @@ type DaemonConfig struct {
 	// EnableNat46X64Gateway is true when L3 based NAT46 and NAT64 translation is enabled
 	EnableNat46X64Gateway bool
 
+	// EnableDNS64 is true when the DNS proxy synthesizes AAAA records out of
+	// A records for names without native AAAA records, embedding the IPv4
+	// address into the NAT46x64 gateway prefix
+	EnableDNS64 bool
+
 	// EnableIPv6NDP is true when NDP is enabled for IPv6
 	EnableIPv6NDP bool
 
+	// EnableIPv6OnlyIPAM allows single-stack IPv6 allocation in the ENI,
+	// Azure, AlibabaCloud and CRD IPAM modes
+	EnableIPv6OnlyIPAM bool
+
 	// EnableIPv6BIGTCP enables IPv6 BIG TCP (larger GSO/GRO limits) for the node including pods.
 	EnableIPv6BIGTCP bool
 
+	// EnableIPv4BIGTCP enables IPv4 BIG TCP (larger GSO/GRO limits) for the node including pods.
+	EnableIPv4BIGTCP bool
+
 	// EnableSRv6 is true when SRv6 encapsulation support is enabled
 	EnableSRv6 bool
 
@@ -1586,6 +1737,10 @@ type DaemonConfig struct {
 	// EnableSCTP is true when SCTP support is enabled.
 	EnableSCTP bool
 
+	// EnableMulticast is true when multicast forwarding is enabled for
+	// group addresses opted in via CiliumMulticastGroup resources.
+	EnableMulticast bool
+
 	// IPv6MCastDevice is the name of device that joins IPv6's solicitation multicast group
 	IPv6MCastDevice string
 
@@ -1611,6 +1766,7 @@ type DaemonConfig struct {
 
 	BPFRoot                       string
 	BPFSocketLBHostnsOnly         bool
+	SocketLBSkipSelector          k8sLabels.Selector
 	CGroupRoot                    string
 	BPFCompileDebug               string
 	CompilerFlags                 []string
@@ -1622,6 +1778,7 @@ type DaemonConfig struct {
 	EnableSocketLBTracing         bool
 	EnableSocketLBPeer            bool
 	EnablePolicy                  string
+	EnableStickyIPs               bool
 	EnableTracing                 bool
 	EnableUnreachableRoutes       bool
 	EnvoyLog                      string
@@ -1654,6 +1811,7 @@ type DaemonConfig struct {
 	EnableIPMasqAgent          bool
 	EnableIPv4EgressGateway    bool
 	InstallEgressGatewayRoutes bool
+	EgressIPPools              map[string]string
 	EnableEnvoyConfig          bool
 	EnableIngressController    bool
 	EnableGatewayAPI           bool
@@ -1748,6 +1906,11 @@ type DaemonConfig struct {
 	// other nodes when available
 	EnableAutoDirectRouting bool
 
+	// DirectRoutingNodeCIDRs enables mixed routing mode. Nodes with an IP
+	// within one of the CIDRs are reached via native routing while
+	// encapsulation remains in use towards all remaining nodes.
+	DirectRoutingNodeCIDRs []*cidr.CIDR
+
 	// EnableLocalNodeRoute controls installation of the route which points
 	// the allocation prefix of the local node.
 	EnableLocalNodeRoute bool
@@ -1946,6 +2109,10 @@ type DaemonConfig struct {
 	// EnableBBR enables BBR TCP congestion control for the node including Pods
 	EnableBBR bool
 
+	// BBRSupported is true if the kernel supports Pod-based BBR TCP
+	// congestion control. Set by probing at runtime.
+	BBRSupported bool
+
 	// ResetQueueMapping resets the Pod's skb queue mapping
 	ResetQueueMapping bool
 
@@ -2009,6 +2176,34 @@ type DaemonConfig struct {
 	// IPAM is the IPAM method to use
 	IPAM string
 
+	// IPAMReleaseGracePeriod is the duration a released pod IP is
+	// quarantined before it is returned to the pool for re-allocation
+	IPAMReleaseGracePeriod time.Duration
+
+	// ExternalIPAMEndpoint is the gRPC endpoint of the external IPAM
+	// plugin used in external IPAM mode
+	ExternalIPAMEndpoint string
+
+	// ExternalIPAMCAFile is the CA certificate bundle used to verify the
+	// external IPAM plugin. TLS is enabled when set.
+	ExternalIPAMCAFile string
+
+	// ExternalIPAMCertFile is the client certificate presented to the
+	// external IPAM plugin for mutual TLS
+	ExternalIPAMCertFile string
+
+	// ExternalIPAMKeyFile is the client key presented to the external IPAM
+	// plugin for mutual TLS
+	ExternalIPAMKeyFile string
+
+	// EnableIPAMEvents enables emission of Kubernetes events for IP
+	// allocations and releases
+	EnableIPAMEvents bool
+
+	// IPAMEventLogFile is the path to a file to which structured IPAM
+	// allocation and release events are appended
+	IPAMEventLogFile string
+
 	// Enable chaining with another CNI plugin.
 	CNIChainingMode string
 
@@ -2061,6 +2256,21 @@ type DaemonConfig struct {
 	// Policy related decisions can be checked via the poicy verdict messages.
 	PolicyAuditMode bool
 
+	// PolicyDefaultDenyNamespaces is the list of namespaces rolled out to
+	// default-deny policy enforcement, each starting with an audit phase.
+	PolicyDefaultDenyNamespaces []string
+
+	// PolicyDefaultDenyAuditDuration is how long a namespace in the staged
+	// default-deny rollout stays in the audit phase before enforcement.
+	PolicyDefaultDenyAuditDuration time.Duration
+
+	// EnableIdentityRelevance enables computing policy map entries only for
+	// identities relevant to the node, i.e. identities of local endpoints
+	// and identities traffic has been seen to or from. Entries for the
+	// remaining identities are filled in when they are first seen in the
+	// datapath.
+	EnableIdentityRelevance bool
+
 	// EnableHubble specifies whether to enable the hubble server.
 	EnableHubble bool
 
@@ -2103,6 +2313,22 @@ type DaemonConfig struct {
 	// HubbleMetrics specifies enabled metrics and their configuration options.
 	HubbleMetrics []string
 
+	// HubbleFlowStorePath specifies the filepath of the on-disk store backing
+	// the Hubble ring buffer. e.g. "/var/run/cilium/hubble/flowstore"
+	HubbleFlowStorePath string
+
+	// HubbleFlowStoreMaxSizeMB specifies the size in MB at which the on-disk
+	// flow store is capped.
+	HubbleFlowStoreMaxSizeMB int
+
+	// HubbleFlowSampling specifies sampling rates for decoded flows per
+	// verdict and namespace, e.g. "FORWARDED/kube-system:0.01".
+	HubbleFlowSampling []string
+
+	// HubbleFlowAggregationWindow specifies the window within which
+	// forwarded flows of the same connection are deduplicated.
+	HubbleFlowAggregationWindow time.Duration
+
 	// HubbleExportFilePath specifies the filepath to write Hubble events to.
 	// e.g. "/var/run/cilium/hubble/events.log"
 	HubbleExportFilePath string
@@ -2251,6 +2477,17 @@ type DaemonConfig struct {
 	// EnableICMPRules enables ICMP-based rule support for Cilium Network Policies.
 	EnableICMPRules bool
 
+	// EnableNodeSelectorLabels enables use of the node label based identity
+	EnableNodeSelectorLabels bool
+
+	// MeshAuthEnabled enables the mutual authentication rule support for
+	// Cilium Network Policies.
+	MeshAuthEnabled bool
+
+	// EnablePolicyRateLimit enables the rate limit rule support for Cilium
+	// Network Policies.
+	EnablePolicyRateLimit bool
+
 	// BypassIPAvailabilityUponRestore bypasses the IP availability error
 	// within IPAM upon endpoint restore and allows the use of the restored IP
 	// regardless of whether it's available in the pool.
@@ -2313,6 +2550,7 @@ var (
 		EnableIPv6:                   defaults.EnableIPv6,
 		EnableIPv6NDP:                defaults.EnableIPv6NDP,
 		EnableSCTP:                   defaults.EnableSCTP,
+		EnableMulticast:              defaults.EnableMulticast,
 		EnableL7Proxy:                defaults.EnableL7Proxy,
 		EndpointStatus:               make(map[string]struct{}),
 		DNSMaxIPsPerRestoredRule:     defaults.DNSMaxIPsPerRestoredRule,
@@ -2323,6 +2561,7 @@ var (
 		IdentityChangeGracePeriod:    defaults.IdentityChangeGracePeriod,
 		IdentityRestoreGracePeriod:   defaults.IdentityRestoreGracePeriod,
 		FixedIdentityMapping:         make(map[string]string),
+		EgressIPPools:                make(map[string]string),
 		KVStoreOpt:                   make(map[string]string),
 		LogOpt:                       make(map[string]string),
 		LoopbackIPv4:                 defaults.LoopbackIPv4,
@@ -2337,6 +2576,7 @@ var (
 		K8sEnableK8sEndpointSlice:    defaults.K8sEnableEndpointSlice,
 		AllocatorListTimeout:         defaults.AllocatorListTimeout,
 		EnableICMPRules:              defaults.EnableICMPRules,
+		EnableNodeSelectorLabels:     defaults.EnableNodeSelectorLabels,
 
 		K8sEnableLeasesFallbackDiscovery: defaults.K8sEnableLeasesFallbackDiscovery,
 		APIRateLimit:                     make(map[string]string),
@@ -2444,6 +2684,19 @@ func (c *DaemonConfig) TunnelingEnabled() bool {
 	return c.Tunnel != TunnelDisabled
 }
 
+// DirectRoutingNode returns true if the given node IP falls into one of the
+// configured direct routing node CIDRs. Such nodes are reached via native
+// routing even though encapsulation is the default routing mode.
+func (c *DaemonConfig) DirectRoutingNode(nodeIP net.IP) bool {
+	for _, cidr := range c.DirectRoutingNodeCIDRs {
+		if cidr.Contains(nodeIP) {
+			return true
+		}
+	}
+
+	return false
+}
+
 // TunnelExists returns true if some traffic may go through a tunnel, including
 // if the primary mode is native routing. For example, in the egress gateway,
 // we may send such traffic to a gateway node via a tunnel.
@@ -2630,6 +2883,28 @@ func (c *DaemonConfig) validateIPv6NAT46x64CIDR() error {
 	return nil
 }
 
+func (c *DaemonConfig) validateEgressIPPools() error {
+	if len(c.EgressIPPools) == 0 {
+		return nil
+	}
+
+	if !c.EnableIPv4EgressGateway {
+		return fmt.Errorf("option --%s requires --%s", EgressIPPools, EnableIPv4EgressGateway)
+	}
+
+	for namespace, pool := range c.EgressIPPools {
+		ip, _, err := net.ParseCIDR(pool)
+		if err != nil {
+			return fmt.Errorf("invalid egress IP pool '%s' for namespace %s: %s", pool, namespace, err)
+		}
+		if ip.To4() == nil {
+			return fmt.Errorf("egress IP pool '%s' for namespace %s is not an IPv4 CIDR", pool, namespace)
+		}
+	}
+
+	return nil
+}
+
 // Validate validates the daemon configuration
 func (c *DaemonConfig) Validate(vp *viper.Viper) error {
 	if err := c.validateIPv6ClusterAllocCIDR(); err != nil {
@@ -2642,6 +2917,10 @@ func (c *DaemonConfig) Validate(vp *viper.Viper) error {
 			c.IPv6NAT46x64CIDR, err)
 	}
 
+	if err := c.validateEgressIPPools(); err != nil {
+		return err
+	}
+
 	if c.MTU < 0 {
 		return fmt.Errorf("MTU '%d' cannot be negative", c.MTU)
 	}
@@ -2650,8 +2929,22 @@ func (c *DaemonConfig) Validate(vp *viper.Viper) error {
 		return fmt.Errorf("RouteMetric '%d' cannot be negative", c.RouteMetric)
 	}
 
-	if c.IPAM == ipamOption.IPAMENI && c.EnableIPv6 {
-		return fmt.Errorf("IPv6 cannot be enabled in ENI IPAM mode")
+	if c.EnableIPv6OnlyIPAM {
+		if c.EnableIPv4 || !c.EnableIPv6 {
+			return fmt.Errorf("--%s requires IPv6 to be enabled and IPv4 to be disabled", EnableIPv6OnlyIPAM)
+		}
+	}
+
+	if c.IPAM == ipamOption.IPAMENI && c.EnableIPv6 && !c.EnableIPv6OnlyIPAM {
+		return fmt.Errorf("IPv6 cannot be enabled in ENI IPAM mode without --%s", EnableIPv6OnlyIPAM)
+	}
+
+	if c.IPAM == ipamOption.IPAMExternal && c.ExternalIPAMEndpoint == "" {
+		return fmt.Errorf("--%s must be provided in %s IPAM mode", ExternalIPAMEndpoint, ipamOption.IPAMExternal)
+	}
+
+	if (c.ExternalIPAMCertFile == "") != (c.ExternalIPAMKeyFile == "") {
+		return fmt.Errorf("--%s and --%s must be provided together", ExternalIPAMCertFile, ExternalIPAMKeyFile)
 	}
 
 	if c.EnableIPv6NDP {
@@ -2836,6 +3129,7 @@ func (c *DaemonConfig) Populate(vp *viper.Viper) {
 	c.ClusterMeshConfig = vp.GetString(ClusterMeshConfigName)
 	c.CNIChainingMode = vp.GetString(CNIChainingMode)
 	c.DatapathMode = vp.GetString(DatapathMode)
+	c.DatapathDrainTimeout = vp.GetDuration(DatapathDrainTimeout)
 	c.Debug = vp.GetBool(DebugArg)
 	c.DebugVerbose = vp.GetStringSlice(DebugVerbose)
 	c.DirectRoutingDevice = vp.GetString(DirectRoutingDevice)
@@ -2843,10 +3137,13 @@ func (c *DaemonConfig) Populate(vp *viper.Viper) {
 	c.EnableIPv4 = vp.GetBool(EnableIPv4Name)
 	c.EnableIPv6 = vp.GetBool(EnableIPv6Name)
 	c.EnableIPv6NDP = vp.GetBool(EnableIPv6NDPName)
+	c.EnableIPv6OnlyIPAM = vp.GetBool(EnableIPv6OnlyIPAM)
 	c.EnableIPv6BIGTCP = vp.GetBool(EnableIPv6BIGTCP)
+	c.EnableIPv4BIGTCP = vp.GetBool(EnableIPv4BIGTCP)
 	c.EnableSRv6 = vp.GetBool(EnableSRv6)
 	c.SRv6EncapMode = vp.GetString(SRv6EncapModeName)
 	c.EnableSCTP = vp.GetBool(EnableSCTPName)
+	c.EnableMulticast = vp.GetBool(EnableMulticastName)
 	c.IPv6MCastDevice = vp.GetString(IPv6MCastDevice)
 	c.EnableIPSec = vp.GetBool(EnableIPSecName)
 	c.EnableWireguard = vp.GetBool(EnableWireguard)
@@ -2857,11 +3154,25 @@ func (c *DaemonConfig) Populate(vp *viper.Viper) {
 	c.EgressMasqueradeInterfaces = vp.GetString(EgressMasqueradeInterfaces)
 	c.BPFSocketLBHostnsOnly = vp.GetBool(BPFSocketLBHostnsOnly)
 	c.EnableSocketLB = vp.GetBool(EnableSocketLB)
+	if sel := vp.GetString(BPFSocketLBSkipSelector); sel != "" {
+		skipSelector, err := k8sLabels.Parse(sel)
+		if err != nil {
+			log.WithError(err).Fatalf("Unable to parse label selector of option --%s", BPFSocketLBSkipSelector)
+		}
+		c.SocketLBSkipSelector = skipSelector
+	}
 	c.EnableSocketLBTracing = vp.GetBool(EnableSocketLBTracing)
 	c.EnableRemoteNodeIdentity = vp.GetBool(EnableRemoteNodeIdentity)
 	c.EnableBPFTProxy = vp.GetBool(EnableBPFTProxy)
 	c.EnableXTSocketFallback = vp.GetBool(EnableXTSocketFallbackName)
 	c.EnableAutoDirectRouting = vp.GetBool(EnableAutoDirectRoutingName)
+	for _, s := range vp.GetStringSlice(DirectRoutingNodeCIDRsName) {
+		drCIDR, err := cidr.ParseCIDR(s)
+		if err != nil {
+			log.WithError(err).Fatalf("Unable to parse CIDR value '%s' of option --%s", s, DirectRoutingNodeCIDRsName)
+		}
+		c.DirectRoutingNodeCIDRs = append(c.DirectRoutingNodeCIDRs, drCIDR)
+	}
 	c.EnableEndpointRoutes = vp.GetBool(EnableEndpointRoutes)
 	c.EnableHealthChecking = vp.GetBool(EnableHealthChecking)
 	c.EnableEndpointHealthChecking = vp.GetBool(EnableEndpointHealthChecking)
@@ -2870,6 +3181,7 @@ func (c *DaemonConfig) Populate(vp *viper.Viper) {
 	c.EnablePolicy = strings.ToLower(vp.GetString(EnablePolicy))
 	c.EnableExternalIPs = vp.GetBool(EnableExternalIPs)
 	c.EnableL7Proxy = vp.GetBool(EnableL7Proxy)
+	c.EnableStickyIPs = vp.GetBool(EnableStickyIPs)
 	c.EnableTracing = vp.GetBool(EnableTracing)
 	c.EnableUnreachableRoutes = vp.GetBool(EnableUnreachableRoutes)
 	c.EnableNodePort = vp.GetBool(EnableNodePort)
@@ -2903,6 +3215,13 @@ func (c *DaemonConfig) Populate(vp *viper.Viper) {
 	c.IdentityChangeGracePeriod = vp.GetDuration(IdentityChangeGracePeriod)
 	c.IdentityRestoreGracePeriod = vp.GetDuration(IdentityRestoreGracePeriod)
 	c.IPAM = vp.GetString(IPAM)
+	c.IPAMReleaseGracePeriod = vp.GetDuration(IPAMReleaseGracePeriod)
+	c.ExternalIPAMEndpoint = vp.GetString(ExternalIPAMEndpoint)
+	c.ExternalIPAMCAFile = vp.GetString(ExternalIPAMCAFile)
+	c.ExternalIPAMCertFile = vp.GetString(ExternalIPAMCertFile)
+	c.ExternalIPAMKeyFile = vp.GetString(ExternalIPAMKeyFile)
+	c.EnableIPAMEvents = vp.GetBool(EnableIPAMEvents)
+	c.IPAMEventLogFile = vp.GetString(IPAMEventLogFile)
 	c.IPv4Range = vp.GetString(IPv4Range)
 	c.IPv4NodeAddr = vp.GetString(IPv4NodeAddr)
 	c.IPv4ServiceRange = vp.GetString(IPv4ServiceRange)
@@ -2954,6 +3273,7 @@ func (c *DaemonConfig) Populate(vp *viper.Viper) {
 	c.MonitorAggregationInterval = vp.GetDuration(MonitorAggregationInterval)
 	c.MonitorQueueSize = vp.GetInt(MonitorQueueSizeName)
 	c.MTU = vp.GetInt(MTUName)
+	c.EnableRouteMTUDiscovery = vp.GetBool(RouteMTUDiscoveryName)
 	c.PProf = vp.GetBool(PProf)
 	c.PProfPort = vp.GetInt(PProfPort)
 	c.PreAllocateMaps = vp.GetBool(PreAllocateMapsName)
@@ -2987,6 +3307,9 @@ func (c *DaemonConfig) Populate(vp *viper.Viper) {
 	c.CTMapEntriesTimeoutSYN = vp.GetDuration(CTMapEntriesTimeoutSYNName)
 	c.CTMapEntriesTimeoutFIN = vp.GetDuration(CTMapEntriesTimeoutFINName)
 	c.PolicyAuditMode = vp.GetBool(PolicyAuditModeArg)
+	c.PolicyDefaultDenyNamespaces = vp.GetStringSlice(PolicyDefaultDenyNamespaces)
+	c.PolicyDefaultDenyAuditDuration = vp.GetDuration(PolicyDefaultDenyAuditDuration)
+	c.EnableIdentityRelevance = vp.GetBool(EnableIdentityRelevance)
 	c.EnableIPv4FragmentsTracking = vp.GetBool(EnableIPv4FragmentsTrackingName)
 	c.FragmentsMapEntries = vp.GetInt(FragmentsMapEntriesName)
 	c.K8sServiceProxyName = vp.GetString(K8sServiceProxyName)
@@ -3002,6 +3325,7 @@ func (c *DaemonConfig) Populate(vp *viper.Viper) {
 	c.BGPConfigPath = vp.GetString(BGPConfigPath)
 	c.ExternalClusterIP = vp.GetBool(ExternalClusterIPName)
 	c.EnableNat46X64Gateway = vp.GetBool(EnableNat46X64Gateway)
+	c.EnableDNS64 = vp.GetBool(EnableDNS64)
 	c.EnableIPv4Masquerade = vp.GetBool(EnableIPv4Masquerade) && c.EnableIPv4
 	c.EnableIPv6Masquerade = vp.GetBool(EnableIPv6Masquerade) && c.EnableIPv6
 	c.EnableBPFMasquerade = vp.GetBool(EnableBPFMasquerade)
@@ -3061,6 +3385,10 @@ func (c *DaemonConfig) Populate(vp *viper.Viper) {
 		}
 	}
 
+	if c.EnableDNS64 && !c.EnableNat46X64Gateway {
+		log.Fatalf("--%s requires --%s enabled", EnableDNS64, EnableNat46X64Gateway)
+	}
+
 	ipv4NativeRoutingCIDR := vp.GetString(IPv4NativeRoutingCIDR)
 
 	if ipv4NativeRoutingCIDR != "" {
@@ -3177,6 +3505,12 @@ func (c *DaemonConfig) Populate(vp *viper.Viper) {
 		c.FixedIdentityMapping = m
 	}
 
+	if m, err := command.GetStringMapStringE(vp, EgressIPPools); err != nil {
+		log.Fatalf("unable to parse %s: %s", EgressIPPools, err)
+	} else {
+		c.EgressIPPools = m
+	}
+
 	c.ConntrackGCInterval = vp.GetDuration(ConntrackGCInterval)
 
 	if m, err := command.GetStringMapStringE(vp, KVStoreOpt); err != nil {
@@ -3262,7 +3596,7 @@ func (c *DaemonConfig) Populate(vp *viper.Viper) {
 	}
 
 	switch c.IPAM {
-	case ipamOption.IPAMKubernetes, ipamOption.IPAMClusterPool, ipamOption.IPAMClusterPoolV2:
+	case ipamOption.IPAMKubernetes, ipamOption.IPAMClusterPool, ipamOption.IPAMClusterPoolV2, ipamOption.IPAMMultiPool:
 		if c.EnableIPv4 {
 			c.K8sRequireIPv4PodCIDR = true
 		}
@@ -3291,6 +3625,10 @@ func (c *DaemonConfig) Populate(vp *viper.Viper) {
 	}
 	c.HubbleMetricsServer = vp.GetString(HubbleMetricsServer)
 	c.HubbleMetrics = vp.GetStringSlice(HubbleMetrics)
+	c.HubbleFlowStorePath = vp.GetString(HubbleFlowStorePath)
+	c.HubbleFlowStoreMaxSizeMB = vp.GetInt(HubbleFlowStoreMaxSizeMB)
+	c.HubbleFlowSampling = vp.GetStringSlice(HubbleFlowSampling)
+	c.HubbleFlowAggregationWindow = vp.GetDuration(HubbleFlowAggregationWindow)
 	c.HubbleExportFilePath = vp.GetString(HubbleExportFilePath)
 	c.HubbleExportFileMaxSizeMB = vp.GetInt(HubbleExportFileMaxSizeMB)
 	c.HubbleExportFileMaxBackups = vp.GetInt(HubbleExportFileMaxBackups)
@@ -3315,6 +3653,9 @@ func (c *DaemonConfig) Populate(vp *viper.Viper) {
 	c.EndpointGCInterval = vp.GetDuration(EndpointGCInterval)
 	c.DisableCNPStatusUpdates = vp.GetBool(DisableCNPStatusUpdates)
 	c.EnableICMPRules = vp.GetBool(EnableICMPRules)
+	c.EnableNodeSelectorLabels = vp.GetBool(EnableNodeSelectorLabels)
+	c.MeshAuthEnabled = vp.GetBool(MeshAuthEnabled)
+	c.EnablePolicyRateLimit = vp.GetBool(EnablePolicyRateLimit)
 	c.BypassIPAvailabilityUponRestore = vp.GetBool(BypassIPAvailabilityUponRestore)
 	c.EnableK8sTerminatingEndpoint = vp.GetBool(EnableK8sTerminatingEndpoint)
 	c.EnableStaleCiliumEndpointCleanup = vp.GetBool(EnableStaleCiliumEndpointCleanup)
@@ -3516,7 +3857,8 @@ func (c *DaemonConfig) checkMapSizeLimits() error {
 
 func (c *DaemonConfig) checkIPv4NativeRoutingCIDR() error {
 	if c.GetIPv4NativeRoutingCIDR() == nil && c.EnableIPv4Masquerade && c.Tunnel == TunnelDisabled &&
-		c.IPAMMode() != ipamOption.IPAMENI && c.EnableIPv4 && c.IPAMMode() != ipamOption.IPAMAlibabaCloud {
+		c.IPAMMode() != ipamOption.IPAMENI && c.EnableIPv4 && c.IPAMMode() != ipamOption.IPAMAlibabaCloud &&
+		c.IPAMMode() != ipamOption.IPAMOpenStack {
 		return fmt.Errorf(
 			"native routing cidr must be configured with option --%s "+
 				"in combination with --%s --%s=%s --%s=%s --%s=true",
@@ -3576,6 +3918,36 @@ func (c *DaemonConfig) calculateBPFMapSizes(vp *viper.Viper) error {
 	c.LBSourceRangeMapEntries = vp.GetInt(LBSourceRangeMapMaxEntries)
 	c.LBMaglevMapEntries = vp.GetInt(LBMaglevMapMaxEntries)
 
+	// The sizing profile scales the policy and LB map sizes as well as the
+	// dynamic size ratio for the CT, NAT and neighbor maps relative to
+	// their defaults. Map sizes explicitly set via option override the
+	// profile for the respective map.
+	profileScale := 1.0
+	c.BPFMapSizingProfile = vp.GetString(BPFMapSizingProfileName)
+	switch c.BPFMapSizingProfile {
+	case "", MapSizingProfileCustom, MapSizingProfileMedium:
+	case MapSizingProfileSmall:
+		profileScale = 0.5
+	case MapSizingProfileLarge:
+		profileScale = 2.0
+	default:
+		return fmt.Errorf("invalid BPF map sizing profile %q, must be one of %s, %s, %s or %s",
+			c.BPFMapSizingProfile, MapSizingProfileCustom, MapSizingProfileSmall,
+			MapSizingProfileMedium, MapSizingProfileLarge)
+	}
+	if profileScale != 1.0 {
+		if !vp.IsSet(PolicyMapEntriesName) {
+			c.PolicyMapEntries = int(float64(c.PolicyMapEntries) * profileScale)
+			log.Infof("option %s set by sizing profile %s to %v",
+				PolicyMapEntriesName, c.BPFMapSizingProfile, c.PolicyMapEntries)
+		}
+		if !vp.IsSet(LBMapEntriesName) {
+			c.LBMapEntries = int(float64(c.LBMapEntries) * profileScale)
+			log.Infof("option %s set by sizing profile %s to %v",
+				LBMapEntriesName, c.BPFMapSizingProfile, c.LBMapEntries)
+		}
+	}
+
 	// Don't attempt dynamic sizing if any of the sizeof members was not
 	// populated by the daemon (or any other caller).
 	if c.SizeofCTElement == 0 ||
@@ -3589,6 +3961,11 @@ func (c *DaemonConfig) calculateBPFMapSizes(vp *viper.Viper) error {
 	// clamped to the table limits. Thus, a ratio of e.g. 0.98 will not lead
 	// to 98% of the total memory being allocated for BPF maps.
 	dynamicSizeRatio := vp.GetFloat64(MapEntriesGlobalDynamicSizeRatioName)
+	if !vp.IsSet(MapEntriesGlobalDynamicSizeRatioName) && profileScale != 1.0 {
+		dynamicSizeRatio *= profileScale
+		log.Infof("option %s set by sizing profile %s to %f",
+			MapEntriesGlobalDynamicSizeRatioName, c.BPFMapSizingProfile, dynamicSizeRatio)
+	}
 	if 0.0 < dynamicSizeRatio && dynamicSizeRatio <= 1.0 {
 		vms, err := mem.VirtualMemory()
 		if err != nil || vms == nil {
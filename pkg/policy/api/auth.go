@@ -0,0 +1,45 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package api
+
+import "fmt"
+
+// AuthenticationMode is the mutual authentication mode required by a rule.
+//
+// +kubebuilder:validation:Enum=disabled;required
+type AuthenticationMode string
+
+const (
+	// AuthenticationModeDisabled does not require any authentication for the
+	// traffic selected by the rule, overriding any requirement imposed by
+	// other rules.
+	AuthenticationModeDisabled AuthenticationMode = "disabled"
+
+	// AuthenticationModeRequired requires the peers to mutually authenticate
+	// before the traffic selected by the rule is allowed.
+	AuthenticationModeRequired AuthenticationMode = "required"
+)
+
+// Authentication specifies the kind of cryptographic authentication required
+// for the traffic to be allowed. Peer identities are authenticated against
+// the certificates issued for them by the cluster mesh certificate authority,
+// such as SPIFFE SVIDs delivered by SPIRE. The result of a successful
+// handshake is cached in the datapath, so the handshake is only performed
+// when no cached authentication exists for the peer yet.
+type Authentication struct {
+	// Mode is the required authentication mode for the traffic to be
+	// allowed.
+	//
+	// +kubebuilder:validation:Required
+	Mode AuthenticationMode `json:"mode"`
+}
+
+// verify returns an error if the authentication mode is not a known one.
+func (a *Authentication) verify() error {
+	switch a.Mode {
+	case AuthenticationModeDisabled, AuthenticationModeRequired:
+		return nil
+	}
+	return fmt.Errorf("invalid authentication mode %q", a.Mode)
+}
@@ -22,6 +22,14 @@ type CIDR string
 // CIDRMatchAll is a []CIDR that matches everything
 var CIDRMatchAll = []CIDR{CIDR("0.0.0.0/0"), CIDR("::/0")}
 
+// CIDRGroupRef is the name of a CiliumCIDRGroup resource. The reference is
+// resolved into the CIDRs of the group before the rule is imported into the
+// policy repository; a reference to a non-existing group matches nothing.
+//
+// +kubebuilder:validation:MaxLength=253
+// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$`
+type CIDRGroupRef string
+
 // MatchesAll determines whether the CIDR matches all traffic.
 func (c CIDR) MatchesAll() bool {
 	for _, wildcard := range CIDRMatchAll {
@@ -38,8 +46,15 @@ func (c CIDR) MatchesAll() bool {
 type CIDRRule struct {
 	// CIDR is a CIDR prefix / IP Block.
 	//
-	// +kubebuilder:validation:Required
-	Cidr CIDR `json:"cidr"`
+	// +kubebuilder:validation:OneOf
+	Cidr CIDR `json:"cidr,omitempty"`
+
+	// CIDRGroupRef is a reference to a CiliumCIDRGroup object. The rule
+	// matches the CIDRs listed in the referenced group. Only one of Cidr
+	// or CIDRGroupRef may be set.
+	//
+	// +kubebuilder:validation:OneOf
+	CIDRGroupRef CIDRGroupRef `json:"cidrGroupRef,omitempty"`
 
 	// ExceptCIDRs is a list of IP blocks which the endpoint subject to the rule
 	// is not allowed to initiate connections to. These CIDR prefixes should be
@@ -139,6 +154,10 @@ func (s CIDRRuleSlice) StringSlice() []string {
 func ComputeResultantCIDRSet(cidrs CIDRRuleSlice) CIDRSlice {
 	var allResultantAllowedCIDRs CIDRSlice
 	for _, s := range cidrs {
+		// Unresolved CIDR group references match nothing.
+		if s.Cidr == "" {
+			continue
+		}
 		_, allowNet, _ := net.ParseCIDR(string(s.Cidr))
 
 		var removeSubnets []*net.IPNet
@@ -155,18 +174,14 @@ func ComputeResultantCIDRSet(cidrs CIDRRuleSlice) CIDRSlice {
 	return allResultantAllowedCIDRs
 }
 
-// addrsToCIDRRules generates CIDRRules for the IPs passed in.
+// prefixesToCIDRRules generates CIDRRules for the prefixes passed in.
 // This function will mark the rule to Generated true by default.
-func addrsToCIDRRules(addrs []netip.Addr) []CIDRRule {
-	cidrRules := make([]CIDRRule, 0, len(addrs))
-	for _, addr := range addrs {
+func prefixesToCIDRRules(prefixes []netip.Prefix) []CIDRRule {
+	cidrRules := make([]CIDRRule, 0, len(prefixes))
+	for _, prefix := range prefixes {
 		rule := CIDRRule{ExceptCIDRs: make([]CIDR, 0)}
 		rule.Generated = true
-		if addr.Is4() {
-			rule.Cidr = CIDR(addr.String() + "/32")
-		} else {
-			rule.Cidr = CIDR(addr.String() + "/128")
-		}
+		rule.Cidr = CIDR(prefix.String())
 		cidrRules = append(cidrRules, rule)
 	}
 	return cidrRules
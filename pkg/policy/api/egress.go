@@ -75,6 +75,19 @@ type EgressCommonRule struct {
 	// +kubebuilder:validation:Optional
 	ToEntities EntitySlice `json:"toEntities,omitempty"`
 
+	// ToNodes is a list of nodes identified by an EndpointSelector to
+	// which the endpoint subject to the rule is allowed to communicate.
+	// The selector matches the labels of the Kubernetes node objects.
+	// This field can only be used when the agent runs with the
+	// enable-node-selector-labels option.
+	//
+	// Example:
+	// Any endpoint with the label "app=metrics-agent" is allowed to
+	// initiate connections to nodes carrying the label "pool=monitoring".
+	//
+	// +kubebuilder:validation:Optional
+	ToNodes NodeSelectorSlice `json:"toNodes,omitempty"`
+
 	// ToServices is a list of services to which the endpoint subject
 	// to the rule is allowed to initiate connections.
 	// Currently Cilium only supports toServices for K8s services without
@@ -87,6 +100,18 @@ type EgressCommonRule struct {
 	// +kubebuilder:validation:Optional
 	ToServices []Service `json:"toServices,omitempty"`
 
+	// ToServiceAccounts is a list of Kubernetes ServiceAccounts to whose
+	// pods the endpoint subject to the rule is allowed to initiate
+	// connections.
+	//
+	// Example:
+	// Any endpoint with the label "app=frontend" is allowed to initiate
+	// connections to pods running under the ServiceAccount "backend" in
+	// the policy's namespace.
+	//
+	// +kubebuilder:validation:Optional
+	ToServiceAccounts ServiceAccountSlice `json:"toServiceAccounts,omitempty"`
+
 	// ToGroups is a directive that allows the integration with multiple outside
 	// providers. Currently, only AWS is supported, and the rule can select by
 	// multiple sub directives:
@@ -117,7 +142,8 @@ type EgressCommonRule struct {
 //     rule is the ToRequires member; the effects of any Requires field in any
 //     rule will apply to all other rules as well.
 //
-//   - ToEndpoints, ToCIDR, ToCIDRSet, ToEntities, ToServices and ToGroups are
+//   - ToEndpoints, ToCIDR, ToCIDRSet, ToEntities, ToNodes, ToServices,
+//     ToServiceAccounts and ToGroups are
 //     mutually exclusive. Only one of these members may be present within an
 //     individual rule.
 type EgressRule struct {
@@ -169,6 +195,19 @@ type EgressRule struct {
 	//
 	// +kubebuilder:validation:Optional
 	ICMPs ICMPRules `json:"icmps,omitempty"`
+
+	// Authentication is the required authentication type for the allowed
+	// traffic, if any.
+	//
+	// +kubebuilder:validation:Optional
+	Authentication *Authentication `json:"authentication,omitempty"`
+
+	// RateLimit limits the rate of the traffic allowed by this rule per
+	// source identity instead of allowing it unconditionally. Traffic
+	// exceeding the limits is dropped.
+	//
+	// +kubebuilder:validation:Optional
+	RateLimit *RateLimit `json:"rateLimit,omitempty"`
 }
 
 // EgressDenyRule contains all rule types which can be applied at egress, i.e.
@@ -183,7 +222,8 @@ type EgressRule struct {
 //     rule is the ToRequires member; the effects of any Requires field in any
 //     rule will apply to all other rules as well.
 //
-//   - ToEndpoints, ToCIDR, ToCIDRSet, ToEntities, ToServices and ToGroups are
+//   - ToEndpoints, ToCIDR, ToCIDRSet, ToEntities, ToNodes, ToServices,
+//     ToServiceAccounts and ToGroups are
 //     mutually exclusive. Only one of these members may be present within an
 //     individual rule.
 type EgressDenyRule struct {
@@ -218,15 +258,20 @@ type EgressDenyRule struct {
 // * ToEntities
 // * ToCIDR
 // * ToCIDRSet
+// * ToNodes
+// * ToServiceAccounts
 // * ToFQDNs
 //
 // ToEndpoints is not aggregated due to requirement folding in
 // GetDestinationEndpointSelectorsWithRequirements()
 func (e *EgressCommonRule) getAggregatedSelectors() EndpointSelectorSlice {
-	res := make(EndpointSelectorSlice, 0, len(e.ToEntities)+len(e.ToCIDR)+len(e.ToCIDRSet))
+	res := make(EndpointSelectorSlice, 0,
+		len(e.ToEntities)+len(e.ToCIDR)+len(e.ToCIDRSet)+len(e.ToNodes)+len(e.ToServiceAccounts))
 	res = append(res, e.ToEntities.GetAsEndpointSelectors()...)
 	res = append(res, e.ToCIDR.GetAsEndpointSelectors()...)
 	res = append(res, e.ToCIDRSet.GetAsEndpointSelectors()...)
+	res = append(res, e.ToNodes.GetAsEndpointSelectors()...)
+	res = append(res, e.ToServiceAccounts.GetAsEndpointSelectors()...)
 	return res
 }
 
@@ -237,6 +282,8 @@ func (e *EgressCommonRule) getAggregatedSelectors() EndpointSelectorSlice {
 // * ToEntities
 // * ToCIDR
 // * ToCIDRSet
+// * ToNodes
+// * ToServiceAccounts
 // * ToFQDNs
 //
 // ToEndpoints is not aggregated due to requirement folding in
@@ -254,6 +301,8 @@ func (e *EgressRule) SetAggregatedSelectors() {
 // * ToEntities
 // * ToCIDR
 // * ToCIDRSet
+// * ToNodes
+// * ToServiceAccounts
 // * ToFQDNs
 //
 // ToEndpoints is not aggregated due to requirement folding in
@@ -46,8 +46,8 @@ func (s *PolicyAPITestSuite) TestCreateDerivativeRuleWithoutToGroups(c *C) {
 }
 
 func (s *PolicyAPITestSuite) TestCreateDerivativeRuleWithToGroupsWitInvalidRegisterCallback(c *C) {
-	cb := func(ctx context.Context, group *ToGroups) ([]netip.Addr, error) {
-		return []netip.Addr{}, fmt.Errorf("Invalid error")
+	cb := func(ctx context.Context, group *ToGroups) ([]netip.Prefix, error) {
+		return []netip.Prefix{}, fmt.Errorf("Invalid error")
 	}
 	RegisterToGroupsProvider(AWSProvider, cb)
 
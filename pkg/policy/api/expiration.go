@@ -0,0 +1,41 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package api
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/cilium/cilium/pkg/labels"
+)
+
+const (
+	// PolicyLabelExpires is the label key carrying the expiration deadline
+	// of a temporary policy exception as a Unix timestamp in seconds.
+	// Rules carrying this label are removed from the policy repository
+	// once the deadline has passed.
+	PolicyLabelExpires = "io.cilium.policy.expires"
+)
+
+// NewExpirationLabel returns the label marking a rule for removal once the
+// given deadline has passed.
+func NewExpirationLabel(deadline time.Time) labels.Label {
+	return labels.NewLabel(PolicyLabelExpires, strconv.FormatInt(deadline.Unix(), 10), labels.LabelSourceUnspec)
+}
+
+// GetRuleExpiration returns the expiration deadline carried in the given rule
+// labels. The second return value is false if the labels do not carry a valid
+// expiration label.
+func GetRuleExpiration(lbls labels.LabelArray) (time.Time, bool) {
+	for _, lbl := range lbls {
+		if lbl.Key == PolicyLabelExpires {
+			secs, err := strconv.ParseInt(lbl.Value, 10, 64)
+			if err != nil {
+				return time.Time{}, false
+			}
+			return time.Unix(secs, 0), true
+		}
+	}
+	return time.Time{}, false
+}
@@ -22,7 +22,7 @@ var (
 
 // GroupProviderFunc is a func that need to be register to be able to
 // register a new provider in the platform.
-type GroupProviderFunc func(context.Context, *ToGroups) ([]netip.Addr, error)
+type GroupProviderFunc func(context.Context, *ToGroups) ([]netip.Prefix, error)
 
 // ToGroups structure to store all kinds of new integrations that needs a new
 // derivative policy.
@@ -35,7 +35,13 @@ type AWSGroup struct {
 	Labels              map[string]string `json:"labels,omitempty"`
 	SecurityGroupsIds   []string          `json:"securityGroupsIds,omitempty"`
 	SecurityGroupsNames []string          `json:"securityGroupsNames,omitempty"`
-	Region              string            `json:"region,omitempty"`
+	// ServicePrefixes selects the published IP ranges of AWS services.
+	// Each entry is a service name, optionally followed by "/<region>" to
+	// restrict the ranges to a single region, e.g. "s3/us-east-1". If no
+	// region is given, Region is used when set, otherwise the ranges of
+	// all regions are selected.
+	ServicePrefixes []string `json:"servicePrefixes,omitempty"`
+	Region          string   `json:"region,omitempty"`
 }
 
 // RegisterToGroupsProvider it will register a new callback that will be used
@@ -47,7 +53,7 @@ func RegisterToGroupsProvider(providerName string, callback GroupProviderFunc) {
 // GetCidrSet will return the CIDRRule for the rule using the callbacks that
 // are register in the platform.
 func (group *ToGroups) GetCidrSet(ctx context.Context) ([]CIDRRule, error) {
-	var addrs []netip.Addr
+	var prefixes []netip.Prefix
 	// Get per  provider CIDRSet
 	if group.AWS != nil {
 		callbackInterface, ok := providers.Load(AWSProvider)
@@ -58,15 +64,15 @@ func (group *ToGroups) GetCidrSet(ctx context.Context) ([]CIDRRule, error) {
 		if !ok {
 			return nil, fmt.Errorf("Provider callback for %s is not a valid instance", AWSProvider)
 		}
-		awsAddrs, err := callback(ctx, group)
+		awsPrefixes, err := callback(ctx, group)
 		if err != nil {
 			return nil, fmt.Errorf(
 				"Cannot retrieve data from %s provider: %s",
 				AWSProvider, err)
 		}
-		addrs = append(addrs, awsAddrs...)
+		prefixes = append(prefixes, awsPrefixes...)
 	}
 
-	resultAddrs := ip.KeepUniqueAddrs(addrs)
-	return addrsToCIDRRules(resultAddrs), nil
+	resultPrefixes := ip.KeepUniquePrefixes(prefixes)
+	return prefixesToCIDRRules(resultPrefixes), nil
 }
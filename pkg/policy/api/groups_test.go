@@ -30,15 +30,15 @@ func GetToGroupsRule() ToGroups {
 	}
 }
 func GetCallBackWithRule(ips ...string) GroupProviderFunc {
-	netIPs := make([]netip.Addr, 0, len(ips))
+	netPrefixes := make([]netip.Prefix, 0, len(ips))
 	for _, ip := range ips {
 		if addr, err := netip.ParseAddr(ip); err == nil {
-			netIPs = append(netIPs, addr)
+			netPrefixes = append(netPrefixes, netip.PrefixFrom(addr, addr.BitLen()))
 		}
 	}
 
-	return func(ctx context.Context, group *ToGroups) ([]netip.Addr, error) {
-		return netIPs, nil
+	return func(ctx context.Context, group *ToGroups) ([]netip.Prefix, error) {
+		return netPrefixes, nil
 	}
 }
 
@@ -82,8 +82,8 @@ func (s *PolicyAPITestSuite) TestGetCIDRSetWithUniqueCIDRRule(c *C) {
 }
 
 func (s *PolicyAPITestSuite) TestGetCIDRSetWithError(c *C) {
-	cb := func(ctx context.Context, group *ToGroups) ([]netip.Addr, error) {
-		return []netip.Addr{}, fmt.Errorf("Invalid credentials")
+	cb := func(ctx context.Context, group *ToGroups) ([]netip.Prefix, error) {
+		return []netip.Prefix{}, fmt.Errorf("Invalid credentials")
 	}
 	RegisterToGroupsProvider(AWSProvider, cb)
 	group := GetToGroupsRule()
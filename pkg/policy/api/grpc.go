@@ -0,0 +1,71 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package api
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// PortRuleGRPC is a list of gRPC call constraints. All fields are optional,
+// if all fields are empty or missing, the rule matches all gRPC calls.
+//
+// gRPC calls are carried as HTTP/2 requests whose path is composed of the
+// fully qualified service name and the method name. The rules are enforced
+// by the HTTP proxy parser.
+type PortRuleGRPC struct {
+	// Service is an extended POSIX regex matched against the fully
+	// qualified gRPC service name of a call, e.g. "helloworld.Greeter".
+	//
+	// If omitted or empty, all services are allowed.
+	//
+	// +kubebuilder:validation:Optional
+	Service string `json:"service,omitempty"`
+
+	// Method is an extended POSIX regex matched against the gRPC method
+	// name of a call, e.g. "SayHello".
+	//
+	// If omitted or empty, all methods are allowed.
+	//
+	// +kubebuilder:validation:Optional
+	Method string `json:"method,omitempty"`
+}
+
+// Sanitize sanitizes gRPC rules. It ensures that the service and method
+// fields are valid regular expressions. If the rule is invalid, returns an
+// error.
+func (g *PortRuleGRPC) Sanitize() error {
+	if g.Service != "" {
+		_, err := regexp.Compile(g.Service)
+		if err != nil {
+			return err
+		}
+	}
+
+	if g.Method != "" {
+		_, err := regexp.Compile(g.Method)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ToHTTPRule returns the HTTP rule matching the requests covered by the gRPC
+// rule, for enforcement by the HTTP proxy parser.
+func (g *PortRuleGRPC) ToHTTPRule() PortRuleHTTP {
+	service := g.Service
+	if service == "" {
+		service = "[^/]+"
+	}
+	method := g.Method
+	if method == "" {
+		method = "[^/]+"
+	}
+	return PortRuleHTTP{
+		Method: "POST",
+		Path:   fmt.Sprintf("/%s/%s", service, method),
+	}
+}
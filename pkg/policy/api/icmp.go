@@ -18,6 +18,15 @@ type ICMPRule struct {
 	//
 	// +kubebuilder:validation:Optional
 	Fields []ICMPField `json:"fields,omitempty"`
+
+	// AllowRelated additionally matches the ICMP error messages that are
+	// emitted in response to packets of established flows (destination
+	// unreachable, time exceeded and parameter problem, as well as packet
+	// too big for IPv6). Allowing these is required for path MTU discovery
+	// to function across a strict host firewall.
+	//
+	// +kubebuilder:validation:Optional
+	AllowRelated bool `json:"allowRelated,omitempty"`
 }
 
 // ICMPField is a ICMP field.
@@ -37,6 +46,38 @@ type ICMPField struct {
 	// +kubebuilder:validation:Maximum=255
 	// +kubebuilder:validation:Minimum=0
 	Type uint8 `json:"type"`
+
+	// Code is a ICMP-code.
+	// It should be 0-255 (8bit).
+	// If omitted, all codes of the given type are matched.
+	//
+	// +kubebuilder:validation:Maximum=255
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Optional
+	Code *uint8 `json:"code,omitempty"`
+}
+
+// relatedICMPFields are the ICMP error messages that are only emitted in
+// response to packets of an existing flow, per RFC 792 and RFC 4443.
+var relatedICMPFields = []ICMPField{
+	{Family: IPv4Family, Type: 3},  // destination unreachable
+	{Family: IPv4Family, Type: 11}, // time exceeded
+	{Family: IPv4Family, Type: 12}, // parameter problem
+	{Family: IPv6Family, Type: 1},  // destination unreachable
+	{Family: IPv6Family, Type: 2},  // packet too big
+	{Family: IPv6Family, Type: 3},  // time exceeded
+	{Family: IPv6Family, Type: 4},  // parameter problem
+}
+
+// fields returns the explicitly listed ICMP fields of the rule, extended
+// with the related error message fields when AllowRelated is set.
+func (ir ICMPRule) fields() []ICMPField {
+	if !ir.AllowRelated {
+		return ir.Fields
+	}
+	fields := make([]ICMPField, 0, len(ir.Fields)+len(relatedICMPFields))
+	fields = append(fields, ir.Fields...)
+	return append(fields, relatedICMPFields...)
 }
 
 // Iterate iterates over all elements of ICMPRules.
@@ -57,7 +98,7 @@ func (ir ICMPRules) Len() int {
 // GetPortProtocols generates PortProtocol slice from ICMPRule and returns it.
 func (ir ICMPRule) GetPortProtocols() []PortProtocol {
 	var pps []PortProtocol
-	for _, t := range ir.Fields {
+	for _, t := range ir.fields() {
 		pp := t.PortProtocol()
 		pps = append(pps, *pp)
 	}
@@ -67,7 +108,7 @@ func (ir ICMPRule) GetPortProtocols() []PortProtocol {
 // GetPortRule generates PortRule from ICMPRule and returns it.
 func (ir ICMPRule) GetPortRule() *PortRule {
 	var pps []PortProtocol
-	for _, t := range ir.Fields {
+	for _, t := range ir.fields() {
 		pp := t.PortProtocol()
 		pps = append(pps, *pp)
 	}
@@ -78,10 +119,18 @@ func (ir ICMPRule) GetPortRule() *PortRule {
 }
 
 // PortProtocol translates ICMPType to PortProtocol.
+//
+// The type is carried in the port number. Fields with a code are encoded
+// with the type in the upper byte and the code in the lower byte, so that
+// they remain distinguishable from type-only fields, which occupy the
+// 0-255 range.
 func (i ICMPField) PortProtocol() *PortProtocol {
 	var proto L4Proto
 
-	typeStr := strconv.Itoa(int(i.Type))
+	port := int(i.Type)
+	if i.Code != nil {
+		port = port<<8 | int(*i.Code)
+	}
 	if i.Family == IPv6Family {
 		proto = ProtoICMPv6
 	} else {
@@ -89,7 +138,7 @@ func (i ICMPField) PortProtocol() *PortProtocol {
 	}
 
 	pr := PortProtocol{
-		Port:     typeStr,
+		Port:     strconv.Itoa(port),
 		Protocol: proto,
 	}
 	return &pr
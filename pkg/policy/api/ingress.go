@@ -72,6 +72,30 @@ type IngressCommonRule struct {
 	// +kubebuilder:validation:Optional
 	FromEntities EntitySlice `json:"fromEntities,omitempty"`
 
+	// FromNodes is a list of nodes identified by an EndpointSelector which
+	// are allowed to communicate with the endpoint subject to the rule.
+	// The selector matches the labels of the Kubernetes node objects.
+	// This field can only be used when the agent runs with the
+	// enable-node-selector-labels option.
+	//
+	// Example:
+	// Any endpoint with the label "app=ingress-backend" can be consumed by
+	// host processes on nodes carrying the label "pool=ingress".
+	//
+	// +kubebuilder:validation:Optional
+	FromNodes NodeSelectorSlice `json:"fromNodes,omitempty"`
+
+	// FromServiceAccounts is a list of Kubernetes ServiceAccounts whose
+	// pods are allowed to communicate with the endpoint subject to the
+	// rule.
+	//
+	// Example:
+	// Any pod running under the ServiceAccount "backend" in the policy's
+	// namespace can be consumed by the endpoints subject to the rule.
+	//
+	// +kubebuilder:validation:Optional
+	FromServiceAccounts ServiceAccountSlice `json:"fromServiceAccounts,omitempty"`
+
 	// TODO: Move this to the policy package
 	// (https://github.com/cilium/cilium/issues/8353)
 	aggregatedSelectors EndpointSelectorSlice `json:"-"`
@@ -89,7 +113,8 @@ type IngressCommonRule struct {
 //     the effects of any Requires field in any rule will apply to all other
 //     rules as well.
 //
-//   - FromEndpoints, FromCIDR, FromCIDRSet and FromEntities are mutually
+//   - FromEndpoints, FromCIDR, FromCIDRSet, FromEntities, FromNodes and
+//     FromServiceAccounts are mutually
 //     exclusive. Only one of these members may be present within an individual
 //     rule.
 type IngressRule struct {
@@ -116,6 +141,19 @@ type IngressRule struct {
 	//
 	// +kubebuilder:validation:Optional
 	ICMPs ICMPRules `json:"icmps,omitempty"`
+
+	// Authentication is the required authentication type for the allowed
+	// traffic, if any.
+	//
+	// +kubebuilder:validation:Optional
+	Authentication *Authentication `json:"authentication,omitempty"`
+
+	// RateLimit limits the rate of the traffic allowed by this rule per
+	// source identity instead of allowing it unconditionally. Traffic
+	// exceeding the limits is dropped.
+	//
+	// +kubebuilder:validation:Optional
+	RateLimit *RateLimit `json:"rateLimit,omitempty"`
 }
 
 // IngressDenyRule contains all rule types which can be applied at ingress,
@@ -130,7 +168,8 @@ type IngressRule struct {
 //     the effects of any Requires field in any rule will apply to all other
 //     rules as well.
 //
-//   - FromEndpoints, FromCIDR, FromCIDRSet and FromEntities are mutually
+//   - FromEndpoints, FromCIDR, FromCIDRSet, FromEntities, FromNodes and
+//     FromServiceAccounts are mutually
 //     exclusive. Only one of these members may be present within an individual
 //     rule.
 type IngressDenyRule struct {
@@ -166,14 +205,19 @@ type IngressDenyRule struct {
 // * FromEntities
 // * FromCIDR
 // * FromCIDRSet
+// * FromNodes
+// * FromServiceAccounts
 //
 // FromEndpoints is not aggregated due to requirement folding in
 // GetSourceEndpointSelectorsWithRequirements()
 func (i *IngressCommonRule) SetAggregatedSelectors() {
-	res := make(EndpointSelectorSlice, 0, len(i.FromEntities)+len(i.FromCIDR)+len(i.FromCIDRSet))
+	res := make(EndpointSelectorSlice, 0,
+		len(i.FromEntities)+len(i.FromCIDR)+len(i.FromCIDRSet)+len(i.FromNodes)+len(i.FromServiceAccounts))
 	res = append(res, i.FromEntities.GetAsEndpointSelectors()...)
 	res = append(res, i.FromCIDR.GetAsEndpointSelectors()...)
 	res = append(res, i.FromCIDRSet.GetAsEndpointSelectors()...)
+	res = append(res, i.FromNodes.GetAsEndpointSelectors()...)
+	res = append(res, i.FromServiceAccounts.GetAsEndpointSelectors()...)
 	// Goroutines can race setting this, but they will all compute
 	// the same result, so it does not matter.
 	i.aggregatedSelectors = res
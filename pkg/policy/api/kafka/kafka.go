@@ -73,9 +73,10 @@ type PortRule struct {
 	// message will be rejected.
 	//
 	// This constraint is ignored if the matched request message type
-	// doesn't contain any topic. Maximum size of Topic can be 249
-	// characters as per recent Kafka spec and allowed characters are
-	// a-z, A-Z, 0-9, -, . and _.
+	// doesn't contain any topic. The name may contain '*' wildcards, each
+	// matching any sequence of allowed characters, e.g. "logs-*".
+	// Maximum size of Topic can be 249 characters as per recent Kafka spec
+	// and allowed characters are a-z, A-Z, 0-9, -, . and _.
 	//
 	// Older Kafka versions had longer topic lengths of 255, but in Kafka 0.10
 	// version the length was changed from 255 to 249. For compatibility
@@ -117,10 +118,13 @@ const (
 // List of Kafka apiKey which are not associated with
 // any topic
 const (
-	HeartbeatKey   = 12
-	LeaveGroupKey  = 13
-	SyncgroupKey   = 14
-	APIVersionsKey = 18
+	HeartbeatKey        = 12
+	LeaveGroupKey       = 13
+	SyncgroupKey        = 14
+	SaslHandshakeKey    = 17
+	APIVersionsKey      = 18
+	InitProducerIDKey   = 22
+	SaslAuthenticateKey = 36
 )
 
 // List of Kafka Roles
@@ -167,6 +171,13 @@ var APIKeyMap = map[string]int16{
 	"deleteacls":           31, /* DeleteAcls */
 	"describeconfigs":      32, /* DescribeConfigs */
 	"alterconfigs":         33, /* AlterConfigs */
+	"alterreplicalogdirs":  34, /* AlterReplicaLogDirs */
+	"describelogdirs":      35, /* DescribeLogDirs */
+	"saslauthenticate":     36, /* SaslAuthenticate */
+	"createpartitions":     37, /* CreatePartitions */
+	"deletegroups":         42, /* DeleteGroups */
+	"electleaders":         43, /* ElectLeaders */
+	"offsetdelete":         47, /* OffsetDelete */
 }
 
 // ReverseApiKeyMap is the map of all allowed kafka API keys
@@ -207,6 +218,13 @@ var ReverseAPIKeyMap = map[int16]string{
 	31: "deleteacls",           /* DeleteAcls */
 	32: "describeconfigs",      /* DescribeConfigs */
 	33: "alterconfigs",         /* AlterConfigs */
+	34: "alterreplicalogdirs",  /* AlterReplicaLogDirs */
+	35: "describelogdirs",      /* DescribeLogDirs */
+	36: "saslauthenticate",     /* SaslAuthenticate */
+	37: "createpartitions",     /* CreatePartitions */
+	42: "deletegroups",         /* DeleteGroups */
+	43: "electleaders",         /* ElectLeaders */
+	47: "offsetdelete",         /* OffsetDelete */
 }
 
 func ApiKeyToString(apiKey int16) string {
@@ -225,12 +243,11 @@ const (
 )
 
 // TopicValidChar is a one-time regex generation of all allowed characters
-// in kafka topic name.
-var TopicValidChar = regexp.MustCompile(`^[a-zA-Z0-9\\._\\-]+$`)
+// in kafka topic name. '*' is not a valid topic character, but is allowed
+// in rules as a wildcard matching any sequence of the other characters.
+var TopicValidChar = regexp.MustCompile(`^[a-zA-Z0-9._*-]+$`)
 
 // Sanitize sanitizes Kafka rules
-// TODO we need to add support to check
-// wildcard and prefix/suffix later on.
 func (kr *PortRule) Sanitize() error {
 	if (len(kr.APIKey) > 0) && (len(kr.Role) > 0) {
 		return fmt.Errorf("cannot set both Role %q and APIKey %q together", kr.Role, kr.APIKey)
@@ -284,14 +301,15 @@ func (kr *PortRule) GetAPIVersion() int32 {
 // GetAPIKeys() returns a slice of numeric apikeys for the PortRule
 func (kr *PortRule) GetAPIKeys() []int32 {
 	// Expand the kr.apiKeyInt array based on the Role.
-	// For produce role, we need to add mandatory apiKeys produce, metadata and
-	// apiversions. While for consume, we need to add mandatory apiKeys like
-	// fetch, offsets, offsetcommit, offsetfetch, apiversions, metadata,
-	// findcoordinator, joingroup, heartbeat,
+	// For produce role, we need to add mandatory apiKeys produce, metadata,
+	// apiversions and initproducerid, the latter being sent by modern
+	// clients with idempotence enabled. While for consume, we need to add
+	// mandatory apiKeys like fetch, offsets, offsetcommit, offsetfetch,
+	// apiversions, metadata, findcoordinator, joingroup, heartbeat,
 	// leavegroup and syncgroup.
 	switch strings.ToLower(kr.Role) {
 	case ProduceRole:
-		return []int32{int32(ProduceKey), int32(MetadataKey), int32(APIVersionsKey)}
+		return []int32{int32(ProduceKey), int32(MetadataKey), int32(APIVersionsKey), int32(InitProducerIDKey)}
 	case ConsumeRole:
 		return []int32{int32(FetchKey), int32(OffsetsKey), int32(MetadataKey),
 			int32(OffsetCommitKey), int32(OffsetFetchKey), int32(FindCoordinatorKey),
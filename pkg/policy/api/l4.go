@@ -177,7 +177,10 @@ type PortRule struct {
 
 	// ServerNames is a list of allowed TLS SNI values. If not empty, then
 	// TLS must be present and one of the provided SNIs must be indicated in the
-	// TLS handshake.
+	// TLS handshake. Each entry is either a literal DNS name or a wildcard
+	// name in which a single '*' replaces the leftmost label, e.g.
+	// *.cilium.io. SNI filtering only inspects the TLS handshake; the
+	// connection is not decrypted unless TLS termination is also configured.
 	//
 	// +kubebuilder:validation:Optional
 	ServerNames []string `json:"serverNames,omitempty"`
@@ -207,13 +210,21 @@ func (pd *PortRule) GetPortRule() *PortRule {
 }
 
 // PortDenyRule is a list of ports/protocol that should be used for deny
-// policies. This structure lacks the L7Rules since it's not supported in deny
 // policies.
 type PortDenyRule struct {
 	// Ports is a list of L4 port/protocol
 	//
 	// +kubebuilder:validation:Optional
 	Ports []PortProtocol `json:"ports,omitempty"`
+
+	// Rules is a list of additional port level rules which must not be met
+	// in order for the traffic to be forwarded. Traffic matching the rules
+	// is denied while all remaining traffic on the given ports is subject
+	// to the allow rules in place. Currently only DNS rules are supported
+	// in deny policies, and only at egress.
+	//
+	// +kubebuilder:validation:Optional
+	Rules *L7Rules `json:"rules,omitempty"`
 }
 
 // GetPortProtocols returns the Ports field of the PortDenyRule.
@@ -221,9 +232,16 @@ func (pd PortDenyRule) GetPortProtocols() []PortProtocol {
 	return pd.Ports
 }
 
-// GetPortRule returns nil has it is not a PortRule.
+// GetPortRule returns the PortDenyRule as a PortRule if it carries L7 rules
+// which need to be evaluated by the proxy, nil otherwise.
 func (pd *PortDenyRule) GetPortRule() *PortRule {
-	return nil
+	if pd.Rules.IsEmpty() {
+		return nil
+	}
+	return &PortRule{
+		Ports: pd.Ports,
+		Rules: pd.Rules,
+	}
 }
 
 // L7Rules is a union of port level rule types. Mixing of different port
@@ -235,6 +253,12 @@ type L7Rules struct {
 	// +kubebuilder:validation:Optional
 	HTTP []PortRuleHTTP `json:"http,omitempty"`
 
+	// GRPC-specific rules. Enforced by the HTTP proxy parser, may not be
+	// combined with HTTP rules.
+	//
+	// +kubebuilder:validation:Optional
+	GRPC []PortRuleGRPC `json:"grpc,omitempty"`
+
 	// Kafka-specific rules.
 	//
 	// +kubebuilder:validation:Optional
@@ -262,7 +286,7 @@ func (rules *L7Rules) Len() int {
 	if rules == nil {
 		return 0
 	}
-	return len(rules.HTTP) + len(rules.Kafka) + len(rules.DNS) + len(rules.L7)
+	return len(rules.HTTP) + len(rules.GRPC) + len(rules.Kafka) + len(rules.DNS) + len(rules.L7)
 }
 
 // IsEmpty returns whether the `L7Rules` is nil or contains no rules.
@@ -0,0 +1,27 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package api
+
+import (
+	"github.com/cilium/cilium/pkg/labels"
+)
+
+// NodeSelectorSlice is a slice of EndpointSelectors which select nodes in
+// the cluster by the labels of their Kubernetes node object. Node labels
+// are only part of node identities when the agent runs with the
+// enable-node-selector-labels option.
+type NodeSelectorSlice []EndpointSelector
+
+// GetAsEndpointSelectors returns the node selectors as a slice of endpoint
+// selectors restricted to the remote-node identity, so that only nodes can
+// be selected by them.
+func (s NodeSelectorSlice) GetAsEndpointSelectors() EndpointSelectorSlice {
+	slice := make(EndpointSelectorSlice, 0, len(s))
+	for idx := range s {
+		es := *s[idx].DeepCopy()
+		es.AddMatch(labels.LabelSourceReservedKeyPrefix+labels.IDNameRemoteNode, "")
+		slice = append(slice, es)
+	}
+	return slice
+}
@@ -0,0 +1,32 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package api
+
+import (
+	. "gopkg.in/check.v1"
+
+	"github.com/cilium/cilium/pkg/labels"
+)
+
+// matches returns true if any of the node selectors in the slice match the labels
+func (s NodeSelectorSlice) matches(ctx labels.LabelArray) bool {
+	return s.GetAsEndpointSelectors().Matches(ctx)
+}
+
+func (s *PolicyAPITestSuite) TestNodeSelectorMatches(c *C) {
+	ingressPool := NodeSelectorSlice{NewESFromLabels(labels.ParseSelectLabel("pool=ingress"))}
+
+	// Only remote-node identities carrying the node label match.
+	c.Assert(ingressPool.matches(labels.ParseLabelArray("reserved:remote-node", "node:pool=ingress")), Equals, true)
+	c.Assert(ingressPool.matches(labels.ParseLabelArray("reserved:remote-node", "node:pool=worker")), Equals, false)
+	c.Assert(ingressPool.matches(labels.ParseLabelArray("reserved:remote-node")), Equals, false)
+
+	// Non-node identities never match, even with a matching label.
+	c.Assert(ingressPool.matches(labels.ParseLabelArray("k8s:pool=ingress")), Equals, false)
+
+	// An empty selector selects all nodes.
+	allNodes := NodeSelectorSlice{NewESFromLabels()}
+	c.Assert(allNodes.matches(labels.ParseLabelArray("reserved:remote-node")), Equals, true)
+	c.Assert(allNodes.matches(labels.ParseLabelArray("k8s:pool=ingress")), Equals, false)
+}
@@ -0,0 +1,34 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package api
+
+import "fmt"
+
+// RateLimit limits the rate of the traffic selected by a rule instead of
+// unconditionally allowing it. The limits are enforced in the datapath per
+// source security identity, so each peer identity is granted the configured
+// rate independently. Traffic exceeding the limits is dropped.
+type RateLimit struct {
+	// PacketsPerSecond is the maximum number of packets per second allowed
+	// per source identity. If zero, no packet based limit is enforced.
+	//
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Optional
+	PacketsPerSecond uint64 `json:"packetsPerSecond,omitempty"`
+
+	// BytesPerSecond is the maximum number of bytes per second allowed per
+	// source identity. If zero, no byte based limit is enforced.
+	//
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Optional
+	BytesPerSecond uint64 `json:"bytesPerSecond,omitempty"`
+}
+
+// verify returns an error if the rate limit does not impose any limit.
+func (r *RateLimit) verify() error {
+	if r.PacketsPerSecond == 0 && r.BytesPerSecond == 0 {
+		return fmt.Errorf("rate limit requires at least one of packetsPerSecond or bytesPerSecond")
+	}
+	return nil
+}
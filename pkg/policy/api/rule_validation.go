@@ -68,6 +68,18 @@ func (r Rule) Sanitize() error {
 		}
 	}
 
+	for i := range r.IngressDeny {
+		if err := r.IngressDeny[i].sanitize(); err != nil {
+			return err
+		}
+	}
+
+	for i := range r.EgressDeny {
+		if err := r.EgressDeny[i].sanitize(); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -77,6 +89,7 @@ func countL7Rules(ports []PortRule) map[string]int {
 		if !port.Rules.IsEmpty() {
 			result["DNS"] += len(port.Rules.DNS)
 			result["HTTP"] += len(port.Rules.HTTP)
+			result["GRPC"] += len(port.Rules.GRPC)
 			result["Kafka"] += len(port.Rules.Kafka)
 		}
 	}
@@ -85,16 +98,19 @@ func countL7Rules(ports []PortRule) map[string]int {
 
 func (i *IngressRule) sanitize() error {
 	l3Members := map[string]int{
-		"FromEndpoints": len(i.FromEndpoints),
-		"FromCIDR":      len(i.FromCIDR),
-		"FromCIDRSet":   len(i.FromCIDRSet),
-		"FromEntities":  len(i.FromEntities),
+		"FromEndpoints":       len(i.FromEndpoints),
+		"FromCIDR":            len(i.FromCIDR),
+		"FromCIDRSet":         len(i.FromCIDRSet),
+		"FromEntities":        len(i.FromEntities),
+		"FromNodes":           len(i.FromNodes),
+		"FromServiceAccounts": len(i.FromServiceAccounts),
 	}
 	l7Members := countL7Rules(i.ToPorts)
 	l7IngressSupport := map[string]bool{
 		"DNS":   false,
 		"Kafka": true,
 		"HTTP":  true,
+		"GRPC":  true,
 	}
 
 	for m1 := range l3Members {
@@ -122,6 +138,28 @@ func (i *IngressRule) sanitize() error {
 		return fmt.Errorf("The ICMPs block may only be present without ToPorts. Define a separate rule to use ToPorts.")
 	}
 
+	if len(i.FromNodes) > 0 && !option.Config.EnableNodeSelectorLabels {
+		return fmt.Errorf("fromNodes can only be applied when the %q flag is set", option.EnableNodeSelectorLabels)
+	}
+
+	if i.Authentication != nil {
+		if !option.Config.MeshAuthEnabled {
+			return fmt.Errorf("authentication rules can only be applied when the %q flag is set", option.MeshAuthEnabled)
+		}
+		if err := i.Authentication.verify(); err != nil {
+			return err
+		}
+	}
+
+	if i.RateLimit != nil {
+		if !option.Config.EnablePolicyRateLimit {
+			return fmt.Errorf("rate limit rules can only be applied when the %q flag is set", option.EnablePolicyRateLimit)
+		}
+		if err := i.RateLimit.verify(); err != nil {
+			return err
+		}
+	}
+
 	for _, es := range i.FromEndpoints {
 		if err := es.sanitize(); err != nil {
 			return err
@@ -134,6 +172,12 @@ func (i *IngressRule) sanitize() error {
 		}
 	}
 
+	for _, es := range i.FromNodes {
+		if err := es.sanitize(); err != nil {
+			return err
+		}
+	}
+
 	for n := range i.ToPorts {
 		if err := i.ToPorts[n].sanitize(true); err != nil {
 			return err
@@ -170,6 +214,12 @@ func (i *IngressRule) sanitize() error {
 		}
 	}
 
+	for _, sa := range i.FromServiceAccounts {
+		if sa.Name == "" {
+			return fmt.Errorf("fromServiceAccounts requires a non-empty name")
+		}
+	}
+
 	i.SetAggregatedSelectors()
 
 	return nil
@@ -177,28 +227,33 @@ func (i *IngressRule) sanitize() error {
 
 func (e *EgressRule) sanitize() error {
 	l3Members := map[string]int{
-		"ToCIDR":      len(e.ToCIDR),
-		"ToCIDRSet":   len(e.ToCIDRSet),
-		"ToEndpoints": len(e.ToEndpoints),
-		"ToEntities":  len(e.ToEntities),
-		"ToServices":  len(e.ToServices),
-		"ToFQDNs":     len(e.ToFQDNs),
-		"ToGroups":    len(e.ToGroups),
+		"ToCIDR":            len(e.ToCIDR),
+		"ToCIDRSet":         len(e.ToCIDRSet),
+		"ToEndpoints":       len(e.ToEndpoints),
+		"ToEntities":        len(e.ToEntities),
+		"ToNodes":           len(e.ToNodes),
+		"ToServices":        len(e.ToServices),
+		"ToServiceAccounts": len(e.ToServiceAccounts),
+		"ToFQDNs":           len(e.ToFQDNs),
+		"ToGroups":          len(e.ToGroups),
 	}
 	l3DependentL4Support := map[interface{}]bool{
-		"ToCIDR":      true,
-		"ToCIDRSet":   true,
-		"ToEndpoints": true,
-		"ToEntities":  true,
-		"ToServices":  true,
-		"ToFQDNs":     true,
-		"ToGroups":    true,
+		"ToCIDR":            true,
+		"ToCIDRSet":         true,
+		"ToEndpoints":       true,
+		"ToEntities":        true,
+		"ToNodes":           true,
+		"ToServices":        true,
+		"ToServiceAccounts": true,
+		"ToFQDNs":           true,
+		"ToGroups":          true,
 	}
 	l7Members := countL7Rules(e.ToPorts)
 	l7EgressSupport := map[string]bool{
 		"DNS":   true,
 		"Kafka": true,
 		"HTTP":  true,
+		"GRPC":  true,
 	}
 
 	for m1 := range l3Members {
@@ -231,6 +286,28 @@ func (e *EgressRule) sanitize() error {
 		return fmt.Errorf("The ICMPs block may only be present without ToPorts. Define a separate rule to use ToPorts.")
 	}
 
+	if len(e.ToNodes) > 0 && !option.Config.EnableNodeSelectorLabels {
+		return fmt.Errorf("toNodes can only be applied when the %q flag is set", option.EnableNodeSelectorLabels)
+	}
+
+	if e.Authentication != nil {
+		if !option.Config.MeshAuthEnabled {
+			return fmt.Errorf("authentication rules can only be applied when the %q flag is set", option.MeshAuthEnabled)
+		}
+		if err := e.Authentication.verify(); err != nil {
+			return err
+		}
+	}
+
+	if e.RateLimit != nil {
+		if !option.Config.EnablePolicyRateLimit {
+			return fmt.Errorf("rate limit rules can only be applied when the %q flag is set", option.EnablePolicyRateLimit)
+		}
+		if err := e.RateLimit.verify(); err != nil {
+			return err
+		}
+	}
+
 	for _, es := range e.ToEndpoints {
 		if err := es.sanitize(); err != nil {
 			return err
@@ -243,6 +320,12 @@ func (e *EgressRule) sanitize() error {
 		}
 	}
 
+	for _, es := range e.ToNodes {
+		if err := es.sanitize(); err != nil {
+			return err
+		}
+	}
+
 	for i := range e.ToPorts {
 		if err := e.ToPorts[i].sanitize(false); err != nil {
 			return err
@@ -278,6 +361,12 @@ func (e *EgressRule) sanitize() error {
 		}
 	}
 
+	for _, sa := range e.ToServiceAccounts {
+		if sa.Name == "" {
+			return fmt.Errorf("toServiceAccounts requires a non-empty name")
+		}
+	}
+
 	for i := range e.ToFQDNs {
 		err := e.ToFQDNs[i].sanitize()
 		if err != nil {
@@ -290,6 +379,38 @@ func (e *EgressRule) sanitize() error {
 	return nil
 }
 
+// sanitize checks the L7 rules of an ingress deny rule. The proxies cannot
+// enforce inverted L7 matches on ingress yet, so any L7 rules are rejected.
+func (i *IngressDenyRule) sanitize() error {
+	for n := range i.ToPorts {
+		if !i.ToPorts[n].Rules.IsEmpty() {
+			return fmt.Errorf("L7 rules are not supported in ingress deny rules yet")
+		}
+	}
+
+	return nil
+}
+
+// sanitize checks the L7 rules of an egress deny rule. Only DNS rules are
+// supported; they are enforced by the DNS proxy which denies matching
+// requests while leaving the remaining traffic to the allow rules in place.
+func (e *EgressDenyRule) sanitize() error {
+	for n := range e.ToPorts {
+		rules := e.ToPorts[n].Rules
+		if rules.IsEmpty() {
+			continue
+		}
+		if len(rules.HTTP) > 0 || len(rules.GRPC) > 0 || len(rules.Kafka) > 0 || len(rules.L7) > 0 || rules.L7Proto != "" {
+			return fmt.Errorf("only DNS L7 rules are supported in egress deny rules yet")
+		}
+		if err := rules.sanitize(e.ToPorts[n].Ports); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (pr *L7Rules) sanitize(ports []PortProtocol) error {
 	nTypes := 0
 
@@ -302,6 +423,15 @@ func (pr *L7Rules) sanitize(ports []PortProtocol) error {
 		}
 	}
 
+	if pr.GRPC != nil {
+		nTypes++
+		for i := range pr.GRPC {
+			if err := pr.GRPC[i].Sanitize(); err != nil {
+				return err
+			}
+		}
+	}
+
 	if pr.Kafka != nil {
 		nTypes++
 		for i := range pr.Kafka {
@@ -344,6 +474,20 @@ func (pr *L7Rules) sanitize(ports []PortProtocol) error {
 	return nil
 }
 
+// validateServerName checks that the given TLS SNI server name is either a
+// literal DNS name or a wildcard name in which a single '*' replaces the
+// leftmost label, e.g. *.cilium.io.
+func validateServerName(sn string) error {
+	name := strings.TrimPrefix(sn, "*.")
+	if strings.ContainsRune(name, '*') {
+		return fmt.Errorf("invalid server name %q: a wildcard is only allowed as the leftmost label", sn)
+	}
+	if !allowedMatchNameChars.MatchString(name) {
+		return fmt.Errorf("invalid server name %q: not a valid DNS name", sn)
+	}
+	return nil
+}
+
 func (pr *PortRule) sanitize(ingress bool) error {
 	hasDNSRules := pr.Rules != nil && len(pr.Rules.DNS) > 0
 	if ingress && hasDNSRules {
@@ -357,6 +501,9 @@ func (pr *PortRule) sanitize(ingress bool) error {
 		if sn == "" {
 			return fmt.Errorf("Empty server name is not allowed")
 		}
+		if err := validateServerName(sn); err != nil {
+			return err
+		}
 	}
 
 	if len(pr.Ports) > maxPorts {
@@ -442,6 +589,11 @@ func (ir *ICMPRule) verify() error {
 		if f.Family != IPv4Family && f.Family != IPv6Family && f.Family != "" {
 			return fmt.Errorf("wrong family: %s", f.Family)
 		}
+		// Type 0 only has code 0; rejecting codes here keeps fields with
+		// a code distinguishable from type-only fields in the datapath.
+		if f.Code != nil && f.Type == 0 {
+			return fmt.Errorf("code is not supported for type 0")
+		}
 	}
 
 	return nil
@@ -478,6 +630,18 @@ func (c CIDR) sanitize() (prefixLength int, err error) {
 // valid, and ensuring that all of the exception CIDR prefixes are contained
 // within the allowed CIDR prefix.
 func (c *CIDRRule) sanitize() (prefixLength int, err error) {
+	// A CIDR group reference is resolved into the CIDRs of the group before
+	// the rule is imported into the policy repository, there is nothing to
+	// validate beyond mutual exclusion with an inline CIDR prefix.
+	if c.CIDRGroupRef != "" {
+		if c.Cidr != "" {
+			return 0, fmt.Errorf("only one of cidr or cidrGroupRef may be specified")
+		}
+		if len(c.ExceptCIDRs) > 0 {
+			return 0, fmt.Errorf("except may not be combined with cidrGroupRef")
+		}
+		return 0, nil
+	}
 
 	// Only allow notation <IP address>/<prefix>. Note that this differs from
 	// the logic in api.CIDR.Sanitize().
@@ -283,6 +283,89 @@ func (s *PolicyAPITestSuite) TestL7RulesWithNonTCPProtocols(c *C) {
 	c.Assert(err, Not(IsNil))
 	c.Assert(err.Error(), Equals, "Empty server name is not allowed")
 
+	// Rule is valid because wildcard ServerNames are allowed for SNI enforcement.
+	validPortRule = Rule{
+		EndpointSelector: WildcardEndpointSelector,
+		Egress: []EgressRule{
+			{
+				EgressCommonRule: EgressCommonRule{
+					ToEndpoints: []EndpointSelector{WildcardEndpointSelector},
+				},
+				ToPorts: []PortRule{{
+					Ports: []PortProtocol{
+						{Port: "443", Protocol: ProtoTCP},
+					},
+					ServerNames: []string{"*.github.com", "api.github.com"},
+				}},
+			},
+		},
+	}
+	err = validPortRule.Sanitize()
+	c.Assert(err, IsNil)
+
+	// Rule is invalid because the wildcard is not the leftmost label.
+	invalidPortRule = Rule{
+		EndpointSelector: WildcardEndpointSelector,
+		Egress: []EgressRule{
+			{
+				EgressCommonRule: EgressCommonRule{
+					ToEndpoints: []EndpointSelector{WildcardEndpointSelector},
+				},
+				ToPorts: []PortRule{{
+					Ports: []PortProtocol{
+						{Port: "443", Protocol: ProtoTCP},
+					},
+					ServerNames: []string{"foo.*.com"},
+				}},
+			},
+		},
+	}
+	err = invalidPortRule.Sanitize()
+	c.Assert(err, Not(IsNil))
+	c.Assert(err.Error(), Equals, "invalid server name \"foo.*.com\": a wildcard is only allowed as the leftmost label")
+
+	// Rule is invalid because the wildcard does not replace a whole label.
+	invalidPortRule = Rule{
+		EndpointSelector: WildcardEndpointSelector,
+		Egress: []EgressRule{
+			{
+				EgressCommonRule: EgressCommonRule{
+					ToEndpoints: []EndpointSelector{WildcardEndpointSelector},
+				},
+				ToPorts: []PortRule{{
+					Ports: []PortProtocol{
+						{Port: "443", Protocol: ProtoTCP},
+					},
+					ServerNames: []string{"*github.com"},
+				}},
+			},
+		},
+	}
+	err = invalidPortRule.Sanitize()
+	c.Assert(err, Not(IsNil))
+	c.Assert(err.Error(), Equals, "invalid server name \"*github.com\": a wildcard is only allowed as the leftmost label")
+
+	// Rule is invalid because the wildcard has no domain to its right.
+	invalidPortRule = Rule{
+		EndpointSelector: WildcardEndpointSelector,
+		Egress: []EgressRule{
+			{
+				EgressCommonRule: EgressCommonRule{
+					ToEndpoints: []EndpointSelector{WildcardEndpointSelector},
+				},
+				ToPorts: []PortRule{{
+					Ports: []PortProtocol{
+						{Port: "443", Protocol: ProtoTCP},
+					},
+					ServerNames: []string{"*."},
+				}},
+			},
+		},
+	}
+	err = invalidPortRule.Sanitize()
+	c.Assert(err, Not(IsNil))
+	c.Assert(err.Error(), Equals, "invalid server name \"*.\": not a valid DNS name")
+
 	//  Rule is invalid because ServerNames with L7 rules are not allowed without TLS termination.
 	invalidPortRule = Rule{
 		EndpointSelector: WildcardEndpointSelector,
@@ -474,6 +557,109 @@ func (s *PolicyAPITestSuite) TestL7RulesWithNonTCPProtocols(c *C) {
 	c.Assert(err.Error(), Equals, "Listener is not allowed with L7 rules (myCustomListener)")
 }
 
+func (s *PolicyAPITestSuite) TestL7RulesInDenyPolicies(c *C) {
+
+	// Rule is valid because DNS rules are supported in egress deny rules.
+	validDenyRule := Rule{
+		EndpointSelector: WildcardEndpointSelector,
+		EgressDeny: []EgressDenyRule{
+			{
+				EgressCommonRule: EgressCommonRule{
+					ToEndpoints: []EndpointSelector{WildcardEndpointSelector},
+				},
+				ToPorts: []PortDenyRule{{
+					Ports: []PortProtocol{
+						{Port: "53", Protocol: ProtoAny},
+					},
+					Rules: &L7Rules{
+						DNS: []PortRuleDNS{
+							{MatchName: "example.com"},
+						},
+					},
+				}},
+			},
+		},
+	}
+
+	err := validDenyRule.Sanitize()
+	c.Assert(err, IsNil, Commentf("Saw an error for an egress deny rule with DNS rules. This should be allowed."))
+
+	// Rule is invalid because the DNS pattern does not validate.
+	invalidDenyRule := Rule{
+		EndpointSelector: WildcardEndpointSelector,
+		EgressDeny: []EgressDenyRule{
+			{
+				EgressCommonRule: EgressCommonRule{
+					ToEndpoints: []EndpointSelector{WildcardEndpointSelector},
+				},
+				ToPorts: []PortDenyRule{{
+					Ports: []PortProtocol{
+						{Port: "53", Protocol: ProtoAny},
+					},
+					Rules: &L7Rules{
+						DNS: []PortRuleDNS{
+							{MatchName: "%%"},
+						},
+					},
+				}},
+			},
+		},
+	}
+
+	err = invalidDenyRule.Sanitize()
+	c.Assert(err, Not(IsNil), Commentf("Invalid DNS match name should be rejected"))
+
+	// Rule is invalid because only DNS rules are supported in egress deny rules.
+	invalidDenyRule = Rule{
+		EndpointSelector: WildcardEndpointSelector,
+		EgressDeny: []EgressDenyRule{
+			{
+				EgressCommonRule: EgressCommonRule{
+					ToEndpoints: []EndpointSelector{WildcardEndpointSelector},
+				},
+				ToPorts: []PortDenyRule{{
+					Ports: []PortProtocol{
+						{Port: "80", Protocol: ProtoTCP},
+					},
+					Rules: &L7Rules{
+						HTTP: []PortRuleHTTP{
+							{Method: "GET", Path: "/admin"},
+						},
+					},
+				}},
+			},
+		},
+	}
+
+	err = invalidDenyRule.Sanitize()
+	c.Assert(err, Not(IsNil), Commentf("HTTP rules should not be allowed in deny rules"))
+
+	// Rule is invalid because L7 rules are not supported in ingress deny rules.
+	invalidDenyRule = Rule{
+		EndpointSelector: WildcardEndpointSelector,
+		IngressDeny: []IngressDenyRule{
+			{
+				IngressCommonRule: IngressCommonRule{
+					FromEndpoints: []EndpointSelector{WildcardEndpointSelector},
+				},
+				ToPorts: []PortDenyRule{{
+					Ports: []PortProtocol{
+						{Port: "53", Protocol: ProtoAny},
+					},
+					Rules: &L7Rules{
+						DNS: []PortRuleDNS{
+							{MatchName: "example.com"},
+						},
+					},
+				}},
+			},
+		},
+	}
+
+	err = invalidDenyRule.Sanitize()
+	c.Assert(err, Not(IsNil), Commentf("L7 rules should not be allowed in ingress deny rules"))
+}
+
 // This test ensures that L7 rules reject unspecified ports.
 func (s *PolicyAPITestSuite) TestL7RuleRejectsEmptyPort(c *C) {
 	invalidL7PortRule := Rule{
@@ -556,6 +742,88 @@ func (s *PolicyAPITestSuite) TestHTTPRuleRegexes(c *C) {
 	c.Assert(err, Not(IsNil))
 }
 
+// This test ensures that port rules using gRPC rules are validated correctly
+// and cannot be combined with other L7 rule types.
+func (s *PolicyAPITestSuite) TestGRPCRules(c *C) {
+
+	validGRPCRule := Rule{
+		EndpointSelector: WildcardEndpointSelector,
+		Ingress: []IngressRule{
+			{
+				IngressCommonRule: IngressCommonRule{
+					FromEndpoints: []EndpointSelector{WildcardEndpointSelector},
+				},
+				ToPorts: []PortRule{{
+					Ports: []PortProtocol{
+						{Port: "50051", Protocol: ProtoTCP},
+					},
+					Rules: &L7Rules{
+						GRPC: []PortRuleGRPC{
+							{Service: "helloworld.Greeter", Method: "SayHello"},
+							{Service: "helloworld.Greeter"},
+						},
+					},
+				}},
+			},
+		},
+	}
+
+	err := validGRPCRule.Sanitize()
+	c.Assert(err, IsNil)
+
+	invalidGRPCRegexServiceRule := Rule{
+		EndpointSelector: WildcardEndpointSelector,
+		Ingress: []IngressRule{
+			{
+				IngressCommonRule: IngressCommonRule{
+					FromEndpoints: []EndpointSelector{WildcardEndpointSelector},
+				},
+				ToPorts: []PortRule{{
+					Ports: []PortProtocol{
+						{Port: "50051", Protocol: ProtoTCP},
+					},
+					Rules: &L7Rules{
+						GRPC: []PortRuleGRPC{
+							{Service: "*", Method: "SayHello"},
+						},
+					},
+				}},
+			},
+		},
+	}
+
+	err = invalidGRPCRegexServiceRule.Sanitize()
+	c.Assert(err, Not(IsNil))
+
+	invalidGRPCWithHTTPRule := Rule{
+		EndpointSelector: WildcardEndpointSelector,
+		Ingress: []IngressRule{
+			{
+				IngressCommonRule: IngressCommonRule{
+					FromEndpoints: []EndpointSelector{WildcardEndpointSelector},
+				},
+				ToPorts: []PortRule{{
+					Ports: []PortProtocol{
+						{Port: "50051", Protocol: ProtoTCP},
+					},
+					Rules: &L7Rules{
+						HTTP: []PortRuleHTTP{
+							{Method: "GET", Path: "/"},
+						},
+						GRPC: []PortRuleGRPC{
+							{Service: "helloworld.Greeter"},
+						},
+					},
+				}},
+			},
+		},
+	}
+
+	err = invalidGRPCWithHTTPRule.Sanitize()
+	c.Assert(err, Not(IsNil))
+	c.Assert(err, ErrorMatches, "multiple L7 protocol rule types specified in single rule")
+}
+
 // Test the validation of CIDR rule prefix definitions
 func (s *PolicyAPITestSuite) TestCIDRsanitize(c *C) {
 	// IPv4
@@ -970,6 +1238,192 @@ func (s *PolicyAPITestSuite) TestWrongICMPFieldFamily(c *C) {
 	c.Assert(err, NotNil)
 }
 
+func (s *PolicyAPITestSuite) TestICMPFieldCode(c *C) {
+	code := uint8(1)
+	validCodeICMPRule := Rule{
+		EndpointSelector: WildcardEndpointSelector,
+		Ingress: []IngressRule{
+			{
+				IngressCommonRule: IngressCommonRule{
+					FromEndpoints: []EndpointSelector{WildcardEndpointSelector},
+				},
+				ICMPs: ICMPRules{{
+					Fields: []ICMPField{{
+						Type: 3,
+						Code: &code,
+					}},
+				}},
+			},
+		},
+	}
+	err := validCodeICMPRule.Sanitize()
+	c.Assert(err, IsNil)
+
+	// Type 0 only has code 0, a code may not be specified for it.
+	typeZeroCodeICMPRule := Rule{
+		EndpointSelector: WildcardEndpointSelector,
+		Ingress: []IngressRule{
+			{
+				IngressCommonRule: IngressCommonRule{
+					FromEndpoints: []EndpointSelector{WildcardEndpointSelector},
+				},
+				ICMPs: ICMPRules{{
+					Fields: []ICMPField{{
+						Type: 0,
+						Code: &code,
+					}},
+				}},
+			},
+		},
+	}
+	err = typeZeroCodeICMPRule.Sanitize()
+	c.Assert(err, NotNil)
+}
+
+// This test ensures that authentication rules are only accepted behind the
+// mesh-auth-enabled flag and with a known authentication mode.
+func (s *PolicyAPITestSuite) TestAuthenticationRule(c *C) {
+	authRule := func(mode AuthenticationMode) Rule {
+		return Rule{
+			EndpointSelector: WildcardEndpointSelector,
+			Ingress: []IngressRule{
+				{
+					IngressCommonRule: IngressCommonRule{
+						FromEndpoints: []EndpointSelector{WildcardEndpointSelector},
+					},
+					Authentication: &Authentication{Mode: mode},
+				},
+			},
+		}
+	}
+
+	option.Config.MeshAuthEnabled = false
+	err := authRule(AuthenticationModeRequired).Sanitize()
+	c.Assert(err, ErrorMatches, ".*mesh-auth-enabled.*")
+
+	option.Config.MeshAuthEnabled = true
+	defer func() {
+		option.Config.MeshAuthEnabled = false
+	}()
+
+	err = authRule(AuthenticationModeRequired).Sanitize()
+	c.Assert(err, IsNil)
+	err = authRule(AuthenticationModeDisabled).Sanitize()
+	c.Assert(err, IsNil)
+	err = authRule(AuthenticationMode("mutual")).Sanitize()
+	c.Assert(err, ErrorMatches, `invalid authentication mode "mutual"`)
+}
+
+// This test ensures that rate limit rules are only accepted behind the
+// enable-policy-rate-limit flag and carry at least one limit.
+func (s *PolicyAPITestSuite) TestRateLimitRule(c *C) {
+	rateLimitRule := func(limit *RateLimit) Rule {
+		return Rule{
+			EndpointSelector: WildcardEndpointSelector,
+			Ingress: []IngressRule{
+				{
+					IngressCommonRule: IngressCommonRule{
+						FromEndpoints: []EndpointSelector{WildcardEndpointSelector},
+					},
+					RateLimit: limit,
+				},
+			},
+		}
+	}
+
+	option.Config.EnablePolicyRateLimit = false
+	err := rateLimitRule(&RateLimit{PacketsPerSecond: 100}).Sanitize()
+	c.Assert(err, ErrorMatches, ".*enable-policy-rate-limit.*")
+
+	option.Config.EnablePolicyRateLimit = true
+	defer func() {
+		option.Config.EnablePolicyRateLimit = false
+	}()
+
+	err = rateLimitRule(&RateLimit{PacketsPerSecond: 100}).Sanitize()
+	c.Assert(err, IsNil)
+	err = rateLimitRule(&RateLimit{BytesPerSecond: 1 << 20}).Sanitize()
+	c.Assert(err, IsNil)
+	err = rateLimitRule(&RateLimit{}).Sanitize()
+	c.Assert(err, ErrorMatches, "rate limit requires at least one of packetsPerSecond or bytesPerSecond")
+}
+
+// This test ensures that node selector rules are only accepted behind the
+// enable-node-selector-labels flag and remain mutually exclusive with the
+// other L3 fields.
+func (s *PolicyAPITestSuite) TestNodeSelectorRule(c *C) {
+	nodeRule := Rule{
+		EndpointSelector: WildcardEndpointSelector,
+		Ingress: []IngressRule{
+			{
+				IngressCommonRule: IngressCommonRule{
+					FromNodes: NodeSelectorSlice{WildcardEndpointSelector},
+				},
+			},
+		},
+		Egress: []EgressRule{
+			{
+				EgressCommonRule: EgressCommonRule{
+					ToNodes: NodeSelectorSlice{WildcardEndpointSelector},
+				},
+			},
+		},
+	}
+
+	option.Config.EnableNodeSelectorLabels = false
+	err := nodeRule.Sanitize()
+	c.Assert(err, ErrorMatches, ".*enable-node-selector-labels.*")
+
+	option.Config.EnableNodeSelectorLabels = true
+	defer func() {
+		option.Config.EnableNodeSelectorLabels = false
+	}()
+
+	err = nodeRule.Sanitize()
+	c.Assert(err, IsNil)
+
+	// FromNodes cannot be combined with the other L3 fields.
+	combinedRule := Rule{
+		EndpointSelector: WildcardEndpointSelector,
+		Ingress: []IngressRule{
+			{
+				IngressCommonRule: IngressCommonRule{
+					FromEndpoints: []EndpointSelector{WildcardEndpointSelector},
+					FromNodes:     NodeSelectorSlice{WildcardEndpointSelector},
+				},
+			},
+		},
+	}
+	err = combinedRule.Sanitize()
+	c.Assert(err, ErrorMatches, "Combining (FromEndpoints and FromNodes|FromNodes and FromEndpoints) is not supported yet")
+}
+
+// This test ensures that AllowRelated expands into the ICMP error message
+// types of both families in addition to the explicitly listed fields.
+func (s *PolicyAPITestSuite) TestICMPRuleAllowRelated(c *C) {
+	rule := ICMPRule{
+		Fields:       []ICMPField{{Type: 8}},
+		AllowRelated: true,
+	}
+
+	pps := rule.GetPortProtocols()
+	c.Assert(pps, HasLen, 1+len(relatedICMPFields))
+	c.Assert(pps[0], Equals, PortProtocol{Port: "8", Protocol: ProtoICMP})
+
+	// Packet too big must be covered for IPv6 path MTU discovery to work.
+	packetTooBig := false
+	for _, pp := range pps {
+		if pp.Protocol == ProtoICMPv6 && pp.Port == "2" {
+			packetTooBig = true
+		}
+	}
+	c.Assert(packetTooBig, Equals, true)
+
+	// Without AllowRelated, only the explicit fields are matched.
+	rule.AllowRelated = false
+	c.Assert(rule.GetPortProtocols(), HasLen, 1)
+}
+
 func (s *PolicyAPITestSuite) TestICMPRuleWithOtherRuleFailed(c *C) {
 	ingressICMPWithPort := Rule{
 		EndpointSelector: WildcardEndpointSelector,
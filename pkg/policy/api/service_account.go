@@ -0,0 +1,46 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package api
+
+import (
+	k8sapi "github.com/cilium/cilium/pkg/k8s/apis/cilium.io"
+	"github.com/cilium/cilium/pkg/labels"
+)
+
+// ServiceAccountSelector selects peer endpoints based on the Kubernetes
+// ServiceAccount they run under. The ServiceAccount name of a pod is
+// projected into the identity labels of its endpoints, so the selector is
+// translated into a regular endpoint selector on that label.
+type ServiceAccountSelector struct {
+	// Name is the name of the ServiceAccount the peer pods run under.
+	//
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+
+	// Namespace is the namespace of the ServiceAccount. If empty, the
+	// namespace the policy lives in is used for namespaced policies and
+	// any namespace is matched for clusterwide policies.
+	//
+	// +kubebuilder:validation:Optional
+	Namespace string `json:"namespace,omitempty"`
+}
+
+// ServiceAccountSlice is a slice of ServiceAccountSelector.
+type ServiceAccountSlice []ServiceAccountSelector
+
+// GetAsEndpointSelectors returns the service account slice as a slice of
+// endpoint selectors matching the projected ServiceAccount label.
+func (s ServiceAccountSlice) GetAsEndpointSelectors() EndpointSelectorSlice {
+	slice := EndpointSelectorSlice{}
+	for _, sa := range s {
+		lbls := []labels.Label{
+			labels.NewLabel(k8sapi.PolicyLabelServiceAccount, sa.Name, labels.LabelSourceK8s),
+		}
+		if sa.Namespace != "" {
+			lbls = append(lbls, labels.NewLabel(k8sapi.PodNamespaceLabel, sa.Namespace, labels.LabelSourceK8s))
+		}
+		slice = append(slice, NewESFromLabels(lbls...))
+	}
+	return slice
+}
@@ -0,0 +1,40 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package api
+
+import (
+	. "gopkg.in/check.v1"
+
+	"github.com/cilium/cilium/pkg/labels"
+)
+
+// matches returns true if any of the service accounts in the slice match the labels
+func (s ServiceAccountSlice) matches(ctx labels.LabelArray) bool {
+	return s.GetAsEndpointSelectors().Matches(ctx)
+}
+
+func (s *PolicyAPITestSuite) TestServiceAccountSelectorMatches(c *C) {
+	backend := ServiceAccountSlice{{Name: "backend"}}
+	c.Assert(backend.matches(labels.ParseLabelArray("k8s:io.cilium.k8s.policy.serviceaccount=backend")), Equals, true)
+	c.Assert(backend.matches(labels.ParseLabelArray(
+		"k8s:io.cilium.k8s.policy.serviceaccount=backend",
+		"k8s:io.kubernetes.pod.namespace=default")), Equals, true)
+	c.Assert(backend.matches(labels.ParseLabelArray("k8s:io.cilium.k8s.policy.serviceaccount=frontend")), Equals, false)
+	c.Assert(backend.matches(labels.ParseLabelArray("id=foo")), Equals, false)
+
+	// A namespace restricts the match to endpoints in that namespace.
+	backendProd := ServiceAccountSlice{{Name: "backend", Namespace: "prod"}}
+	c.Assert(backendProd.matches(labels.ParseLabelArray(
+		"k8s:io.cilium.k8s.policy.serviceaccount=backend",
+		"k8s:io.kubernetes.pod.namespace=prod")), Equals, true)
+	c.Assert(backendProd.matches(labels.ParseLabelArray(
+		"k8s:io.cilium.k8s.policy.serviceaccount=backend",
+		"k8s:io.kubernetes.pod.namespace=default")), Equals, false)
+	c.Assert(backendProd.matches(labels.ParseLabelArray(
+		"k8s:io.cilium.k8s.policy.serviceaccount=backend")), Equals, false)
+
+	// Any entry in the slice grants access.
+	multi := ServiceAccountSlice{{Name: "backend"}, {Name: "frontend"}}
+	c.Assert(multi.matches(labels.ParseLabelArray("k8s:io.cilium.k8s.policy.serviceaccount=frontend")), Equals, true)
+}
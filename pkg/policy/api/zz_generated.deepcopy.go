@@ -34,6 +34,11 @@ func (in *AWSGroup) DeepCopyInto(out *AWSGroup) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.ServicePrefixes != nil {
+		in, out := &in.ServicePrefixes, &out.ServicePrefixes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -47,6 +52,22 @@ func (in *AWSGroup) DeepCopy() *AWSGroup {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Authentication) DeepCopyInto(out *Authentication) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Authentication.
+func (in *Authentication) DeepCopy() *Authentication {
+	if in == nil {
+		return nil
+	}
+	out := new(Authentication)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CIDRRule) DeepCopyInto(out *CIDRRule) {
 	*out = *in
@@ -144,6 +165,13 @@ func (in *EgressCommonRule) DeepCopyInto(out *EgressCommonRule) {
 		*out = make(EntitySlice, len(*in))
 		copy(*out, *in)
 	}
+	if in.ToNodes != nil {
+		in, out := &in.ToNodes, &out.ToNodes
+		*out = make(NodeSelectorSlice, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.ToServices != nil {
 		in, out := &in.ToServices, &out.ToServices
 		*out = make([]Service, len(*in))
@@ -151,6 +179,11 @@ func (in *EgressCommonRule) DeepCopyInto(out *EgressCommonRule) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.ToServiceAccounts != nil {
+		in, out := &in.ToServiceAccounts, &out.ToServiceAccounts
+		*out = make(ServiceAccountSlice, len(*in))
+		copy(*out, *in)
+	}
 	if in.ToGroups != nil {
 		in, out := &in.ToGroups, &out.ToGroups
 		*out = make([]ToGroups, len(*in))
@@ -232,6 +265,16 @@ func (in *EgressRule) DeepCopyInto(out *EgressRule) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Authentication != nil {
+		in, out := &in.Authentication, &out.Authentication
+		*out = new(Authentication)
+		**out = **in
+	}
+	if in.RateLimit != nil {
+		in, out := &in.RateLimit, &out.RateLimit
+		*out = new(RateLimit)
+		**out = **in
+	}
 	return
 }
 
@@ -395,6 +438,11 @@ func (in *HeaderMatch) DeepCopy() *HeaderMatch {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ICMPField) DeepCopyInto(out *ICMPField) {
 	*out = *in
+	if in.Code != nil {
+		in, out := &in.Code, &out.Code
+		*out = new(uint8)
+		**out = **in
+	}
 	return
 }
 
@@ -414,7 +462,9 @@ func (in *ICMPRule) DeepCopyInto(out *ICMPRule) {
 	if in.Fields != nil {
 		in, out := &in.Fields, &out.Fields
 		*out = make([]ICMPField, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 	return
 }
@@ -485,6 +535,18 @@ func (in *IngressCommonRule) DeepCopyInto(out *IngressCommonRule) {
 		*out = make(EntitySlice, len(*in))
 		copy(*out, *in)
 	}
+	if in.FromNodes != nil {
+		in, out := &in.FromNodes, &out.FromNodes
+		*out = make(NodeSelectorSlice, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.FromServiceAccounts != nil {
+		in, out := &in.FromServiceAccounts, &out.FromServiceAccounts
+		*out = make(ServiceAccountSlice, len(*in))
+		copy(*out, *in)
+	}
 	if in.aggregatedSelectors != nil {
 		in, out := &in.aggregatedSelectors, &out.aggregatedSelectors
 		*out = make(EndpointSelectorSlice, len(*in))
@@ -554,6 +616,16 @@ func (in *IngressRule) DeepCopyInto(out *IngressRule) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Authentication != nil {
+		in, out := &in.Authentication, &out.Authentication
+		*out = new(Authentication)
+		**out = **in
+	}
+	if in.RateLimit != nil {
+		in, out := &in.RateLimit, &out.RateLimit
+		*out = new(RateLimit)
+		**out = **in
+	}
 	return
 }
 
@@ -610,6 +682,11 @@ func (in *L7Rules) DeepCopyInto(out *L7Rules) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.GRPC != nil {
+		in, out := &in.GRPC, &out.GRPC
+		*out = make([]PortRuleGRPC, len(*in))
+		copy(*out, *in)
+	}
 	if in.Kafka != nil {
 		in, out := &in.Kafka, &out.Kafka
 		*out = make([]kafka.PortRule, len(*in))
@@ -667,6 +744,28 @@ func (in *Listener) DeepCopy() *Listener {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in NodeSelectorSlice) DeepCopyInto(out *NodeSelectorSlice) {
+	{
+		in := &in
+		*out = make(NodeSelectorSlice, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+		return
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeSelectorSlice.
+func (in NodeSelectorSlice) DeepCopy() NodeSelectorSlice {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeSelectorSlice)
+	in.DeepCopyInto(out)
+	return *out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PortDenyRule) DeepCopyInto(out *PortDenyRule) {
 	*out = *in
@@ -675,6 +774,11 @@ func (in *PortDenyRule) DeepCopyInto(out *PortDenyRule) {
 		*out = make([]PortProtocol, len(*in))
 		copy(*out, *in)
 	}
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = new(L7Rules)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -788,6 +892,22 @@ func (in *PortRuleDNS) DeepCopy() *PortRuleDNS {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PortRuleGRPC) DeepCopyInto(out *PortRuleGRPC) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PortRuleGRPC.
+func (in *PortRuleGRPC) DeepCopy() *PortRuleGRPC {
+	if in == nil {
+		return nil
+	}
+	out := new(PortRuleGRPC)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PortRuleHTTP) DeepCopyInto(out *PortRuleHTTP) {
 	*out = *in
@@ -864,6 +984,22 @@ func (in PortRules) DeepCopy() PortRules {
 	return *out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RateLimit) DeepCopyInto(out *RateLimit) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RateLimit.
+func (in *RateLimit) DeepCopy() *RateLimit {
+	if in == nil {
+		return nil
+	}
+	out := new(RateLimit)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Rule) DeepCopyInto(out *Rule) {
 	*out = *in
@@ -979,6 +1115,42 @@ func (in *Service) DeepCopy() *Service {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceAccountSelector) DeepCopyInto(out *ServiceAccountSelector) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceAccountSelector.
+func (in *ServiceAccountSelector) DeepCopy() *ServiceAccountSelector {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceAccountSelector)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in ServiceAccountSlice) DeepCopyInto(out *ServiceAccountSlice) {
+	{
+		in := &in
+		*out = make(ServiceAccountSlice, len(*in))
+		copy(*out, *in)
+		return
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceAccountSlice.
+func (in ServiceAccountSlice) DeepCopy() ServiceAccountSlice {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceAccountSlice)
+	in.DeepCopyInto(out)
+	return *out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ServiceSelector) DeepCopyInto(out *ServiceSelector) {
 	*out = *in
@@ -70,6 +70,23 @@ func (in *AWSGroup) DeepEqual(other *AWSGroup) bool {
 		}
 	}
 
+	if ((in.ServicePrefixes != nil) && (other.ServicePrefixes != nil)) || ((in.ServicePrefixes == nil) != (other.ServicePrefixes == nil)) {
+		in, other := &in.ServicePrefixes, &other.ServicePrefixes
+		if other == nil {
+			return false
+		}
+
+		if len(*in) != len(*other) {
+			return false
+		} else {
+			for i, inElement := range *in {
+				if inElement != (*other)[i] {
+					return false
+				}
+			}
+		}
+	}
+
 	if in.Region != other.Region {
 		return false
 	}
@@ -77,6 +94,20 @@ func (in *AWSGroup) DeepEqual(other *AWSGroup) bool {
 	return true
 }
 
+// DeepEqual is an autogenerated deepequal function, deeply comparing the
+// receiver with other. in must be non-nil.
+func (in *Authentication) DeepEqual(other *Authentication) bool {
+	if other == nil {
+		return false
+	}
+
+	if in.Mode != other.Mode {
+		return false
+	}
+
+	return true
+}
+
 // DeepEqual is an autogenerated deepequal function, deeply comparing the
 // receiver with other. in must be non-nil.
 func (in *CIDRRule) DeepEqual(other *CIDRRule) bool {
@@ -87,6 +118,9 @@ func (in *CIDRRule) DeepEqual(other *CIDRRule) bool {
 	if in.Cidr != other.Cidr {
 		return false
 	}
+	if in.CIDRGroupRef != other.CIDRGroupRef {
+		return false
+	}
 	if ((in.ExceptCIDRs != nil) && (other.ExceptCIDRs != nil)) || ((in.ExceptCIDRs == nil) != (other.ExceptCIDRs == nil)) {
 		in, other := &in.ExceptCIDRs, &other.ExceptCIDRs
 		if other == nil {
@@ -223,6 +257,13 @@ func (in *EgressCommonRule) DeepEqual(other *EgressCommonRule) bool {
 		}
 	}
 
+	if ((in.ToNodes != nil) && (other.ToNodes != nil)) || ((in.ToNodes == nil) != (other.ToNodes == nil)) {
+		in, other := &in.ToNodes, &other.ToNodes
+		if other == nil || !in.DeepEqual(other) {
+			return false
+		}
+	}
+
 	if ((in.ToServices != nil) && (other.ToServices != nil)) || ((in.ToServices == nil) != (other.ToServices == nil)) {
 		in, other := &in.ToServices, &other.ToServices
 		if other == nil {
@@ -240,6 +281,13 @@ func (in *EgressCommonRule) DeepEqual(other *EgressCommonRule) bool {
 		}
 	}
 
+	if ((in.ToServiceAccounts != nil) && (other.ToServiceAccounts != nil)) || ((in.ToServiceAccounts == nil) != (other.ToServiceAccounts == nil)) {
+		in, other := &in.ToServiceAccounts, &other.ToServiceAccounts
+		if other == nil || !in.DeepEqual(other) {
+			return false
+		}
+	}
+
 	if ((in.ToGroups != nil) && (other.ToGroups != nil)) || ((in.ToGroups == nil) != (other.ToGroups == nil)) {
 		in, other := &in.ToGroups, &other.ToGroups
 		if other == nil {
@@ -387,6 +435,22 @@ func (in *EgressRule) DeepEqual(other *EgressRule) bool {
 		}
 	}
 
+	if (in.Authentication == nil) != (other.Authentication == nil) {
+		return false
+	} else if in.Authentication != nil {
+		if !in.Authentication.DeepEqual(other.Authentication) {
+			return false
+		}
+	}
+
+	if (in.RateLimit == nil) != (other.RateLimit == nil) {
+		return false
+	} else if in.RateLimit != nil {
+		if !in.RateLimit.DeepEqual(other.RateLimit) {
+			return false
+		}
+	}
+
 	return true
 }
 
@@ -556,6 +620,14 @@ func (in *ICMPField) DeepEqual(other *ICMPField) bool {
 		return false
 	}
 
+	if (in.Code == nil) != (other.Code == nil) {
+		return false
+	} else if in.Code != nil {
+		if *in.Code != *other.Code {
+			return false
+		}
+	}
+
 	return true
 }
 
@@ -583,6 +655,10 @@ func (in *ICMPRule) DeepEqual(other *ICMPRule) bool {
 		}
 	}
 
+	if in.AllowRelated != other.AllowRelated {
+		return false
+	}
+
 	return true
 }
 
@@ -668,6 +744,20 @@ func (in *IngressCommonRule) DeepEqual(other *IngressCommonRule) bool {
 		}
 	}
 
+	if ((in.FromNodes != nil) && (other.FromNodes != nil)) || ((in.FromNodes == nil) != (other.FromNodes == nil)) {
+		in, other := &in.FromNodes, &other.FromNodes
+		if other == nil || !in.DeepEqual(other) {
+			return false
+		}
+	}
+
+	if ((in.FromServiceAccounts != nil) && (other.FromServiceAccounts != nil)) || ((in.FromServiceAccounts == nil) != (other.FromServiceAccounts == nil)) {
+		in, other := &in.FromServiceAccounts, &other.FromServiceAccounts
+		if other == nil || !in.DeepEqual(other) {
+			return false
+		}
+	}
+
 	if ((in.aggregatedSelectors != nil) && (other.aggregatedSelectors != nil)) || ((in.aggregatedSelectors == nil) != (other.aggregatedSelectors == nil)) {
 		in, other := &in.aggregatedSelectors, &other.aggregatedSelectors
 		if other == nil || !in.DeepEqual(other) {
@@ -751,6 +841,22 @@ func (in *IngressRule) DeepEqual(other *IngressRule) bool {
 		}
 	}
 
+	if (in.Authentication == nil) != (other.Authentication == nil) {
+		return false
+	} else if in.Authentication != nil {
+		if !in.Authentication.DeepEqual(other.Authentication) {
+			return false
+		}
+	}
+
+	if (in.RateLimit == nil) != (other.RateLimit == nil) {
+		return false
+	} else if in.RateLimit != nil {
+		if !in.RateLimit.DeepEqual(other.RateLimit) {
+			return false
+		}
+	}
+
 	return true
 }
 
@@ -813,6 +919,23 @@ func (in *L7Rules) DeepEqual(other *L7Rules) bool {
 		}
 	}
 
+	if ((in.GRPC != nil) && (other.GRPC != nil)) || ((in.GRPC == nil) != (other.GRPC == nil)) {
+		in, other := &in.GRPC, &other.GRPC
+		if other == nil {
+			return false
+		}
+
+		if len(*in) != len(*other) {
+			return false
+		} else {
+			for i, inElement := range *in {
+				if !inElement.DeepEqual(&(*other)[i]) {
+					return false
+				}
+			}
+		}
+	}
+
 	if ((in.Kafka != nil) && (other.Kafka != nil)) || ((in.Kafka == nil) != (other.Kafka == nil)) {
 		in, other := &in.Kafka, &other.Kafka
 		if other == nil {
@@ -892,6 +1015,26 @@ func (in *Listener) DeepEqual(other *Listener) bool {
 	return true
 }
 
+// DeepEqual is an autogenerated deepequal function, deeply comparing the
+// receiver with other. in must be non-nil.
+func (in *NodeSelectorSlice) DeepEqual(other *NodeSelectorSlice) bool {
+	if other == nil {
+		return false
+	}
+
+	if len(*in) != len(*other) {
+		return false
+	} else {
+		for i, inElement := range *in {
+			if !inElement.DeepEqual(&(*other)[i]) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
 // DeepEqual is an autogenerated deepequal function, deeply comparing the
 // receiver with other. in must be non-nil.
 func (in *PortDenyRule) DeepEqual(other *PortDenyRule) bool {
@@ -916,6 +1059,14 @@ func (in *PortDenyRule) DeepEqual(other *PortDenyRule) bool {
 		}
 	}
 
+	if (in.Rules == nil) != (other.Rules == nil) {
+		return false
+	} else if in.Rules != nil {
+		if !in.Rules.DeepEqual(other.Rules) {
+			return false
+		}
+	}
+
 	return true
 }
 
@@ -1049,6 +1200,23 @@ func (in *PortRuleDNS) DeepEqual(other *PortRuleDNS) bool {
 	return true
 }
 
+// DeepEqual is an autogenerated deepequal function, deeply comparing the
+// receiver with other. in must be non-nil.
+func (in *PortRuleGRPC) DeepEqual(other *PortRuleGRPC) bool {
+	if other == nil {
+		return false
+	}
+
+	if in.Service != other.Service {
+		return false
+	}
+	if in.Method != other.Method {
+		return false
+	}
+
+	return true
+}
+
 // DeepEqual is an autogenerated deepequal function, deeply comparing the
 // receiver with other. in must be non-nil.
 func (in *PortRuleHTTP) DeepEqual(other *PortRuleHTTP) bool {
@@ -1146,6 +1314,23 @@ func (in *PortRules) DeepEqual(other *PortRules) bool {
 	return true
 }
 
+// DeepEqual is an autogenerated deepequal function, deeply comparing the
+// receiver with other. in must be non-nil.
+func (in *RateLimit) DeepEqual(other *RateLimit) bool {
+	if other == nil {
+		return false
+	}
+
+	if in.PacketsPerSecond != other.PacketsPerSecond {
+		return false
+	}
+	if in.BytesPerSecond != other.BytesPerSecond {
+		return false
+	}
+
+	return true
+}
+
 // deepEqual is an autogenerated deepequal function, deeply comparing the
 // receiver with other. in must be non-nil.
 func (in *Rule) deepEqual(other *Rule) bool {
@@ -1316,6 +1501,43 @@ func (in *Service) DeepEqual(other *Service) bool {
 	return true
 }
 
+// DeepEqual is an autogenerated deepequal function, deeply comparing the
+// receiver with other. in must be non-nil.
+func (in *ServiceAccountSelector) DeepEqual(other *ServiceAccountSelector) bool {
+	if other == nil {
+		return false
+	}
+
+	if in.Name != other.Name {
+		return false
+	}
+	if in.Namespace != other.Namespace {
+		return false
+	}
+
+	return true
+}
+
+// DeepEqual is an autogenerated deepequal function, deeply comparing the
+// receiver with other. in must be non-nil.
+func (in *ServiceAccountSlice) DeepEqual(other *ServiceAccountSlice) bool {
+	if other == nil {
+		return false
+	}
+
+	if len(*in) != len(*other) {
+		return false
+	} else {
+		for i, inElement := range *in {
+			if !inElement.DeepEqual(&(*other)[i]) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
 // DeepEqual is an autogenerated deepequal function, deeply comparing the
 // receiver with other. in must be non-nil.
 func (in *ServiceSelector) DeepEqual(other *ServiceSelector) bool {
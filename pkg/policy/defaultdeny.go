@@ -0,0 +1,133 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package policy
+
+import (
+	"sort"
+	"time"
+
+	k8sConst "github.com/cilium/cilium/pkg/k8s/apis/cilium.io"
+	"github.com/cilium/cilium/pkg/labels"
+	"github.com/cilium/cilium/pkg/lock"
+)
+
+// podNamespaceLabel selects the Kubernetes namespace label of an identity.
+var podNamespaceLabel = labels.LabelSourceK8s + "." + k8sConst.PodNamespaceLabel
+
+// DefaultDenyPhase is the enforcement phase of a namespace in a staged
+// default-deny rollout.
+type DefaultDenyPhase string
+
+const (
+	// DefaultDenyPhaseAudit is the initial phase of a namespace in the
+	// rollout. Policy verdicts for endpoints in the namespace are computed
+	// and logged, but traffic is not dropped.
+	DefaultDenyPhaseAudit DefaultDenyPhase = "Audit"
+
+	// DefaultDenyPhaseEnforcing is the final phase of a namespace in the
+	// rollout. Endpoints in the namespace are subject to default-deny
+	// policy enforcement.
+	DefaultDenyPhaseEnforcing DefaultDenyPhase = "Enforcing"
+)
+
+// DefaultDenyNamespaceStatus describes the rollout state of a single
+// namespace.
+type DefaultDenyNamespaceStatus struct {
+	// Namespace is the name of the namespace.
+	Namespace string
+
+	// Phase is the current enforcement phase of the namespace.
+	Phase DefaultDenyPhase
+
+	// AuditStarted is the time the namespace entered the audit phase.
+	AuditStarted time.Time
+
+	// EnforcedSince is the time enforcement started for the namespace.
+	// It is zero while the namespace is still in the audit phase.
+	EnforcedSince time.Time
+}
+
+// defaultDenyRollout tracks the namespaces being rolled out to default-deny
+// policy enforcement. Each namespace starts in the audit phase when it is
+// added to the rollout and moves to the enforcing phase once the audit
+// duration has passed.
+type defaultDenyRollout struct {
+	mutex lock.RWMutex
+
+	// auditDuration is how long a namespace stays in the audit phase.
+	auditDuration time.Duration
+
+	// namespaces maps each namespace in the rollout to the time it
+	// entered the audit phase.
+	namespaces map[string]time.Time
+}
+
+var ddRollout = &defaultDenyRollout{
+	namespaces: map[string]time.Time{},
+}
+
+func (r *defaultDenyRollout) phase(auditStarted time.Time) DefaultDenyPhase {
+	if time.Since(auditStarted) < r.auditDuration {
+		return DefaultDenyPhaseAudit
+	}
+	return DefaultDenyPhaseEnforcing
+}
+
+// SetDefaultDenyRollout replaces the set of namespaces in the staged
+// default-deny rollout and sets the duration of the audit phase. Namespaces
+// already part of the rollout keep their current phase, newly added
+// namespaces enter the audit phase.
+func SetDefaultDenyRollout(namespaces []string, auditDuration time.Duration) {
+	ddRollout.mutex.Lock()
+	defer ddRollout.mutex.Unlock()
+
+	ddRollout.auditDuration = auditDuration
+	now := time.Now()
+	updated := make(map[string]time.Time, len(namespaces))
+	for _, ns := range namespaces {
+		if started, ok := ddRollout.namespaces[ns]; ok {
+			updated[ns] = started
+		} else {
+			updated[ns] = now
+		}
+	}
+	ddRollout.namespaces = updated
+}
+
+// DefaultDenyRolloutPhase returns the rollout phase of the given namespace
+// and whether the namespace is part of the rollout.
+func DefaultDenyRolloutPhase(namespace string) (DefaultDenyPhase, bool) {
+	ddRollout.mutex.RLock()
+	defer ddRollout.mutex.RUnlock()
+
+	started, ok := ddRollout.namespaces[namespace]
+	if !ok {
+		return "", false
+	}
+	return ddRollout.phase(started), true
+}
+
+// DefaultDenyRolloutStatus returns the status of each namespace in the
+// staged default-deny rollout, sorted by namespace name.
+func DefaultDenyRolloutStatus() []DefaultDenyNamespaceStatus {
+	ddRollout.mutex.RLock()
+	defer ddRollout.mutex.RUnlock()
+
+	status := make([]DefaultDenyNamespaceStatus, 0, len(ddRollout.namespaces))
+	for ns, started := range ddRollout.namespaces {
+		s := DefaultDenyNamespaceStatus{
+			Namespace:    ns,
+			Phase:        ddRollout.phase(started),
+			AuditStarted: started,
+		}
+		if s.Phase == DefaultDenyPhaseEnforcing {
+			s.EnforcedSince = started.Add(ddRollout.auditDuration)
+		}
+		status = append(status, s)
+	}
+	sort.Slice(status, func(i, j int) bool {
+		return status[i].Namespace < status[j].Namespace
+	})
+	return status
+}
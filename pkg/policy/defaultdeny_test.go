@@ -0,0 +1,48 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package policy
+
+import (
+	"time"
+
+	. "gopkg.in/check.v1"
+)
+
+func (ds *PolicyTestSuite) TestDefaultDenyRollout(c *C) {
+	defer SetDefaultDenyRollout(nil, 0)
+
+	// Namespaces start in the audit phase.
+	SetDefaultDenyRollout([]string{"foo", "bar"}, time.Hour)
+
+	phase, ok := DefaultDenyRolloutPhase("foo")
+	c.Assert(ok, Equals, true)
+	c.Assert(phase, Equals, DefaultDenyPhaseAudit)
+
+	_, ok = DefaultDenyRolloutPhase("other")
+	c.Assert(ok, Equals, false)
+
+	// Updating the rollout keeps the audit start time of namespaces that
+	// are already part of it.
+	status := DefaultDenyRolloutStatus()
+	c.Assert(status, HasLen, 2)
+	c.Assert(status[0].Namespace, Equals, "bar")
+	c.Assert(status[1].Namespace, Equals, "foo")
+	fooStarted := status[1].AuditStarted
+
+	SetDefaultDenyRollout([]string{"foo"}, time.Hour)
+	status = DefaultDenyRolloutStatus()
+	c.Assert(status, HasLen, 1)
+	c.Assert(status[0].AuditStarted, Equals, fooStarted)
+
+	// Once the audit duration has passed the namespace is enforcing.
+	SetDefaultDenyRollout([]string{"foo"}, 0)
+	phase, ok = DefaultDenyRolloutPhase("foo")
+	c.Assert(ok, Equals, true)
+	c.Assert(phase, Equals, DefaultDenyPhaseEnforcing)
+
+	status = DefaultDenyRolloutStatus()
+	c.Assert(status, HasLen, 1)
+	c.Assert(status[0].Phase, Equals, DefaultDenyPhaseEnforcing)
+	c.Assert(status[0].EnforcedSince, Equals, status[0].AuditStarted)
+}
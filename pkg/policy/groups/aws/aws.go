@@ -27,17 +27,34 @@ func init() {
 }
 
 // GetIPsFromGroup will return the list of the ips for the given group filter
-func GetIPsFromGroup(ctx context.Context, group *api.ToGroups) ([]netip.Addr, error) {
-	result := []netip.Addr{}
+func GetIPsFromGroup(ctx context.Context, group *api.ToGroups) ([]netip.Prefix, error) {
+	result := []netip.Prefix{}
 	if group.AWS == nil {
 		return result, fmt.Errorf("no aws data available")
 	}
-	return getInstancesIpsFromFilter(ctx, group.AWS)
+	if len(group.AWS.ServicePrefixes) > 0 {
+		prefixes, err := getServicePrefixes(ctx, group.AWS)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, prefixes...)
+		// Do not fall through to the instance lookup when the group only
+		// selects service prefixes, as an empty instance filter would
+		// match all instances.
+		if len(group.AWS.Labels)+len(group.AWS.SecurityGroupsIds)+len(group.AWS.SecurityGroupsNames) == 0 {
+			return result, nil
+		}
+	}
+	prefixes, err := getInstancesIpsFromFilter(ctx, group.AWS)
+	if err != nil {
+		return nil, err
+	}
+	return append(result, prefixes...), nil
 }
 
 // getInstancesFromFilter returns the instances IPs in aws EC2 filter by the
 // given filter
-func getInstancesIpsFromFilter(ctx context.Context, filter *api.AWSGroup) ([]netip.Addr, error) {
+func getInstancesIpsFromFilter(ctx context.Context, filter *api.AWSGroup) ([]netip.Prefix, error) {
 	var result []ec2_types.Reservation
 	input := &ec2.DescribeInstancesInput{}
 
@@ -80,8 +97,8 @@ func getInstancesIpsFromFilter(ctx context.Context, filter *api.AWSGroup) ([]net
 	return extractIPs(result), nil
 }
 
-func extractIPs(reservations []ec2_types.Reservation) []netip.Addr {
-	result := []netip.Addr{}
+func extractIPs(reservations []ec2_types.Reservation) []netip.Prefix {
+	result := []netip.Prefix{}
 	for _, reservation := range reservations {
 		for _, instance := range reservation.Instances {
 			for _, iface := range instance.NetworkInterfaces {
@@ -90,13 +107,13 @@ func extractIPs(reservations []ec2_types.Reservation) []netip.Addr {
 					if err != nil {
 						continue
 					}
-					result = append(result, addr)
+					result = append(result, netip.PrefixFrom(addr, addr.BitLen()))
 					if ifaceIP.Association != nil {
 						addr, err = netip.ParseAddr(aws.ToString(ifaceIP.Association.PublicIp))
 						if err != nil {
 							continue
 						}
-						result = append(result, addr)
+						result = append(result, netip.PrefixFrom(addr, addr.BitLen()))
 					}
 				}
 			}
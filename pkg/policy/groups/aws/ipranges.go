@@ -0,0 +1,142 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package aws
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/netip"
+	"strings"
+	"time"
+
+	"github.com/cilium/cilium/pkg/lock"
+	"github.com/cilium/cilium/pkg/policy/api"
+)
+
+const (
+	// ipRangesURL is the location of the IP ranges published by AWS.
+	ipRangesURL = "https://ip-ranges.amazonaws.com/ip-ranges.json"
+
+	// ipRangesMaxAge is how long a fetched ip-ranges.json document is
+	// reused before it is fetched again. Derivative policies are
+	// recreated periodically, so the CIDR sets follow updates of the
+	// published ranges with at most this delay.
+	ipRangesMaxAge = 1 * time.Hour
+)
+
+// ipRangesDocument is the subset of ip-ranges.json that is needed to
+// resolve service prefixes.
+type ipRangesDocument struct {
+	SyncToken    string             `json:"syncToken"`
+	Prefixes     []ipRangesPrefix   `json:"prefixes"`
+	IPv6Prefixes []ipRangesV6Prefix `json:"ipv6_prefixes"`
+}
+
+type ipRangesPrefix struct {
+	IPPrefix string `json:"ip_prefix"`
+	Region   string `json:"region"`
+	Service  string `json:"service"`
+}
+
+type ipRangesV6Prefix struct {
+	IPv6Prefix string `json:"ipv6_prefix"`
+	Region     string `json:"region"`
+	Service    string `json:"service"`
+}
+
+var ipRangesCache struct {
+	mutex    lock.Mutex
+	document *ipRangesDocument
+	fetched  time.Time
+}
+
+// getIPRanges returns the published AWS IP ranges, fetching them at most
+// once per ipRangesMaxAge.
+func getIPRanges(ctx context.Context) (*ipRangesDocument, error) {
+	ipRangesCache.mutex.Lock()
+	defer ipRangesCache.mutex.Unlock()
+
+	if ipRangesCache.document != nil && time.Since(ipRangesCache.fetched) < ipRangesMaxAge {
+		return ipRangesCache.document, nil
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, ipRangesURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("Cannot fetch AWS IP ranges: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("Cannot fetch AWS IP ranges: unexpected status %s", resp.Status)
+	}
+
+	document := &ipRangesDocument{}
+	if err := json.NewDecoder(resp.Body).Decode(document); err != nil {
+		return nil, fmt.Errorf("Cannot decode AWS IP ranges: %w", err)
+	}
+	ipRangesCache.document = document
+	ipRangesCache.fetched = time.Now()
+	return document, nil
+}
+
+// getServicePrefixes returns the published IP ranges of the AWS services
+// selected by the service prefixes of the given filter.
+func getServicePrefixes(ctx context.Context, filter *api.AWSGroup) ([]netip.Prefix, error) {
+	document, err := getIPRanges(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return selectServicePrefixes(document, filter)
+}
+
+// selectServicePrefixes resolves the service prefixes of the given filter
+// against the given ip-ranges.json document. Each service prefix is a
+// service name, optionally followed by "/<region>", e.g. "s3/us-east-1".
+// When no region is given, the region of the filter is used if set,
+// otherwise the ranges of all regions are selected.
+func selectServicePrefixes(document *ipRangesDocument, filter *api.AWSGroup) ([]netip.Prefix, error) {
+	result := []netip.Prefix{}
+	for _, servicePrefix := range filter.ServicePrefixes {
+		service, region, _ := strings.Cut(servicePrefix, "/")
+		if service == "" {
+			return nil, fmt.Errorf("empty service in service prefix %q", servicePrefix)
+		}
+		if region == "" {
+			region = filter.Region
+		}
+
+		found := false
+		for _, p := range document.Prefixes {
+			if !strings.EqualFold(p.Service, service) || (region != "" && p.Region != region) {
+				continue
+			}
+			prefix, err := netip.ParsePrefix(p.IPPrefix)
+			if err != nil {
+				continue
+			}
+			result = append(result, prefix)
+			found = true
+		}
+		for _, p := range document.IPv6Prefixes {
+			if !strings.EqualFold(p.Service, service) || (region != "" && p.Region != region) {
+				continue
+			}
+			prefix, err := netip.ParsePrefix(p.IPv6Prefix)
+			if err != nil {
+				continue
+			}
+			result = append(result, prefix)
+			found = true
+		}
+		if !found {
+			return nil, fmt.Errorf("no AWS IP ranges found for service prefix %q", servicePrefix)
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,65 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package aws
+
+import (
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cilium/cilium/pkg/policy/api"
+)
+
+var testIPRanges = &ipRangesDocument{
+	SyncToken: "1",
+	Prefixes: []ipRangesPrefix{
+		{IPPrefix: "52.216.0.0/15", Region: "us-east-1", Service: "S3"},
+		{IPPrefix: "52.92.16.0/20", Region: "eu-west-1", Service: "S3"},
+		{IPPrefix: "3.5.140.0/22", Region: "us-east-1", Service: "EC2"},
+	},
+	IPv6Prefixes: []ipRangesV6Prefix{
+		{IPv6Prefix: "2600:1fa0::/40", Region: "us-east-1", Service: "S3"},
+	},
+}
+
+func TestSelectServicePrefixes(t *testing.T) {
+	// Service names are matched case-insensitively and a region given in
+	// the service prefix restricts the selection.
+	prefixes, err := selectServicePrefixes(testIPRanges, &api.AWSGroup{
+		ServicePrefixes: []string{"s3/us-east-1"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []netip.Prefix{
+		netip.MustParsePrefix("52.216.0.0/15"),
+		netip.MustParsePrefix("2600:1fa0::/40"),
+	}, prefixes)
+
+	// Without a region, the ranges of all regions are selected.
+	prefixes, err = selectServicePrefixes(testIPRanges, &api.AWSGroup{
+		ServicePrefixes: []string{"S3"},
+	})
+	require.NoError(t, err)
+	require.Len(t, prefixes, 3)
+
+	// The region of the group is used when the service prefix has none.
+	prefixes, err = selectServicePrefixes(testIPRanges, &api.AWSGroup{
+		ServicePrefixes: []string{"s3"},
+		Region:          "eu-west-1",
+	})
+	require.NoError(t, err)
+	require.Equal(t, []netip.Prefix{
+		netip.MustParsePrefix("52.92.16.0/20"),
+	}, prefixes)
+
+	// Unknown services and empty service names are rejected.
+	_, err = selectServicePrefixes(testIPRanges, &api.AWSGroup{
+		ServicePrefixes: []string{"does-not-exist"},
+	})
+	require.Error(t, err)
+	_, err = selectServicePrefixes(testIPRanges, &api.AWSGroup{
+		ServicePrefixes: []string{"/us-east-1"},
+	})
+	require.Error(t, err)
+}
@@ -93,8 +93,8 @@ func (s *GroupsTestSuite) TestDerivativePoliciesAreDeletedIfNoToGroups(c *C) {
 }
 
 func (s *GroupsTestSuite) TestDerivativePoliciesAreInheritCorrectly(c *C) {
-	cb := func(ctx context.Context, group *api.ToGroups) ([]netip.Addr, error) {
-		return []netip.Addr{netip.MustParseAddr("192.168.1.1")}, nil
+	cb := func(ctx context.Context, group *api.ToGroups) ([]netip.Prefix, error) {
+		return []netip.Prefix{netip.MustParsePrefix("192.168.1.1/32")}, nil
 	}
 
 	egressRule := []api.EgressRule{
@@ -180,7 +180,8 @@ type PerSelectorPolicy struct {
 
 	// ServerNames is a list of allowed TLS SNI values. If not empty, then
 	// TLS must be present and one of the provided SNIs must be indicated in the
-	// TLS handshake.
+	// TLS handshake. Entries may be literal DNS names or wildcard names in
+	// which a single '*' replaces the leftmost label.
 	ServerNames StringSet `json:"serverNames,omitempty"`
 
 	// isRedirect is 'true' when traffic must be redirected
@@ -468,7 +469,9 @@ func (l4Filter *L4Filter) ToMapState(policyOwner PolicyOwner, direction trafficd
 
 	for cs, currentRule := range l4Filter.L7RulesPerSelector {
 		// have wildcard?        this is a L3L4 key?
-		isDenyRule := currentRule != nil && currentRule.IsDeny
+		// A deny rule which redirects is enforced by the proxy at L7, the
+		// datapath entry must send the traffic there instead of dropping it.
+		isDenyRule := currentRule != nil && currentRule.IsDeny && !currentRule.IsRedirect()
 
 		if (l4Filter.Port != 0 || l4Filter.PortName != "") && l4Filter.wildcard != nil {
 			// Now that we have a port number and a wildcard the filter has a
@@ -514,6 +517,16 @@ func (l4Filter *L4Filter) ToMapState(policyOwner PolicyOwner, direction trafficd
 			}
 		}
 		for _, id := range identities {
+			// Skip identities that are not relevant to the node. The
+			// entries are filled in via incremental updates if the
+			// identity becomes relevant later. Deny entries are always
+			// installed: traffic from a denied identity that matches a
+			// broader allow entry would be forwarded without generating
+			// the drop notification that marks the identity relevant,
+			// permanently bypassing the deny.
+			if !isDenyRule && !IdentityIsRelevant(id) {
+				continue
+			}
 			keyToAdd.Identity = id.Uint32()
 			keysToAdd.DenyPreferredInsert(keyToAdd, entry)
 		}
@@ -554,7 +567,7 @@ func (l4 *L4Filter) IdentitySelectionUpdated(selector CachedSelector, added, del
 		}
 		l7Rules := l4.L7RulesPerSelector[selector]
 		isRedirect := l7Rules.IsRedirect()
-		isDeny := l7Rules != nil && l7Rules.IsDeny
+		isDeny := l7Rules != nil && l7Rules.IsDeny && !isRedirect
 		l4Policy.AccumulateMapChanges(selector, added, deleted, l4, direction, isRedirect, isDeny)
 	}
 }
@@ -603,7 +616,11 @@ func (l7 L7DataMap) addRulesForEndpoints(rules *api.L7Rules, terminatingTLS, ori
 		OriginatingTLS: originatingTLS,
 		IsDeny:         deny,
 		ServerNames:    NewStringSet(sni),
-		isRedirect:     !deny && (forceRedirect || terminatingTLS != nil || originatingTLS != nil || len(sni) > 0 || !rules.IsEmpty()),
+		// A deny rule with L7 rules redirects to the proxy, which denies
+		// matching requests and leaves the rest to the allow rules in
+		// place. A deny rule without L7 rules is enforced in the datapath.
+		isRedirect: (!deny && (forceRedirect || terminatingTLS != nil || originatingTLS != nil || len(sni) > 0 || !rules.IsEmpty())) ||
+			(deny && !rules.IsEmpty()),
 	}
 	if rules != nil {
 		l7policy.L7Rules = *rules
@@ -719,6 +736,9 @@ func createL4Filter(policyCtx PolicyContext, peerEndpoints api.EndpointSelectorS
 				switch {
 				case len(pr.Rules.HTTP) > 0:
 					l4.L7Parser = ParserTypeHTTP
+				case len(pr.Rules.GRPC) > 0:
+					// gRPC rules are enforced by the HTTP parser.
+					l4.L7Parser = ParserTypeHTTP
 				case len(pr.Rules.Kafka) > 0:
 					l4.L7Parser = ParserTypeKafka
 				case pr.Rules.L7Proto != "":
@@ -753,8 +773,21 @@ func createL4Filter(policyCtx PolicyContext, peerEndpoints api.EndpointSelectorS
 			forceRedirect = true
 		}
 
+		// Translate gRPC rules into the HTTP rules enforcing them, keeping
+		// the rule in the policy repository unmodified.
+		rules := pr.Rules
+		if rules != nil && len(rules.GRPC) > 0 {
+			translated := *rules
+			translated.GRPC = nil
+			translated.HTTP = make([]api.PortRuleHTTP, 0, len(rules.GRPC))
+			for i := range rules.GRPC {
+				translated.HTTP = append(translated.HTTP, rules.GRPC[i].ToHTTPRule())
+			}
+			rules = &translated
+		}
+
 		if l4.L7Parser != ParserTypeNone {
-			l4.L7RulesPerSelector.addRulesForEndpoints(pr.Rules, terminatingTLS, originatingTLS, policyCtx.IsDeny(), pr.ServerNames, forceRedirect)
+			l4.L7RulesPerSelector.addRulesForEndpoints(rules, terminatingTLS, originatingTLS, policyCtx.IsDeny(), pr.ServerNames, forceRedirect)
 		}
 	}
 
@@ -1105,6 +1138,17 @@ func (l4 *L4Policy) removeUser(user *EndpointPolicy) {
 // present in both 'adds' and 'deletes'.
 func (l4 *L4Policy) AccumulateMapChanges(cs CachedSelector, adds, deletes []identity.NumericIdentity, l4Filter *L4Filter,
 	direction trafficdirection.TrafficDirection, redirect, isDeny bool) {
+	// Identities that are not relevant to the node have no policy map
+	// entries, so additions for them are not distributed. Deletions are
+	// kept so that entries for identities that lose relevance are cleaned
+	// up. Deny entries are exempt and always installed, see ToMapState().
+	if !isDeny {
+		adds = filterRelevantIdentities(adds)
+		if len(adds) == 0 && len(deletes) == 0 {
+			return
+		}
+	}
+
 	port := uint16(l4Filter.Port)
 	proto := uint8(l4Filter.U8Proto)
 	derivedFrom := l4Filter.DerivedFromRules
@@ -520,6 +520,68 @@ func (ds *PolicyTestSuite) TestMergeIdenticalAllowAllL3AndRestrictedL7Kafka(c *C
 	c.Assert(state.matchedRules, Equals, 0)
 }
 
+// gRPC rules are translated into the corresponding HTTP rules and enforced
+// with the HTTP parser.
+func (ds *PolicyTestSuite) TestMergeGRPCTranslatedToHTTP(c *C) {
+	grpcRule := &rule{
+		Rule: api.Rule{
+			EndpointSelector: endpointSelectorA,
+			Ingress: []api.IngressRule{
+				{
+					IngressCommonRule: api.IngressCommonRule{
+						FromEndpoints: []api.EndpointSelector{api.WildcardEndpointSelector},
+					},
+					ToPorts: []api.PortRule{{
+						Ports: []api.PortProtocol{
+							{Port: "50051", Protocol: api.ProtoTCP},
+						},
+						Rules: &api.L7Rules{
+							GRPC: []api.PortRuleGRPC{
+								{Service: "helloworld.Greeter", Method: "SayHello"},
+							},
+						},
+					}},
+				},
+			},
+		}}
+
+	expected := L4PolicyMap{"50051/TCP": &L4Filter{
+		Port:     50051,
+		Protocol: api.ProtoTCP,
+		U8Proto:  6,
+		wildcard: wildcardCachedSelector,
+		L7Parser: ParserTypeHTTP,
+		L7RulesPerSelector: L7DataMap{
+			wildcardCachedSelector: &PerSelectorPolicy{
+				L7Rules: api.L7Rules{
+					HTTP: []api.PortRuleHTTP{{Method: "POST", Path: "/helloworld.Greeter/SayHello"}},
+				},
+				isRedirect: true,
+			},
+		},
+		Ingress:          true,
+		DerivedFromRules: labels.LabelArrayList{nil},
+	}}
+
+	buffer := new(bytes.Buffer)
+	ctxToA := SearchContext{To: labelsA, Trace: TRACE_VERBOSE}
+	ctxToA.Logging = stdlog.New(buffer, "", 0)
+	c.Log(buffer)
+
+	state := traceState{}
+	res, err := grpcRule.resolveIngressPolicy(testPolicyContext, &ctxToA, &state, L4PolicyMap{}, nil, nil)
+	c.Assert(err, IsNil)
+	c.Assert(res, Not(IsNil))
+	c.Assert(res, checker.Equals, expected)
+	c.Assert(state.selectedRules, Equals, 1)
+	c.Assert(state.matchedRules, Equals, 1)
+	// The rule in the policy repository is unmodified.
+	c.Assert(grpcRule.Ingress[0].ToPorts[0].Rules.HTTP, HasLen, 0)
+	c.Assert(grpcRule.Ingress[0].ToPorts[0].Rules.GRPC, HasLen, 1)
+	res.Detach(testSelectorCache)
+	expected.Detach(testSelectorCache)
+}
+
 // Case 5: use conflicting protocols on the same port in different rules. This
 // is not supported, so return an error.
 func (ds *PolicyTestSuite) TestMergeIdenticalAllowAllL3AndMismatchingParsers(c *C) {
@@ -0,0 +1,48 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package policy
+
+import (
+	"github.com/cilium/cilium/pkg/lock"
+)
+
+// nsAuditMode tracks the per-namespace policy audit mode overrides requested
+// via CiliumPolicyDefaults. Endpoints in a namespace with an override run
+// with policy audit mode set accordingly, regardless of the global setting.
+type nsAuditMode struct {
+	mutex lock.RWMutex
+
+	// namespaces maps each namespace with an override to the requested
+	// audit mode.
+	namespaces map[string]bool
+}
+
+var namespaceAudit = &nsAuditMode{
+	namespaces: map[string]bool{},
+}
+
+// SetNamespaceAuditMode sets the policy audit mode override for the given
+// namespace.
+func SetNamespaceAuditMode(namespace string, audit bool) {
+	namespaceAudit.mutex.Lock()
+	defer namespaceAudit.mutex.Unlock()
+	namespaceAudit.namespaces[namespace] = audit
+}
+
+// ClearNamespaceAuditMode removes the policy audit mode override of the
+// given namespace.
+func ClearNamespaceAuditMode(namespace string) {
+	namespaceAudit.mutex.Lock()
+	defer namespaceAudit.mutex.Unlock()
+	delete(namespaceAudit.namespaces, namespace)
+}
+
+// NamespaceAuditMode returns the policy audit mode override of the given
+// namespace and whether an override exists.
+func NamespaceAuditMode(namespace string) (bool, bool) {
+	namespaceAudit.mutex.RLock()
+	defer namespaceAudit.mutex.RUnlock()
+	audit, ok := namespaceAudit.namespaces[namespace]
+	return audit, ok
+}
@@ -0,0 +1,130 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package policy
+
+import (
+	"github.com/cilium/cilium/pkg/identity"
+	"github.com/cilium/cilium/pkg/identity/cache"
+	"github.com/cilium/cilium/pkg/lock"
+)
+
+// identityRelevance tracks the identities that are relevant to the node,
+// i.e. the identities of local endpoints and identities traffic has been
+// seen to or from. When enabled, policy map entries are only computed for
+// relevant identities; entries for the remaining identities are filled in
+// via incremental updates once they become relevant.
+type identityRelevance struct {
+	mutex lock.RWMutex
+
+	// enabled is true when relevance-based policy computation is enabled.
+	enabled bool
+
+	// identities is the set of identities relevant to the node. Reserved
+	// and locally scoped identities are always relevant and not tracked
+	// here.
+	identities map[identity.NumericIdentity]struct{}
+}
+
+var idRelevance = &identityRelevance{
+	identities: map[identity.NumericIdentity]struct{}{},
+}
+
+// alwaysRelevant returns true for identities that are relevant to the node
+// regardless of the tracked set. Reserved identities are in use on every
+// node and locally scoped identities are only allocated for traffic already
+// seen by the node.
+func alwaysRelevant(nid identity.NumericIdentity) bool {
+	return nid.IsReservedIdentity() || nid.HasLocalScope()
+}
+
+// EnableIdentityRelevance enables relevance-based policy computation. It
+// must be called before the first policy is computed and cannot be undone.
+func EnableIdentityRelevance() {
+	idRelevance.mutex.Lock()
+	idRelevance.enabled = true
+	idRelevance.mutex.Unlock()
+}
+
+// IdentityRelevanceEnabled returns true if relevance-based policy
+// computation is enabled.
+func IdentityRelevanceEnabled() bool {
+	idRelevance.mutex.RLock()
+	defer idRelevance.mutex.RUnlock()
+	return idRelevance.enabled
+}
+
+// IdentityIsRelevant returns true if policy map entries must be computed
+// for the given identity. All identities are relevant when relevance-based
+// policy computation is disabled.
+func IdentityIsRelevant(nid identity.NumericIdentity) bool {
+	idRelevance.mutex.RLock()
+	defer idRelevance.mutex.RUnlock()
+
+	if !idRelevance.enabled || alwaysRelevant(nid) {
+		return true
+	}
+	_, ok := idRelevance.identities[nid]
+	return ok
+}
+
+// MarkIdentitiesRelevant adds the given identities to the set of identities
+// relevant to the node and returns the identities that were not relevant
+// before. The unknown identity and identities that are always relevant are
+// ignored. The caller is responsible for re-announcing the returned
+// identities to the selector cache, see
+// SelectorCache.NotifyRelevantIdentities.
+func MarkIdentitiesRelevant(nids []identity.NumericIdentity) []identity.NumericIdentity {
+	idRelevance.mutex.Lock()
+	defer idRelevance.mutex.Unlock()
+
+	if !idRelevance.enabled {
+		return nil
+	}
+	var added []identity.NumericIdentity
+	for _, nid := range nids {
+		if nid == identity.IdentityUnknown || alwaysRelevant(nid) {
+			continue
+		}
+		if _, ok := idRelevance.identities[nid]; !ok {
+			idRelevance.identities[nid] = struct{}{}
+			added = append(added, nid)
+		}
+	}
+	return added
+}
+
+// filterRelevantIdentities returns the identities for which policy map
+// entries must be computed. The given slice is returned unchanged when
+// relevance-based policy computation is disabled.
+func filterRelevantIdentities(nids []identity.NumericIdentity) []identity.NumericIdentity {
+	idRelevance.mutex.RLock()
+	defer idRelevance.mutex.RUnlock()
+
+	if !idRelevance.enabled {
+		return nids
+	}
+	filtered := make([]identity.NumericIdentity, 0, len(nids))
+	for _, nid := range nids {
+		if alwaysRelevant(nid) {
+			filtered = append(filtered, nid)
+			continue
+		}
+		if _, ok := idRelevance.identities[nid]; ok {
+			filtered = append(filtered, nid)
+		}
+	}
+	return filtered
+}
+
+// forgetRelevantIdentities removes deleted identities from the relevance
+// set, so that an identity that is reallocated later starts out as not
+// relevant again.
+func forgetRelevantIdentities(deleted cache.IdentityCache) {
+	idRelevance.mutex.Lock()
+	defer idRelevance.mutex.Unlock()
+
+	for nid := range deleted {
+		delete(idRelevance.identities, nid)
+	}
+}
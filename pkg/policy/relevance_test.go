@@ -0,0 +1,60 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package policy
+
+import (
+	. "gopkg.in/check.v1"
+
+	"github.com/cilium/cilium/pkg/checker"
+	"github.com/cilium/cilium/pkg/identity"
+	"github.com/cilium/cilium/pkg/identity/cache"
+)
+
+func (ds *PolicyTestSuite) TestIdentityRelevance(c *C) {
+	oldRelevance := idRelevance
+	idRelevance = &identityRelevance{
+		identities: map[identity.NumericIdentity]struct{}{},
+	}
+	defer func() { idRelevance = oldRelevance }()
+
+	nid := identity.NumericIdentity(1000)
+	localNid := identity.LocalIdentityFlag | 1
+
+	// All identities are relevant while relevance-based policy computation
+	// is disabled, and marking is a no-op.
+	c.Assert(IdentityIsRelevant(nid), Equals, true)
+	c.Assert(MarkIdentitiesRelevant([]identity.NumericIdentity{nid}), IsNil)
+	c.Assert(filterRelevantIdentities([]identity.NumericIdentity{nid}),
+		checker.DeepEquals, []identity.NumericIdentity{nid})
+
+	EnableIdentityRelevance()
+	c.Assert(IdentityRelevanceEnabled(), Equals, true)
+
+	// Reserved and locally scoped identities are always relevant, other
+	// identities only once marked.
+	c.Assert(IdentityIsRelevant(identity.ReservedIdentityHost), Equals, true)
+	c.Assert(IdentityIsRelevant(localNid), Equals, true)
+	c.Assert(IdentityIsRelevant(nid), Equals, false)
+
+	added := MarkIdentitiesRelevant([]identity.NumericIdentity{
+		identity.IdentityUnknown, identity.ReservedIdentityHost, localNid, nid,
+	})
+	c.Assert(added, checker.DeepEquals, []identity.NumericIdentity{nid})
+	c.Assert(IdentityIsRelevant(nid), Equals, true)
+
+	// Marking an already relevant identity returns nothing.
+	c.Assert(MarkIdentitiesRelevant([]identity.NumericIdentity{nid}), IsNil)
+
+	// Filtering retains always relevant and marked identities only.
+	filtered := filterRelevantIdentities([]identity.NumericIdentity{
+		nid, identity.NumericIdentity(2000), identity.ReservedIdentityWorld,
+	})
+	c.Assert(filtered, checker.DeepEquals,
+		[]identity.NumericIdentity{nid, identity.ReservedIdentityWorld})
+
+	// Deleted identities are forgotten so that a reallocated identity
+	// starts out as not relevant.
+	forgetRelevantIdentities(cache.IdentityCache{nid: nil})
+	c.Assert(IdentityIsRelevant(nid), Equals, false)
+}
@@ -10,6 +10,7 @@ import (
 	"net/netip"
 	"sync"
 	"sync/atomic"
+	"time"
 
 	cilium "github.com/cilium/proxy/go/cilium/api"
 
@@ -347,6 +348,23 @@ func (p *Repository) SearchRLocked(lbls labels.LabelArray) api.Rules {
 	return result
 }
 
+// GetExpiredRuleLabels returns the labels of all rules carrying an expiration
+// label with a deadline in the past. The returned label arrays can be passed
+// to DeleteByLabels to remove the expired rules.
+func (p *Repository) GetExpiredRuleLabels(now time.Time) []labels.LabelArray {
+	p.Mutex.RLock()
+	defer p.Mutex.RUnlock()
+
+	var expired []labels.LabelArray
+	for _, r := range p.rules {
+		if deadline, ok := api.GetRuleExpiration(r.Labels); ok && deadline.Before(now) {
+			expired = append(expired, r.Labels)
+		}
+	}
+
+	return expired
+}
+
 // Add inserts a rule into the policy repository
 // This is just a helper function for unit testing.
 // TODO: this should be in a test_helpers.go file or something similar
@@ -382,6 +400,7 @@ func (p *Repository) AddListLocked(rules api.Rules) (ruleSlice, uint64) {
 	p.rules = append(p.rules, newList...)
 	p.BumpRevision()
 	metrics.Policy.Add(float64(len(newList)))
+	metrics.PolicyRulesNeverMatched.Add(float64(len(newList)))
 	return newList, p.GetRevision()
 }
 
@@ -488,6 +507,11 @@ func (p *Repository) DeleteByLabelsLocked(lbls labels.LabelArray) (ruleSlice, ui
 		p.BumpRevision()
 		p.rules = new
 		metrics.Policy.Sub(float64(deleted))
+		for _, r := range deletedRules {
+			if matchCount, _ := r.metadata.usage(); matchCount == 0 {
+				metrics.PolicyRulesNeverMatched.Dec()
+			}
+		}
 	}
 
 	return deletedRules, p.GetRevision(), deleted
@@ -512,6 +536,65 @@ func JSONMarshalRules(rules api.Rules) string {
 	return string(b)
 }
 
+// ruleUsage annotates a policy rule with the usage information gathered for
+// it by the policy engine.
+type ruleUsage struct {
+	rule *api.Rule
+
+	// MatchCount is the number of times the rule has matched a subject
+	// while resolving endpoint policies.
+	MatchCount uint64
+
+	// LastMatch is the last time the rule matched a subject while
+	// resolving endpoint policies, or nil if the rule has never matched.
+	LastMatch *time.Time
+}
+
+// MarshalJSON returns the JSON encoding of the rule extended with its
+// matchCount and lastMatch fields.
+func (r ruleUsage) MarshalJSON() ([]byte, error) {
+	b, err := json.Marshal(r.rule)
+	if err != nil {
+		return nil, err
+	}
+	usage, err := json.Marshal(struct {
+		MatchCount uint64     `json:"matchCount"`
+		LastMatch  *time.Time `json:"lastMatch,omitempty"`
+	}{r.MatchCount, r.LastMatch})
+	if err != nil {
+		return nil, err
+	}
+
+	// Splice the usage fields into the rule's JSON object.
+	b = append(b[:len(b)-1], ',')
+	return append(b, usage[1:]...), nil
+}
+
+// JSONMarshalRulesWithUsageRLocked returns the rules in the repository which
+// match the specified labels as string in JSON representation, with each rule
+// annotated with its match count and last match timestamp. The number of
+// matched rules is returned alongside the JSON.
+func (p *Repository) JSONMarshalRulesWithUsageRLocked(lbls labels.LabelArray) (string, int) {
+	result := make([]ruleUsage, 0, len(p.rules))
+	for _, r := range p.rules {
+		if !r.Labels.Contains(lbls) {
+			continue
+		}
+		matchCount, lastMatch := r.metadata.usage()
+		annotated := ruleUsage{rule: &r.Rule, MatchCount: matchCount}
+		if !lastMatch.IsZero() {
+			annotated.LastMatch = &lastMatch
+		}
+		result = append(result, annotated)
+	}
+
+	b, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return err.Error(), len(result)
+	}
+	return string(b), len(result)
+}
+
 // GetJSON returns all rules of the policy repository as string in JSON
 // representation
 func (p *Repository) GetJSON() string {
@@ -757,6 +840,16 @@ func (p *Repository) computePolicyEnforcementAndRules(securityIdentity *identity
 			return true, true, matchingRules
 		}
 
+		// Namespaces that are part of a staged default-deny rollout are
+		// default deny even without matching rules. During the audit phase
+		// the endpoints additionally run in policy audit mode, so that
+		// verdicts are logged instead of enforced.
+		if ns := lbls.Get(podNamespaceLabel); ns != "" {
+			if _, ok := DefaultDenyRolloutPhase(ns); ok {
+				return true, true, matchingRules
+			}
+		}
+
 		// Default mode means that if rules contain labels that match this
 		// endpoint, then enable policy enforcement for this endpoint.
 		return ingress, egress, matchingRules
@@ -5,9 +5,12 @@ package policy
 
 import (
 	"bytes"
+	"encoding/json"
 	"fmt"
 	stdlog "log"
+	"strings"
 	"testing"
+	"time"
 
 	. "gopkg.in/check.v1"
 
@@ -223,6 +226,16 @@ func (ds *PolicyTestSuite) TestComputePolicyEnforcementAndRules(c *C) {
 	c.Assert(egress, Equals, true)
 	c.Assert(matchingRules, checker.DeepEquals, ruleSlice{}, Commentf("no rules should be returned since policy enforcement is disabled"))
 
+	// Endpoints in a namespace that is part of a staged default-deny
+	// rollout are default deny even without matching rules.
+	defer SetDefaultDenyRollout(nil, 0)
+	SetDefaultDenyRollout([]string{"rollout-ns"}, time.Hour)
+	rolloutIdentity := identity.NewIdentityFromLabelArray(identity.NumericIdentity(9002),
+		labels.LabelArray{labels.NewLabel(k8sConst.PodNamespaceLabel, "rollout-ns", labels.LabelSourceK8s)})
+	ingress, egress, _ = repo.computePolicyEnforcementAndRules(rolloutIdentity)
+	c.Assert(ingress, Equals, true)
+	c.Assert(egress, Equals, true)
+
 	// Check that the "always" and "never" modes are not affected.
 	SetPolicyEnabled(option.AlwaysEnforce)
 	ingress, egress, _ = repo.computePolicyEnforcementAndRules(initIdentity)
@@ -236,6 +249,46 @@ func (ds *PolicyTestSuite) TestComputePolicyEnforcementAndRules(c *C) {
 
 }
 
+func (ds *PolicyTestSuite) TestGetExpiredRuleLabels(c *C) {
+	repo := NewPolicyRepository(nil, nil, nil)
+	repo.selectorCache = testSelectorCache
+
+	now := time.Now()
+
+	expiredLbls := labels.LabelArray{
+		labels.ParseLabel("exception"),
+		api.NewExpirationLabel(now.Add(-time.Minute)),
+	}
+	expiredRule := api.Rule{
+		EndpointSelector: api.NewESFromLabels(labels.ParseSelectLabel("foo")),
+		Labels:           expiredLbls,
+	}
+	pendingRule := api.Rule{
+		EndpointSelector: api.NewESFromLabels(labels.ParseSelectLabel("foo")),
+		Labels: labels.LabelArray{
+			labels.ParseLabel("exception"),
+			api.NewExpirationLabel(now.Add(time.Hour)),
+		},
+	}
+	permanentRule := api.Rule{
+		EndpointSelector: api.NewESFromLabels(labels.ParseSelectLabel("bar")),
+		Labels:           labels.LabelArray{labels.ParseLabel("permanent")},
+	}
+
+	for _, r := range []api.Rule{expiredRule, pendingRule, permanentRule} {
+		_, _, err := repo.Add(r, []Endpoint{})
+		c.Assert(err, IsNil)
+	}
+
+	// Only the rule with a deadline in the past is reported.
+	expired := repo.GetExpiredRuleLabels(now)
+	c.Assert(expired, HasLen, 1)
+	c.Assert(expired[0], checker.DeepEquals, expiredLbls)
+
+	// Once the deadline of the pending rule has passed, it is reported too.
+	c.Assert(repo.GetExpiredRuleLabels(now.Add(2*time.Hour)), HasLen, 2)
+}
+
 func (ds *PolicyTestSuite) TestAddSearchDelete(c *C) {
 	repo := NewPolicyRepository(nil, nil, nil)
 	repo.selectorCache = testSelectorCache
@@ -322,6 +375,63 @@ func (ds *PolicyTestSuite) TestAddSearchDelete(c *C) {
 	repo.Mutex.RUnlock()
 }
 
+func (ds *PolicyTestSuite) TestRuleUsage(c *C) {
+	repo := NewPolicyRepository(nil, nil, nil)
+	repo.selectorCache = testSelectorCache
+
+	tag1 := labels.LabelArray{labels.ParseLabel("tag1")}
+	rule1 := api.Rule{
+		EndpointSelector: api.NewESFromLabels(labels.ParseSelectLabel("bar")),
+		Ingress: []api.IngressRule{
+			{
+				IngressCommonRule: api.IngressCommonRule{
+					FromEndpoints: []api.EndpointSelector{
+						api.NewESFromLabels(labels.ParseSelectLabel("foo")),
+					},
+				},
+			},
+		},
+		Labels: tag1,
+	}
+
+	_, _, err := repo.Add(rule1, []Endpoint{})
+	c.Assert(err, IsNil)
+
+	repo.Mutex.RLock()
+	defer repo.Mutex.RUnlock()
+
+	// the rule has not matched anything yet
+	matchCount, lastMatch := repo.rules[0].metadata.usage()
+	c.Assert(matchCount, Equals, uint64(0))
+	c.Assert(lastMatch.IsZero(), Equals, true)
+
+	jsonPolicy, numRules := repo.JSONMarshalRulesWithUsageRLocked(tag1)
+	c.Assert(numRules, Equals, 1)
+	c.Assert(strings.Contains(jsonPolicy, `"matchCount": 0`), Equals, true)
+	c.Assert(strings.Contains(jsonPolicy, `"lastMatch"`), Equals, false)
+
+	// resolving a policy for a subject selected by the rule records a match
+	c.Assert(repo.AllowsIngressRLocked(&SearchContext{
+		From: labels.ParseSelectLabelArray("foo"),
+		To:   labels.ParseSelectLabelArray("bar"),
+	}), Equals, api.Allowed)
+
+	matchCount, lastMatch = repo.rules[0].metadata.usage()
+	c.Assert(matchCount, Equals, uint64(1))
+	c.Assert(lastMatch.IsZero(), Equals, false)
+
+	jsonPolicy, numRules = repo.JSONMarshalRulesWithUsageRLocked(tag1)
+	c.Assert(numRules, Equals, 1)
+	c.Assert(strings.Contains(jsonPolicy, `"matchCount": 1`), Equals, true)
+	c.Assert(strings.Contains(jsonPolicy, `"lastMatch"`), Equals, true)
+
+	// the annotated representation can still be parsed as a list of rules
+	var rules api.Rules
+	err = json.Unmarshal([]byte(jsonPolicy), &rules)
+	c.Assert(err, IsNil)
+	c.Assert(rules, HasLen, 1)
+}
+
 func BenchmarkParseLabel(b *testing.B) {
 	repo := NewPolicyRepository(nil, nil, nil)
 	repo.selectorCache = testSelectorCache
@@ -6,11 +6,13 @@ package policy
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/cilium/cilium/pkg/identity"
 	slim_metav1 "github.com/cilium/cilium/pkg/k8s/slim/k8s/apis/meta/v1"
 	"github.com/cilium/cilium/pkg/labels"
 	"github.com/cilium/cilium/pkg/lock"
+	"github.com/cilium/cilium/pkg/metrics"
 	"github.com/cilium/cilium/pkg/option"
 	"github.com/cilium/cilium/pkg/policy/api"
 	"github.com/cilium/cilium/pkg/policy/api/kafka"
@@ -29,6 +31,15 @@ type ruleMetadata struct {
 	// IdentitySelected is a cache that maps from an identity to whether
 	// this rule selects that identity.
 	IdentitySelected map[identity.NumericIdentity]bool
+
+	// MatchCount is the number of times this rule has matched a subject
+	// while resolving endpoint policies.
+	MatchCount uint64
+
+	// LastMatch is the last time this rule matched a subject while
+	// resolving endpoint policies. The zero value means the rule has
+	// never matched.
+	LastMatch time.Time
 }
 
 func newRuleMetadata() *ruleMetadata {
@@ -43,6 +54,28 @@ func (m *ruleMetadata) delete(identity *identity.Identity) {
 	delete(m.IdentitySelected, identity.ID)
 }
 
+// recordMatch bumps the match count of the rule and updates its last match
+// timestamp.
+func (m *ruleMetadata) recordMatch() {
+	if m == nil {
+		return
+	}
+	m.Mutex.Lock()
+	defer m.Mutex.Unlock()
+	if m.MatchCount == 0 {
+		metrics.PolicyRulesNeverMatched.Dec()
+	}
+	m.MatchCount++
+	m.LastMatch = time.Now()
+}
+
+// usage returns the match count and last match timestamp of the rule.
+func (m *ruleMetadata) usage() (uint64, time.Time) {
+	m.Mutex.Lock()
+	defer m.Mutex.Unlock()
+	return m.MatchCount, m.LastMatch
+}
+
 func (r *rule) String() string {
 	return r.EndpointSelector.String()
 }
@@ -412,6 +445,9 @@ func mergeIngress(policyCtx PolicyContext, ctx *SearchContext, fromEndpoints api
 				for _, l7 := range pr.Rules.HTTP {
 					ctx.PolicyTrace("          %+v\n", l7)
 				}
+				for _, l7 := range pr.Rules.GRPC {
+					ctx.PolicyTrace("          %+v\n", l7)
+				}
 				for _, l7 := range pr.Rules.Kafka {
 					ctx.PolicyTrace("          %+v\n", l7)
 				}
@@ -545,6 +581,7 @@ func (r *rule) resolveIngressPolicy(
 	}
 
 	if found+foundDeny > 0 {
+		r.metadata.recordMatch()
 		if found != 0 {
 			state.matchedRules++
 		}
@@ -631,6 +668,9 @@ func mergeEgress(policyCtx PolicyContext, ctx *SearchContext, toEndpoints api.En
 				for _, l7 := range pr.Rules.HTTP {
 					ctx.PolicyTrace("          %+v\n", l7)
 				}
+				for _, l7 := range pr.Rules.GRPC {
+					ctx.PolicyTrace("          %+v\n", l7)
+				}
 				for _, l7 := range pr.Rules.Kafka {
 					ctx.PolicyTrace("          %+v\n", l7)
 				}
@@ -766,6 +806,7 @@ func (r *rule) resolveEgressPolicy(
 	}
 
 	if found+foundDeny > 0 {
+		r.metadata.recordMatch()
 		if found != 0 {
 			state.matchedRules++
 		}
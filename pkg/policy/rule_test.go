@@ -1221,6 +1221,51 @@ func (ds *PolicyTestSuite) TestICMPPolicy(c *C) {
 	c.Assert(res, checker.Equals, expected)
 	c.Assert(ingressState.selectedRules, Equals, 1)
 	c.Assert(ingressState.matchedRules, Equals, 1)
+
+	res.Detach(testSelectorCache)
+	expected.Detach(testSelectorCache)
+
+	// A rule for ICMP with a code, carried in the lower byte of the port
+	icmpCode := uint8(1)
+	rule4 := &rule{
+		Rule: api.Rule{
+			EndpointSelector: api.NewESFromLabels(labels.ParseSelectLabel("bar")),
+			Ingress: []api.IngressRule{
+				{
+					ICMPs: api.ICMPRules{{
+						Fields: []api.ICMPField{{
+							Type: 3,
+							Code: &icmpCode,
+						}},
+					}},
+				},
+			},
+		},
+	}
+
+	expected = NewL4Policy(0)
+	expected.Ingress["769/ICMP"] = &L4Filter{
+		Port:     3<<8 | 1,
+		Protocol: api.ProtoICMP,
+		U8Proto:  u8proto.ProtoIDs["icmp"],
+		Ingress:  true,
+		wildcard: wildcardCachedSelector,
+		L7RulesPerSelector: L7DataMap{
+			wildcardCachedSelector: nil,
+		},
+		DerivedFromRules: labels.LabelArrayList{nil},
+	}
+
+	ingressState = traceState{}
+	res = NewL4Policy(0)
+	res.Ingress, err =
+		rule4.resolveIngressPolicy(testPolicyContext, toBar, &ingressState, L4PolicyMap{}, nil, nil)
+	c.Assert(err, IsNil)
+	c.Assert(res.Ingress, Not(IsNil))
+
+	c.Assert(res, checker.Equals, expected)
+	c.Assert(ingressState.selectedRules, Equals, 1)
+	c.Assert(ingressState.matchedRules, Equals, 1)
 }
 
 // Tests the restrictions of combining certain label-based L3 and L4 policies.
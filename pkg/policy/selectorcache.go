@@ -196,6 +196,17 @@ type userNotification struct {
 	wg       *sync.WaitGroup
 }
 
+const (
+	// minSelectorsPerShard is the minimum number of selectors each worker
+	// must have to process for sharding an incremental identity update to
+	// be worthwhile. Below this the update is applied inline.
+	minSelectorsPerShard = 64
+
+	// maxSelectorUpdateShards bounds the number of workers processing an
+	// incremental identity update in parallel.
+	maxSelectorUpdateShards = 8
+)
+
 // SelectorCache caches identities, identity selectors, and the
 // subsets of identities each selector selects.
 type SelectorCache struct {
@@ -1034,33 +1045,110 @@ func (sc *SelectorCache) UpdateIdentities(added, deleted cache.IdentityCache, wg
 
 	if len(deleted)+len(added) > 0 {
 		// Iterate through all locally used identity selectors and
-		// update the cached numeric identities as required.
+		// update the cached numeric identities as required. Selectors
+		// are independent of each other, so during identity churn
+		// storms the updates are sharded across a bounded number of
+		// workers to speed up policy convergence. The cache mutex is
+		// held for the duration of the update either way.
+		selectors := make([]identitySelector, 0, len(sc.selectors))
 		for _, sel := range sc.selectors {
-			var adds, dels []identity.NumericIdentity
-			switch idSel := sel.(type) {
-			case *labelIdentitySelector:
-				for numericID := range deleted {
-					if _, exists := idSel.cachedSelections[numericID]; exists {
-						dels = append(dels, numericID)
-						delete(idSel.cachedSelections, numericID)
-					}
+			selectors = append(selectors, sel)
+		}
+
+		shards := len(selectors) / minSelectorsPerShard
+		if shards > maxSelectorUpdateShards {
+			shards = maxSelectorUpdateShards
+		}
+		if shards <= 1 {
+			sc.updateSelectorShard(selectors, added, deleted, wg)
+		} else {
+			var shardWG sync.WaitGroup
+			for i := 0; i < shards; i++ {
+				start := i * len(selectors) / shards
+				end := (i + 1) * len(selectors) / shards
+				shardWG.Add(1)
+				go func(shard []identitySelector) {
+					defer shardWG.Done()
+					sc.updateSelectorShard(shard, added, deleted, wg)
+				}(selectors[start:end])
+			}
+			shardWG.Wait()
+		}
+	}
+
+	// Deleted identities are no longer relevant to the node.
+	forgetRelevantIdentities(deleted)
+}
+
+// updateSelectorShard applies the added and deleted identities to the given
+// shard of selectors. Distinct selectors do not share any mutable state, so
+// multiple shards may be processed in parallel while the caller holds the
+// cache mutex. User notifications are queued through the notification queue,
+// which performs its own locking.
+func (sc *SelectorCache) updateSelectorShard(selectors []identitySelector, added, deleted cache.IdentityCache, wg *sync.WaitGroup) {
+	for _, sel := range selectors {
+		var adds, dels []identity.NumericIdentity
+		switch idSel := sel.(type) {
+		case *labelIdentitySelector:
+			for numericID := range deleted {
+				if _, exists := idSel.cachedSelections[numericID]; exists {
+					dels = append(dels, numericID)
+					delete(idSel.cachedSelections, numericID)
 				}
-				for numericID := range added {
-					if _, exists := idSel.cachedSelections[numericID]; !exists {
-						if idSel.matches(sc.idCache[numericID]) {
-							adds = append(adds, numericID)
-							idSel.cachedSelections[numericID] = struct{}{}
-						}
+			}
+			for numericID := range added {
+				if _, exists := idSel.cachedSelections[numericID]; !exists {
+					if idSel.matches(sc.idCache[numericID]) {
+						adds = append(adds, numericID)
+						idSel.cachedSelections[numericID] = struct{}{}
 					}
 				}
-				if len(dels)+len(adds) > 0 {
-					idSel.updateSelections()
-					idSel.notifyUsers(sc, adds, dels, wg)
-				}
-			case *fqdnSelector:
-				// This is a no-op right now. We don't encode in the identities
-				// which FQDNs they correspond to.
 			}
+			if len(dels)+len(adds) > 0 {
+				idSel.updateSelections()
+				idSel.notifyUsers(sc, adds, dels, wg)
+			}
+		case *fqdnSelector:
+			// This is a no-op right now. We don't encode in the identities
+			// which FQDNs they correspond to.
+		}
+	}
+}
+
+// NotifyRelevantIdentities re-announces the given identities to the users of
+// all selectors that select them. This is used when an identity becomes
+// relevant to the node after policies have been computed, to fill in the
+// policy map entries that were skipped for it.
+//
+// The caller is responsible for marking the identities relevant beforehand,
+// see MarkIdentitiesRelevant.
+//
+// Caller should Wait() on the WaitGroup before triggering any policy
+// updates, see UpdateIdentities.
+func (sc *SelectorCache) NotifyRelevantIdentities(identities []identity.NumericIdentity, wg *sync.WaitGroup) {
+	ids := make(map[identity.NumericIdentity]struct{}, len(identities))
+	for _, nid := range identities {
+		ids[nid] = struct{}{}
+	}
+
+	sc.mutex.Lock()
+	defer sc.mutex.Unlock()
+
+	for _, sel := range sc.selectors {
+		// Skip wildcard selectors, as datapath and L7 proxies do not
+		// need enumeration of all ids for L3 wildcard. This mirrors the
+		// per-selector logic in ToMapState().
+		if sel.IsWildcard() {
+			continue
+		}
+		var adds []identity.NumericIdentity
+		for _, nid := range sel.GetSelections() {
+			if _, exists := ids[nid]; exists {
+				adds = append(adds, nid)
+			}
+		}
+		if len(adds) > 0 {
+			sel.notifyUsers(sc, adds, nil, wg)
 		}
 	}
 }
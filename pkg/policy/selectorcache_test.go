@@ -4,6 +4,7 @@
 package policy
 
 import (
+	"fmt"
 	"sync"
 
 	. "gopkg.in/check.v1"
@@ -398,6 +399,46 @@ func (ds *SelectorCacheTestSuite) TestIdentityUpdates(c *C) {
 	c.Assert(len(sc.selectors), Equals, 0)
 }
 
+func (ds *SelectorCacheTestSuite) TestIdentityUpdatesSharded(c *C) {
+	sc := testNewSelectorCache(cache.IdentityCache{})
+
+	// Add enough selectors for identity updates to be fanned out to
+	// multiple shards.
+	numSelectors := 4 * minSelectorsPerShard
+	user1 := newUser(c, "user1", sc)
+	cached := make([]CachedSelector, 0, numSelectors)
+	for i := 0; i < numSelectors; i++ {
+		sel := api.NewESFromLabels(labels.NewLabel("app", fmt.Sprintf("test%d", i), labels.LabelSourceK8s))
+		cached = append(cached, user1.AddIdentitySelector(sel))
+	}
+
+	addedIDs := cache.IdentityCache{}
+	for i := 0; i < numSelectors; i++ {
+		addedIDs[identity.NumericIdentity(1000+i)] = labels.Labels{
+			"app": labels.NewLabel("app", fmt.Sprintf("test%d", i), labels.LabelSourceK8s),
+		}.LabelArray()
+	}
+	wg := &sync.WaitGroup{}
+	sc.UpdateIdentities(addedIDs, nil, wg)
+	wg.Wait()
+
+	// Each selector selects exactly the identity carrying its label.
+	for i, cs := range cached {
+		selections := cs.GetSelections()
+		c.Assert(len(selections), Equals, 1)
+		c.Assert(selections[0], Equals, identity.NumericIdentity(1000+i))
+	}
+
+	// Deletions are fanned out the same way.
+	wg = &sync.WaitGroup{}
+	sc.UpdateIdentities(nil, addedIDs, wg)
+	wg.Wait()
+
+	for _, cs := range cached {
+		c.Assert(len(cs.GetSelections()), Equals, 0)
+	}
+}
+
 func (ds *SelectorCacheTestSuite) TestFQDNSelectorUpdates(c *C) {
 	sc := testNewSelectorCache(cache.IdentityCache{})
 
@@ -0,0 +1,6 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+// Package xdpfilter mirrors CiliumXDPFilter resources into the XDP prefilter
+// CIDR maps and the XDP port filter map.
+package xdpfilter
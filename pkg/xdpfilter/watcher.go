@@ -0,0 +1,193 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package xdpfilter
+
+import (
+	"net"
+	"reflect"
+
+	"golang.org/x/sys/unix"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/cilium/cilium/pkg/datapath"
+	"github.com/cilium/cilium/pkg/k8s/apis/cilium.io/v2alpha1"
+	"github.com/cilium/cilium/pkg/k8s/client"
+	"github.com/cilium/cilium/pkg/k8s/informer"
+	"github.com/cilium/cilium/pkg/k8s/utils"
+	"github.com/cilium/cilium/pkg/logging"
+	"github.com/cilium/cilium/pkg/logging/logfields"
+	"github.com/cilium/cilium/pkg/maps/xdpportmap"
+)
+
+var log = logging.DefaultLogger.WithField(logfields.LogSubsys, "xdp-filter")
+
+// K8sEventRegister is used to do metrics accounting for the filter watcher's
+// Kubernetes events.
+type K8sEventRegister interface {
+	// K8sEventReceived is called to do metrics accounting for received
+	// Kubernetes events, as well as calculating timeouts for k8s watcher
+	// cache sync.
+	K8sEventReceived(apiGroupResourceName string, scope string, action string, valid, equal bool)
+
+	// K8sEventProcessed is called to do metrics accounting for each processed
+	// Kubernetes event.
+	K8sEventProcessed(scope string, action string, status bool)
+}
+
+// tcpFlagBits translates the TCP flag names of a XDPPortFilter into the mask
+// stored in the port filter map.
+func tcpFlagBits(flags []v2alpha1.TCPFlag) uint8 {
+	var bits uint8
+
+	for _, flag := range flags {
+		switch flag {
+		case "FIN":
+			bits |= xdpportmap.TCPFlagFIN
+		case "SYN":
+			bits |= xdpportmap.TCPFlagSYN
+		case "RST":
+			bits |= xdpportmap.TCPFlagRST
+		case "PSH":
+			bits |= xdpportmap.TCPFlagPSH
+		case "ACK":
+			bits |= xdpportmap.TCPFlagACK
+		case "URG":
+			bits |= xdpportmap.TCPFlagURG
+		}
+	}
+
+	return bits
+}
+
+func protoNumber(protocol string) (uint8, bool) {
+	switch protocol {
+	case "TCP":
+		return unix.IPPROTO_TCP, true
+	case "UDP":
+		return unix.IPPROTO_UDP, true
+	}
+
+	return 0, false
+}
+
+// sourceCIDRs parses the source CIDRs of a CiliumXDPFilter. Invalid entries
+// are logged and skipped so that a single typo does not stall the remaining
+// rules.
+func sourceCIDRs(filter *v2alpha1.CiliumXDPFilter) []net.IPNet {
+	cidrs := make([]net.IPNet, 0, len(filter.Spec.SourceCIDRs))
+	for _, c := range filter.Spec.SourceCIDRs {
+		_, cidr, err := net.ParseCIDR(c)
+		if err != nil {
+			log.WithError(err).WithField("filter", filter.Name).
+				Warningf("Ignoring invalid source CIDR %q", c)
+			continue
+		}
+		cidrs = append(cidrs, *cidr)
+	}
+
+	return cidrs
+}
+
+func applyFilter(pf datapath.PreFilter, filter *v2alpha1.CiliumXDPFilter) {
+	if cidrs := sourceCIDRs(filter); len(cidrs) > 0 {
+		if err := pf.Insert(0, cidrs); err != nil {
+			log.WithError(err).WithField("filter", filter.Name).
+				Warning("Unable to add source CIDRs to the XDP prefilter")
+		}
+	}
+
+	for _, port := range filter.Spec.Ports {
+		proto, ok := protoNumber(port.Protocol)
+		if !ok {
+			continue
+		}
+		if err := xdpportmap.Update(port.Port, proto, tcpFlagBits(port.TCPFlags)); err != nil {
+			log.WithError(err).WithField("filter", filter.Name).
+				Warningf("Unable to add port filter for port %d to BPF map", port.Port)
+		}
+	}
+}
+
+func removeFilter(pf datapath.PreFilter, filter *v2alpha1.CiliumXDPFilter) {
+	if cidrs := sourceCIDRs(filter); len(cidrs) > 0 {
+		if err := pf.Delete(0, cidrs); err != nil {
+			log.WithError(err).WithField("filter", filter.Name).
+				Warning("Unable to remove source CIDRs from the XDP prefilter")
+		}
+	}
+
+	for _, port := range filter.Spec.Ports {
+		proto, ok := protoNumber(port.Protocol)
+		if !ok {
+			continue
+		}
+		if err := xdpportmap.Delete(port.Port, proto); err != nil {
+			log.WithError(err).WithField("filter", filter.Name).
+				Warningf("Unable to remove port filter for port %d from BPF map", port.Port)
+		}
+	}
+}
+
+// WatchFilters starts an informer on the cluster-wide CiliumXDPFilter
+// resources and mirrors them into the XDP prefilter CIDR maps and the XDP
+// port filter map consulted before the main datapath.
+func WatchFilters(clientset client.Clientset, k8sEventReg K8sEventRegister, pf datapath.PreFilter) {
+	apiGroup := "cilium/v2alpha1::CiliumXDPFilter"
+	_, filterInformer := informer.NewInformer(
+		utils.ListerWatcherFromTyped[*v2alpha1.CiliumXDPFilterList](clientset.CiliumV2alpha1().CiliumXDPFilters()),
+		&v2alpha1.CiliumXDPFilter{},
+		0,
+		cache.ResourceEventHandlerFuncs{
+			AddFunc: func(obj interface{}) {
+				var valid bool
+				defer func() { k8sEventReg.K8sEventReceived(apiGroup, "CiliumXDPFilter", "create", valid, false) }()
+				if filter, ok := obj.(*v2alpha1.CiliumXDPFilter); ok {
+					valid = true
+					applyFilter(pf, filter)
+					k8sEventReg.K8sEventProcessed("CiliumXDPFilter", "create", true)
+				} else {
+					log.Warningf("Unknown CiliumXDPFilter object type %s received: %+v", reflect.TypeOf(obj), obj)
+				}
+			},
+			UpdateFunc: func(oldObj, newObj interface{}) {
+				var valid, equal bool
+				defer func() { k8sEventReg.K8sEventReceived(apiGroup, "CiliumXDPFilter", "update", valid, equal) }()
+				if oldFilter, ok := oldObj.(*v2alpha1.CiliumXDPFilter); ok {
+					if newFilter, ok := newObj.(*v2alpha1.CiliumXDPFilter); ok {
+						valid = true
+						if oldFilter.Spec.DeepEqual(&newFilter.Spec) {
+							equal = true
+							return
+						}
+						removeFilter(pf, oldFilter)
+						applyFilter(pf, newFilter)
+						k8sEventReg.K8sEventProcessed("CiliumXDPFilter", "update", true)
+					}
+				}
+			},
+			DeleteFunc: func(obj interface{}) {
+				var valid bool
+				defer func() { k8sEventReg.K8sEventReceived(apiGroup, "CiliumXDPFilter", "delete", valid, false) }()
+				filter, ok := obj.(*v2alpha1.CiliumXDPFilter)
+				if !ok {
+					deletedObj, ok := obj.(cache.DeletedFinalStateUnknown)
+					if !ok {
+						return
+					}
+					filter, ok = deletedObj.Obj.(*v2alpha1.CiliumXDPFilter)
+					if !ok {
+						return
+					}
+				}
+				valid = true
+				removeFilter(pf, filter)
+				k8sEventReg.K8sEventProcessed("CiliumXDPFilter", "delete", true)
+			},
+		},
+		nil,
+	)
+
+	go filterInformer.Run(wait.NeverStop)
+}
@@ -495,7 +495,9 @@ func cmdAdd(args *skel.CmdArgs) (err error) {
 			peer      netlink.Link
 			tmpIfName string
 		)
-		veth, peer, tmpIfName, err = connector.SetupVeth(ep.ContainerID, int(conf.DeviceMTU), int(conf.GROMaxSize), int(conf.GSOMaxSize), ep)
+		veth, peer, tmpIfName, err = connector.SetupVeth(ep.ContainerID, int(conf.DeviceMTU),
+			int(conf.GROMaxSize), int(conf.GSOMaxSize),
+			int(conf.GROIPV4MaxSize), int(conf.GSOIPV4MaxSize), ep)
 		if err != nil {
 			err = fmt.Errorf("unable to set up veth on host side: %s", err)
 			return err
@@ -560,7 +562,7 @@ func cmdAdd(args *skel.CmdArgs) (err error) {
 	}
 
 	switch conf.IpamMode {
-	case ipamOption.IPAMENI, ipamOption.IPAMAzure, ipamOption.IPAMAlibabaCloud:
+	case ipamOption.IPAMENI, ipamOption.IPAMAzure, ipamOption.IPAMAlibabaCloud, ipamOption.IPAMOpenStack:
 		err = interfaceAdd(ipConfig, ipam.IPV4, conf)
 		if err != nil {
 			err = fmt.Errorf("unable to setup interface datapath: %s", err)
@@ -570,6 +572,12 @@ func cmdAdd(args *skel.CmdArgs) (err error) {
 
 	var macAddrStr string
 	if err = netNs.Do(func(_ ns.NetNS) error {
+		// Retrieve the cookie of the container netns. SO_NETNS_COOKIE
+		// is not available on older kernels, in which case the cookie
+		// is left unset.
+		if cookie, err := netns.GetNetNSCookie(); err == nil {
+			ep.NetnsCookie = int64(cookie)
+		}
 		if ipv6IsEnabled(ipam) {
 			if err := sysctl.Disable("net.ipv6.conf.all.disable_ipv6"); err != nil {
 				logger.WithError(err).Warn("unable to enable ipv6 on all interfaces")
@@ -588,6 +596,29 @@ func cmdAdd(args *skel.CmdArgs) (err error) {
 		Sandbox: args.Netns,
 	})
 
+	if n.TapMode {
+		// Bridge the endpoint interface to a tap device for VM
+		// workloads, e.g. KubeVirt guests on a secondary network. The
+		// addresses configured on the endpoint interface are only
+		// reported in the result, the VM is expected to configure them
+		// on its own interface.
+		var bridgeIfName, tapIfName string
+		bridgeIfName, tapIfName, err = connector.SetupTapRemoteNs(netNs, args.IfName, int(conf.DeviceMTU))
+		if err != nil {
+			err = fmt.Errorf("unable to set up tap device in container namespace: %s", err)
+			return
+		}
+		logger.WithFields(logrus.Fields{
+			logfields.Bridge: bridgeIfName,
+			logfields.Tap:    tapIfName,
+		}).Debug("Bridged endpoint interface to tap device")
+
+		res.Interfaces = append(res.Interfaces, &cniTypesV1.Interface{
+			Name:    tapIfName,
+			Sandbox: args.Netns,
+		})
+	}
+
 	// Add to the result the Interface as index of Interfaces
 	for i := range res.Interfaces {
 		res.IPs[i].Interface = cniTypesV1.Int(i)
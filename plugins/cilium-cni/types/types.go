@@ -17,6 +17,7 @@ import (
 	eniTypes "github.com/cilium/cilium/pkg/aws/eni/types"
 	azureTypes "github.com/cilium/cilium/pkg/azure/types"
 	ipamTypes "github.com/cilium/cilium/pkg/ipam/types"
+	openStackTypes "github.com/cilium/cilium/pkg/openstack/eni/types"
 )
 
 // NetConf is the Cilium specific CNI network configuration
@@ -28,9 +29,15 @@ type NetConf struct {
 	Azure        azureTypes.AzureSpec   `json:"azure,omitempty"`
 	IPAM         IPAM                   `json:"ipam,omitempty"` // Shadows the JSON field "ipam" in cniTypes.NetConf.
 	AlibabaCloud alibabaCloudTypes.Spec `json:"alibaba-cloud,omitempty"`
-	EnableDebug  bool                   `json:"enable-debug"`
-	LogFormat    string                 `json:"log-format"`
-	LogFile      string                 `json:"log-file"`
+	OpenStack    openStackTypes.Spec    `json:"openstack,omitempty"`
+	// TapMode bridges the endpoint interface to a tap device inside the
+	// container namespace so that VM workloads, e.g. KubeVirt guests on a
+	// secondary network, can attach to the Cilium-managed interface while
+	// policy enforcement keeps happening on the host side of the veth pair.
+	TapMode     bool   `json:"tap-mode"`
+	EnableDebug bool   `json:"enable-debug"`
+	LogFormat   string `json:"log-format"`
+	LogFile     string `json:"log-file"`
 }
 
 // IPAM is the Cilium specific CNI IPAM configuration
@@ -5,12 +5,14 @@ package kafka
 
 import (
 	"encoding/binary"
+	"strings"
 
 	cilium "github.com/cilium/proxy/go/cilium/api"
 	"github.com/sirupsen/logrus"
 
 	"github.com/cilium/cilium/pkg/flowdebug"
 	"github.com/cilium/cilium/pkg/kafka"
+	api "github.com/cilium/cilium/pkg/policy/api/kafka"
 	. "github.com/cilium/cilium/proxylib/proxylib"
 )
 
@@ -46,6 +48,12 @@ func init() {
 
 type KafkaParser struct {
 	connection *Connection
+
+	// saslTokens is the number of opaque client authentication tokens
+	// still expected after a SaslHandshake v0 request. The tokens are
+	// exchanged outside of the Kafka framing and must be passed through
+	// without being parsed as requests.
+	saslTokens int
 }
 
 func (pf *KafkaParserFactory) Create(connection *Connection) interface{} {
@@ -53,12 +61,40 @@ func (pf *KafkaParserFactory) Create(connection *Connection) interface{} {
 	return &p
 }
 
+// saslTokenRounds returns the number of opaque client authentication tokens
+// to expect after a successful SaslHandshake v0 for the given mechanism.
+// Handshake v1 wraps the tokens in SaslAuthenticate requests instead, which
+// are parsed like any other request.
+func saslTokenRounds(mechanism string) int {
+	switch strings.ToUpper(mechanism) {
+	case "SCRAM-SHA-256", "SCRAM-SHA-512":
+		return 2
+	default:
+		// PLAIN and OAUTHBEARER complete in a single round trip. This
+		// is also the best guess for unknown mechanisms; clients using
+		// multi-step mechanisms such as GSSAPI should use handshake v1.
+		return 1
+	}
+}
+
 func (p *KafkaParser) OnData(reply bool, reader *Reader) (OpType, int) {
 	length := reader.Length()
 	if length == 0 {
 		return NOP, 0
 	}
 
+	if !reply && p.saslTokens > 0 {
+		// Opaque SASL token from a v0 handshake, size-delimited but
+		// not a Kafka request frame.
+		lenbuf := make([]byte, 4)
+		n, err := reader.PeekFull(lenbuf)
+		if err != nil {
+			return MORE, 4 - n
+		}
+		p.saslTokens--
+		return PASS, 4 + int(binary.BigEndian.Uint32(lenbuf))
+	}
+
 	correlationID := int32(0)
 	framelength := 4          // account for the length field
 	lenbuf := make([]byte, 8) // Peek the first eight bytes
@@ -106,6 +142,19 @@ func (p *KafkaParser) OnData(reply bool, reader *Reader) (OpType, int) {
 		ApiKey:        int32(req.GetAPIKey()),
 		Topics:        req.GetTopics(),
 	}}
+
+	switch req.GetAPIKey() {
+	case api.APIVersionsKey, api.SaslHandshakeKey, api.SaslAuthenticateKey:
+		// Connection-level requests needed for version negotiation and
+		// authentication are always allowed; policy constrains the
+		// operations performed once the connection is set up.
+		if req.GetAPIKey() == api.SaslHandshakeKey && req.GetVersion() == 0 {
+			p.saslTokens = saslTokenRounds(req.GetSaslMechanism())
+		}
+		p.connection.Log(cilium.EntryType_Request, logEntry)
+		return PASS, framelength
+	}
+
 	if p.connection.Matches(req) {
 		p.connection.Log(cilium.EntryType_Request, logEntry)
 		return PASS, framelength
@@ -9,6 +9,8 @@ import (
 	_ "github.com/cilium/cilium/proxylib/cassandra"
 	_ "github.com/cilium/cilium/proxylib/kafka"
 	_ "github.com/cilium/cilium/proxylib/memcached"
+	_ "github.com/cilium/cilium/proxylib/mysql"
+	_ "github.com/cilium/cilium/proxylib/postgres"
 	_ "github.com/cilium/cilium/proxylib/r2d2"
 	_ "github.com/cilium/cilium/proxylib/testparsers"
 
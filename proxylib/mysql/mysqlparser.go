@@ -0,0 +1,362 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package mysql
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"regexp"
+	"strings"
+
+	cilium "github.com/cilium/proxy/go/cilium/api"
+	"github.com/sirupsen/logrus"
+
+	"github.com/cilium/cilium/proxylib/proxylib"
+)
+
+//
+// MySQL Parser
+//
+// Spec: https://dev.mysql.com/doc/dev/mysql-server/latest/page_protocol_basics.html
+//
+
+// Current MySQL parser supports filtering on the user and database of a
+// session, taken from the handshake response, and on the statement class of
+// COM_QUERY commands. The statement class is derived from the first keyword
+// of the query: 'select', 'insert', 'update', 'delete', 'ddl' (CREATE,
+// ALTER, DROP, TRUNCATE, GRANT and REVOKE) or 'other'.
+//
+// Policy Examples:
+// {statement : "select"} - Allow reads, no other statements.
+// {database : "reporting"} - Allow any statement on the reporting database.
+// {user : "batch-.*", statement : "insert"} - Allow batch users to insert.
+//
+// Encrypted sessions cannot be parsed, so handshake responses requesting TLS
+// are denied with an error packet. COM_INIT_DB commands update the database
+// of the session and are automatically allowed, as are all other non-query
+// commands, to simplify the policy language.
+
+type mysqlRule struct {
+	userRegexCompiled     *regexp.Regexp
+	databaseRegexCompiled *regexp.Regexp
+	statementExact        string
+}
+
+type mysqlRequestData struct {
+	user      string
+	database  string
+	statement string
+}
+
+const mysqlHdrLen = 4        // payload length (uint24), sequence id (uint8)
+const mysqlMaxLen = 16777215 // per spec, larger payloads are split
+
+const (
+	comInitDB = 0x02
+	comQuery  = 0x03
+
+	clientConnectWithDB    = 0x00000008
+	clientSSL              = 0x00000800
+	clientPluginAuthLenEnc = 0x00200000
+)
+
+// statementClasses are the valid values of a 'statement' rule.
+var statementClasses = map[string]bool{
+	"select": true,
+	"insert": true,
+	"update": true,
+	"delete": true,
+	"ddl":    true,
+	"other":  true,
+}
+
+// statementClass returns the statement class of the given query based on its
+// first keyword.
+func statementClass(query string) string {
+	fields := strings.Fields(query)
+	if len(fields) == 0 {
+		return "other"
+	}
+	switch strings.ToUpper(fields[0]) {
+	case "SELECT", "WITH":
+		return "select"
+	case "INSERT":
+		return "insert"
+	case "UPDATE":
+		return "update"
+	case "DELETE":
+		return "delete"
+	case "CREATE", "ALTER", "DROP", "TRUNCATE", "GRANT", "REVOKE":
+		return "ddl"
+	default:
+		return "other"
+	}
+}
+
+func (rule *mysqlRule) Matches(data interface{}) bool {
+	reqData, ok := data.(mysqlRequestData)
+	if !ok {
+		logrus.Warning("Matches() called with type other than mysqlRequestData")
+		return false
+	}
+	if rule.userRegexCompiled != nil &&
+		!rule.userRegexCompiled.MatchString(reqData.user) {
+		logrus.Debugf("MySQLRule: user mismatch %s, %s", rule.userRegexCompiled.String(), reqData.user)
+		return false
+	}
+	if rule.databaseRegexCompiled != nil &&
+		!rule.databaseRegexCompiled.MatchString(reqData.database) {
+		logrus.Debugf("MySQLRule: database mismatch %s, %s", rule.databaseRegexCompiled.String(), reqData.database)
+		return false
+	}
+	if len(rule.statementExact) > 0 && rule.statementExact != reqData.statement {
+		logrus.Debugf("MySQLRule: statement mismatch %s, %s", rule.statementExact, reqData.statement)
+		return false
+	}
+	return true
+}
+
+// ruleParser parses protobuf L7 rules to enforcement objects
+// May panic
+func ruleParser(rule *cilium.PortNetworkPolicyRule) []proxylib.L7NetworkPolicyRule {
+	l7Rules := rule.GetL7Rules()
+	if l7Rules == nil {
+		return nil
+	}
+
+	allowRules := l7Rules.GetL7AllowRules()
+	rules := make([]proxylib.L7NetworkPolicyRule, 0, len(allowRules))
+	for _, l7Rule := range allowRules {
+		var mr mysqlRule
+		for k, v := range l7Rule.Rule {
+			switch k {
+			case "user":
+				if v != "" {
+					mr.userRegexCompiled = regexp.MustCompile(v)
+				}
+			case "database":
+				if v != "" {
+					mr.databaseRegexCompiled = regexp.MustCompile(v)
+				}
+			case "statement":
+				mr.statementExact = v
+			default:
+				proxylib.ParseError(fmt.Sprintf("Unsupported key: %s", k), rule)
+			}
+		}
+		if mr.statementExact != "" && !statementClasses[mr.statementExact] {
+			proxylib.ParseError(fmt.Sprintf("Unable to parse L7 mysql rule with invalid statement: '%s'", mr.statementExact), rule)
+		}
+		logrus.Debugf("Parsed MySQLRule pair: %v", mr)
+		rules = append(rules, &mr)
+	}
+	return rules
+}
+
+type factory struct{}
+
+func init() {
+	logrus.Debug("init(): Registering mysqlParserFactory")
+	proxylib.RegisterParserFactory("mysql", &factory{})
+	proxylib.RegisterL7RuleParser("mysql", ruleParser)
+}
+
+type parser struct {
+	connection *proxylib.Connection
+
+	// handshakeDone is set once the handshake response has been seen,
+	// subsequent request packets with sequence id 0 are commands.
+	handshakeDone bool
+
+	// user and database of the session, from the handshake response.
+	user     string
+	database string
+}
+
+func (f *factory) Create(connection *proxylib.Connection) interface{} {
+	logrus.Debugf("MySQLParserFactory: Create: %v", connection)
+
+	return &parser{connection: connection}
+}
+
+func (p *parser) OnData(reply, endStream bool, dataArray [][]byte) (proxylib.OpType, int) {
+
+	// inefficient, but simple for now
+	data := bytes.Join(dataArray, []byte{})
+
+	// we don't process reply traffic for now, the server speaks first
+	if reply {
+		if len(data) == 0 {
+			return proxylib.NOP, 0
+		}
+		logrus.Debugf("reply, passing %d bytes", len(data))
+		return proxylib.PASS, len(data)
+	}
+
+	if len(data) < mysqlHdrLen {
+		// Partial header received, ask for more
+		return proxylib.MORE, mysqlHdrLen - len(data)
+	}
+
+	payloadLen := int(data[0]) | int(data[1])<<8 | int(data[2])<<16
+	seq := data[3]
+	requestLen := mysqlHdrLen + payloadLen
+
+	dataMissing := requestLen - len(data)
+	if dataMissing > 0 {
+		logrus.Debugf("Hdr received, but need %d more bytes of packet", dataMissing)
+		return proxylib.MORE, dataMissing
+	}
+
+	payload := data[mysqlHdrLen:requestLen]
+
+	if !p.handshakeDone {
+		return p.onHandshakeResponse(payload, seq, requestLen)
+	}
+
+	if seq != 0 || len(payload) == 0 {
+		// auth continuation or an empty packet, always allowed
+		return proxylib.PASS, requestLen
+	}
+
+	switch payload[0] {
+	case comInitDB:
+		p.database = string(payload[1:])
+		logrus.Debugf("Session database changed to '%s'", p.database)
+		return proxylib.PASS, requestLen
+	case comQuery:
+	default:
+		// non-query command, always allowed
+		return proxylib.PASS, requestLen
+	}
+
+	reqData := mysqlRequestData{
+		user:      p.user,
+		database:  p.database,
+		statement: statementClass(string(payload[1:])),
+	}
+
+	matches := true
+	access_log_entry_type := cilium.EntryType_Request
+
+	if !p.connection.Matches(reqData) {
+		matches = false
+		access_log_entry_type = cilium.EntryType_Denied
+	}
+
+	p.connection.Log(access_log_entry_type,
+		&cilium.LogEntry_GenericL7{
+			GenericL7: &cilium.L7LogEntry{
+				Proto: "mysql",
+				Fields: map[string]string{
+					"user":      reqData.user,
+					"database":  reqData.database,
+					"statement": reqData.statement,
+				},
+			},
+		})
+
+	if !matches {
+		p.connection.Inject(true, errPacket(seq+1, "access denied by Cilium network policy"))
+		logrus.Debugf("Policy mismatch, dropping %d bytes", requestLen)
+		return proxylib.DROP, requestLen
+	}
+
+	return proxylib.PASS, requestLen
+}
+
+// onHandshakeResponse extracts the user and database of the session from the
+// first client packet.
+func (p *parser) onHandshakeResponse(payload []byte, seq byte, requestLen int) (proxylib.OpType, int) {
+	// client flags (uint32), max packet size (uint32), charset (uint8)
+	// and a 23 byte filler precede the user name
+	if len(payload) < 32 {
+		logrus.Errorf("Invalid handshake response of %d bytes", len(payload))
+		return proxylib.ERROR, int(proxylib.ERROR_INVALID_FRAME_TYPE)
+	}
+
+	flags := binary.LittleEndian.Uint32(payload[0:4])
+	if flags&clientSSL != 0 {
+		// Encrypted sessions cannot be parsed, deny the request
+		// before the session switches to TLS.
+		logrus.Debug("Denying TLS handshake request")
+		p.connection.Inject(true, errPacket(seq+1, "TLS sessions are not allowed by Cilium network policy"))
+		return proxylib.DROP, requestLen
+	}
+
+	rest := payload[32:]
+	user, rest, ok := cutString(rest)
+	if !ok {
+		logrus.Errorf("Invalid handshake response, no user name")
+		return proxylib.ERROR, int(proxylib.ERROR_INVALID_FRAME_TYPE)
+	}
+	p.user = user
+
+	// skip the authentication response to reach the database name
+	if flags&clientPluginAuthLenEnc != 0 {
+		authLen, n := lenEncInt(rest)
+		if n == 0 || authLen+n > len(rest) {
+			logrus.Errorf("Invalid handshake response, bad auth data")
+			return proxylib.ERROR, int(proxylib.ERROR_INVALID_FRAME_TYPE)
+		}
+		rest = rest[authLen+n:]
+	} else {
+		if len(rest) < 1 || int(rest[0])+1 > len(rest) {
+			logrus.Errorf("Invalid handshake response, bad auth data")
+			return proxylib.ERROR, int(proxylib.ERROR_INVALID_FRAME_TYPE)
+		}
+		rest = rest[int(rest[0])+1:]
+	}
+	if flags&clientConnectWithDB != 0 {
+		if database, _, ok := cutString(rest); ok {
+			p.database = database
+		}
+	}
+	p.handshakeDone = true
+
+	logrus.Debugf("Handshake response for user '%s', database '%s'", p.user, p.database)
+	return proxylib.PASS, requestLen
+}
+
+// cutString cuts a null-terminated string off the front of the given data.
+func cutString(data []byte) (string, []byte, bool) {
+	idx := bytes.IndexByte(data, 0)
+	if idx < 0 {
+		return "", data, false
+	}
+	return string(data[:idx]), data[idx+1:], true
+}
+
+// lenEncInt reads a length-encoded integer and returns its value and encoded
+// size, or a size of 0 if the data is too short.
+func lenEncInt(data []byte) (int, int) {
+	if len(data) < 1 {
+		return 0, 0
+	}
+	switch {
+	case data[0] < 0xfb:
+		return int(data[0]), 1
+	case data[0] == 0xfc && len(data) >= 3:
+		return int(binary.LittleEndian.Uint16(data[1:3])), 3
+	case data[0] == 0xfd && len(data) >= 4:
+		return int(data[1]) | int(data[2])<<8 | int(data[3])<<16, 4
+	case data[0] == 0xfe && len(data) >= 9:
+		return int(binary.LittleEndian.Uint64(data[1:9])), 9
+	}
+	return 0, 0
+}
+
+// errPacket returns an ERR packet with the given sequence id and message,
+// sent to the client when a request is denied by policy.
+func errPacket(seq byte, message string) []byte {
+	payloadLen := 9 + len(message)
+	packet := make([]byte, 0, mysqlHdrLen+payloadLen)
+	packet = append(packet,
+		byte(payloadLen), byte(payloadLen>>8), byte(payloadLen>>16), seq,
+		0xff,       // ERR header
+		0x15, 0x04, // error code 1045, access denied
+		'#', '4', '2', '0', '0', '0')
+	return append(packet, message...)
+}
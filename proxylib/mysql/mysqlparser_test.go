@@ -0,0 +1,171 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package mysql
+
+import (
+	"encoding/binary"
+	"testing"
+
+	. "gopkg.in/check.v1"
+
+	"github.com/cilium/cilium/proxylib/accesslog"
+	"github.com/cilium/cilium/proxylib/proxylib"
+	"github.com/cilium/cilium/proxylib/test"
+)
+
+// Hook up gocheck into the "go test" runner.
+func Test(t *testing.T) {
+	TestingT(t)
+}
+
+type MySQLSuite struct {
+	logServer *test.AccessLogServer
+	ins       *proxylib.Instance
+}
+
+var _ = Suite(&MySQLSuite{})
+
+// Set up access log server and Library instance for all the test cases
+func (s *MySQLSuite) SetUpSuite(c *C) {
+	s.logServer = test.StartAccessLogServer("access_log.sock", 10)
+	c.Assert(s.logServer, Not(IsNil))
+	s.ins = proxylib.NewInstance("node1", accesslog.NewClient(s.logServer.Path))
+	c.Assert(s.ins, Not(IsNil))
+}
+
+func (s *MySQLSuite) TearDownTest(c *C) {
+	s.logServer.Clear()
+}
+
+func (s *MySQLSuite) TearDownSuite(c *C) {
+	s.logServer.Close()
+}
+
+// packet returns the given payload framed with a packet header.
+func packet(seq byte, payload []byte) []byte {
+	pkt := make([]byte, 0, mysqlHdrLen+len(payload))
+	pkt = append(pkt, byte(len(payload)), byte(len(payload)>>8), byte(len(payload)>>16), seq)
+	return append(pkt, payload...)
+}
+
+// handshakeResponse returns a handshake response packet for the given user
+// and database.
+func handshakeResponse(user, database string) []byte {
+	payload := make([]byte, 32)
+	binary.LittleEndian.PutUint32(payload, clientConnectWithDB)
+	payload = append(payload, user...)
+	payload = append(payload, 0, 0) // user terminator, empty auth response
+	payload = append(payload, database...)
+	payload = append(payload, 0)
+	return packet(1, payload)
+}
+
+// queryPacket returns a COM_QUERY packet for the given statement.
+func queryPacket(query string) []byte {
+	return packet(0, append([]byte{comQuery}, query...))
+}
+
+func (s *MySQLSuite) TestMySQLOnDataIncomplete(c *C) {
+	conn := s.ins.CheckNewConnectionOK(c, "mysql", true, 1, 2, "1.1.1.1:34567", "10.0.0.2:3306", "no-policy")
+	data := [][]byte{{0x0, 0x0}}
+	conn.CheckOnDataOK(c, false, false, &data, []byte{}, proxylib.MORE, 2)
+}
+
+func (s *MySQLSuite) TestMySQLOnDataBasicPass(c *C) {
+
+	// allow all rule
+	s.ins.CheckInsertPolicyText(c, "1", []string{`
+		endpoint_ips: "1.1.1.1"
+		endpoint_id: 2
+		ingress_per_port_policies: <
+		  port: 3306
+		  rules: <
+		    l7_proto: "mysql"
+		  >
+		>
+		`})
+	conn := s.ins.CheckNewConnectionOK(c, "mysql", true, 1, 2, "1.1.1.1:34567", "10.0.0.2:3306", "1.1.1.1")
+	msg1 := handshakeResponse("marty", "deathstar")
+	msg2 := queryPacket("SELECT * FROM plans")
+	msg3 := queryPacket("INSERT INTO plans VALUES (1)")
+	data := [][]byte{msg1, msg2, msg3}
+	conn.CheckOnDataOK(c, false, false, &data, []byte{},
+		proxylib.PASS, len(msg1),
+		proxylib.PASS, len(msg2),
+		proxylib.PASS, len(msg3),
+		proxylib.MORE, 4)
+}
+
+func (s *MySQLSuite) TestMySQLOnDataAllowDenyStatement(c *C) {
+
+	s.ins.CheckInsertPolicyText(c, "1", []string{`
+		endpoint_ips: "1.1.1.1"
+		endpoint_id: 2
+		ingress_per_port_policies: <
+		  port: 3306
+		  rules: <
+		    l7_proto: "mysql"
+		    l7_rules: <
+		      l7_allow_rules: <
+			rule: <
+			  key: "statement"
+			  value: "select"
+			>
+		      >
+		    >
+		  >
+		>
+		`})
+	conn := s.ins.CheckNewConnectionOK(c, "mysql", true, 1, 2, "1.1.1.1:34567", "10.0.0.2:3306", "1.1.1.1")
+	msg1 := handshakeResponse("marty", "deathstar")
+	msg2 := queryPacket("SELECT * FROM plans")
+	msg3 := queryPacket("DROP TABLE plans")
+	data := [][]byte{msg1, msg2, msg3}
+	conn.CheckOnDataOK(c, false, false, &data, errPacket(1, "access denied by Cilium network policy"),
+		proxylib.PASS, len(msg1),
+		proxylib.PASS, len(msg2),
+		proxylib.DROP, len(msg3),
+		proxylib.MORE, 4)
+}
+
+func (s *MySQLSuite) TestMySQLOnDataAllowDenyDatabase(c *C) {
+
+	s.ins.CheckInsertPolicyText(c, "1", []string{`
+		endpoint_ips: "1.1.1.1"
+		endpoint_id: 2
+		ingress_per_port_policies: <
+		  port: 3306
+		  rules: <
+		    l7_proto: "mysql"
+		    l7_rules: <
+		      l7_allow_rules: <
+			rule: <
+			  key: "database"
+			  value: "reporting"
+			>
+		      >
+		    >
+		  >
+		>
+		`})
+	conn := s.ins.CheckNewConnectionOK(c, "mysql", true, 1, 2, "1.1.1.1:34567", "10.0.0.2:3306", "1.1.1.1")
+	msg1 := handshakeResponse("marty", "deathstar")
+	msg2 := queryPacket("SELECT * FROM plans")
+	data := [][]byte{msg1, msg2}
+	conn.CheckOnDataOK(c, false, false, &data, errPacket(1, "access denied by Cilium network policy"),
+		proxylib.PASS, len(msg1),
+		proxylib.DROP, len(msg2),
+		proxylib.MORE, 4)
+}
+
+func (s *MySQLSuite) TestMySQLOnDataTLSDenied(c *C) {
+	conn := s.ins.CheckNewConnectionOK(c, "mysql", true, 1, 2, "1.1.1.1:34567", "10.0.0.2:3306", "no-policy")
+	payload := make([]byte, 32)
+	binary.LittleEndian.PutUint32(payload, clientSSL)
+	msg := packet(1, payload)
+	data := [][]byte{msg}
+	conn.CheckOnDataOK(c, false, false, &data, errPacket(2, "TLS sessions are not allowed by Cilium network policy"),
+		proxylib.DROP, len(msg),
+		proxylib.MORE, 4)
+}
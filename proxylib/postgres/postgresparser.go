@@ -0,0 +1,353 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package postgres
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"regexp"
+	"strings"
+
+	cilium "github.com/cilium/proxy/go/cilium/api"
+	"github.com/sirupsen/logrus"
+
+	"github.com/cilium/cilium/proxylib/proxylib"
+)
+
+//
+// PostgreSQL v3 Parser
+//
+// Spec: https://www.postgresql.org/docs/current/protocol-message-formats.html
+//
+
+// Current PostgreSQL parser supports filtering on the user and database of a
+// session, taken from the startup message, and on the statement class of
+// simple ('Query') and extended ('Parse') protocol queries. The statement
+// class is derived from the first keyword of the query:
+// 'select', 'insert', 'update', 'delete', 'ddl' (CREATE, ALTER, DROP,
+// TRUNCATE, GRANT and REVOKE) or 'other'.
+//
+// Policy Examples:
+// {statement : "select"} - Allow reads, no other statements.
+// {database : "reporting"} - Allow any statement on the reporting database.
+// {user : "batch-.*", statement : "insert"} - Allow batch users to insert.
+//
+// Encrypted sessions cannot be parsed, so SSLRequest and GSSENCRequest
+// messages are answered with a deny ('N') on behalf of the server, causing
+// well-behaved clients to continue in cleartext. Non-query client requests,
+// including authentication responses, are automatically allowed to simplify
+// the policy language.
+
+type postgresRule struct {
+	userRegexCompiled     *regexp.Regexp
+	databaseRegexCompiled *regexp.Regexp
+	statementExact        string
+}
+
+type postgresRequestData struct {
+	user      string
+	database  string
+	statement string
+}
+
+const pgStartupHdrLen = 4  // length (uint32), includes itself
+const pgHdrLen = 5         // type (uint8), length (uint32), length includes itself
+const pgMaxLen = 268435456 // 256 MB sanity limit on a single message
+
+const (
+	pgSSLRequestCode    = 80877103
+	pgGSSENCRequestCode = 80877104
+	pgCancelRequestCode = 80877102
+)
+
+// statementClasses are the valid values of a 'statement' rule.
+var statementClasses = map[string]bool{
+	"select": true,
+	"insert": true,
+	"update": true,
+	"delete": true,
+	"ddl":    true,
+	"other":  true,
+}
+
+// statementClass returns the statement class of the given query based on its
+// first keyword.
+func statementClass(query string) string {
+	fields := strings.Fields(query)
+	if len(fields) == 0 {
+		return "other"
+	}
+	switch strings.ToUpper(fields[0]) {
+	case "SELECT", "WITH":
+		return "select"
+	case "INSERT":
+		return "insert"
+	case "UPDATE":
+		return "update"
+	case "DELETE":
+		return "delete"
+	case "CREATE", "ALTER", "DROP", "TRUNCATE", "GRANT", "REVOKE":
+		return "ddl"
+	default:
+		return "other"
+	}
+}
+
+func (rule *postgresRule) Matches(data interface{}) bool {
+	reqData, ok := data.(postgresRequestData)
+	if !ok {
+		logrus.Warning("Matches() called with type other than postgresRequestData")
+		return false
+	}
+	if rule.userRegexCompiled != nil &&
+		!rule.userRegexCompiled.MatchString(reqData.user) {
+		logrus.Debugf("PostgresRule: user mismatch %s, %s", rule.userRegexCompiled.String(), reqData.user)
+		return false
+	}
+	if rule.databaseRegexCompiled != nil &&
+		!rule.databaseRegexCompiled.MatchString(reqData.database) {
+		logrus.Debugf("PostgresRule: database mismatch %s, %s", rule.databaseRegexCompiled.String(), reqData.database)
+		return false
+	}
+	if len(rule.statementExact) > 0 && rule.statementExact != reqData.statement {
+		logrus.Debugf("PostgresRule: statement mismatch %s, %s", rule.statementExact, reqData.statement)
+		return false
+	}
+	return true
+}
+
+// ruleParser parses protobuf L7 rules to enforcement objects
+// May panic
+func ruleParser(rule *cilium.PortNetworkPolicyRule) []proxylib.L7NetworkPolicyRule {
+	l7Rules := rule.GetL7Rules()
+	if l7Rules == nil {
+		return nil
+	}
+
+	allowRules := l7Rules.GetL7AllowRules()
+	rules := make([]proxylib.L7NetworkPolicyRule, 0, len(allowRules))
+	for _, l7Rule := range allowRules {
+		var pr postgresRule
+		for k, v := range l7Rule.Rule {
+			switch k {
+			case "user":
+				if v != "" {
+					pr.userRegexCompiled = regexp.MustCompile(v)
+				}
+			case "database":
+				if v != "" {
+					pr.databaseRegexCompiled = regexp.MustCompile(v)
+				}
+			case "statement":
+				pr.statementExact = v
+			default:
+				proxylib.ParseError(fmt.Sprintf("Unsupported key: %s", k), rule)
+			}
+		}
+		if pr.statementExact != "" && !statementClasses[pr.statementExact] {
+			proxylib.ParseError(fmt.Sprintf("Unable to parse L7 postgres rule with invalid statement: '%s'", pr.statementExact), rule)
+		}
+		logrus.Debugf("Parsed PostgresRule pair: %v", pr)
+		rules = append(rules, &pr)
+	}
+	return rules
+}
+
+type factory struct{}
+
+func init() {
+	logrus.Debug("init(): Registering postgresParserFactory")
+	proxylib.RegisterParserFactory("postgres", &factory{})
+	proxylib.RegisterL7RuleParser("postgres", ruleParser)
+}
+
+type parser struct {
+	connection *proxylib.Connection
+
+	// startupDone is set once the startup message has been seen,
+	// subsequent messages carry a type byte.
+	startupDone bool
+
+	// user and database of the session, from the startup message.
+	user     string
+	database string
+}
+
+func (f *factory) Create(connection *proxylib.Connection) interface{} {
+	logrus.Debugf("PostgresParserFactory: Create: %v", connection)
+
+	return &parser{connection: connection}
+}
+
+func (p *parser) OnData(reply, endStream bool, dataArray [][]byte) (proxylib.OpType, int) {
+
+	// inefficient, but simple for now
+	data := bytes.Join(dataArray, []byte{})
+
+	// we don't process reply traffic for now
+	if reply {
+		if len(data) == 0 {
+			return proxylib.NOP, 0
+		}
+		logrus.Debugf("reply, passing %d bytes", len(data))
+		return proxylib.PASS, len(data)
+	}
+
+	if !p.startupDone {
+		return p.onStartupMessage(data)
+	}
+
+	if len(data) < pgHdrLen {
+		// Partial header received, ask for more
+		return proxylib.MORE, pgHdrLen - len(data)
+	}
+
+	msgLen := binary.BigEndian.Uint32(data[1:pgHdrLen])
+	if msgLen < pgStartupHdrLen || msgLen > pgMaxLen {
+		logrus.Errorf("Invalid message length %d", msgLen)
+		return proxylib.ERROR, int(proxylib.ERROR_INVALID_FRAME_LENGTH)
+	}
+	requestLen := 1 + int(msgLen) // type byte is not included in the length
+
+	dataMissing := requestLen - len(data)
+	if dataMissing > 0 {
+		logrus.Debugf("Hdr received, but need %d more bytes of message", dataMissing)
+		return proxylib.MORE, dataMissing
+	}
+
+	var query string
+	switch data[0] {
+	case 'Q': // simple query
+		query = string(bytes.TrimRight(data[pgHdrLen:requestLen], "\x00"))
+	case 'P': // extended protocol Parse, the query follows the statement name
+		parts := bytes.SplitN(data[pgHdrLen:requestLen], []byte{0}, 3)
+		if len(parts) < 2 {
+			logrus.Errorf("Invalid Parse message")
+			return proxylib.ERROR, int(proxylib.ERROR_INVALID_FRAME_TYPE)
+		}
+		query = string(parts[1])
+	default:
+		// non-query message, always allowed
+		return proxylib.PASS, requestLen
+	}
+
+	reqData := postgresRequestData{
+		user:      p.user,
+		database:  p.database,
+		statement: statementClass(query),
+	}
+
+	matches := true
+	access_log_entry_type := cilium.EntryType_Request
+
+	if !p.connection.Matches(reqData) {
+		matches = false
+		access_log_entry_type = cilium.EntryType_Denied
+	}
+
+	p.connection.Log(access_log_entry_type,
+		&cilium.LogEntry_GenericL7{
+			GenericL7: &cilium.L7LogEntry{
+				Proto: "postgres",
+				Fields: map[string]string{
+					"user":      reqData.user,
+					"database":  reqData.database,
+					"statement": reqData.statement,
+				},
+			},
+		})
+
+	if !matches {
+		p.connection.Inject(true, accessDeniedMsg)
+		logrus.Debugf("Policy mismatch, dropping %d bytes", requestLen)
+		return proxylib.DROP, requestLen
+	}
+
+	return proxylib.PASS, requestLen
+}
+
+// onStartupMessage handles the first, untyped message of a session.
+func (p *parser) onStartupMessage(data []byte) (proxylib.OpType, int) {
+	if len(data) < pgStartupHdrLen {
+		return proxylib.MORE, pgStartupHdrLen - len(data)
+	}
+
+	requestLen := binary.BigEndian.Uint32(data[0:pgStartupHdrLen])
+	if requestLen < 8 || requestLen > pgMaxLen {
+		logrus.Errorf("Invalid startup message length %d", requestLen)
+		return proxylib.ERROR, int(proxylib.ERROR_INVALID_FRAME_LENGTH)
+	}
+
+	dataMissing := int(requestLen) - len(data)
+	if dataMissing > 0 {
+		logrus.Debugf("Startup hdr received, but need %d more bytes", dataMissing)
+		return proxylib.MORE, dataMissing
+	}
+
+	code := binary.BigEndian.Uint32(data[4:8])
+	switch code {
+	case pgSSLRequestCode, pgGSSENCRequestCode:
+		// Encrypted sessions cannot be parsed. Deny encryption on
+		// behalf of the server, the client continues in cleartext.
+		logrus.Debugf("Denying encryption request %d", code)
+		p.connection.Inject(true, []byte{'N'})
+		return proxylib.DROP, int(requestLen)
+	case pgCancelRequestCode:
+		return proxylib.PASS, int(requestLen)
+	}
+
+	if code>>16 != 3 {
+		logrus.Errorf("Unsupported protocol version %d", code)
+		return proxylib.ERROR, int(proxylib.ERROR_INVALID_FRAME_TYPE)
+	}
+
+	// the protocol version is followed by key/value parameter pairs
+	parts := bytes.Split(data[8:requestLen], []byte{0})
+	for i := 0; i+1 < len(parts); i += 2 {
+		switch string(parts[i]) {
+		case "user":
+			p.user = string(parts[i+1])
+		case "database":
+			p.database = string(parts[i+1])
+		}
+	}
+	if p.database == "" {
+		// per spec the database defaults to the user name
+		p.database = p.user
+	}
+	p.startupDone = true
+
+	logrus.Debugf("Startup message for user '%s', database '%s'", p.user, p.database)
+	return proxylib.PASS, int(requestLen)
+}
+
+// accessDeniedMsg is an ErrorResponse followed by a ReadyForQuery, sent to
+// the client when a query is denied by policy.
+var accessDeniedMsg = accessDeniedResponse()
+
+func accessDeniedResponse() []byte {
+	var body bytes.Buffer
+	for _, field := range []struct {
+		code  byte
+		value string
+	}{
+		{'S', "ERROR"},
+		{'C', "42501"}, // insufficient_privilege
+		{'M', "access denied by Cilium network policy"},
+	} {
+		body.WriteByte(field.code)
+		body.WriteString(field.value)
+		body.WriteByte(0)
+	}
+	body.WriteByte(0)
+
+	msg := make([]byte, 0, pgHdrLen+body.Len()+6)
+	msg = append(msg, 'E')
+	msg = append(msg, 0, 0, 0, 0)
+	binary.BigEndian.PutUint32(msg[1:pgHdrLen], uint32(pgStartupHdrLen+body.Len()))
+	msg = append(msg, body.Bytes()...)
+	// ReadyForQuery (idle), the denied query left no open transaction
+	return append(msg, 'Z', 0, 0, 0, 5, 'I')
+}
@@ -0,0 +1,170 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package postgres
+
+import (
+	"encoding/binary"
+	"testing"
+
+	. "gopkg.in/check.v1"
+
+	"github.com/cilium/cilium/proxylib/accesslog"
+	"github.com/cilium/cilium/proxylib/proxylib"
+	"github.com/cilium/cilium/proxylib/test"
+)
+
+// Hook up gocheck into the "go test" runner.
+func Test(t *testing.T) {
+	TestingT(t)
+}
+
+type PostgresSuite struct {
+	logServer *test.AccessLogServer
+	ins       *proxylib.Instance
+}
+
+var _ = Suite(&PostgresSuite{})
+
+// Set up access log server and Library instance for all the test cases
+func (s *PostgresSuite) SetUpSuite(c *C) {
+	s.logServer = test.StartAccessLogServer("access_log.sock", 10)
+	c.Assert(s.logServer, Not(IsNil))
+	s.ins = proxylib.NewInstance("node1", accesslog.NewClient(s.logServer.Path))
+	c.Assert(s.ins, Not(IsNil))
+}
+
+func (s *PostgresSuite) TearDownTest(c *C) {
+	s.logServer.Clear()
+}
+
+func (s *PostgresSuite) TearDownSuite(c *C) {
+	s.logServer.Close()
+}
+
+// startupMessage returns a startup message with the given parameter pairs.
+func startupMessage(params ...string) []byte {
+	body := []byte{0x0, 0x3, 0x0, 0x0} // protocol version 3.0
+	for _, param := range params {
+		body = append(body, param...)
+		body = append(body, 0)
+	}
+	body = append(body, 0)
+
+	msg := make([]byte, 4, 4+len(body))
+	binary.BigEndian.PutUint32(msg, uint32(4+len(body)))
+	return append(msg, body...)
+}
+
+// queryMessage returns a simple query message for the given statement.
+func queryMessage(query string) []byte {
+	msg := make([]byte, 5, 5+len(query)+1)
+	msg[0] = 'Q'
+	binary.BigEndian.PutUint32(msg[1:], uint32(4+len(query)+1))
+	msg = append(msg, query...)
+	return append(msg, 0)
+}
+
+func (s *PostgresSuite) TestPostgresOnDataIncomplete(c *C) {
+	conn := s.ins.CheckNewConnectionOK(c, "postgres", true, 1, 2, "1.1.1.1:34567", "10.0.0.2:5432", "no-policy")
+	data := [][]byte{{0x0, 0x0}}
+	conn.CheckOnDataOK(c, false, false, &data, []byte{}, proxylib.MORE, 2)
+}
+
+func (s *PostgresSuite) TestPostgresOnDataBasicPass(c *C) {
+
+	// allow all rule
+	s.ins.CheckInsertPolicyText(c, "1", []string{`
+		endpoint_ips: "1.1.1.1"
+		endpoint_id: 2
+		ingress_per_port_policies: <
+		  port: 5432
+		  rules: <
+		    l7_proto: "postgres"
+		  >
+		>
+		`})
+	conn := s.ins.CheckNewConnectionOK(c, "postgres", true, 1, 2, "1.1.1.1:34567", "10.0.0.2:5432", "1.1.1.1")
+	msg1 := startupMessage("user", "marty", "database", "deathstar")
+	msg2 := queryMessage("SELECT * FROM plans")
+	msg3 := queryMessage("INSERT INTO plans VALUES (1)")
+	data := [][]byte{msg1, msg2, msg3}
+	conn.CheckOnDataOK(c, false, false, &data, []byte{},
+		proxylib.PASS, len(msg1),
+		proxylib.PASS, len(msg2),
+		proxylib.PASS, len(msg3),
+		proxylib.MORE, 5)
+}
+
+func (s *PostgresSuite) TestPostgresOnDataAllowDenyStatement(c *C) {
+
+	s.ins.CheckInsertPolicyText(c, "1", []string{`
+		endpoint_ips: "1.1.1.1"
+		endpoint_id: 2
+		ingress_per_port_policies: <
+		  port: 5432
+		  rules: <
+		    l7_proto: "postgres"
+		    l7_rules: <
+		      l7_allow_rules: <
+			rule: <
+			  key: "statement"
+			  value: "select"
+			>
+		      >
+		    >
+		  >
+		>
+		`})
+	conn := s.ins.CheckNewConnectionOK(c, "postgres", true, 1, 2, "1.1.1.1:34567", "10.0.0.2:5432", "1.1.1.1")
+	msg1 := startupMessage("user", "marty", "database", "deathstar")
+	msg2 := queryMessage("SELECT * FROM plans")
+	msg3 := queryMessage("DROP TABLE plans")
+	data := [][]byte{msg1, msg2, msg3}
+	conn.CheckOnDataOK(c, false, false, &data, accessDeniedMsg,
+		proxylib.PASS, len(msg1),
+		proxylib.PASS, len(msg2),
+		proxylib.DROP, len(msg3),
+		proxylib.MORE, 5)
+}
+
+func (s *PostgresSuite) TestPostgresOnDataAllowDenyUser(c *C) {
+
+	s.ins.CheckInsertPolicyText(c, "1", []string{`
+		endpoint_ips: "1.1.1.1"
+		endpoint_id: 2
+		ingress_per_port_policies: <
+		  port: 5432
+		  rules: <
+		    l7_proto: "postgres"
+		    l7_rules: <
+		      l7_allow_rules: <
+			rule: <
+			  key: "user"
+			  value: "batch-.*"
+			>
+		      >
+		    >
+		  >
+		>
+		`})
+	conn := s.ins.CheckNewConnectionOK(c, "postgres", true, 1, 2, "1.1.1.1:34567", "10.0.0.2:5432", "1.1.1.1")
+	msg1 := startupMessage("user", "marty")
+	msg2 := queryMessage("SELECT * FROM plans")
+	data := [][]byte{msg1, msg2}
+	conn.CheckOnDataOK(c, false, false, &data, accessDeniedMsg,
+		proxylib.PASS, len(msg1),
+		proxylib.DROP, len(msg2),
+		proxylib.MORE, 5)
+}
+
+func (s *PostgresSuite) TestPostgresOnDataSSLRequestDenied(c *C) {
+	conn := s.ins.CheckNewConnectionOK(c, "postgres", true, 1, 2, "1.1.1.1:34567", "10.0.0.2:5432", "no-policy")
+	sslRequest := make([]byte, 8)
+	binary.BigEndian.PutUint32(sslRequest, 8)
+	binary.BigEndian.PutUint32(sslRequest[4:], pgSSLRequestCode)
+	data := [][]byte{sslRequest}
+	conn.CheckOnDataOK(c, false, false, &data, []byte{'N'},
+		proxylib.DROP, len(sslRequest),
+		proxylib.MORE, 4)
+}